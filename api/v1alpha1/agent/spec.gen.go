@@ -19,102 +19,151 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/W7ctpb4qxC6BdL2N55x8sstWgMXC9d2WqNJbdhxF3c73gVHOjPDa4lUSMrOtDCw",
-	"r7Gvt0+y4CEpURI11jh2LorevzIWP87h4fnmIfN7koqiFBy4VsnB74lK11BQ/HlYljlLqWaCn/DbX6jE",
-	"r6UUJUjNAP+CpoFmGTN9aX7e6qI3JSQHidKS8VVyP0kyUKlkpembHCQn/JZJwQvgmtxSyegiB3IDm71b",
-	"mldASsqkmhDG/wGphoxklZmGyIprVkAy8dOLhemQ3N/3vkzChVyWkCKyeX62TA5+/T35QsIyOUj+Mmvo",
-	"MHNEmEUocD/pkoDTAsy/7WW9XwMxLUQsiV4Doc1UDdKeJhGkf08EhxEonhZ0BQGe51Lcsgxkcn99f/0A",
-	"LTTVlXqPPcxOVkVy8GtyLqGkiNYkudRUavvzouLc/jqRUshkklzxGy7uzGqORFHmoCFLrrtLmyQf98zM",
-	"e7dUGnIoA6KHQwiz1xgg0WtrsOo1eTR7DQ3evaZgIW1SqcuqKKjcxEn2I9BcrzfJJDmGlaQZZBEy7Uya",
-	"NswGxmCXAPhgnwhV2h1qdO8nydH51QUoUckU3gnOtJC7iU9s8D1OLLjVFX25qZtIKrimjCuSgaYsV2Qp",
-	"JBEcCFUlpNoLVlpJaXSH0lQ7aWOKHJ6fEg9+mkw6IptTpd9LyhVCes+GBNj0I0bPWEg1aroeCxlZSlEg",
-	"XgoJSLQglAu9BmkAL4UsqE4Okoxq2GvrrEYlFqAUXUWw+LEqKCcSaIZ60fUjjGe4e3xVU4cuRKUdxjV6",
-	"0xgwsVAgbyH7AThIGt8Gs/ppAZpmVNPpqu5J9JrqDjXuqCIKNFlQBRmpSgu2Xjjj+pvXDR6Ma1gZ/TRJ",
-	"JFAVA/7lQjJYfkVsO+57C+ILNWqddj/M9NuYtGY4y/9JrYtHDkNlcI+r+VAxCZkRY5yhxmASY7h6+c3u",
-	"x/R1F71A7byXlZnmDc0V7KxoOvO6uTpf/dSdzy0d0aJDgN1hWUpx67WR/3kMnOGPN5TltjFNQSm2yKH7",
-	"h5ffcyoVdr3c8BR/nN2CzGlZMr66hBxSLaSh8i80Z6b5qsyosxhG5/jP76pcszKHszsOQf9x9DrhUuS5",
-	"8VIu4EMFSgeLOjKaZWkEEi7ZyhikHfrUFBnsUZPqAkqhjCbdROlkyDPY0CNm2FgT9k0OoAeoi22elsdw",
-	"y1IICG0/hOS2X3pEfw9FmVMNv4BUTHC3B5aTlmzl/RdvacZ5QT8wHRluvKhto36qFiA5aFCXkErQOw0+",
-	"5Tnj8AioP2pdxoYZGlia9TWi/U4klBKUmY1QUq43iqU0Jxk29q0cLZkjcn/Cw/NT10YyWDIOClXsrf0G",
-	"GbHY1va0hmytgFgSyonVUlNyacyJVEStRZVnRk/fgtREQipWnP1Wz4a2UaNd1aA0MaZAcpoTdPUnhPKM",
-	"FHRDJJh5ScWDGbCLmpJ3QhrztxQHZK11qQ5msxXT05tv1ZQJQ+6i4kxvZsZ7kGxRGeadZXAL+Uyx1R6V",
-	"6ZppSHUlYUZLtofIcvSDpkX2F+mkQsUMyg3jWZ+UPzGeEWZ2xPa0qDYUM5/Moi9OLt8TP7+lqiVgsK0N",
-	"LQ0dGF+CtD3RyTCzAM9KwbizwTlD16daFEybTUJ9Ycg8JUeUc6HJAkhlpBSyKTnl5IgWkB9RBc9OSUM9",
-	"tWdIpuIej/UtHrKzZ0iid6ApmnSnFbaNaDTReCfAjXEeQMeYB3LkeCBAP2az7Wy96KIfPcdDx47PNxBF",
-	"Rl0eM2gzEIxWxQKkmcg51obL7tYsXRMqAcEZjhsJRpmgTPUh/VxD8X2IdzdrPy4+e+AXjtuzeCTb3Twk",
-	"sSdMgHkNZdQGtmOk/kYaMXpwI00n4xNbpWu8dq8a0JtVG6WhCKnzNA7u9jC2S68HqWJN1xAhJPAMJGSD",
-	"hsdbHcfQmTdsdpjhzSVbTaMpkhDNLpyt+CqRQx/V1cX50YnTptE8lTKelOCnx5HWDjqtucKRw3j9KMSN",
-	"8l5Ox3AvNcgLWAiBzlWfr8xQAh8hrTRkBLsT6fsT4MhuaaW0KAhNcefRuKKMuUDujuk1wTDVcZ6acyGJ",
-	"kVWWGkv7fg0K6uEiTSvpQAUbt6bKQYZsQmieizuDghH1Uii9Z9uIpupGTedGgTIDapw+tiQwq/Xa3NGS",
-	"Skk35m/Ep/ZCxxGqct2fn06WmSs3UbqmfAWKrOktkAUAt6IOmfeKnB+3K5Vw+bCNSgtYCgnjGcr2DzgK",
-	"9xU39TmI5cAFXMUapnoGprHwRnONQ69mm89CjDjrGEP9eZjmflBvneIKmR60hcramHF4dGZz9qlvldz3",
-	"67FoXTZIfKKltnm12kozD+dpjPM25B9nn7fMFWb5qVLtPEyTFr/iqipLIccn9KOQaxDR1hputLVBZqA5",
-	"wLBe+dll3JyyIppaFUpLAIKtzsmW5Ori7cPOh51weAvOLgf9xDgqHafo7NJiFeUrbDlmK1A67uhn2Nad",
-	"i3wJ09WUqDV99ddvDuj+dDr9auRC2zCHl93RvH23xiq6ONZeC2p6A9xrQaNRrSl1zrG1ClYR+rhiSk6o",
-	"CWJwAmM6as3tohkhM+u0bHCczXdk07EK0yzoECePGZPWSiJOpA/xthPak2YbcV26boCz0rIaax/DiayO",
-	"mSQZUzefMr6AQozV+bEZOvQwq6knddiNpc3w6dy/U+lOC48k0yyl+aPP6WKAw2PAfmsDPNYaIBRr9kjG",
-	"2sJsfJD76ItfEAf2ZfAtszoj7DVaRLoH7BE5sQ7NMFzbTkqXEh0PO5qB7YFfG59uHHs2gdn9JBEjBznb",
-	"Y3MjLoPYT7oabFxuxGYBC3sy2nb3xq+9c8AaW7hVnFmfHQqq0/U51Rqk5YcaYkE/vgW+0uvk4NVfv5kk",
-	"pe2UHCT/+Svd++1w7z/29747mM/3/ms6n8/nX19//UXMUD3kUA67mI2Oi2XBbWuYC4+7a+441vC095KJ",
-	"G1tQdN9YbvNRqa5o3hwg0y0Z9TEi5IKYMJFjcZnu5qP3E4ixCLif3dl59k52y0qrPdVTW07ogz2wdhYN",
-	"MnXRjKFj9Hw+JO9YCXfVAlv1ysNLbqWujCvlvcpHeelmBhMSXAKg6R930r+DQqmhtFTKrvYVlcAujNFj",
-	"BqtCTl3gNGKCpv/9JHGnD7uEpdlAIj7gyhZWbSlI4kIRkjHc+pqFcG8afBuqBds87IN8hgSx0yu+zOTp",
-	"ws8nyApvrY86w6PSeHlUk5WaJOfiDiRkZ8vlI/2xFhYB1F5bgEikte1ttZpCdCPNrRVE2iO+Wku4ovau",
-	"7uFOGQGtDMvUrKpYhoeqFWcfKsg3hGXANVtuwtxQ34wFR3fxaOww6GG0PIbaZNGdtsd1hjg2X96e83sh",
-	"NDk93mUqgzAm3Oz643ie+U7k0geIIwF0A7CQJPU6+lgMS0Ano/bI6FdgAEzu1mBjV1VCypYMMrJkORCH",
-	"DmYb/+gh8CQR/A2zJzOjsDCdzzwBYoiU1PisMfqaFkNc729j9tYlVRnvZFsNpTE7y5QdmFJO3KG7IMAw",
-	"o0v91qRuZyShnBjhM/RlEstmNiMY78HIv20Tnzyh6ayKNXtPaVVaeD/OqvSnCKzKVfleHFNtxPWs0mdL",
-	"9zuoSXqMCWmBDEBEWkOo0cGd4qh2a2gJmLp5+kLbSZcnLh3DOi4X0osDlpEydUMq5bKObRYblqua0aMS",
-	"1p5zuxwgjD4nGPL0Su/6uPS6tEulXGEMIkWxJo/mKMs4bGvA968Sqn+VUP3pSqh64rRbNVV/+CMKqxym",
-	"MeMwUItL82jS01bg9njOt/haelDG60LbbvjCq4w1VfVhPfYPVNlCiBwod2kYbD3Uw5AOteFxMzleKaDa",
-	"lV+F4O6oakEal1TwI77fDEP/fuOhdwrKTKuMWvucLiD/lLtddoJW2OI+aYH5sU3nGDt6n6vNMm4/R/GF",
-	"t6IPGAvTzSIZdLSpql7fF4poKlfgElp9k5Eq2QeZKmkBnJ+82wOeigwycv7T0eVfXu6TtCn8JspWfnt+",
-	"iG5L1kmSji9sfIItPexupL8P4soayB0zFrXZW6a8i4lBjVGyUBMVidIUyW/fe0PZcds+kD8e6LhbKrk3",
-	"STRNXKujnfRkrcfuJ0nAFRF+Climx1eGhyAL2SrKRltzvP1LVRBf+admcIdTfNGtxsxM/yxj6PoU9ve3",
-	"ph70Qet7OPeTpB1sRp1fM5mhTR2UW2EwKryueRU2/jYhoqGWj12OJNi44QIKcVuHLVAnxEbGLC0s60lb",
-	"X2sIra81uE5fC9utP57IMM4M8IHigzKnjBMNHzX58ur9m71vvzKR8YIq+OZ1zaBuBs9XnjgxDjX9Tsyw",
-	"gUqtO38zTFtXXxr3DqFMybtKofPmIvZ5gsjNE4PRPLE4zZMpOYYlrXL0+ZpO4W7hp2TihvS35n6SrKSo",
-	"yjhJzPJeKII9JkFCxycSjPj68hNeFSBZSk6Pu2hJIbTFqu8HigyGQf/vf/+PIiXIgmFNKjG9p+TvokL/",
-	"2KJjc2WF8WaXtGA5o5KIVNPc1rBRkgM1O0B+AylsJcmE7H/z+jXuLlVzbkxnygo3wujN+KDXr/a/Mh66",
-	"rlg2U6BX5h/N0psNWTC3gXVtz5ScLonxwGuiTebcYNpZDsZ1mLUxkVhNNIOgLYzrl5gPh7R0oURe6SZn",
-	"5FnUy7I/S/xZaLAST/mGwEemME7BrmgEF0CMa3UnmdYQz6dUCuRWrhF3HOQzcE0s+q4FLqp641es+lXV",
-	"TF8YQ9BT0aLi+rymOiKZHCSzpOtgnDuyu4IAxh3BY+Tzuxi5oOBvyj18M7/pG4SWglQKDJXR/G94SmzL",
-	"nEePutEjvIBbpuJJ0F71eo1eb/BkKBUyGfnSQKeS4sG9dzck3MbF4Abp39aNvM5TEJhzposcxqeTT+ox",
-	"NsLsoBZMed1/eCEobRgHzebwsygoP1n81YQYxlsfw+g4zZyI0jrbJHdVAj+d/P1vvxy+vTqxT1wYljOe",
-	"NTXucf9FDFXfnmlo0nK/Hii/mCSyGnBjUlEUlGNB8QLqk4MJYTzNK1TgRr9RuaoKtLGVMt+UpjyjMiNq",
-	"DXluRETTjy5pvmSQZ16NK1K4K6AekiIlK7GCeYXx9sQsmi3t8cQdyAYJUvEMc+0LqtZkL7WG/mM8LLoT",
-	"8uaYyYcSlYwHYXdDzFply4rbVBFbEoYBSg5LTaAo9cZ8wH51JzOJUeKKrEWxU+Lf7MdYVtstGxww/Kj7",
-	"qDHexsRrZ6Iev2tWgKgGPMGCfmRFVZDMH6tg3Xx4DcyeVqGqt89uTMmc42b5IS4bugjPwdDyofpkt0Cc",
-	"SSdzvhRu/sWGUJthqTjTU3Lp3YnmI/oZB3O+R16oF4iQAhN5KPxU2E8F45UG+2ltP61FJe2HzH7I6EbN",
-	"nc6ua6Re7n13PZ9nX/+qinV2/cWo516SuJb6lD1v75VZ9s6a8soM6jIuzhTP1McnOHjcizlOI+OGGd+r",
-	"kdqGGYLzUC+/JUgTwhvvEZVRw0NW4GmqW2BweuNtTYiq0jUq4I/UMOTUpTTQDa0TZ0yhS1qKssopcpVv",
-	"8RjQSguSMZUa788/EFJ7kca6bzvwHjwjrs8bPWGCxWvh1+291IZGKAWhqfBhzQnef0rw/Mn9wrd28F9R",
-	"2jcC3IcLyAXFcgkKhQkU8c9xQarjhRqc+zuA6jjeA/d/Ig7urwaV+oPDyE/XQixiAP9g9sG5ZQFXRK1F",
-	"/DGBnsittS6jfrnhyfPt5+ZBmE7u1uCuaklQpeAKBUJpIZtiA4zEbDlG65bnNO48f2ZfXVXLJfvYB3VO",
-	"ZZ2RuLp4ayO7VBSggluPC6qwdUpONZYFWCcJyIcK8BBU0gI0HuRZXXIw5zNDxJkWM3/u9G/Y+W/Yec5H",
-	"XHUNgoV6uz57fOA5KAZ48BGysRdgLmAJErjdTZ/RwTvq7vZK5O44KWl6MyatN3xdZ/ANj0iVBRaT7VKq",
-	"MlSK/qy75PCMLXbrayePtNEPYjlJFAJ7OCcwvmwIDUtJ0xH3ahxVmhGTAOj1QwcObnSzghhZ3+Elled5",
-	"Jy04ue1tRdNmNLA/NnUJqTw3/oBiyjgo9YE8KSo80byFibNxTn0pHGHXpJy9wr4pppQjJxycC904K488",
-	"S2o62/fDNuFBUuQscJIgPu4FLaVpUY4vvM4gh0cOXW15KO2QKPhQoepyL2y0qhaCMrHgEbXaLCrDau4k",
-	"kZzXLqWnBBrRKbkAmu0Jnm9Gvqv2yYd872hpcHTFGDewsZebbQGJs4yUY2GEsleRhVxRzn6zV/ZSqmEl",
-	"pPnzS5WK0n5V+JbUV57Novsb1zqhxnF9Y/7zHY8lUw/DghGqibgz/rItyLHfJ8ZxmWMBwsyAmifEEnno",
-	"7RMcNVwXxIko6YcKPP0QrCvMZa5KCI+H5AsVFPA0d0CbuqBxseOFewzj87xz+s97u9Svc5dbbiPvUcUJ",
-	"uPW+Sey4yr80MuouCnb+zHfTeq+zDPL3H/f+2mNuou36tozH/DAHqS+qWDa4Uxbd1UrrqqB8r67Q7VSe",
-	"oK9r5o5XgFRD5ujYp87CSiNxCzIIauktSONTV/Yp0eC02l+7NoAZX03JG9SDB/18W5ht6+TQJt0M2qSd",
-	"P5u202Xzefb/flXF+jp6ibAEmQLX0aDhvT0JdO2GanZFtiRFstXK+DExSlpLbb3TWxhzA6y135duULyo",
-	"2c8YbFNrHW1j+yBztYAF2ZvoZWa8RzIuKzMIpJl4sEsAcbCPRSVYjRdys4/MEKBgnLoPhX1u0vw8Or8a",
-	"LCOJv0dsC6gHdeBAcbX33IfGDfv197U3vPkZbWLi1KC/DD/O+g2s5qF0/Ta8HrAGA5S4j+zSgHH12m6b",
-	"ccBORFZ4ieKM5xv7aDN+LcGoCcskWLhktcjOBqNRuxGTEe5G9F0vWpQ546tT43q5Mq0BLboAfQfAazuH",
-	"Q826PoNibJ0jDBwjtKqXgmVPwq2KrDgS+uJ9WHuVJGcpcAWN05ccljRdA3k13U8mSSXz5CDxBc93d3dT",
-	"is1TIVczN1bN3p4enfx8ebL3aro/XesCa9o008ZSJmclcOLeNH1HOV0BHnYenp+SPUJX5jc0r6ndem8l",
-	"qbi7ueTy5ZyWLDlI/v90f/rSHZwjC81oyWa3L2c276hmv5tl3M+8Ycc6BYicW63A1vstqzyvA7fmukQ7",
-	"r16XJdQp2tMsOUh+AB3xUw1yPjeImqHzbGIQ4dTzMtPiKjPcPtSvGfpt17KCiftPG6LO+eCz5nj9hHR9",
-	"HQcVM5QNWOx70es6DPYa/UjMD+OGvNrf75SOBX767B/uFfBmvjHOevjO530vhD37yfDIq/3XkTcqhS8Y",
-	"M11e7798MtRseWIEmytOK73GkDizQF8/P9CfhX4jKu4Afvf8AP1/osCXOfMvPNAVehuOqa/NtwHpbO4W",
-	"lLEzZQllTtOwFrctjsdxcbyww1p10A8IY5huOH5KYby2nUHp74V9pvVJ9sPheN82CAaZ+2cUwxBqTPRe",
-	"PyGsQY77nmbEXwr7k8jyA0LV1Nb7q0woUUJFRcpeOgnq8bHEfUCUbH1x/zbe83B1H84oBn/53Ah0CuWR",
-	"Jpm1Nd9+XtiHuX20+cLdef+TSd0/16D15OwhMXRmbtD3NHvZMWkNF0TMGs1ikrjVsNnDeb4CWUrW1N/H",
-	"5nkyc/dM1meUgHhD9KcyClHGxEwX3opFtrAR3MxE/v8XAAD//z2GPf8AbgAA",
+	"H4sIAAAAAAAC/+y9fW/kuPEg/FUIJcDu5mm3PZPJIjHwwwOP7c36Zjxu+GUPuXhuwZaquxlLpJak7Old",
+	"GLivcV/vPsmh+CJREtWttsezF2T+slt8qWKxSBbrjb8lqShKwYFrlRz+lqh0BQU1/x6VZc5Sqpngp/z+",
+	"JyrN11KKEqRmYH5BU0CzjGFdms9aVfS6hOQwUVoyvkweJ0kGKpWsxLrJYXLK75kUvACuyT2VjM5zIHew",
+	"3runeQWkpEyqCWH8X5BqyEhWYTdEVlyzApKJ717MsULy+Nj7MgkHclVCapDN84tFcvjP35I/Slgkh8kf",
+	"9hs67Dsi7Eco8DjpkoDTAvBve1jXKyBYQsSC6BUQ2nSVTLo0iSD9WyI4jEDxrKBLCPCcSXHPMpDJ48fH",
+	"j1tooamu1LWpgTNZFcnhP5OZhJIatCbJlaZS238vK87tf6dSCplMkht+x8UDjuZYFGUOGrLkY3dok+TT",
+	"Hva8d08lkkMhiB4OIcxeYYBEr6zBqlfk0ewVNHj3ioKBtEmlrqqioHIdJ9mPQHO9WieT5ASWkmaQRci0",
+	"M2naMBsYg1UC4IN1IlRpV6jRfZwkx7ObS1CikimcC860kLstn1jjR9Ox4Hav6K+buoikgmvKuCIZaMpy",
+	"RRZCEsGBUFVCqv3CSispgWuiNNVutTFFjmZnxIOfJpPOks2p0teScmUgXbOhBYz1CO4zFlKNmq7bQkYW",
+	"UhQGL2UISLQglAu9AomAF0IWVCeHSUY17LX3rGZLLEApuoxg8WNVUE4k0Mzsi64eYTwzs8eXNXXoXFTa",
+	"YVyjN40BE3MF8h6yvwMHSePTgKOfFqBpRjWdLuuaRK+o7lDjgSqiQJM5VZCRqhS8NXDG9fdvGjwY17DE",
+	"/WmSSKAqBvzbuWSw+I7YcjPvLYjfqFHjtPORHG5m0prhLP8n9V48spnZDB7NaH6pmIQMl7HpocZgEmO4",
+	"evjN7Mf26y56wbZzLSvs5geaK9h5o+n06/rqfPVddz639ogWHQLsjspSinuzG13Pzn8CyRbM702+4AS4",
+	"/fYDZbktTFNQis1z6P7wq3lGpTJVr9Y8Nf9c3IPMaVkyvryCHFItJNL8J5ozLL4pM+rOD9yB/OfzKtes",
+	"zOHigYOpf8JUKjg3YkYySWa0snBsg3FUPeVS5DnKMpfwSwVKB4PtlbXJcgxSswWuarhiSzzV+l0M1qkJ",
+	"OVijpvAllELhdryOkhepOljQm4OwsJ6PH3IAPTAppsxPwQncsxSC+bEfwlmyX/pzZT53Zsx+rOftGooy",
+	"pxp+AqmY4G4aLcsu2NILSv5IGydu/Z3pSPPHyeZW76o5SA4a1BWkEvROjc94zjg8AeqPWpdPaHaRsie0",
+	"+olWuY6N7uOjn5n+Rm+/EwmlBIXdEUrK1VqxlOYkM4X9w5uWzE1pv8Oj2ZkrIxksGAdlTo57+w0yYtGt",
+	"xYQasj3cxIJQTuzmOyVXeEpKRdRKVHmGx889SE0kpGLJ2a91b+bI10Zc0KA0YVyD5DQn5gYzIZRnpKBr",
+	"IgH7JRUPejBV1JScCwmE8YU4JCutS3W4v79kenr3VzVlAuldVJzp9T4KRZLNK1xO+xncQ76v2HKPynTF",
+	"NKS6krBPS7ZnkOU4KDUtsj9It05V7Jy8Yzzrk/Id4xlhOCO2pkW1oRh+wkFfnl5dE9+/paolYFNVNbRE",
+	"OjC+AGlrGtkJewGelYJxJ1rkzEh01bxgGifJ7GBI5ik5ppwLTeZAKtw3IJuSM06OaQH5MVXw4pRE6qk9",
+	"JJmKC3JWZNomPlwYEp2DpthKuT1oU4tmbxwv27g2TrDpyCjBOnI8EKAfE0Vsb71LU18pEL8Rd0TZgctx",
+	"VJLDRuuBO3ZVzEFiR+6+gFz2sGLpilAJBhxy3EgwCu+aqg/pQw3F1yFeiq7F03jvgbg7bs7iF/Tu5BkS",
+	"e8IEmNdQRk1g++rXn0hcRlsnknEr6ttNFy8jfmswQrpaKw1FSJ3PI7dvvp136bWVKm+F0OlZdLydCsSW",
+	"zt3ZMsfvhKEaRnl+Hjq6sC5kRmUT52bTTdAFsS2iZAo6O2FLUDreZWbKPGZPBIA3FqVpUcZhzCuWZ0T7",
+	"Ss8ENni6XzdHOcnpHPKnAEIhfE7Tu11mIUV+F3lOsCHRYkIYSgrrIfZdjphkW41oQeYeX8Lhk97Qd4en",
+	"Q27awNgVz3Jwt4CIxOSP13ofc2PWgiypXoEklGSMLrlQmqVkbrozMk1V5oJmhGkv/+CGGOV7B+LsJE6Q",
+	"sxM/ka7ihFQ8A+n28QApC9ItPIPJdjI1wIeJdDy72bT2XXFn5R/Pbrau+FRUXG87uXKxNBIvdhg/OgqR",
+	"QR7vxhS1TtPj2c12qtgeJw7BDZQxV4GhA0ICzhNkg0vWFbTJRHwzPLMXbDlmDttwNuKrRA59VJeXs+NT",
+	"J2VGzRIKFPZ9dhIp7aDT6itsOYzXCVN3m1jMlwc8Rsk8F+mdJ9oWTtvKIq592KfZaySUQg7tlsPGjTuQ",
+	"vMN47a5hupyS20Rl9DYhQpLbhN8XcMBf3SZRUIr9Cm/XGlQcHhbHABHGyRybjVI6xsWoBvTwBP7AcrCy",
+	"zKZpbNdqTWaBKw3lxbrKtinNBq7N18EuvYKwQ6YaMFIUUTovJGykc0lTIPSestyId1qQipeS3bMc8MCq",
+	"FEi1G9Fx/6q4nvml1wdaUr3aMBaqn8sxYb+7oe6Vwf3+saTff834nxYqyukdFnST3KLRpFYg1yMM522Y",
+	"SX8U4k55jVZHbbLQIC8BhQZEoy/VY1MCnyCtDMmxOpG+PgFuuCGtlBYFoSm2UkYMMLRy1oEHplfE2D6c",
+	"3K9uuZBeMFBTcr0CBXVzkaaVdKCC42FFlYMM2YTQPBcPiAIKKKVQes+WEU3VnZre8mSSMAQ17jZsSYCj",
+	"9XdpR0sqJV3jb4NPrZUcR6jKVX95Otkjs3IdpSvKl6DIit4DmQNwe9GygmWwt+xKJTN82ESlOSyEhPEM",
+	"ZesHHGXm1UzqSxDLgQu4ijVM9QJMY+GN5hqHXs02X4QYcdahEr4Q0zwO7ltnZoRMD2oilL3hj8Oj05vT",
+	"DvR1Au77x7FoXTVIPFNPYo21tY6EeTifRzWyCfmnaUc29BW6jlCl2ua8xtfihquqtILmSFtaFHINIlpa",
+	"w42WNsgMFAcY1iN/L5bqSZdnBbhytcLbHd4XpaiWVsbB2wORotLWQ6Br3YjoWC54vrYdGpbBDr33Q6P0",
+	"7OtS7WZhFO1I1bg0KHDniaj/AUoDEncnDg8WasU1y91d39x5CFMkzYVqXR/mQuRAOXb/rFvXJFGMp7CJ",
+	"HgYrDg9GgKDcDlYzO9hxvheosdyk8S2EMkYf4Jrk1qRkZzd+VUfrwrYZ/JeojIEIuJasURvaDT0j2MX2",
+	"uXv+nfQcCiHXm64zTY2O7qMwBdsuMFpomm+Q0E05qZTzbTFdPuc6F8AbHvUH0A9C3uHqlwuawqbxx+q2",
+	"LnXcViDM19hGElYeZZkEpYZocjYj1NfwnfWhME6Oz04uCRe6tjjUJ3WfxTvCSkFTh0Uch/OjY4/EIApG",
+	"d8C0EdYFhx21B6HaoNf1dmZ39/aQmMMTfnEVvxGxuIpWKC3Ba2ktnpLcXL7fjhXbooq9uBo0tMRR6WjP",
+	"Lq4sVlFas90MAb4v8q25rqoVff2X7w/pwXQ6/W7kQNswNwz7HqRkWQb8zHNobK11qpGcGZvvCohhbESc",
+	"+vNVlZBaV7QHkEA0vQPeGJOF7alrjyHS6rPNQVFX1s5NBOsyrcgiB9DRc7k2Nw2zdOwUVhvQQxW3UEAg",
+	"4gSt7NhETZbd1rgV9kegaiuS0nltDKO7G/wVXnji4E3RIJgJoXbayxVV1tKgOzsGdkAUlFRSc5laE0pU",
+	"TtXKKV/al7F9CWg12A39xmMiOgRfTArr2zpiOK6mURtNiJW7PPKpyAWvh2q0YShRolK4huDGht8O9++p",
+	"3GU8wzevGU3v6HLjIRhUCc8+ThhXmuY5ZKS0VfrLZtwJEDTvDeR+jDUQKSchB6pG9Bk/S+63mhZmUmRV",
+	"qjeSqqlCWAZcswVzElNpizryAfmBQe4MWgsmiwcqgWQCFJ7sTjVvbuiiYNop6cdS2EMMKe2V81S5zi0H",
+	"YtHJ+Vn8zgmS0dzKw3FAtkZg0HoipKpi2eah3NycnTwDwD3wTMghVsKyJ3c+vMI6uqO+YjbdcKi4Qre7",
+	"OD0OcrtVBjqVttVrWVWO90uZklOarlwHhAW6J+cNI2Rm1a5r0876y7V3yY3ehULcHZnOY9tnayS/DUuG",
+	"m5emJ83HDcR1DqgDglVaVmM1fGFHVksySTKm7p7T3t5pntND5eMCxndwY5p0aYmUqBFyIxtL1+GAl/9O",
+	"pQvAOZZMoxX7yaEvMcBhZE2/tAEeKw0QihV7JGNloYN7jLYDVtZGT2BmjSiK4UQKT/cc0mAL6UsPnW1H",
+	"5JnRKjGpIrKoY+o2ClcOWKsn9AWwyIxe162R2k6jC9ytjDFIYN2XwaJZX2PwsLVfApNnrbWBY2HjheM9",
+	"s1e5sNboIXXjIXe4PXi43UvDaNhR1/Lhy8P2Xa8xeWJo08hGTiWwWc4/Qmycz2drubYNKWpnVnLxcLGB",
+	"OxVgnx0KqtPVjGoN0vJDDbGgn94DX+pVcvj6L99PktJWSg6T//lPuvfr0d7/ONj72+Ht7d7P09vb29s/",
+	"ffzTH6Nq0C0XhmEBpzl7YyKxLQ19/OOGEBc9F9zyp8S1RadxLanTPNNUVzRv4v3ohkiBMUvItm7pzS0u",
+	"092sX33H6Jhtue+1unPvHa9du1ptEJbaEFAZzIGV/4ygaHu0dIyGU4bkHbvCLcDN+8r2Ibdczx4nVuuL",
+	"9pon2b+wBzS2XQEYkXSccWCHDaWG0tpSdpX7sIOdjJ09Zmg7KY3ooKnf3oDiy6VZp12TBS5Una6897f5",
+	"Qdxu1D2KVQnpjuvL4pndcKaH15cLCtnFXp0NxEcEi6pF1PYiTuJrOuSCkHPrFWBYq8G3mfSASzeIDC/v",
+	"t+8nyk73Z7RLfwZn/Y3R+BcmXi4ejN+4q0ySmUD9anaxWDzxqtLCIoDaKwsQiZS2LyKtohDdSHFrBJHy",
+	"/jXmaqsDY1PDBX+BWfUsU/uonlHWH5D9UkG+9iqudVtlkgu+tOZLphVZUZk9UKe1UGKhzQ9mgqqEXEdO",
+	"7SVwPSoGwOvasQGRNhUEEbxjf+sxaxjiFQdwFNTo6X829IyzFXNyx9gR9HLfsauRMWFN6MrjxN8NR5yu",
+	"zuXd3eUG9E+hp23E2X2H7bt2gI7s2o33pBq6W3d9Z5+HTMeZN3oDUXpYtepL+0j0oyuNn/Qu/GxbjAxn",
+	"2UXDFNj7UQXXsW0PmbW61tmesgI+pXmV1YeHEKUJk2mZc3eYm6h9PjJDuGcY+47dsuLYX/hK5MqrSkcv",
+	"QWdBGKDL5ezc2xhUYAnpbT87jDy0xUQG7JTgI/sKjBU9tWq4Ada7Vp+iGw7gniwWVWvfG6E2soaOTGGY",
+	"s6UtSk6IqlB5rYjtZmL/2pkUkiyMo1d01jqa540iUFAYwyLaP5oSB0b1XtBszJiwC2QVSbjQewtR8V2C",
+	"Lz7QYkv/8MmoqaZBPNaTYl1ppQUKiGkQ9dqHS4yTFkGXJeoSs5jKgFHvvKJ5vn5OPGyP1bxmXFXzgXnA",
+	"utY7X5WQsgVLMZZ8b8TM1EKEJPCJxffcuPmwYYtJi/UDPHcL0+040z7RbCSM5Yg8rMDuS44g7iglbsVj",
+	"1X9729EkERzP9ZZybBMWWPnCEyC64VK92hSvImqFoHHcdv7UjHccrY3Qgo7ZTNmGKeXEZTsQBJgLsqx5",
+	"1c6MJJQT4JohfZk0GVRGBIVuN5m1b72f3Ze5jtREKJ/z3tjC+2n3xn4Xwb3xprwWJ3adXlT6YuH+D9LT",
+	"POWS2AIZgIiUhlCjjTt5ctql4V2PqbvPn7ht0jOyOIZ1XC6kXw4mLVnL5tNmseF1VTN6dIW1+9y8DgyM",
+	"Pid8fIwkYurj0qvSzlETen1Tk56J5mYtm2YbNdJfc9d8zV3zH5e7precdktj02/+hIw2DtPY4TCQtY3m",
+	"UausTcbW4zlf4nMzov/mCpzDaZ3ZwLhL+zg9Uz8eN+FLj/QwpCONPI6dmxSVVAf5Ejw4FMlDSOOsHr7F",
+	"2/Uw9LdrD72TyQdLZfzqhDk7npMr2HbQUky6T1og6HzdiWCL5gdus4ybz1F84U/RLYcFVrNIdi6YlPTq",
+	"fqOIpnIJzuIWcfZQEYe1VEkLYHZ6vgc8FXifnL07vvrDqwOSNjkAibJJAD0/DNyY21bc8RmlPsOUHnUn",
+	"0ucXdZdX8sDyPJxbpryIaS41TCsCNVENUZp8ib0Z1WVxpDUoXac93TTY69l5WLtnIFJyJNsMGMgHKu5m",
+	"K+91ErWD19vZTvtsvQ+iYrnhqgg/NoV9vkQehCxkyygbbjRi95P8QnzkzzdR67I4XqEyj293vLuenR9L",
+	"MFYQmjetNpgSowxjLEB9l4+hpMCmvs8FvD3Ti6uHom/7yrsxq0GtGnBxFjRIeSa4c1i2+Xf8DepYgr29",
+	"XEIh7uvLE9SGt5E3pxaWdaetrzWE1tcaXKeuhe3GH1enoEgFg+kpcso40fBJk29vrn/Y++t3REiT7/j7",
+	"NzWbux7CjBBDfI71TrHZQKj4Qx3baS8cEoiDMiXnlTIipNMb3CYGOZ9ixeJ0m0zJCSwwJac5GutK4WyZ",
+	"T8nENelPDUZvSlENpPzC4X2jiKkxCdRKDi1qguNc8BSvCpAsJWcnXbSkEHogHUwhMhgG/X/+1/9WpARZ",
+	"MBuGirWn5B+iMlK6RWftwi4kkAUtWM6oJCLVNLfGSUpyoDgD5FeQwsZBTcjB92/emNml6pbjAZ6ywrXA",
+	"3Tfe6M3rg+/wnqArlu0r0Ev8o1l6tyZz5iawDi6ekrOF8e6viTa55YhpZzjmdoljxQOvIRoiaCPz+xrV",
+	"4Ys1nSuRV7rRXHkW7RgsyAehwa54yteoBFXmtmSqmqN4bmNbHiTTGuJanUoNRQo4rhGYx/cFuCamA6gX",
+	"XHTrjefz7SePYvoSFv3vNmdLTXWDZHKY7CddMWfmyO78Jhkndd6XiN3J9tcrkHXq5u0hNU3d4IIrSKXA",
+	"xyOpNU+JLbnl8cBolEsv4Z6puCq2l6SrRq/XeDKkkBkbJNpxOI3hi/JgLEa/jvALEvgjgzNFABUDJoYM",
+	"Fxug0rvJtkVdLCCm1OjCx8aC5+tbzjhaPxvVuG3/jar1ufYQZYtO1nyb0sxxtJYV4KZ9po1yGHs2agIF",
+	"GUKwiNQDUdNbPtYu4Tg3RvhAC9/Kf91mcav6R63veK3+ad3GXvQ7qAVdfuy/pxJ4oI2D5ixEUVC+s/hj",
+	"KDGMN75x02EtTkRp7zwmWhWZ5t3pP/7rp6P3N6f25RqbK0DjmovHePrEEQ1NdoxTrAbkONQWUe4TTHoD",
+	"zoRYfjU5Zzhab5ZVYYSMSuE3pSnPqMyIWkGe4x6h6Sdnu1gwyDN/jilSuKTsHpIiJStNDpmlUXuY4CnL",
+	"9msbyuqRcNkdKUpQK7KXWknnU/x2ir4AJ0xu0xczHmg/GmLWZ5asXHCmjVpniuSw0ASKUq+N7RLr1ZWw",
+	"E5vobCWKnewvOB9jWW03pXzA8KOyv0cAWv13p6Mev2tWgKgGROGCfmJFVeA7TO5W6uJYPSM7o6E56+xr",
+	"OlNyy81k+SZOKT0PzZHm6DfnB7sH4mQacsvbcbKImjXNkysvTzUfjaB1eMv3yDfqG4OQAtxwlflU2E8F",
+	"45UG+2llP61EJe2HzH7I6FrdukOr9qV/tfe3j7e32Z/+qYpV9vGP40IA47vUc+a8PVc+28hOOyXax3uM",
+	"a3qKG0ziHRw+7SGsluuACFdtwwyBWdqv3xIkakIgc5tRw0N2wdNOoKvpHsXNYbcIcmarmmbMRtyWoqxy",
+	"IxDUJR4DWmlBMqZSFH/9uz+1GI3iTXT/asYylG/Ruyg4wgSD18KP24vpDY28g0p9VPh73anJQGcfNHH/",
+	"mSe0zF9R2lc73IdLExmPdSkUgruf427pjhdqcO53ANVxvAfuf4qy+dWgUn9wGPnuWohFDsB/s/PBiWUB",
+	"V0RPi/jTHb0lh1ai6MUEeXK22X0h0FOgKtUly5OgSsGVWRBKC9kItoN5S+O3hy98WVHVYsE+9UHNqKxV",
+	"MjeX763kn4oCVJB3ElUgWFoL4E6oJ79UYGzRkhagcbrdXnJ4y/eRiPta7Hvz3/9vKv+XqTxCPm/dlurp",
+	"+npB+tIXJL+EYpQffFxxbAKfS1iABG7ZuZWvzWXfiTwe4b1Kn5FuaPDJoD7epuZOLlNDMZtf2fQl2dRN",
+	"VGy2N74u9UQpbcQ0KQNsu1psvP8eFpj009t1TY4qTYtJADRGpK9MFSFfM4UxktlwgZd5ATTwIennSqzL",
+	"kJDegcMppfOclCAVUzgvtWsQKSrjW4Ge4lbMc5RXpoUdk3Iim6mbGrNSxFbKfUq951i1m8r2Zcx1932M",
+	"HrENPu5tyPqllXHeGhnk8MSmyw1PgKJl/pfKHF4us0/LfypwWG16aSRDhazmfBrIrL5VeUoYOXJKLoFm",
+	"e8jQI5OxP9vd4JyWNogVi/GZaZth2bqyOeGQcr+8cP0JuaTo72bqpVTDUkj8+a1KRWm/KvPA4XeezaLz",
+	"G992wy3X1Y1dIdFcEs8+W7uuUY1WFeVdA+131PaRW+MKtY+gbhNiiTwUEmBaDXsociJK+ksFnn4GbCfP",
+	"lTLect+owJWwCW9qPBTHqU/ibxG+3JkmUhY90KjUbEFTvR2Ir+l3fAlLprRcu+xtxVrI5b7FRO1Dthy4",
+	"zow2LHWvc60b76ZrW3Dny+k6TCDsR2CsluYKOL3lJiDAVGzfDKkBYEjgk1IxrXDVTEUJvMlPPLWpKzXT",
+	"5vmgOnHp0O3RCc5ximtqYjR0L62lR96R+/4VVnPJLW+rg4M/pyv4ZP6Bl7m2ikVr2o0Kp8rzNmVRh7Dr",
+	"FdE3TkLifJV0xupb7MKO0evSvS3UzYozWsgJGp46U1FfUTuc8OxLvfzvx7lL0qGRaW3iBIy9kmDe7BoZ",
+	"1tx6uKyVOyTmU6PE2I79E1HPyDOUi+XIZmH++CclKGryv45s2cm2a9ZD75WuwcP/3zcP0lMyGu36xpjH",
+	"/CgHqS+rmLV4Y/zpEVlhINXepghUin3HHXWrIVn9xJW0HMKRcwKlN70HSZfgctOxwJ3Pp5VEwIwvp+QH",
+	"IyQe9u1xoTWuY2ObdC1sk7Z9bdo2p93eZv8fWtI+RpNRlSBT4How115TjlSzI7Kew5Itl+Y8iVDSXmMM",
+	"e8E9jMkk1JrvK9coHnvmewymqTWOtuC2lblawALrTjThoonyHme1GQTSdDxYJYA4WMeiEozGL3KcR4YE",
+	"KBin7kNhH4jHf49nN4PeurObmB7BxrkNboYDMXBerTHUbljp8VirCtYfzGGeuG3QBySPO7YHRrPNnL8J",
+	"ry3HwgAlHiOzNCAV+N1u0+FgKhFZmVhX89yF4G4J4nIlfoEY/3C7i+x8YDTbbiwXZDAbsePAZONkfGkS",
+	"Szhv+IFddA76AYDX55xpCuqLbIwtP4MBN4P2Q57NsCfhVEVGvGnXCfNbRvdcu8uGWfhreYBq47zBuL32",
+	"uudX+l4lG54QxmKrPzAoGH/zOm/q+MglvCrM7KY75uRoJWNlnFQKnvTuSD22NgYxesfzGQQ7/KXNQZBM",
+	"XJYM/08T/EvDn2ec+mr180fWOz7bOWS5h1uDS6+oRi5e4tHrlXbQ75UH4+mV1QPsI1qPuFcURkZ3Inr6",
+	"HNIqN9dcCGKSrKpLr1Fx2FoJyK1OC+rUIkZZaMI9c6D3QCi5np1H1M0NtHewnlXznEUizGK1vKh3PTu/",
+	"/nl28/b92TGhEmgtRgbjQHS/PXr3nR2JC8SBrDemFu/P1zq6xGzzgWeDgsIAwaufj66vMdZWaVnZrFoO",
+	"yyYoKJCAW2Ser2PDQR0BevRoSYmxDjhgVz8e7b3+y/cdrdDx1eUuQ7tiS07j2cG6NVqzcHX29w9H1zeX",
+	"pwg3pMTAGMagBHfHmwKvWsUemTD4KozMctQwie4VGEdUwxmn777zZdez8wlhSlWNrv56dm4SuaDyqZID",
+	"oZ1+yjYwca/KNg7urrYw55R9NqW0/WC560tw2G3SOzt5m9yT+PqMDbe9LiKsFDsLBsLHYttSpJ6Pg00l",
+	"aGcBQLHLz9lK5MbNdy70Kjrv/rmR7k5hNrbOTmhewAfj+jZxrsLXs/PXP7u9Hhr0IhGsddnbXMwja6pV",
+	"HnDF67c/n538fPH2v50eX+NerCGtQ/ftsMctIZ7Kdakhs+bxPgKdCm0MTj8cX/5jdn168vPV6fHl6bV/",
+	"mA1RgIzUbb2HR4fKu7Ngh1x9/Mdz0qVz5hrLUb4+oVw9+Ft0vKqz5oyIPlUDVFctYrtfjsdC98o4/N3p",
+	"qoap9xOaVyx1fQah9hh+z0C4O1gPvcBeb4uOenZXtMkuHF3HRiX+buF2Bwc7h9v9+a9vvotE0ZFYEF07",
+	"dO7g4Gvo3KjQOWS6DTFbwYL5QpbZe4Q46OqqBleI6iwRLazxEglmWj4xY2N3y9g9fVhn6TK9cqazdz+5",
+	"j4oAXzIOzpgJ2RL2FdOwR/cf2IK9qAHTjM8Z85FoqLEO8R0yYY58sKs3Kdh/m1mDrESu1aTx4k6pgjot",
+	"LZVwy11KHZMVAhBpap6tjQNkivhVGN0PNhliXVSpZbuvBtixz6v59dun2KPJ7W9vkzlLwQks3CpZj0qa",
+	"roC8nh4kk6SSeXKY+NxIDw8PU2qKp+hV4dqq/fdnx6cfrk73Xk8PpitdmPQVxu8AnctK4MRaysg55XRp",
+	"JbWj2RnZc5mkoXlFt+blpOI2KVfmYjo4LVlymPx5ejB95ZjC7BmYd2n//tW+483933AYj/vW8Ln/m8vD",
+	"cnbyiJXLWJRVVWKsg51DRpdcKM1SYjvweVxC+ag5Ck58SHEdXXCWJYfJjekwNKoahL1Pu9FYD7sl1Z0y",
+	"LHHM7+bGzW0z3cg/k8TukVFrdxcSQmiedGsPMg6zpuBOgD9OfMO3Ilt3Ej4EVu/95a/MCHpNV42UybhN",
+	"0N/t/LGLiflgRWnDFK8P3kTyrAovnCFLvTl4tQGrfynB21htzONi8okYvLqJUWmlV8Z/LbNA37w80A9C",
+	"/4Apbg3AV39+eYDuJnJqbujXQryn0uVk0XSJ7O4yHiUf8dvAgvXWYCP9x64vS7A6wQV696heKsT2yszi",
+	"K/PvoCNeGVsW54de3vGXWpzXPqenUaCSroHcQTVhLw1YU/eyV3XL0mwtloPPxiMR6kYY5uId8ubXReoX",
+	"6cHfXh6ge6NP8EXOUr3r6mzyBkaPUAllTlPovlWz7aC8tM1aOc5+r5Ny9IG123w4HEedWQcvAjW29N4c",
+	"HLw8x72lGQk8sf4T1vKWRdXkzXOsZleUUNElZWqEufbM1WRgKdmsXf1Muy/D1X04oxj81Usj0EliZ2iS",
+	"2bPmr18W9lGOF+w1uXTPX/yHrbrf90DrrbNty9Adc4OyZ6OMqY+0hgsixxrNYitx48FmlZx8CbKUrNEf",
+	"x/r5bMfdC50+oxbIxbvfkT1/r0PhqYy5r8siDc2V8TPDWpFqg2Rj2CJpaEYazchHpr/edKKBqEbm/0G2",
+	"/vzn3RZr3xeW7nZaX18Fvd9jTRuXZ3nv14RVo+6jC+j/HQDDjpYLALQAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -18,3 +18,6 @@ type ReplaceDeviceStatusJSONRequestBody = externalRef0.Device
 
 // CreateEnrollmentRequestJSONRequestBody defines body for CreateEnrollmentRequest for application/json ContentType.
 type CreateEnrollmentRequestJSONRequestBody = externalRef0.EnrollmentRequest
+
+// AnswerEnrollmentRequestTPMChallengeJSONRequestBody defines body for AnswerEnrollmentRequestTPMChallenge for application/json ContentType.
+type AnswerEnrollmentRequestTPMChallengeJSONRequestBody = externalRef0.TPMCredentialChallengeResponse
@@ -6,6 +6,16 @@ import (
 
 const (
 	DeviceConditionBootstrapReason = "Bootstrapping"
+
+	DeviceDisconnectedReasonNotSeen     = "NotSeen"
+	DeviceDisconnectedReasonReconnected = "Reconnected"
+
+	DevicePausedReasonDevice  = "DevicePaused"
+	DevicePausedReasonFleet   = "FleetPaused"
+	DevicePausedReasonResumed = "Resumed"
+
+	EnrollmentRequestApprovedReasonManual           = "ManuallyApproved"
+	EnrollmentRequestApprovedReasonEnrollmentPolicy = "ApprovedByEnrollmentPolicy"
 )
 
 // Adapted from apimachinery
@@ -25,3 +25,19 @@ func NewDeviceStatus() DeviceStatus {
 		},
 	}
 }
+
+// NewEvent returns an event about the resource of the given kind and name.
+// The store sets the name, timestamp and count of the event when emitting it.
+func NewEvent(kind, name string, eventType EventType, reason EventReason, message string) *Event {
+	return &Event{
+		ApiVersion: "v1alpha1",
+		Kind:       "Event",
+		InvolvedObject: ObjectReference{
+			Kind: kind,
+			Name: name,
+		},
+		Type:    eventType,
+		Reason:  reason,
+		Message: message,
+	}
+}
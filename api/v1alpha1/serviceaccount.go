@@ -0,0 +1,85 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+const (
+	// ServiceAccountScopeRead allows GET requests.
+	ServiceAccountScopeRead = "read"
+	// ServiceAccountScopeWrite allows all requests.
+	ServiceAccountScopeWrite = "write"
+)
+
+// ServiceAccountScopeCollections are the resource collections that scopes can be restricted to.
+var ServiceAccountScopeCollections = []string{
+	"auditrecords",
+	"certificatesigningrequests",
+	"devices",
+	"enrollmentconfig",
+	"enrollmentpolicies",
+	"enrollmentrequests",
+	"events",
+	"fleets",
+	"repositories",
+	"resourcesyncs",
+	"serviceaccounts",
+}
+
+// ParseServiceAccountScope splits a scope into the resource collection it is restricted to, which is
+// empty if it applies to all collections, and the access it grants.
+func ParseServiceAccountScope(scope string) (string, string, error) {
+	collection, access, restricted := strings.Cut(scope, ":")
+	if !restricted {
+		collection, access = "", scope
+	} else if !slices.Contains(ServiceAccountScopeCollections, collection) {
+		return "", "", fmt.Errorf("unknown resource collection %q in scope %q, must be one of %s", collection, scope, strings.Join(ServiceAccountScopeCollections, ", "))
+	}
+	if access != ServiceAccountScopeRead && access != ServiceAccountScopeWrite {
+		return "", "", fmt.Errorf("unknown access %q in scope %q, must be %q or %q", access, scope, ServiceAccountScopeRead, ServiceAccountScopeWrite)
+	}
+	return collection, access, nil
+}
+
+// ServiceAccountScopesAllow returns true if one of the scopes allows a request with the method to the collection.
+func ServiceAccountScopesAllow(scopes []string, collection string, method string) bool {
+	access := ServiceAccountScopeWrite
+	if method == http.MethodGet || method == http.MethodHead {
+		access = ServiceAccountScopeRead
+	}
+	return slices.ContainsFunc(scopes, func(scope string) bool {
+		return serviceAccountScopeCovers(scope, collection, access)
+	})
+}
+
+// ServiceAccountScopesCover returns true if every one of the requested scopes is covered by one of the scopes.
+func ServiceAccountScopesCover(scopes []string, requested []string) bool {
+	for _, r := range requested {
+		collection, access, err := ParseServiceAccountScope(r)
+		if err != nil {
+			return false
+		}
+		if !slices.ContainsFunc(scopes, func(scope string) bool {
+			return serviceAccountScopeCovers(scope, collection, access)
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// serviceAccountScopeCovers returns true if the scope grants the access to the collection, or to all
+// collections if the collection is empty.
+func serviceAccountScopeCovers(scope string, collection string, access string) bool {
+	scopeCollection, scopeAccess, err := ParseServiceAccountScope(scope)
+	if err != nil {
+		return false
+	}
+	if len(scopeCollection) > 0 && scopeCollection != collection {
+		return false
+	}
+	return scopeAccess == ServiceAccountScopeWrite || access == ServiceAccountScopeRead
+}
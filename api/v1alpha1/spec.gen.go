@@ -18,198 +18,324 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3PctpbgX8H0nS0nd1ot2zc3da+qUlO6sp1o44dKknNrNvJsQSS6GyMSYABQcier",
-	"qv0b+/f2l0zh4EGQBNmkrJdtfkmsJp4HB+eF8/hjlvC84IwwJWd7f8xksiY5hn/uF0VGE6woZy/Z5S9Y",
-	"wK+F4AURihL4i1QfcJpS3RZnR7UmalOQ2d5MKkHZanY9n6VEJoIWuu1sb/aSXVLBWU6YQpdYUHyeEXRB",
-	"NjuXOCsJKjAVco4o+y+SKJKitNTDIFEyRXMym7vh+bluMLu+bv0yDzdyUpAEFptl75azvV//mP2rIMvZ",
-	"3uxPuxUcdi0QdiMQuJ43QcBwTvT/69s6XROkvyC+RGpNEK6GqhbtYBJZ9B8zzsiAJR7meEWCdR4JfklT",
-	"ImbXH64/bIGFwqqUp9BCn2SZz/Z+nR0JUmBY1nx2orBQ5p/HJWPmXy+F4GI2n71nF4xf6d0c8LzIiCLp",
-	"7ENza/PZxx098s4lFhocUk/RWkM4Z+tjsIjWt2pVrU9uma0P1bpbn4KN1EElT8o8x2ITB9lPBGdqvZnN",
-	"Zy/ISuCUpBEwjQZNfc5qjs4mweSdbSJQqTfwy9UAKNX6gLMlXbXxW39DCXxczOaNK4FLtXZAinQDOMzb",
-	"hEF3e3/8uqOX/hK7OYL8VlJBUg0+P3E1WOwS/AOrZN2eBn5GVCLMEMkIkCTK0Dn8LMlvJWEJae82ozlV",
-	"+h/DbuwREQlhCq8IXPOcMpprPHrmF0qZIitzheczSTKSKC70BH3DvsbnJDtxjXXHMkmIlKdrQeSaZ+m2",
-	"AcJ1XXcB7cRCoQN47jNKyZIyIoH0ZVQqTQYBjvo3js4JIh9JUmqKTlkPbGUwH1Ukl9t2YY72eq7hemg6",
-	"VIDFQuBNfHcHR++PieSlSMgbzqjiYhyriHWG8zvQm1nqu0ZO6EpTq2O9J6naIOxsigQpBJF6QoSRsD8u",
-	"uUAYSbpiJEVJ1RctBc8B8gf77atZ0F+IkDBh65odHdpvtfO7NL+RFJnNGpZGZbUqoCP6Z8yQAekCnRCh",
-	"OyK55mWWalJxSYTeScJXjP7uRwN8ADTBSu9KI79gOEPA/+cIsxTleIME0eOikgUjQBO5QG+4IIiyJd9D",
-	"a6UKube7u6JqcfE3uaBcn1ZeMqo2uwlnStDzUnEhd1NySbJdSVc7WCRrqkiiSkF2cUF3YLEMiOMiT/8k",
-	"7NnKGNG6oCxtg/JnylKgJMi0NEutIKZ/0ps+fnlyitz4BqoGgMGRV7DUcKBsSYRp6c+ZsLTglCn4I8mo",
-	"JlyyPM+pkg5bNJgX6AAzxpW+fmWRYkXSBTpk6ADnJDvAktw5JDX05I4GWRSWOVE4xQpvu+TvAERviMJA",
-	"6OxF7evRebXMRZ3PJHC/mw9jurf4UXXbLKYEm7QrjzGoznle01GEQzc3aOiIcDc5mijFHVMKz7/qsHy9",
-	"7WQ0VxzE+7rP9rrJAie69RB0Sx+1oVrj6IQ5/VGEwkkv9eP9p8BFQQTCgpcsRRiVkoidRBANU3RwcjxH",
-	"OU9JRlLEGbooz4lgRBGJKAdY4oIuAklDLi6fLfqX0KQq5GNBhVG5SMI1PFuLtN2Nsu8JxiXOaErVBsQe",
-	"wJdq3tl8tuQix8oIz395PmvL0vMZ+agE7rNU+EvWOuDm5WmYMPTACCuDWUQ6nV8DF6k1VshBGIQyDeWC",
-	"F2UGP51v4Nf9o0Mk4bpoyEN7vXFN02ielwqfZxFrh8GiqDB5uiboHEvy/Xc7hCU8JSk6evmm+vfPByd/",
-	"evZUr2aB3jjJfE2Q5kkLL2JSkoGEjkNk6JNTDUUID+R8o6LaHgiu4m3UenLIUoNgsCThEcL0MaQeqNRv",
-	"Jc7okpIUjC2xaUoaIXPvD1/c/SEFa5B4RSKY/h5+B5DrTQDZJcAMLsgGmV7B7imDVVApy7rEX+MQW5FX",
-	"7zhutHobGKzuHi4NGii8HBJgxjia52W4LmzCRSH4Jc52U8IoznaXmGalIMhIf27rsEm9eM0tMGUyAnat",
-	"Z1EtxmwQ+Uilki1KF9Kn6O20A7YVuHkFNcS1Nu0BPuReaaoK5C0CiQP/zRgk9any8I4t0M+MXzGUBA0F",
-	"QfsAN5LO0QvCqP6/Bs8rTDNYk8e9YbqyX8Xs+oOmpUtcZpqCXV9HNPUQRYKtRRHDj9u98epMU6IwzSTw",
-	"E84IwvoaKocDSSkEiCNKn7STYzWiO00/YgjCUp0KzCTMdEq77MK6HVI0J2YmvzTl+5LUCEl6XRY3FUeY",
-	"cbUmYhFigZaGduqm8FAukZqGtFfxU5ljhgTBKSCZbYeouShayHPQwee8VHbFfnmL2GT8HEhA+iNhxLDt",
-	"+O4XTrBZrHxLQ2jq0LjCEqihZmIpKgszbcjnv/8uyucFwTI2+TfngpLlt8h8r+QIN+MTOWifAzVFN6rT",
-	"DN1IA7uBFbOJ/9ZwalcwjyGc3351+r1XpaKZzpp9Kko9zCucSTLaft0Y147V+NUN3fg5ND3X4RCszlEi",
-	"Y8N2/zRUCVZtSdI+GD+pYTy1P9z9PcJCQtOTDUvgH+8uichwUVC2coZUDeVftOSpIaFVD/swUpDE/fym",
-	"zBQtMvLuipGg/TB4vWSCZ1lOmLI8LNhUJ58b0sZDpLOFB9UxKbikiotNFE4aPJ0fWsAMP3rAvsoIUR3Q",
-	"hW8Oli/IJU1IAGjzQwhu80sL6KckLzSLtGqUPQODSUu6cs9iTi0aZqr/kapI9+t5f6+fvaR8QhJB1KjO",
-	"hyyjjNxg1p+UKmLdAAalVDy/ffv2vEliT4wkax6WgMLmpr1mKQmswusIctHWZ/RizQG3ybf5vW4KL9Yb",
-	"SROcoRQ+LiYj1mTunszdcrcim8MlFtvnBobsmIBhRmu9sLc9SOKaaENA7fCkiMpnutOmwyGjzM+1jrp0",
-	"WoDGsqs1Tdag5UBPp2Vvn0YqLFREyXrrZ3FtkJONvdAZHz0QYoedWdybo3l41jRiABOs3M8y6ADrfgLt",
-	"g9TXaOtB6kZagDdEV6sYjjSA6C03UpE8hM7tSOP9rhxNeG2FiuGzXYAQhKVEkLST8TiuYxE6dYzNdAu8",
-	"KrYZTOrz9K5X8oy0l7o6Pjp4aalp1HYktdjH2eGLyNfGcmpjhT271/UT5xfSySENxr1URByTc85BEmzj",
-	"le5aORFAcyRce0QYoJsVOXBiTRmaS+k7ZrXOK6rWCHRqi3nyjHEBpiyqBRR0uiaS+O48SUphpwoObo2l",
-	"nRkMI1nGr/QS9FUvuFQ75htSWF7IxRkb+ppjQGRAoHfrqHnTnAfr8SLzMECVtvndw8kgs7PjJ2vMVkSi",
-	"Nb4k6JwQ1jRDWTluLJRg+6QPSudkyQUZjlCmfYBRcK5wqHcBLDtdgFW0Qqo7QBoz32CsscvzaHMvwIij",
-	"jmbU94M015106xB2SFUnL5SGxwxbR2M0y5/aXMn+/mHosk6qRXwipzZGQM+lqZvndphz3+Jvxp97xgo9",
-	"XbGUdaNR5Rr6nsmyKLgY7tQandlPEf3q541+rRbT8TlYod953D+k+lZ3BjG/y0ltfmjfj+AgRhCwya3j",
-	"sbl1zMdR/k5af2N/EDPuu5O4UE3z6GsQl0oQguCrVbUFen/8ersKYgbsXUiXthhfSkM1endiVhXlLvDl",
-	"BV11uj+k8K05FvqGLFYLJNf4+V+/38NPF4vFtwM3Wp+ze9sN+aut3CQd77N61U4WUviCMCcLafpmBGqr",
-	"IhvZ0IhDzrqwQC9xsrYD6Ose+lhrEHCRGtVlA/0M+U4HUx29of3EPNxu8YmJqJLO0LPFgz/pft91wLUv",
-	"DB2YlRTlUCk5HMhIGvNZSuXFp/TPSc6H3v/YCM0n76Kc+UHt6obCpjtO5Z9Y2LiZA0EVTXB244iV2MRh",
-	"QEz7azV57GuwoNhnt8jYt/ABMbCAtq9fYA3q5slhq8FXpBlqFrknSUdEjZvXfEeFfcUZPnf00ag1/Vpr",
-	"dsPQszLPXM9nfGAny3uMhdQKRG0ZUq/GWkiNrGHfiepK3/C9N56nYhs3hDNto0OOVbI+wkpLlHU/wBx/",
-	"fE3YSq1ne8//+v18VphGs73Zf/6Kd37f3/lfT3f+vnd2tvO/F2dnZ2d//vDnf40xqm1qZbei2eXRFH4N",
-	"X8TiSlvl3YSdroxsXy3CKYFpZqzSiSpxVvm84J53tSFXyJoyQnOuWctIQbf9jBCzg7VtvKNHb9i4h3tT",
-	"+TMwfBYYMrY2DQ3HqEtRCN6hN9w5TvXRle1brhmwtSjldMsb6ep6hAxLdUIIsP5hzkkjCIqfpUZSxvLX",
-	"0eJ5CxkMCTm05pMBA1Ttr+czq+OMMU6lHc9xAVbWVlW/BbP4pQjBGB69RyE4m2q9FdSCY+6WQe7hmcjS",
-	"FecZd3tGqFt4G+qNFH4H3h3xQOHKNj2fHfErIkj6brm8oTxWW0Uwa+tbsJDI17q0VfsULjfyubaDyPeI",
-	"rFa7XFF+51tYW4bxlaap3C1LmoKNqGT0t5JkG0RTregvN6GFuM3GAgNBXBvbD1poKg8GN+f3XA3bwjoN",
-	"HPNq1giT5VyhwxdjhtILBrO72X98ne9cI3TiFMSBEzQVsBAkfh/tVXTfgIZd/YbaLwcFGF2tCfNxCcbT",
-	"f0kzguxynIPyZ60Cz2ecvaLZ8CBn3fidA0BsIQVW6zh89RcNXCdvwxuOfVqhrPHmoiENbzRUmo4JZsia",
-	"9jgiFN51sDuaxJ6MgPh5pqiGLxXg6bcZgHhbNf86T7z1Zw3LVQzbu02uUlv3zbhKe4iAq7wvTvkLEwb1",
-	"rlTvlvbfgRvlTVhIbcpgisjXcNZo54Y/Z/1rixOE4ntDb0RWFKn7Tkh3u5cZIQoJokrBSGqIx5KoZA2P",
-	"lkhStsoIApfTXp2mQrGuWLEBfuhBYMO8tY9zQfBFyq9Y707ON+gsXNfZLFCgWqgim5LXI1i8XVP/whVX",
-	"OIvTK/gUOG7FZhoYF2Au9qOCjhWx+6DTDAEAUM0jyNo8/8aGo7SFyouH9gpOqbwwoW7tG9nNxjxfiTK0",
-	"+pj9bAfm+BD3RKZSlDDrfpbxKxzNexJpVM9+Qi5JBtq+/kxSvTjbwdAnwbNM8yEKCFIIvhJERt5kV4KX",
-	"xT823daWDJ+TDF2QDUhPBREakRF0c/5IgI3V/NiteFwAYY4/vmf4EtMMAvuiB2TT2gQ31wEd+Z7+Yrik",
-	"XgYScYfInLL9LVPij40pS9aeyx/D1jmjZrmyL7TJrcDHLbvJvNunkUsVR4lNNbVAZwwQ2nWxL+HnocSL",
-	"wdeda3HkkiC7QHTGltyOf75B2ESUlYyqBTpxrgHVjyAn752xHfREPoEFSROADT/l5qecslIR89Pa/LTm",
-	"pTA/pOaHFG8kuNqE1tBnO3//cHaW/vlXma/TD1EraBXqUuWUaiaTcy12rIPQNvmqGvPEdriez1aiSHZy",
-	"zPAKUjjtkG4HxwYtiCygZ7gYRW3F87QRpdWkJ7uPDVcFaRu69ZpkJ5+NKdThqwt1aF2ncVEP7e63m8mn",
-	"I8DPiLst/cOE9bVwzn1xAbpEatEBtO8gdhsckZ1TLbQPuNo55xnBzD6UwNd91T3TPsgjenBgIFjZMIlw",
-	"uissazMNM/u7HjFJpvrmZm8EfuivIqqPg/DzKXlIzQA1w6L9SXF4wdo03E23iur+PAfhRdxzL9qs7sTX",
-	"ajKxhod254seySDLXlt+mHz8vtDUTXHGtZ0C6GbmnIOG5jm51faJRAqLFbGPzm3KkEjRnjKRwkwQSxgU",
-	"JpqUJqDcJw+JAThtODIMD0G8BaK+3yTlLs2EFe/RFdUydUXdqXRmYNDNNTZXSgEApYq976f+GrLDjr3D",
-	"x6Oj4Th3j0HMoRJIRpEmL8lcz/uT3YQo08KrdvqbxeisNu1cLeQTaHCPl8W4fDRt7bQt85VqrYlV4q0K",
-	"o9Td/VJBsttAcS1pn8I7n91Us/YKdiTlcrCDaoLOVQ0CFeys7dsJjGYnQJYdR7zbGGPaXpBNV5vmaXYM",
-	"3h5q0A46zzycQEOPC6o23fswibUGLL97WD9IdOHwxt/2iuvKHQTtXcqgreZVn4Tmej6rP1vGzf2bAm6w",
-	"f941JFurGj6GmlszOs2AVLhXsANIUwaOFDm/9A9gxLtWDHz9qq3SD1r71c9Q+9VP12hr5rb7jz+Ja9mG",
-	"sA439iLDlCFFPir0zfvTVzt/+xZx0cztZ0dw1M8BJ0ZHdbuXultH5N+VS4ukjElKaGkPZlmgN6UEWc6+",
-	"/Z7NYHFnM72is5lZ09lsgV6YBxKQ832j8LTgp9ncdmkfDdjxeFnEQaK390Qa2/Y8MJS6J2nNZFwgAytz",
-	"ImiCDl80lyU4V2ZVbbGQp6R76v//f/+fRAUROYUYZ8iZuUD/wUsQl81yjNdFroXbJc5pRrFAPFE4MzGR",
-	"GGUE6xNAvxPBTUzCHD39/rvv4HSxPGNawEtobnto7h7v9N3zp99qgV2VNN2VRK30/xRNLjbo3Np9kY8V",
-	"W6DDJdICuQfa/IzplTa2A/ZHeP9HaQA0vUATaNm20He/1uBzybNSVd4HDkXdXXZeqW+5IubG+8R68HSh",
-	"m4Kodk4QvyTiSlClSPxlvpRE9GINv4IckreONbGHJX/hoqQXHqLba31lX7EDq7AVY9MpYG8y/k7G38oR",
-	"St+UcQZf0+V2jbwwZtyA5z/VjXbw83SPH9xSV53DMMc7INiTSe4LNcnB8R4bj4DO8EJjbPAlhIZ4DVRk",
-	"Kk4fekx64Cy01YxnvRiOeEaTrcENx7XGn1JiSNmkizHt8T5SmzW9KOP0uelB5RbdiQFdFrng4zgrnPFS",
-	"GxpzBa3niICAirNsg2jl91a1MEl09EWGZGaJS9FduSp4KyckcL9aW52wpXqOM6x5l7tPD1lKW+6eY2Lm",
-	"5w7tB1Ht+rUeacmDnMY0OSYF9w5yUYv0EmeSNEE8JPGvG9qFEZeiwyHym4JDJlbNcnOuyLfg6W/ytw6q",
-	"kaZHtm2iW43mPG1nDqPqWO+mdfF5ydSR1wStm+Rsd9Y0zR9ZVdCGu1JmUTzGEZxmGUnC57a+vQJjAKaK",
-	"1XJUSqI1P7iyG5Yg8+WMRQM5gQgfk0sq4y7+rQxtfnmtzvMuz8P5wIqSjTjhreduswDag4vNGwQ31FLk",
-	"Nit4kMSm9B8cLPHS94kS7mDID+0Cm0Hg7rDZTIRKGucRdrB4dczYinuLnjYkdIZ4YYiCl/R/fvkfP/yy",
-	"//r9S1PKVKOcVuaxRCRS+VR6V8EKJuOcM0XZYVrVYpuW1uvl9+aIsiQrwaiE2QZhsSpzYGul1L9JhVmK",
-	"RYrkmmSZviIKf7QhIaY6iDUtSZTbnMxuJokKWkCWrhX4qsz1punSBN9cERHUACxZCpEk51iu0U5ijI8f",
-	"4w+KV1xcvKBim18wZYHLSgVMb0YSJTOiM10iCtpZRpYKkbxQG/0DtPONXEUMidY8HxXWos9jKKqNc74O",
-	"EH5QgugYboOfc2OgFr4rmhPLZief1xE+r9e9xx5SqU858/pZ6W2PppTvdaeWnKB/jDvGxwfYu1llZEuR",
-	"4cAQD29thQxBtJ+7v9a/XSuvQIwqHDIXHieqNg0Mv6QZmSNZJmsgwB+xRsiFFZPBNO6dzqgE2bqqdeO/",
-	"uBXgUnGUUpnwS8jb6gkFmKs1d+8L5+yMgPTRdA4wweYDv37eDIuEWxCyCvfU8pLZ+jsvqLT/gprK8H9e",
-	"mKT99odjknEMwcCY5JzZP4c9nFlc8NPZv4NZLca7yd2fsAb7V7UU/4NdkRuutrAIA/zM+IMVywKsiHIL",
-	"n91/pO6R4EUiVKxcryTff+ce9pDgXJlysRHhW8orLtKueFLz1firl2ptnrd+Oj09MiGUmiaHzqF+uFhQ",
-	"5QUtjJXrFyJ8xFB74pMLWlj1x9Wdugw7xLxeVSYHQeL09Qk4oyBrLRq0cD34BdkMH1w3Hjo2vyBdr+X6",
-	"061Avrsm2KnFbCB9W6Yawv/iZSpavGOtVBFVMDVxPeoPbw7ewNHVmti8uoLIgjMJlF0qLqqYcHjmNFHz",
-	"tYi9RVwLvGelU5bLJf3YnuoIC//c//74ta3VxnMigxTV51jC1wU6VBC9baR9gn4rCQTPCZwTBQ8Bhinu",
-	"nbFdDcRdxXedQfnfofEP0Di2xj6t1x/XvSu6DoO6yOkNjTnrGiUeVpFlaPWnwUYguHlw6BwlOMsQFyjJ",
-	"ODO1v2NYBOUzTbhoBz7p4QyuafRMEWeZKbbpumoNEeoAVTXj3EEv0HtgfjldrRVgt8NKoyOCMA88xi76",
-	"nJhJzjfueO0bDtJHofVOWInPPgDcdk2ywlAeePfyO3KIoo/Gv4IsxhjC5uGxxhDmMMerMFGUI16DE1se",
-	"kyURUBrfAs9XoLBZKSOVIVCBk4shTlbdaTg7ywlFsidAkpgxKSi6Uszd6bW264xttrfw0g21k62rnM8k",
-	"TLbdGjo8HQiI1AVOBuTLtFCpesyDSbe+hdje1Q5iYK0/+0RyMuS4sDVL5+aB01q6wI9HELT/9gVkZtGi",
-	"8y4rs8yGKrt3J4kgBZ/Wt9aUrdpvFPD55cdCmJoSW5HzTbM9BC2rZP16vEP5gFx9/iEy+sysv9h3vXMi",
-	"kXsZM+CRG6bWRNGkKouF8lKax53QNpdRqUx6/UssKC+lf2CCZcgF2g+SKeKNeR0CGq7ZAl+iP6q3tjly",
-	"C7uOPggpysqYH7f9AuOfE7Bj0qBaLNg1UUZzo8irWsUdoCo+NYct4RuU+Q0c84mAUDbwnQNQ+ShuqBdg",
-	"X9GpRLzAv5XE+yA4pqK4qa3qCmb6iDVLeoOHcmweyUC91yoeNa0EUYKSS8PGGPmonANWFU/u4X5goGIy",
-	"jCScSSrBExPG0suyb+323YY4kNmd1jPu6H2bdDwpgjwJILxihjBakitnqzKHW0ByeQMSd/TOQcSw3Xoi",
-	"FGPQhX36kzSgdDqvyZmVmIBjVUHaicnClHgGMXqOSpZpYWDDS7MeQRJCPSitbqKVY8wQCZ2GO+op5Zgy",
-	"ylaHiuQHmoS1EbDdxscJejyT5bnUx62/AcrZ1cNxVLWe9KFYWdjqAe743Qa9Ocj+alDIse3U0jDwkgQz",
-	"uCNmc92pif1+5W5REpUm7w1grwGvHsYdBRgbSgZXiqWI51SpKmuBJILijP5uCkjVFgqna+ys6Bvr2XhO",
-	"EqyFMmPHgCfjdcku9Ei8+gogsPCEhEjQ6NtqP4JY0Bm8bO7JbMS/C9xoJ87HhWcmTRdm6PLZ4tlfUcqN",
-	"1ypRwRwG9ylThOlj1JvwelcMU/5MpKI5iLJ/NneQ/m4f3ROe6fODRRyA74w3Kep5BQFC2jW2kWiBRgj/",
-	"wIKTYZlpYiylwcHakoW1NnRYFw2fdgbAQ62zveUK/v/SVat+wYl8yxX8HfW/Nu5bYwrjN6QLY+TwK/rQ",
-	"3pccLG82AWIyghyars/aMugbyJh9+8lt9CYCp5UWiaq+aYSrM3utqBWaQEM19yjDNwTKEiZIVuIYjTUv",
-	"QltTkT3iPcgYV5Vt+YZBc1VjU395E0bMRfM3uYrvpzQnUuG8GJ4FNiUZuWHXVU+h6X1kmEDiiXDN6S7I",
-	"WRcUofbGHwl5aoyvFTpqVrs3pqIFOiY43dES1sD8U58czfjGyNnWlxAS/RiBUN9Ta//BLBSDuFhhpmkc",
-	"VLPHiqy40H9+IxNemF8N3/rWyzOzwXaaUE2ybWPPHVeMRLWGwN8RK8SvoMw+uK2a37X0i87Af29XT3U2",
-	"QwbIXeUYQwGo420exEULP5jWZgml1pfWyGRPZODmWhWkqLxnh5k6jzTJCtLAVFXyh1ubeEcATBAf5Z+E",
-	"wvAanKaQ57fIjE4oTMTShx7nmub5/M+Td2/REQdIdL9mAfLF12iER8URTkGYtatZtPgEvP90esM0KfsR",
-	"EQlhKmplqb45QcYetsGcOhEoqsamVe0e/+c3z54+/T/wyPvvvz7d+fuHb/9HNK3Rsa3C2Mz8P5jNBB1f",
-	"WseS9rNud/GMJryG1rbutGhdx11j3D7HFFYYmLo/DsDeFOexuDZX4nJQ+nNofM/lEFplQTup2OdbMuEm",
-	"xQ/GFjWtmckjltbqq09iY8NK61brgF6uqLJG4CiNPO558jkOn3iCkK0fqQqff0xaXDDck6pK6hT9MUVx",
-	"ffVRXNUNGhfKFfS73XiuauB4UFf9ez2yy3+jU5zmw8d3icZpDOSMntpPoV5faKhXg+bsDRWbm5EgW71u",
-	"Q0+DbY1P5Lpqu2XVHTFKzRbjApXCF/2B0UpBl0+PLaoPdr/Zepw8vJ8RoY7LmON/o75DU2NelzlmO77U",
-	"QCOWD8Cnx46nyepMLOxSDtcSMvJLIgL/RXxJhNZjIec1vIy5ZCmufqSeWKu46BWgwF7btTp0rG64S8+b",
-	"ztLzuqv0ou4ZfXaW/tuvMl/H8wAXPfr7qUlE4dRyvrQ7Ms+Dgq5WRMgoJI2VzzzHX5Ihpaxq531iO8Wr",
-	"M7gRg2Oq7aNuqNuKXLXJAjt9tCojFMQZ5oDbOUk1cGeTYMbONmYpwW6c6qjPkWoA5JS5x4ccF4VNMHNw",
-	"9L7z9h69j5nZTWr6Ts26I229s/p3viF0vglce8q1eQuWlplVrp0n1jDm0LGbbWS/b11bbAwdkLiOnFKH",
-	"ycZRuz6TAzRCooRqMO+cT4H5tYCHf4MkIAAZKjLaDFGR3VjG+eA0ojmycF5klK0OtfR6Gasl4anoOVFX",
-	"hDBvPYGuel/3QBhrISMdESO15FnBtufhUUV23Ed1TjYsiYkK1ddmDvLAWQ3cR6wrgkkIBOHEgWlDcePF",
-	"Co4TVrIFDcaXqJuUoMnMMZk5gvs21tAR9LxtU0c1tDN2TLf1YU0Wtu+GJaO5KFD6yWjxxRotGhSkdVmL",
-	"rZEx2Ffpq8XCNf35D6E8sWthcwRWPao7qjBlxs80xvuNuz7jZ0yW56471TcQ6jTCUhpjGRcMNwIkAwUJ",
-	"5IxZrzN7PR5HdE47JUQk6NA6lAjbqg3vcTE1wzNJRBhHrxh4M5tRRa8+zQKEb0b7elPMOEPIAc9z2hHC",
-	"bpwdoQFaY7mucs7qdZA0fvJu5B973JD86IGXUWzwIT6CY0xZJteNfaon1rExqqY31F6pBFZktRmu80Ii",
-	"rBPrbAVWy0b2Djfi1lAG37JnS1WGqwYSh5+dpcxVVivMr838RU3bHuSqMUl8T6uMB73qd1mVYE3bwB6Q",
-	"hKt5RHqgeNW5LWaAVheIHIRwrdO1IHLNs60ZVALPmqhD0wkX6p1InTuXy+2zL5NWdh9b8s+5VXGhTOXd",
-	"0EfJ9HtBZBJ9cz+R6xtFPBeCXmJFfiabIyxlsRZYku7YZfPd6PRyfeT7PoaQ5fqCtsUW232jk5OfhocX",
-	"R485eIUYB3oZHtmWh447iozUu294Xrg4yZ74yL7IwGpTMbrUxVUtJ6XGnKJKwaxwDQWXceaKUaScPXEV",
-	"a5GJVgk8MQemZB/y9FCxbCO/OwfCDm9KLONvHDlO1pSRzqmu1pvGBLawpV7D2ewVplkpSFXw1MQuUFkF",
-	"9ZgMCybcAKIV6jJIFQq0j45hmSjJsDDExnnY2M3qi4HOSw1lYuIe+CURgqYEUbWlrHP0OJ23qwceegfB",
-	"VXvobHZiqK3Lhe53eufqitbtdzBLd6Qr/Drgkp/aLIidqn2jQd1AGHrFIpdQcfJ2mAx9k6EPy93G1Rln",
-	"62t2vl1zX2P0uHtTpFHdx6nRYPJzenCjYexEBinPTT4w2Q6/UNthjCi1k+vEC1Kc+qr1V2suief47n4u",
-	"wSuDb0+5YcYfsryqSv+gIIowK/R8Cz27iZHL79hSqVvwdarKiH66lcviuqnoOiR6bow96cO1bq5hpEfP",
-	"aEKY0ahNUMpsv8DJmqDni6czq5jN3M26urpaYPi84GK1a/vK3deHBy/fnrzceb54ulirHErIKaoyPdy7",
-	"gjBkzhO9qXJZ7x8dzuazS8dUZiUzzCO1Ua8MF3S2N/vL4unimTWJAkz1Jd29fLaLS7XerQJIVjE8/5Eo",
-	"k96qFlIRZmc7TPWGS+VUQgjZgHBxmOz506eNck5BSMzuf1mdyhzptgMPZoEDaMSZ/qz3/d2zv0X4awkm",
-	"d+V3oWEEQ9RgYfPnkE5o/GIbGJCYNGQxULh2AHWXTwpuLNXDrAk2iVMcurQKxnlwNJH0Qxy8jdsNiQZg",
-	"NwCSp8+62lBWtRoMuPnsr7d4qKbYWuQ8D608YhihbxYcWlDfzdbddIzP7CQjsdqL5vdagLsmQAfVYCdm",
-	"MBeo2DzhFzBAZ3t5l1fAC79d6G/O+m5P5j2z1fR+h3s0nym8ko2Ce/UDAR+56JUCAboXlnXgazGgt3nj",
-	"wnWno/YNtRxskr+55zQobeUlLWOcDBOtWH4FI+gBIATdZOxRzUZPXGaRJzYLhLX8FIJcQtaaeooNyOc0",
-	"25vBgioS4VPQ9BGHeSzm2+TgsJ5IStBEVZkx4G3dJkRxQfUmpJsKWyC2XuuLXBKx8SmJYgvNaqmR7m+1",
-	"AFs5r3JvP/nhyRw9+UH/V2syT/7lhycLKA+HLsjm2Q9wRs/mF2Tz/F/MH8+/7doTjH2zPYX5oMPcJwbF",
-	"/HbCjCxVtpXTKicOpA4xqT66UarWHdFlHZ+hdpwZtJHsBhza14S10k1XVwQc3IJEMgChThygOQQPVnAK",
-	"X/P+8jz6mvdH73uJ2afi5uHkHKa2yYpne17cX/iMaO1F6Y7/2Iw7vd43Gz+7ebXpmtM8D82H0nffo5PX",
-	"3wpt7yShYP/oYS/3wPj/gVMU1Hp/zCyt4DKalMlkRgqAjCyUW/zMlFPtEz7saP/g6ebuj9/AplKElCjJ",
-	"9UPgYTcOPr9FfBg1vTmq1Kzh+cOsYT9JSOEX8bfbuxjN2uDRyTNBcLqBgE5hFzFRhJAiDFJOdv/Q7OF6",
-	"kI4SISHohnrJNtk4dAPrnxZYnS3majmdZbx1wnEDRfahiMoDoJSe9Lu7n/QtV694yT5ZUdNXv1FfIRms",
-	"Mh8TnN4YMSvTYJWcSEQwtTXqp+PpfFYy+ltJbFY14IYT6j5i1C1cEcr6SAUWyhQONHbhBiIPt/1ABqtb",
-	"IbHd+7hFAjtUctwBuP3buHOrZfO6toLjJCeGcuJXIh3dOz3QE/797ic84GyZUZfkehgBKqO8E/K83Zjq",
-	"HJv+ty3a3QHDHEl3Jo11okQTJboLSjRGE93FRSG4DxLvUknZ5sYE7AVhm8+Aek3i/td6qTptueZq3Jx1",
-	"75v+nw/rfkyYPrGsz/h2GVeF6o49GrcRW2P/Bj4itt5+h+W1+vqVun/YcvX9vh5dMHxNpaq+TV4ckxfH",
-	"4/Hi2EdLmlkci+7IkhRXD6GGOqarrZ5QSr3wscdher6CgWorH55kenJMuS3HlE9CcKj9MPb4TcGIkRhr",
-	"o2bRMsMrqP9ly5xCYgoNsjzHYlN3vZYL9E8NbjhPjkBerFeKheOu5bgA2moHC7zGbVY0wApY/xNzgWuU",
-	"5UlYbhUL4u69q8/1xA6sh3oCMeyi7CSuQdsYrHwU8eRqdL+uRoapT35FVvL+y72I+i7pYJd8Fld2TRUl",
-	"hK2Q1uGs5D/ehZ3XDj7IqPvsTmadTKgPoh7G8LSttI3xnelA4lBZG2N98T0eu6mlG5m/SoeBbVppxLGl",
-	"A3OOCU6H4Y0xI6MJfb4o9OlwLgE/CCe4eRxK4zgEjccTn/TWseeLcQ3Zjq+TGfkLMiN3XM3hbhedxB0a",
-	"Pwa54GGl6vu7mZMEP5GCe1MZdoN6iFE50J6ZLVnPM7BGMpuFsE0toLErm/jFi4O+PuTklvDI0dyVi+zE",
-	"85U11i/LLPNFn02mjyUXw6TYH4mKVD/dcgve3pU8O+9MMnvB+BVDzQqacQsqtD1uNX2YWxeBbg8b/a59",
-	"ym85cguZbufjuZ1VxrNuW4SsZVYcYZU4cdkOJ5vWV2SU6NN8RqNSoAM9Bmz6WjShSTG5vysTEGfio55N",
-	"fqPgdaEzG5ZpCaKS6U7Zyj2fty5UFVbts2NtDXV0N8p6nqbo4OT4M6DQra1OyH5fyI7a2N7E7C68/4SE",
-	"WdWBdzlEtpIKfMW+kS2Qb3GTrGCHenNhRWE8eU9O3pNTDqwpB9bkmDYq583kozaEZ/XnvKr6mDTBvZ5k",
-	"7axDd6P0dWQ3uj//skHplWr5pabUTl+Pv1vsnvVK62O84NqC5FBpfYzpJzrL56OyTlG3N9ZWIu5zFVyj",
-	"xurRiGaEH7YiohDUMJY6zk0o96Wi3Ai/ngGEztq3b4nSfRZ5U24o+jwIxj+kxDUZJb/UV9mbSle1rCj9",
-	"8TK2YfudLUYsovkhvmqStO8A/dCkqb6Q6e3iXsnE8+f3sctC8IRIic8z8pIpqjYPnJjiFujUp/iUbCdQ",
-	"UYl9vG/AJKx/5cL6p2BgXGp/ZEj4dcvu0wUIiTVU9bvJo/or0zFuofMfv9I3dFsrsffdvAOAr6lU/tP0",
-	"PD49j0/P41MmnnvJxOPy7oBrnz9elzCKMkRwsja1ZDsmxan175YHvGRqSm7ziHwIgKdMfgNdfHpLmplX",
-	"FutjvgHu210I1mbse/YBCCadrNAPbRR2KNqS2Xf/gP9f77r61ra+8k2E+WaJ7C65vlmqfpuIqvkzcCIn",
-	"QLYmWsQV22Vwpx7evPK4lY3G+W9RO7YftWYSj/ig55MeNOlBkx40uQlPIn5jngbRnoT9bXxyuEw1xo+x",
-	"yfqGyVKfzGHvjsGGDxMDZ31Ur2NNSE9PAyMFx4jn5FYkPyY4/XxQ/O2E4l8Jikdo/nDSHjcDBW9eY954",
-	"X4WW1EeMW53moCmf0n3UTtvylhihzXEs1QR5EI5GcoDdJqp2vjt0pfp3mtCwl4cTM0b/28N0Xe6LAAcW",
-	"9jE5aZdRFIa2o+ns8rbp7BeTkHYrqk4upF+mp3lwK4eHrXSxFWj78NLPgz6+3dudnN75JhpwWxJllyr0",
-	"SX7aW4TP8a6wk5r0mct9N/G13s5rHgEifR0c5ytF3IA4ClJwSRUX9Ea1WI/D7nHbUaPJV+rI4OG82eLD",
-	"IPog+ppK1YDn5EY9uQ9M7gOT+8DkPtCfyd2R38lzoJcxbfEVDlrHHYaPwwZ3IUYGE9yz63Bz5smu8NCm",
-	"vhrudgi1Y55Ae7C7IctuxihntWEfu6rfj+Vfpdo0RHaPPFX2YNMxwemESxMujXs47EEo+7L2eDDqi3lH",
-	"HIbD00PCl/aQ0Lyow98Se+k+dPgcL+rdSej3e1cnjWAiELdPIGrKh+SlSIjcsORmJnXT/2TDkk41pGry",
-	"VdvUK0hvtaoHTeNW9RrUJ6v6ZFWfrOqfv1X9dF139q2ItsaOJc30stzezjvXUhO9bmxQn4z6ty3uVTR7",
-	"Mutv4Y1bDfs9DNKZ9mss8m5Uh2CKezfvN+eexPmHN/DXsLhLyh5n4+9B9LZ4PU5Brw39+K2z/Qj/ldpn",
-	"h+gUUWt/D14Ze/+EVRNWOW48zu7fg1rWFv64cOsLsv4Pw+bJvPflmfeaV3bMC0AvL7BvAJ/nlb1LYf6+",
-	"7+2kPkzk4m7Ihf5kjG7mPpcim+3NdmfXH67/OwAA///MduVu2pQBAA==",
+	"H4sIAAAAAAAC/+y9f3PcNrYg+lWwffeVk7utlu1k8mb8KrWlyHJGN7GtkuTcd98oO4Um0d24YgM9ACi5",
+	"J09V+zX26+0n2ToHAAmSIJuU9cs2/0msJn4eHByc3+ePSSLXGymYMHry6o+JTlZsTfGfB5tNxhNquBRH",
+	"4uo3qvDXjZIbpgxn+BcrP9A05dCWZieVJma7YZNXE20UF8vJzXSSMp0ovoG2k1eTI3HFlRRrJgy5oorT",
+	"ecbIJdvuXdEsZ2RDudJTwsV/ssSwlKQ5DENULgxfs8nUDy/n0GByc9P4ZRpu5GzDElxslr1fTF797Y/J",
+	"f1VsMXk1+Zf9Eg77Dgj7EQjcTOsgEHTN4P/VbZ2vGIEvRC6IWTFCy6Em0zpMIov+YyIF67HE4zVdsmCd",
+	"J0pe8ZSpyc3vN7/vgIWhJtfn2AJOMl9PXv1tcqLYhuKyppMzQ5Wx/zzNhbD/OlJKqsl08kFcCnkNuzmU",
+	"603GDEsnv9e3Np183IOR966oAnBomKKxhnDOxsdgEY1v5aoan/wyGx/KdTc+BRupgkqf5es1Vds4yP7K",
+	"aGZW28l08potFU1ZGgHTYNBU5yznaG0STN7aJgKVaoNiuQCAPOXmfW4SGcPwf18xs2IKsTuhWUZ0niSM",
+	"pSydkjWjcC7ErKgh3BDFTK4ESwklLz9+JBqnIolM2WwyLeB4BgNoPZlO3lCe5Yr1BVqwznKM8NdiPL+r",
+	"U5ZIlTY3FXwkCv+nCSXr3FBAQXJwcox7nZLrlSRrmjLCzZRQkZKVvIadJisqlixFqCimZa4S3GOVZtAN",
+	"/40pjXM2lnBy7L6RlC24YBoHu7K/sZTYq28JC9dEsY1imgmDpwk/U0HshZ+RM6agI9ErmWcpSaS4Ysrg",
+	"1paC/7MYTRMjcZqMGqYN4cIwJWhGkArbHa7pligG45JcBCNgEz0jb6VihIuFfEVWxmz0q/39JTezyz/r",
+	"GZdAu9a54Ga7n0hhFJ/nRiq9n7Irlu1rvtyjKllxwxKTK7ZPN3wPFysQRWfr9F88NHWTfk4nKV8s2h8h",
+	"o3JWf3cOyL+dvX9H1kwt4ZUxyYp8c/rmkPzf3/35h28t4gLO6spJkjlbwC4LnOfCyGoLujBMEW5m5I1U",
+	"hJJEMWpYWjbAQ+N23OuVzMq+FswL7JYyJERlN27inQjV8O2a6vriZuT9mhvD7JALyjOW4geN89h/4UZT",
+	"nhIhPfI2cLfxiFxyEbk7v3CRwhopsediEaPET0sQGDk9OjuvgcMOXTbVJeYC1nGxQELDNVkoucZRmEg3",
+	"kgtjt5txJgzR+XzNjSaK/SNn2gBSz8ghFbC3OSP5JoWjmJFjQQ7pmmWHVLN7x1uAnt4DkEUxd80MTamh",
+	"k1fdD/17BNFbZij0kiVV7mRgQgp+M504wByncYbl+LVnV1zDKTH0kgkLdYDs/7t3ar/sHadkxWjKFOEL",
+	"j4CaOWK4ZIIpRHsJb8Q112wW27zHgn6bP2ULpphIcCvavdhpC/P11/Pzk/ChKXemN1LY9SykWlMzeTXh",
+	"wnz3slwgF4YtgYeaTnQ+DxfZnGdDzYrMWSavq3TAUhBPJwA6+FwYOYWHcgXX9sJt4mJCpCIXE7rZKHlF",
+	"s4tJFFiGr5k2dL2JLwQ+VydULGH8iqWVrcIV2KvyzuUUuWYqPjpPAaXN1u7LbsXNNYW9wMrmGdcrlpL5",
+	"Fr/R3Kygk2UzyMZxpjNytN6YLaBNrQXXJOUaBIA0CoArpua9MP43aHjj8J0rlgKXELy5joIFly+EbnhN",
+	"HEjc3AHCllewgopRlrvkKX7l2nQyHdDA0tAM/iUXJPioR0bi3hkJbthaN2H5a8tpFM134qTtNClFPaoU",
+	"3Y6v6eO8pnCe9i0dRiXsebfe8t8chWrSTxgKEMgRTMckavtMrZlZyZR8c/L+7HxKTj7Afw7OD/86JVKR",
+	"10e/Hp0ffRsKSpanBOKEZwBUARjYyXRi2cYhchMs+dCPV/zyYZPWfjlxMxQ/vHZT4d7N6lCKBV/GiJtZ",
+	"AblY8GWEfOVm5aXpSDfcQ+SUoduH019besGXmIqlcsx+4nKw2KH+hJtuTIM/4+UUhGUMdVdckDn+rOG6",
+	"iJjUl/E1R/LfT7VzwlQC1HjJUB+05oKv4fxfNPkUOATNMpYYqXaiPp2z7Mw3ho5WZD5fKaZXMksnr/qv",
+	"66YNaGcOCi3A858rL5N/8hCODF+SOSPsI0tyw1KAcDtsdTBfL6JsjxZIBhfHtsOLOm2O7e7w5MOpo7Zv",
+	"peBGqmE6xVhnPL9D2MwCeCF2xpegPnGsdhOErU0Dmk+oJ9xOotR8CY9+UvYtyf/hwchZ3DdnMT7zjy00",
+	"a3dRu3q0Xi17UT2zf/thbPeBbAeuPPZAtc4TlzQ6m1fljtamI6V4ZBmk9WR6CyTtZzuKJ1+oeNJN15rG",
+	"HUU3G6YIVTIXKaEk10zteV324dnplKxlyjKWEinIZT5nSjDDNOESYUk3fBZwGnp29WLWvYQ6VWEfN1xZ",
+	"2xxLJMCzsUjX3VqFC4JxRTOecrNFtgfxpZy3n86PfTSKdpm0i0vWOOD65anZumFgQo3FLKa9ThKAaxVr",
+	"HsLIlAGUN3KTZ9SUWjUwQWm8LgB5bA8bB5rG12CommcsprNXbcwkSKdzqtkP3+8xkciUpeTk6G35718O",
+	"z/7lxXNYzYy89Zz5ihF4k2YFi8lZhhw6DZGhi08t9GjFgcy3JirtIeOq3kXN7McitQgWaq1ZapldZUk9",
+	"Uql/5DTjC85StMrHpsl5hMx9OH59/4cUrEHTJYtg+gf8HUEOm0Cyy/AxuGRbYnsFu+cCV8G1zqscf+WF",
+	"2Im8sOO4d8O7wLPh/uFSo4Gq4EMCzBhG8woerg2bvCp+P2WC02x/YQ3I3qAgF+UmYfHwWlAudATs1DBU",
+	"dYstYR+5NrpB6UL6FNe92wGbAty0hBqRImElwPvcK6CqSN4ikDgsvlnPFZZ6nspBf0Z+AT8BkgQNFSMH",
+	"CDeWTslrJjhLLXjeoO0xxL1+snKxign4sqRsQfMMKNjNTURSD1Ek2FoUMYpx2zdenmnKDOWZxvdECkYo",
+	"XEPjcSDJlUJ2xMBJez4WEP201fyfUW3OFRUaZzrnbQ5E0C6w6xRLM0VfllomCdblcNNIQgWa3frbfdZM",
+	"Aw1pruKv+ZoKohhNEclcO8LtRQEmz0OHzmVu3IqL5UXNOHKOJCD92RoJo8cAu595xma2LFoGZrUCGs7y",
+	"iI9YSvKNFJWNc2F++D76zitGdWzyb+aKs8W3xH4v+Qg/4zPda589JUU/qpcM/Ug9u6EWs47/TnHqVjCN",
+	"IVyx/fL0O69KSTO9Fvpc5Qx9dTLNBjs61cZ1Y9V+9UPXfg59lKpwCFbnKdFkOjk/efsbU/j6T6bhB0uj",
+	"nL+R/YiqUG6focof/jafUKWx6dlWJPiP91dMZXSz4WLp1aoA89+ADwW4gCDi/Ok2LPE/v80zwzcZe38t",
+	"GLZ/zXUihUA3x8l0ckJzO4/t0A+qR0LJLFszYdxLF2y28a0KltbHMhiitU0ByNYWBYRP2UZqbqTaRsEL",
+	"UG390DiD8GNxHm8yxkzLoeA3fwSv2RVPWHA+9ofwlOwvzbPCn2snZn8szu2crTfwFjt5zR2jRdkFX3pH",
+	"TS9/9bMJ/MxNpPvNtLvXLwVLfsYSxcygzsci44LdYta/GrO5Rbf3Cb9Fr9+ANYjtDiGeayPXd6+2n9Zf",
+	"jjPLoDt/Bng41rY9vJQJrqIQfXTEter3G49GzVfJ/l7V8G9WW80TmpEUP47+AaMWf9TiU71fEvL+jJjr",
+	"cwv9fIxvsqM1PMybERRxAbvGd7dEErT41NF02xKQkK/nTMFATrhhSpPrFQd3NMVwuq1XHuyeRhuqTER2",
+	"fFfM4tsQz/IXvHR89NDvrteZxaMZ6ofnND4WMMHKi1l6HWDVT755kHCNdh4kF1YusUQXJCdPGlCi0Ftt",
+	"2DqEzt0IGd2hDHV47YTKT1Ka5Di631oDYr/O3dsyh98JX6O+yuFz29MFbVmK8S0tahH4FAxBbI8omILB",
+	"XvNlqx40xW9+Zbec4LzbT3Oe8ywlhbvhJ07W+rqfl085ycDl4zYTgcQwp8nlkFNIAN9llhHoiP6uVv3V",
+	"hr7LHodsmzmHELteIthH0zF2DadDbOpA7FykGWv1uziouFQEezaSLClGw1CScroUUhuekDkOhzxNvskk",
+	"TQk3nv8BghjFe+98+rq3j3YuUqYcHQ8WZad0Fw9XMuurWz1+vRNIbTpUWGNVS9oESKmpRp6GttKAobBo",
+	"eancbAdmh+e0W571nXad+ivRNP9nCxLDl0JdjqfCUj8Z+I1tDdOVedp1Vr77zr1UkMAp5e2UgDCaAW9t",
+	"eAYYmUqm+26zFV+qcG7HnsOTD10vh/tcezcOTz7sfC8SmQuzi+/J5BLlJRgwznigWTM+DH6q8GKHJx92",
+	"3yk74tQtsAMyKPW2sReKwS1naSvBdx+qYCK+W+CCuetEq/N0rlfLjDWXujw9OTxyMkrU0KSZhrGPX0e+",
+	"1pZTGSvs2b6u11xfdqGY/x7gGCXzTCaXHmg7MG0nirj+4Zj4Uim2kartrW2PI75kStQQrzo0my1nEE2S",
+	"Uh9KIq7W7Ll40RJJAvToJyA6u8lVBS6DaFWcCS+nbj/ANzxjlhPuOsZqq8phruGmwetSNNl1pGmL0uU8",
+	"eONXLByQ63IaJddROC8U64TzhiaM0CvKMxQOjCS52Ch+xTMG7E6umdJDHwhc1Im/ei3xSu17oeZTMSYc",
+	"d9jSTdQTHMaHL83xC8T/uNBRTK+hoDvkCoymha2k2GF4bu1I+lcpL7XXIdaUbgvD1CkDlhOW0ZQJoWvp",
+	"14zNifLtCROIDU5dSBNnXRUpKsuWzhB2zc3KRtc5qVFfCKk8W6ln5HzFNCu6yyTJlZsqeB5WVLuZ0Vab",
+	"ZfIalgDs7UZqs2e/EUP1pZ5diL4OZhZEFgSwW6+JqXsY4HoKBXw/QOWu+f3DyT6Z3rXIBsdqsqJXjMwZ",
+	"E3XLuKMtQ6GE22ddULJxvf0RyrYPMArPFQ/1PoAVhB37d6JEqntAGjtfb6xxyyvQ5kGAEUcdqtgDIc1N",
+	"K906xh1y06rH0lY/1G8dtdGcbqmpUXK//953WWflIj5Ry2b9EgoNG/fz3I1irWvxt9OtdYwVZmmhWlct",
+	"12Vakw9C5xvLaPY0G0dnLqaIfi3mjX4tF9PyOVhhsfO4y3r5reqfbn8fQ2If3R09OIgBBGz0NH9qnubT",
+	"YZS/ldbf2kXd3XS51LdSwGoGeGI06HjgWiuZL62kAzoEomRumIqRi4ie/r3ItnZAvLcwoHf3Kw1nTXuc",
+	"ZRkQoYC2xmVCCfxHBM0Z2+CUgM+CXdtZrY7O6otR8wE3IcmkrigR5lJmjAoY/pN0LyAFRWM2S3jgqgS7",
+	"tj6/wm7W8DXrrycFq1eX1XAtNVJRuHqZpdH2dOMKO7hFu07wP2WOFJfBVStNT5atSwkMsfvsPl0z9Zat",
+	"pdp2KTXKFjUN6Bo/7FJjGGlo1iGn43eSa+fMiUN+ilInmK991++YuZbqEngAtaAJ69p/rG1FtSNsA8J9",
+	"i10g4ZuDNFVM6zaYHJ8Q6lv4wZqzcEEOj1+fEiFNYbXu70m/polbRXwNbw8O/SJalzB1iXZAZJeCDdQh",
+	"hsrDxtC7kd1p70Jgth/4+7O4XoTHzXxSG8W8pc+uU0EQ/+5V8R3mvPdnrcb6+FJqOvT3Z3ZVUVjzYcZk",
+	"Pxb5BpVWekVf/umHV/T5bDb7tudGq3N2bPuKKcXTlPnA9vhdqzVDztpFMODfwO3691VvWGJ9r6+ZYmFW",
+	"KGgv7Uh1mz5R1GUIpEFj4/wioS03miwyxkz0XS5cFtpROvYK647lgZlUakZYJOuotnuTBViG3fGkJQFG",
+	"Y6m2YZEPqX25w+ZfgdojPj1+ap0G8zfBL5sV1dZabWoUAwYgmm2o8tE8lOiM6pVTwVZVMvuKgdFx2PJL",
+	"ESS6Bf/ZO1T22I5ricrjKbF8l198IjMXd2S8Thw4SjANFTO4vcFvr/avqBqyn5tdl5PtupZOXYT5CVPc",
+	"XeP2wN94e8p7OmeZFEvkmK5XTFS7cI2OGTBcbmoKKFBmba1us374o2/p6Fs6+pbWxN/illr96x2lc4iN",
+	"vYtMQKPG210nG5ZMeEqAt929CqiTTtPCvAKtpShfK9+nkLg1XYdPhWKbjCasvZ+Tq1xHICxTovGebsk6",
+	"14hC8GvayQMc7U403jsBeQPsuzOS2wgzKZq8xnwb/u32Z4Hib2Y5iFxUIWGNCNUBPUw2VJkqlS9pv/If",
+	"KrxCY1/dPFToFOpQwMjGOdYx50BU9uuOX/t+PY6/f968Wt72AUzXYZ3H6r+/On92r1uMRrq0s3a76VJp",
+	"lu7mqU4b/NQACLk+uyET4rtlw3yG4wqnVWkHzFhv+NXCcgYxYhjMteKb12p7motWOlttBkzItXearzFf",
+	"18ieWBYM3simi5qNFcYhd4vpDu6BZ63tnm0DNq/Tu7bLqyXO4TXfUWqSFRdLDJzrI4jhqrUTs3xOOu1g",
+	"g6PVpuwvIsgypq89Hb1mV0zRwgfLr79YF65Dr6izWK/4csW0IRvFpeJm694mWgG87XTJ2AYlVgnnF1e/",
+	"ylsebQ11+vs1lz4t1YOqQqud1zihySVddurkgiahKk4QLrShKE9sbJMmxvdTSAXdY0l/dzu4W0YkY1T3",
+	"GDOu2rra6e94omSaJ6YTVGUTlzEZs3Xgeuyn2pUjbzjLnI/2gqv1NeBlKpnGfOzWXxBZNGlTuQ+BsJ+x",
+	"edNRRPbOiD43x+u3x9ED0Exxmln1fHwi2yLwsr3lTHk0BUu4lQ9BPpZbTHDFRCpVGyrBt1sP3v7O1Bxa",
+	"mt5iSYeOy310yg7nXEKFZ9adn511trG8vA+1mpEjmqzcAITrSh5NfK9Vatn9LfazYnra++WFDR3g4Dvz",
+	"Hv3RrqjuvpoeNL93ANcFgLfoeZNN3tftKBzIum5MJynXl5/S35pYPmWE3Ofl6D8AJgtqwBIgUSzI7awv",
+	"XNsL3vw7Va4Az6HiBlzrb136JjZxWFmn+bWcPPY1WFDss19k7FuYYCIG2xbX79JsiacG7OwmYxqUjRlL",
+	"AhLSVGbWyI7MUptVS+mIatwhdS303E1WGQkCFOxiBnPUuFM7aPSCu5vRZxHQ9n5WUd6vPuuwre9jJZ90",
+	"11qehU7Z3TvfhK0eQq7289ZtGE9E4JU9OzkLZbeIfACrcWHMleta9e7UdyesTifOI6GJDsjen1BjmBLV",
+	"HIRr+vFXJpZmNXn18k8/YCp4aDR5Nfkff6N7/zzY+/+e7/3l1cXF3t9nFxcXF//6+7/+16hXxg6xuZ3B",
+	"aYsEDL+GaSvi3pllzGARDkhcX9BVG0WdIwxNTE6zMt8W7TBQ9LlCtndFqWXXMtCjrRnrHzlf2gzEHjx6",
+	"LRC9fya34gws/4eMoh3RwjGazozGRPWeSdu66MruLVfi4W6m1gkFZPRbOeXCCBnV5owxZEn7+SoNICjF",
+	"LBWSMpTvG+yH10CGauRUjwHK9lUCFL8u1dje0INKl8qWQvNCHDWqP8WYPHTY/bLrTD8Ibtrvl7NFDXGi",
+	"T1tSfgSXqgLUmlo9fqdDLAgxt7gBiFrlestDD7C0g2W4/1QU/qDscd+hs/wd5J/orMb5HvNVxYtxljE0",
+	"08mJvGaKpe8Xi1uKKpVVBLM2vgULiXytCiKVT+FyI58rO4h8b4oxZzujKssWzuZs08zyVO+DekbbIEX+",
+	"j5xlW6/i2lZVJqjDRJsIN5qsqEqvqdNaaLkw+AdHW65U28irvWTC9Epr4V1/oANRthSst9F1qLRDy3J8",
+	"goOgRUP/0zEynFYsVwGkQ4FkBQOH6mmKLrOx3Ey9bNjjdXVx+E6Wa9E/heG/kQj8AeS7iMqOUO0ypFO3",
+	"ydb1gN5PW0wtwjgqgWjTrlr1X3sYUGzw9hB8tj16ZmgZomEK3I9BBVdztW0z7tSdRRvKCvYxyfK0eDyk",
+	"3GDml4p36YCziboLR05IbphCdzNLsuKrf+8bkTOvKu19BZ0FoQUupydvvY1BB5aQBvkZsPPQFhPZsFOC",
+	"9xwrMFY01KohASyoVhOiHQ9wgxeLqrWvkKmNVffCj2HO5CorWdbItMNM7f/tSRbFbFtsnhXNcycLFHyM",
+	"rSI6Png2tuzqV0nTPntyGVmkIkKavYXMxZCMEGHu9fj47COqqWZBiqFbpW+juZHAICZBIrfmvARjRnwJ",
+	"WJvGDBozMPeKnGbZ9lNSvDVQzWvGdT5vOQdoa1MGuIT5Cbiw7fU4mYKJUJCsPU5z4+bDEi2mFdQP1jks",
+	"81wtwveWZiOJlqPSF9QBxD2lxN14n739s7YdTSdSwLveuwIcNH7vARAluNSsupJoyEIhiNHkLsibi1r0",
+	"NzIt4OjFte2YUEGck6UkjLu8YQWu2pNRWFxQGA7w5QrdKHr4A+w2mVWl3jsPsC7yTsEsdyk3VtZ9O7mx",
+	"OUQgN37YnMvX9p6+z837hft3kB76NkJiZcpgisjXcNZo51qe6urXhqwX6hfrUZRO2VDl5LS/3dY7RTGT",
+	"K8FSSzwWzKl2KND7ZcbIm51xGyWKtblm9kgKE1R9aJbwnytGL1OoQ9G1k/mWXITrupgEGt4Gqui6buUJ",
+	"LN6tqXvhGIzXFfdXOmjEZuqdgA6w7klBxy4p7YJOLG6xqro787USqudf23CUtnB9+di5xasm1uqNbH/G",
+	"incl+qBVx+x+dnCO3+P5zLlWOc56ABE4NBpgHGlULQ3LrmxeNeqieNKig6VPENgC7xBHBNkouVRMR7JD",
+	"LJXMNz9t2/XbNlfqJdsi97RhChCZYDefGQmxsZyf+hUPjQn9+EEUyb9a9B625m9wcz3Qg7Rh/mKkOaKC",
+	"g0RLdkMuDnZMST/WpsxFc67iGHbOGbUb5l11X/wKiqJufjIPe8eXGkngMmXMsBm5EIjQvosLFZqHHC/F",
+	"jPlSc5Q93QLJhahGhmECTZT+yJlPUlL+iHzyqwuxR57pZ7ggbavT4U9r+9Oai9ww+9PK/rSSubI/pPaH",
+	"lG41Jv0JzbUv9v7y+8VF+q9/0+tV+nvUTFtW8SgLblcxnBUt9pwAuIu/Ksc8cx0gkYDaJHtrKugS61vv",
+	"sfakAjVaEFlAx3Axilou6ERmPInc1nqLUlYFedOV73K0o1xPEMG0osZZqOBQVZ6NqWTGCLcxwq1GD+zl",
+	"GlZHodH7bise14ePZ42Ktarmj6q3GG//YyeSip1IL0VOveOYW+pLrWIcpUw7Lz+0sqccUfjj0+8uZAMD",
+	"SZlwivuioCx1iVjWrhguzbJgJOQsqGJER7UjltU8yecZT35h28h1CIvvbrCdk0YsywLBX8VKZuR4gZEi",
+	"mplpiUMwPfyqGBxa6HkM5WKtAM0VlvQdJLKUnFRQ5+1QrtdSvLNqr6b3GXysRoiVo4S1TN0Giz34MFh7",
+	"QWAL4FLQsmEHlmpbW3M1OtmwbaNIOCzEuKFwY6VwaUt0bjbZtpqDIUAwPC2uyQbRcApH5qcu3wAcyh5m",
+	"pP5BeWnW9OOBK2cbOaG3DXmvRKP4cqpnYCr59X3dXA33BdpkfM3rVRTaNUI+XnAnZYHN+7p+0BGLfr9R",
+	"MmYG5mtmabAN2qzckuIi9c5ehjN9EIZnLVPZyJzIXEIScI1havCsN31IYYsXbrzdIH9c0YtZsls6jFdh",
+	"eNcfu/phCtgafGXKA9N2FCaoimKHt0aKYm4a3pxb1L+obrv7yWrNathoUi3yF6Y89PcLLUfYrdP/eWRg",
+	"R/H1KxZffbn3W8qv1Wrxdy/AVgrsWtNNnKg2ca5kRYuS9dcuAD5gBzBXoE9VX7w4zah12kFIy2+A48aX",
+	"GKqQVj8dOIAMf1F9j5hWvvzmZ6+VQoSvKu6o86lcm+ewAjfYTv5tt9mpOM9eeLFLsxE0a1NtnJaP2/g0",
+	"PA3dRnAkA5Ubrueo3fjytRvhw7WbArTqN2gT755pYqhaMhfhFQku1pEECYlWdoJQOXHyy+HZv7x4HsrV",
+	"RNui7531+NJa1GD/orz3IYr7VC/OVEWueZaF1J3rIkfLigm0FgU6hUArFHXJ2KwPjGHaFObOrs2en7wN",
+	"WzcCkrTqiTY7RcFKw2Gxmb0el5KhGUTaCk4IAhlKrIrgY/mxiZeAgyytq3saeNEZNFmPggQo3J6Gd4ZE",
+	"ms36cAXO42J3oofzk7eHimHUDc3KXh2ha90Ic1YaiGtHmJsVzJL0QtzGgAe5WVUVhns57zIhY5qZW9mq",
+	"C5N1nQpXd1BO0LqqXqDCnTXAZZ+7vQDl9vwT0sQ72/aSbdva1E+zZfDmUL120Hrm4QQAPcyH1b4PjfxA",
+	"j+W3D1sMEl04xsU1VrlmOp6DA9sT/3lnUU7XDjyUjoA7iAx4ZV0GEqlSTbRcM+OCWylIVpsNEzZhHi2Y",
+	"pyBuwb8aSwl95GKRcTHqaO6fEe9VBharb+MuGR4y1u5SvdXUXFzJ7IqlVjfRT4NxyhZMMWGda0Z+/S75",
+	"9RaC0B3scx3e4tndaagUo7rHi3mFbAQ2BR6gu2R8UdTZYiuqsAuUJUUL7jIGFd+8ziRRzJKERtV5HHBA",
+	"6RbnM79za1H//F2CUfVWFZU5HUTLkw7h1VVWGVfSokXxn2qakyu8OyOVfmx1SXEO/XQk0HzUi3yxepGA",
+	"WLYUh4BvhX8wEjU0mpfAm0yLQJ8WsdOWU8wYM4cYyZVivohKMfT6h1oRxNdcJ1IIlpjgx1MW/nZqHaXP",
+	"bIBkz0iiYP9daw+a1bYRfGndUaNNsbngS3Sfje+nrOVzfff+bM+jhZ//PTBo2IeP60pWdAFvVlYGMfrf",
+	"S25cKkLJtU0TaPn2ko2/hhGvlRTLEDne4ZhBZsMBRwTbKPoXvxQD3Uwn1ZDDzlLX5a5s2R2qi/BMH2S+",
+	"4FkFsQ8Vs9Fjp2wtr4rgNVYkPum5mcoqi0ErvxYzVH4tpqu1tXO7/cfDWYFmsdaa5Rnlghj20ZBvPpy/",
+	"2fvzt3Cyc6rZD98Xah83QlgmvE3vA+2OoFtL/eDrotSfNcErRtwsM/LW+SW5uM2LCS7O1923a7qYzMhr",
+	"G9yED3XRKDwt/GkydV2aR4M++DJvYQlhe8+0jUuZBkEOblkogfpaWiJfM8UTcvy6viwlpYlWTp9O1jJl",
+	"7VP/7//5vzTZMLXmtiohtJ6R/5A5PoN2OVtXhUcxsqBrnnGqiEwMzZx3FskYhRMg/2RK2rJYU/L8h++/",
+	"x9Ol+kJQkrKEr10PmZuWTt+/fP4tPMQm5+m+ZmYJ/zM8udySuYvZIEXF2dJ9yQNteiFgpbXt4HMCe9Uk",
+	"DYAGC7TlmptyYXukFZ1rmeWmjBz2KFpLGEHeScOcD6HYQhC6RnYEm6Jqem6LPlwrbgyLR9Xmui1Ts8Ma",
+	"zFJ+D1gTCworLlz0aceHqrnWNy4CNfCCcWr7dCz7Ozq7jM4uZRIDuCnDHFxsl7t1asEx46J28akqauPP",
+	"4z1+dFG7PId+STOg+Shqf6miNh7vzso4sVaRwjiha3utwAnWN3HNQLRBDaXNieSKCkVKXfD2BHHFNFh2",
+	"Bvkbwm29TzdxmTRgWpYBtQ1tE5u/ZWDKrjqodmUG3wH5UkCOVzOwZumfIPAkMLx1xlqXD0T8GDucRxBa",
+	"Ox1G0oZOAHyvZd5WFbeI6g785AtrWa0+DjUk5WlYCwWusnNH4BqItuaozyBpsACkydjS5Tkpe2gYVxKX",
+	"LmVG3qDem/BobHgYGV6L957Wo72n1VhvwLEw1rvKQwcOJ3vXPIU/DNDC2fCAcF+4qK1wiv1aBWyZDqqo",
+	"zyQXReUkbOOS+QbJuM+DfIRlldFmjaWyHFq9ttL/Q3g5i13QurMUU0lAsPiSLqsvFaVb3WJhjDVVlzbM",
+	"f0WvYDlBBaRyp9WDeF63+H33MirZuQQHZTh6Zz6PSuNPCWvxhdRiZvD7rW8ZLWvZwv7VWhWLbiVzbQ5K",
+	"wcdhTkk2gU3ffPHuFWCwHY75AvgizHHqWthquEB74JWnSFgsuvssBv4OY3wWkLIs5lA31M+oyMbz6b5F",
+	"aSMT1O6TL1qXaN+LKay+XQNdkn5mgimenLKNLHLnRB38FjTTrA7iPmZJP3RRWEe15Er6ZiO15vNsSxRb",
+	"S8O+xadHc8yT8+H0151qDxjZtYlulZtIEYzGBV9ycBlo/o6pcE8KRZPLoDTZn9Q9HU+cpsmV6uCCFLXi",
+	"mo+IG6/xodz67ipuZduAk5ck18xX5NZbkRD7JdSgldNZTuOUXXEdz/5XA3WwvEbnaVtSomnPqnS1Giex",
+	"9cJDGsuv5kkgKdDeMjNcEwYSEPK6wBowmqwC3qegZOfN+aGzFNn2QnABCXfLbIy2/zNdpBC0pJIX23BL",
+	"UGjXcUo8o3IGeupjg/kIYWSUhzRLYYZa1U89ix1YPBWmw9wY4IPEj6/+mEjBXHasKorbbJM+RVG/RJJH",
+	"RZ/oyxUM+fvNtD5hUPSg32wuKWl0Kj/Y7zfdEDiq7LIGgbLacQ21BJEbSxULTcovR//x428Hv344IhvK",
+	"7fFqZuDOsWgl48JMWixgYKX+vMV0BWIx8mWSzP3wLJ0Si6+YPFBsCVXLfI3veq7hN22oSKlKiV6xLAMa",
+	"YehHly5zwVmWetU9xIRnhm+yYiZNNnyDPnhLNBVivT6L9ltyzVS5CJID6hMKRqMV2Uuscedj3EEd0k+/",
+	"5mpXzjQughCoEpiFml7lwqombLoArknGFoaw9cZs4QdsVzSCQXLNlCYruR6U8hPOoy+qDUtMFyC8z0k3",
+	"9DbaHHC1gRr4brokxjEfWKv4d9N57CGV+pQzr54VbHswpYSUzE1GCX6MJw2MD9DAm34PeSVbtQxvbYkM",
+	"QSZkf39d7j/rYsd1gEP2wtNabVUcHixs7Zm4ybFtit1choSN3OQZ9cIFfvEroLmRVrFxhTqOglDALEWh",
+	"7Ab9KvcSh02ZFdsBJth8kPNQ1lNG+5zoxVNRetTgVcdcj+5f6OOB/5cb9D/Q7odTlkmK7jGUraVwf/Zz",
+	"THC4UEzn/g5mdRjvJ/d/yk35V7mU4ge3Ij9cZWGRB/Azex8cWxZgRfS1MGZTphQcIHwldJaoCOn+Cf0a",
+	"vOMEUVIacngQlz60vpaqrfav+2rzH+RmZRVMfz0/P7HppYEmh1qcYrjIVPqSb6wV4TemCla5OfHZJd84",
+	"+Y/YGAVyFXaIRVGbTPeCxPmvZxicRJw2vtfCYfBLtu0/ODTuO7a8ZG3eSPDpTiAPuNtOrv3XXVP1ef8K",
+	"RO4WsMGuE5WwgbiedKd+D3yMQJnqFJiK6Y0UGim7NlKVElpZt6ZWMSYuBj+w1K3zxYJ/bE51EvjafTj9",
+	"1YqwiVwz7ZT38GFONX4tJEknnZJ/5AwTCyu6ZgYNrfZRfHUh9gGI+0bue4Pdf8fGP2LjHoJmRewvjmuU",
+	"9B9a0vdXqO09uaU6b1V5ijr5zLJlz+iE3mpAJD2I9ZJAUlkiFUkyKWwuqdbMUDaXeMuFguHsZUPo26MA",
+	"5PBdQUROEqYL00qJ6TPyAV//NV+uDHQvrqUVklGawUfWLdodMUgxDr+ckwCmEhNLt5KiNAWyGyuWbUoD",
+	"Trkjf1PgaAoz+2yIKnQaHmsMYY7XdBmWOfXUu0mg+Toa71NEWHngodqeoykYepTyYjmJL+G0cyd2zui6",
+	"sfJfnxeHY8tB9UnaCiSPdO0+6Zo7qNhp/5LPmRLMMH3GEsVM94nf2TFpnGy3QaB/sRz4oDc0Ybu17A4q",
+	"ZY9pMGkMSCNSRcBXHmEMZFXTbwR2a4pBe5dsO7U+VE7Z69ONHrx7jYWbQHrcF3mWuUoGpYnfGvSFxPCH",
+	"pp0SPx993CiGPtA7ydPbentMNWmS1a/Dc3T0qDVe+DpFPdngi3NgmTNNvHXcggdUyivm6pi5NxDTi4KB",
+	"N1RPZxwTE4oUteUyL1xN7DK0zQfri8HTLQ5gcUQKxNQ/Snv7lPiF3USNwoaLPJbawn0pcpoy41E210zh",
+	"39Sm1fQamjKuGd+VonKP9U0sM4RVcp0whdnB0D0fQVUUebDXzCIZXK4N/UfOCjdHz1YYSbjW+EGi87hP",
+	"AuYe38AXj1pDOXQCRiPjtpViRnF2ZRkZAcEdzse7WEkJ90MLFVuACL1+NAZ74FiwLOfO52y3zIPM7bRa",
+	"kAv2bb290OEMQWBWVACdYddeXWsPF0RaH2Trj977oFrGq1onydo0cJ/FSVpQerWPLZqb2ByOpoS0lxSV",
+	"NoUkOSW5yJjWZCtzux7FEsYLUDrxXMk1VgsLMyC0VP1bUw7RSMeGrVtSdTbbFKnXCjzT+VzDcQvjUM6t",
+	"Ho/DuXS5HMRVquyP32+w0Ii6Xy0KecYtdTRMKgfrgpihb1Ad+4uV+0Vpkls/r9JdCIfxR4H6tlzglRIp",
+	"kS55sCtqopniNOP/RKSpLhRP15oayDcueGLOEppr5lR5sPVklYtLV07Qf0UQOHhiHDc2+rbcj2IOdBYv",
+	"63uyG+H6U3bi3WhlZqv4UUGuXsxe/Imk0uf1DeawuM+FYQKOMdcF3xLHlH9l2vA1CjP/is00/6d7xROZ",
+	"wfnhIg7RPbfQqsO8iiEhbRvbyjRII1RhY6RJv8JVsSel9oI1WSuncGtRsNt32uvAj4EReCcN/v8I4nY0",
+	"KLkl0++kwb+jIV54+averTsMsDXuwur5ihX93tyX7i1x1AFiCwYd264vmlKILSh897WvYBPlQ9okUeU3",
+	"wuuPPYjqG6bwgUjjD74lUI4w2fzt7qFxGnZsa12SIwEKQkhTmldumYesbIwYPd8Wz1U8k+R04pM4nIe5",
+	"IvqlbUhZxm7ZdclEa7jqAbGPQFIQ4Ypff1DSshyl1H9qwGDnVExOCiOYhwRqS2fklNF0Dzis3nmhPzFB",
+	"3FvLZ9vPNvO+ZQjhnjoVKBUhGyTVkkK4B7ZLqGFLqeDPb3QiN/ZX+259W/Azk96qylBOlJXEGBWCBD6x",
+	"sQMKQiqoAddZ7SNjfIg0F+QCQwT2YaqLCbFAbisaHDJALe4pyC46+OG0luNZcKYLJGfqmQ4iacoC6GWA",
+	"Tj9tfz25TgutKBoQBf/S1bRNzUsej2aBhxC+1APNB1RvbqrvW4eoE3obxoHDxiTI9wn/nOx3dvNLro3a",
+	"PgnrXbsRbM6oYipqC7vNLm5lCatN1OdmeHx4IBWVTHhUP0WV4QuamN2T+JZlUQu73ymBMG+y3kq13Lcr",
+	"0fssXbaYs3p7yNbNeRXTfZfZLrD5ZXTLlG7sACPO0QQ4uxBYTBsbVi2DFCdAEKTOooYBDmo5kxsmvBSk",
+	"9Ay1zzPDTcZIyX+0Wg9biSHs2FAUSOyGl0yb+uIduK9eQDO9oi//9MOri/z58++SFfuI/2D3Y7aUi8qx",
+	"oy9KnmWVxaGwO9RE6DtPQuCMisu+jiP2Yre8Obe08cnwteoMYCka3rWFD+xkDuXeHx7XqY0P+/xHTrcz",
+	"Lu0R4hyskg8C28HZOJl7oFGshEMMvicgkAV1QwopbgikWzKIBAlmCp+vMD8JTVO8LxiNif/ClC+/d4QP",
+	"1K/Nv529f0dOJPJ57e5qyFrH14ifkFdLw9jQWQOm6ODV6u9fl1tPmEqYMFErYvnNq2nsSh1fXBVxNmVj",
+	"26oipfyPb148f/7/oxfnf//b872//P7t/xUN4fM5mQLj5zAhOuh45DzHm36bLZagCOsQeuZ3Tdtqsb2J",
+	"+777fQYRZrGSF364/nqLNgBGjn6ei3R3XIFd4U/YNki7nxQUK5ZVSMu+Ax+6xjfTyUrKy56xxuATq/2+",
+	"Mrns2e1XudTBHmTfyOYzP5W8YkrxNGWiZ8+ivdUc4VWtZB1rCTXySQyalAROz2p4vfHF1SmvKPb7ZzGo",
+	"aZ1iiBLEhETsZCfWS3uo4q47cLIOpfgdCl63iAtE+dWTL59QrMp1BYR+yY3zzrDv0QRIMvDMv0dpVTuP",
+	"dxrydEHqnp+5CWYGq5T1rvEmIvwlfIJt3rbfYBFOfhvThIzpfsZ0P5a5tddoWM6foN/dJv4pB45n/6l+",
+	"r6YAKr7xMaHX4ycCUrXT6PmQFs/BmBPoC80JVKM5r/qKB/WY/p3hg6HH8K7GZ3rVu22opdjVFpmOsvUO",
+	"eLTksai3GJbMIvT57ZnRIujy6fknqoN9ahKKYXkgPGN+kDFlTvNYbHRlB02dwwqy/u+1Zf1H8MHY8cpU",
+	"eZup87X7UqmBCIJREOJFr5gCTUCunfKgyNc7Zwup3MSgJHBZh17dacahWu6gi4v0v0HcaEvWoA4NyLnN",
+	"heq+A9Tsjqz7mOLLJSodI5C0VuAJ+qteMZ+VqI8ghud95jpFiwcUIwbHVNlHVbu/E7kqkwV+HD5d83Ry",
+	"qDj6aU3Ax3she8Yotk5SDtzaJJixtY1dSrAbL8PCOXIAwJoL75yyttmP4J+HJx9ab+/Jh5gbBoZzXrbK",
+	"+lxfxntZr5BWH5NWn5GbgnJt36GuauKkfK/J7ffstOxmF+HvWtcOrUcLJG4ip9Si9PLUrkv3gY2IglYz",
+	"8t77nNpfN0wRf0GQtbJUZLA+pCS7EW4uPI1oQSiIdQaPLWGYcuXfWqjonJlrxkShxsGuTD8IYaxE1bcE",
+	"1VcqRQXbnoZHFdlxF9U524okxiqUX+tlv4NwFjhq76pqc1Kj/TCwohlpA/2MLHlmlI3cazuKV6MCZVSg",
+	"7If3bagKJeh510qUcmivRhlv6+MqQ1zfrUgGv6JI6Ud1yBerDqlRkMZl3exMHuA8f6Rq+hyF0v8xtCxa",
+	"uDIVZY/yjhrKnctH7O23HmRCXgidz313DjcQPZNwKbWxzCocAZZsOZAL4aIS3PV4GgkMmmkDm1N6h2Pl",
+	"WjXhPcynqH+2wcjD0ckG3k5nVNKrT9MA0dvRvs40pF4RcijXa97iCGiDYbABWVG9KssewTrAiSl28n7k",
+	"nzvc1IvRAy/02OB9Ykhuocr6AMqTMxRw2jw+nZqlyIJd3ANqMEUZF9Ynkq8jrsp9a0daGQs37eJwavVO",
+	"O+MAcs1S5xrTR2MUujbD0nPNKnP1BHFY5zFcQRTeNv+scy5hLtAoqhapqRm0UdSw5ba/jgEzsJ+54AfU",
+	"ElfPpBhxZ2x10bJjS2XW6RrRCD97zaRL2Us29td6TuG6LhUd2WzdriBte6e6w2vfylL+IbB7JMauHxEM",
+	"xLXKcV8HIOdTsbsS+OtIF0xmgwk0zleK6ZXMdib1DHzBom7Uvu530lJYuPrdMnJCij1kiGlilRgYAGG2",
+	"YXnow2NMXplxwaYucqmsWM7cmw1p1vcyjPc8ODm2HuijqXSU5UdZHnpUr94wab7W927l+ergcceIZpuq",
+	"c0T1+3jnH1sj0DyPXnxxtduoFfhStQIRWrTjyrcW3qnWonQC3i5ygLGmbfle8RuOazkIP4svX0HtqD6/",
+	"x1JRgUd7YLvCKotyp4rR9GIytSYmwMKfj84LHJnaKqhQnJJVGkGMddDIpTDPtmSjGCQhK+J+C1QucwW4",
+	"jAqKwTnbKhyy5KQufJGLV3ZlQ3KZ13lxC8Mex9sixcdaDZPjdx2zPb/4MZccYtv5WqmXucwcil3JywFG",
+	"wurazmEm/27uAKxb9W7AnseDMCONHKPNrrNtUTysAEA/WLKPG66YPogJtHzNKgWqirsD8wqJjDlTAFi2",
+	"qdSUZWYaNE4l0/i7nau/uN0vKhMniXqy9CAH7pb7V7sYq38hgJ4hsxiT5oLdfPQjaHhYOiVawu1OilfF",
+	"5wRKCV1SLnonN7ZLmfa/wohEQWTVToRzbcvnELHvK8W5KckF/wcmNTIrLmLE5i7R0qfsWLva27T0BTBl",
+	"zzjRixRe62x+++ejNTK/nXD2QTzbNEhuRkU31k2LatkBBhnNskUTHx3pbMdH00DGoFxjf9x6yngPuuEP",
+	"ejgQoB/G1059QXPFsMp3UUDR+qN8UVQ/Tn7dxNMAn6IXQSrzXqU+VYmPzD/QSSM2/8xVHXAWIKkMkdAz",
+	"jFC1/V4znURDlc706lYJMTaKX1HDfmHbE6r1ZqWoZu2pLex364+kVydF36eQ06K6oF0ZPty+ydnZX/tn",
+	"j4+qTAPf7GGg1+GR7XD/vqeocNh9NWCtyALdkf25K8S73FT0TrS9BEXJUupSDDoVAGAaJKR2yYhSKZ4Z",
+	"38JmYgyyDNWLRmJqwNu5TZdiitUy+PDxlkxBVMf9s9c0WXHBWqe6Xm1rEwAMHI9xMXlDeZYryFNk1+Py",
+	"8nFdJqy0BTRsKj37glTkrjLN5QE5xWWSJKPKEhsfmug2CxeDzHNTPi4uGJYR3sLhdB+ng2UJPPIeE4e+",
+	"IheTM2u5uJhYIbrY6b0rVfSGJXtUpHtu8f0ueVmXx+6t7kh9mgvn73yAVhD/D8wMiHSbhn8eC+qbwc5Z",
+	"ik7Qa3mF/3L1eofViSnXVq6l8alYXPyLX17ja235je/Bfhrfig02F1rsuPGpAMHNdHJ+8vbAGKZNm8m7",
+	"8h1TjoT5X709Csh8xfAMbI2zkrsUNSL10lnG6BUjlJyfvI1oo8vZ4N3M5xmPKMBirbzd8vzk7fnfTz78",
+	"9OvxIaGK0SJaI9gHLPebg1++tTtJYHosTFTfU4Xjmm9NlNmy3bfoT99YafAxWODZ3w/Oz0Hhqo3K8Tr5",
+	"Vbq1JJUcdxUwz7ex7YBsDGWijKI2C6+b7OyvB3sv//RDLUPP4dnpkK2d8aWgsMrW/RUtKqdwdvzzu4Pz",
+	"D6dHMG8IiZY99FkSuzwsQBRZT+WzX8zJ0ds9z5wkwWcHDSZSqbQtxIuYcfTLt/7b+cnbqc1PXEiJ5ydv",
+	"yZqKfEHx5FSUfPsj60DiRpNdGFy/bWWKn6krQ7+x48B3N5ZLJ93/0GsMSBXc0/j9jG23ei8iqBTjYc5P",
+	"3h4qhmPR7HBFs4yJaGRRtJ0zc7BE+ZrvqCnyZwZGfFAhz6VZRc/d5/atUwokbDVKmFCBlqsrpqYuV/P5",
+	"yduXf3e0npXLi8rK7ttPmZxH7lTle4AVL3/6+/Hrv7//6d+ODs+BFhuWFPYbu+1+V0gkagsSsK080FxA",
+	"rUF1BUfvDk//4+T86PXfz44OT4/Oy7RymrGUFH2D/N0hlIejYA1czfX3x6RTly26L0b59oQKfe2D1eJN",
+	"nbHzSIDfDGzW6fmax69boK4rwHZ/ORwLa/bF5x8OV90BPZfdq9UwXGtQDTgJs3AWicJGw/PobDI6m1C9",
+	"X7s6w7xN6p3v1t2kNnrc3yTSqOpwUmswupk9ustJ7ER6WWprHUevky/V6yRGlJr1LDPGWpy28ZMTQvyL",
+	"7++nS5O92wRrx++zvIJW9ktrGOQJvJk2lh8be5izRbFjR6XuIHeGK+t5J1ETDtetWaqPAWlIfAJokTDX",
+	"ycFmcyozdpCb1UBlfUueZbrZKJmxaLZl7FLJueymt5mu18ysZNRKAiMetyQYh2/k+HVtxPYqam3j2K/R",
+	"kfoZQkLguwUHc8YuCJ7ArYxU1K3tVY90NuER30zvIOH75dXb+NnbzfY8+l9+K0SOlw74mjCx5KLVOFoU",
+	"rItbSPFzadgPR5yi3SRMKaiJtvX84VEreusnlHe+LeF8LTHiLa101UxHw7DvvvIwhzur5WHGlJQzX1A/",
+	"ketXf375/Hk8n3DlUu28Hq5ppw0vHLP1Ilu9BpTtbD7CSyXzlgitBc8g2Te2mAbl9p3LJUaLWPu/IiJf",
+	"M8UTcvy66tdyMYHrar0fm9eVtYRhBgpJR/wsK1CY0nwVVSZMWPN6Fk+7n7L2Df7v//m/dODVSqD1jPyH",
+	"zJE7tJu2Oj+b44KuecapIjIxNPP+FRmjqAr8J1OSfAPoMSXPf3j+/FsADdUXgpKUJXztesCbH+/03Z+/",
+	"/7b0I/EQn9Zcb3GRlWTfMFkl5DWoPNMe9Uvn6Bbiwn+dyGIDgMOE7zPyThqXZomKLWFQNApWjk19pS95",
+	"xRT415oWH5BcMxVfhsMzrBVzD3gWrUvVGhQbXJgHqk9xZeldS8F33XpDdO2KGGmdGAFg2LN2in15vzrJ",
+	"iHCAm15V6P3VLV3xfvmt8fohLWXpku1rbtge3b/mC36vZRxCWm6z0tA0XG9bIYfp5KpVfVlqFJqHAuM3",
+	"nf2cZsD1wnJH1pcrodofmSZUsQvh+G2s48hg0dbDMz4hFMx2tzBKD7pCx/FYpw7txjIUPV18i/vbhBj0",
+	"4M6Om/GEOVOBsOG9BxuarBh5OQNuAbmQiWcqrq+vZxQ/z6C2jOur9389Pjx6d3a093L2fLYy6wwvJzfw",
+	"sE/eb5ggVjQlb6mgS2sjOTg5ngSoO8mF1YOlrmCgoBs+eTX5bvZ89sLhAJII0DfsX73Yp3nKjWKJVCn+",
+	"vozJ7LZ0Kjwp0Jq45nDE69zY+vHgGoruQUVVQC7FcerUSgfQ79TNAqtQdM0MUxqF8UgxL8sQkqIhnPI/",
+	"cqa2vjylxttW6HTsfQ9LyPqIFhgBBkAnXluL2NQbPfM1U5+5+pYOFTeKXWE93mrxUCC1sFJckK+NVZbQ",
+	"RcdEILbRMOxYNTtbXdRSExttUtb8lAvnZcXSolygNS1zV55UT71IocmzH59NybMff3xmtZDP/suPz2bI",
+	"fMBVUD9SQLVpUfwLdD4/voGrMb1iav4jFuxj37ZtEGcrikUP2iWKYvQjX+frSuVUe4zFBsN6rgW4yXlx",
+	"wJYdsYVC24+t0h0IRwVnkL8paFJYKhfTna4YVtkr+RSqAzTE9GdBGVqEUBu8sFpyBU5hIoLvXkbp9x+d",
+	"Hql2n0Za19Q5Tu2Ek8mrSt6C2IKg00/bYSfX6RFbzGx9YtvmtM634bSdTpZFjxtIceAPEskTyF6ujrVL",
+	"CRFU7tj/T+f812+egCih7QLpea2K3y9ARr+/w0mPlJIqNtVPNCVBvYzvn7+4/zk/CJDwsWxkaif97v4n",
+	"fSPV3Jb1uMEcOkv0nQveocnv8KF8oMxqv6yAEn2ffmY2jUG1LkfjJQJNlBOm7xWtilnaMerFnyOMVo7p",
+	"kkyxCziUmwYsrmjGU+fNFIXGb66BBYkPUWqCwrdrPsh4j1eM2lvr+ZkyT4QFbjsRabm1tScBFkZwNwHC",
+	"x9pwUbbqDbjp5E8PcW2Pne3P6umKZsGhBS5kmi8FF0tvZLI7yVjMM83+XileDS9m4F51ZgfzNKN+wq9x",
+	"gNb2+j6vQGFobiWoD07cAlLTeiDo49rOAHfCssnzdjYfOeA74oBt4eWqAM6umNqalauLFuXMoNftONmH",
+	"4tcv2fbFj3hGL6aXbPvyv9g/Xo7c+efKnXvT+sxpFkYOfaeduo2Ejvz6kCdtI2OeWa5F+KwRB+XGe3ao",
+	"GO1gJiZTP9pPMt3e//Fb2JTKOqNydvMYeNiOgy+fv3ic6e1RpXYNLx9nDQcuZtou4s93dzHq7tLRyTPF",
+	"aLrFMl/KeMF2pAglReglnOz/Ac/DTS8ZJUJCyC3lkl28cWgF6p4Wnzpn83AvnXt4q4TjFoLsYxGVR1IK",
+	"fX//k76T5o3MxScLaqW1r+AQk94i8ymj6a0RMyjTb8ObFtyy0TVMbYz66Xg6ndi0KLburX0NR9R9wqi7",
+	"ASG8ibwbqgzH9GTWJlxD5P66H6zfficktn0fd0hg+3KOewi3/zbs3Cq17G8c4zjyiSGf+BXZNx6UHsCE",
+	"f7n/CcHYkPHEDCFAefTt3GQ0uT3VObX975q1u4cHcyDdGSXWkRKNlOg+KNEQSXQfIzyKAn9tIqnY3pqA",
+	"vWZi+xlQr5Hd/1ovVasu116N2z/dB7b/5/N0PyVMH5+sz/h2WVeF8o49GbcR5yN+Cx+R165nXPNafv1K",
+	"3T8sYHf4erTBEAyP5bfRi2P04ng6XhwHEBdkWPuOfNjJfNtEHduVpT5SHZPEDTsO2/MNDlRZef8kv6Nj",
+	"yl05pnwSgmOs3tDjx05DMdZFJJNFRpcwjQsGsgE8ALL1mqpa+jo9I/8O4NY2AAv5RV/Ew54dHnelPil8",
+	"9oMFGRpcRXvEClz/M3uBK5TlWXmQGLLl772L2SLP3MAw1DMMX1V5K3EN2sZgVURoj65GD+tqZB/10a/o",
+	"4eIA3knjKyq08WdxYdcmeyfUMWktzkrFx/vQ87rBeyl1X9zLrKMK9VHEwxieNoW2Ib4zLUgcCmtDtC9F",
+	"j6euamlH5q/SYWCXVBpxbGnBHPBi6Yc3rpjOiD5fFPq0OJegHwTTNRxK4ziEjYcTn/TOseeLcQ3Zja+j",
+	"GvkLUiO3XM3+bhetxB0bPwW+4HG56oe7mSMHP5KCBxMZ9ue5SLP22O7X8lpk0rGCKadLIbXhCbHdbG04",
+	"dzV9EnhaVH3AVFvLf/LNHuxHMa1ZSgxVc5plDUrzM3OGjp/sij4rXhI2WT3KMtk9FxRVUvUZWq//yzu+",
+	"/hag7UYyHz0zDXgqsqK2EFS+geN3xWfcqW+Z+cpZ3qieyJdOpZGLUrsZZZ1FB+4lNStU94rUgVxbZS83",
+	"RLCPhuitSPSMuPdYh8fhrW3Ztn4Vq+na6i87Nn3ad+7FA1+Fyqv4dWJ3+1ORSKFlx1tR4r9rCf8X1k+g",
+	"Df0O3ZhfvObAb3T0YHvqHFEml+2Z6c6MYtQSVmgXVpWLUHXnJ4OpM1O2piKgy/8pc6zhIFX5mzMhMmUH",
+	"FiQATjvD9Css+JEvUMNyh3ZOV64z3C4TRvGgLLUt/0dywY3P/Mw1rnNG3uYmx/Ak9jHJcs2vbCFugEqb",
+	"uRPGmXzSQv2xNs8DfgkOpPdyYU1t66Wbze2Xi0sV7BorTgC2EMUyamDi1OWkIhm/ZOTF8zXg2cvVlEjf",
+	"VpDTN4fku++++wsp0ri1rVJzkQx0z3lXeE+sJcoICROGZLZiinQ7aJvPUJ5VpltzAT4Zk1fP+/gi/MLY",
+	"hmi8qZj0nF1bUOXC8MxZ7u1TxTVJMqlZ2raShQSLYbflfPfjYdhHs7/JKK9Rt54iwSig39dz9KeHMDy7",
+	"svUfRFGT1079AHv9mRp2TbdQ3l3mg19CXwS4/TksrERF04Kw9zcbvS+m+XLtjuUeRwvScDX1bbAr0Fs/",
+	"EQS7LwV2DbceWpPdC7XHB+zx5XebwJylrdR86RxWF3mW+SvmeN2FVLWL12LJ/ZmZUzdPUNdqx717d182",
+	"3aiDLyqtscg48SApy5LFmEBse9po+jjvSQS6Hbrk75un/E76Wiej2uEJqR3KCpvtnFZZ1W0gi2WXNvp1",
+	"jWyVZ6sGo1LATz0FbPpavAFG1ulRWCdWZP6zOb4DD9vWjPC2pS1Mg91B+ZS2BPaVqQWLDPE70335G+Wi",
+	"r1NyeHb6GVDoxlZHZH8oZCdNbK9jdhveb2TGE36rgODywMmJHyXub162DBp+pXHCNdBvd0QM9wEyhBdF",
+	"QTwGEo+BxGM6+DEd/Bij2Y9xQdK5HaM1e7xY3TGUovFubVviKevAv6fIysY0DxxjGZ9/9NV+bI1dBLc7",
+	"2eQBIZh97kCUPd4OUbnE5vh8JMX2y/BVavX6ywaRuM3d2AaK4hHXRlzreNWHBHnuRjjs92Qx7osJAh2C",
+	"46Pm7UtLhBu/yP1DQvu8G9jvs7/I9y0uPMaNHoWUkZjcLzGJykOfUGu2xLLd5oKxqmwD5P3tBZ1lZKMw",
+	"Hu0Fo71gtBeM9oLRXjCoXORoMOjzZnWXiy372GDLziSMjRO4d6vBoHo7Lx62MmmlNOtYFfVrNF5ES980",
+	"Pg9KINlkJPty67fUC3w+5Tx63YyvXKl8m5KqJVx3mC4G1E5dcLFkaqO4fViqODei3JeKcrfTf+4oQXhH",
+	"lO6zKDl4S9bnUTD+MTmuUQn6pQZz3Za7qhQU7HaTcg2b4TkxYhEtrfZVk6QDD+jHJk3VhYyG1wclEy9f",
+	"PsQuN0omTGtI63EkDDfbR67pdgd06lNCUXcTqCjHPjykcGTWv3Jm/VMwMM61PzEk/Lp59/EC9CPWZrNO",
+	"VjTLmFiydsaSCm3TxDFyfvKWJIqlTBhOM1J0vhWzicM22p2fvD0s1vTlXifYZgHIYsOn7vI86bs1Mp2P",
+	"fJ+vYOxWFguNzq5N1EnDfxodMx7e1YGLK5ldsfQ9jjG75CL90Volp7VPsJ0f11ubA2D0hPhcPSGK/Kij",
+	"F0QfOgm0afR8qD0HAJTqE7DIGLuVn+Qb2zFudC0+fqVukQjVHa6QLQAEnC0+jQ/r6PE4ejyOdckfpC65",
+	"r0IOqyqP15fP54IwmqyIJW3xSWnqMv3pQ5kLM5b6fkIMEb4pI0PU9k7vKLr9xmF9zN3Tf7sP5Y4d+4Hd",
+	"OoNJR8eCx7bzexRt8Oz7f+D/b/YNW28yatiVzQl8G2beD0GKMeJ8/blr91vZrJNFhfcZXyLPQDYmmsWV",
+	"q4vgTj2+xexpCxu1898hduw+angknvBBT0c5aJSDRjlojPwaWfy6JbBKtEdmf9c72Z+nGhKaUn/6+vFS",
+	"n/zC3t8DGxrHe876pBye6pAevT0GMo6RYJidSA4Odp8Pir8bUfwrQfEIze9P2uNqoMDmNcTP6E2oSX3C",
+	"uNWqDhpLBj9EzpsdtsQIbY5jKRDkXjgaqdJ7l6jaancAWWS9pmpbLcKlvSTUz/JwZsf41GKp43W5GwIc",
+	"aNiHJG9cRFEY2w6ms4u7prNfTGbGnag6Omh+mcGDwa3sH4nc9qxg28fnfh7V+PZgd3K084004K44yjZR",
+	"aB+FcL3im1RtVS7aAzr0Sl6T6xX3fiEVtu1a5llK5iyTYkmMBN11hKCQa6qJtbOnRCriaA+qqpf8iokp",
+	"ueZmJXMDQSJiCeXMqLD2iqYkpranucBx3/s9jETpjiYtIGqhPKqWB9yoTwlm3SHODY8XHBUPn7kkdZuA",
+	"1N3c2xNApK+Dh/tKETcgjoptpOZGqtuVdjwNu8e1sbUmX6lrUAHnXXUcVRdEwZBcg+cYmDA65IwOOaND",
+	"zuiQ0zVPQTTHoo3dD9MO7/ugddwF/zRscB9sZDDBAzvj12ceNXWPrTyv4G4LUzvEqaADu2u87KCaRpVh",
+	"n7qo343lX6XY1Id3jxj/O7AJVEYjLo24NMwU34FQzlb9dDDqi7HM98Ph0TT3pZnm6he1v3W+k+5jh8/x",
+	"ot4fh/6wd3WUCEYCcfcEoiJ8aJmrhOmtSG6nUrf9z7YiaRVDyiZftU69hPROrXrQNK5Vr0B91KqPWvVR",
+	"q/75a9VhnXEeCrBjwTNYlt/bvHUtFdbr1gr1Ual/1+xeSbNHtf6Ot3GnYr/jgfSq/coTeT+iQzDFg6v3",
+	"63OP7PzjK/grWNzGZQ/T8XcgepO9HiagV4Z++trZboT/SvWzfWSKqLa/A6+svn/EqhGr/Gs8TO/fgVpO",
+	"F/60cOsL0v73w+ZRvfflqffqV3aIBaDzLXA2gM/zyt4nM//Q93YUH0ZycT/kIpBUQPfGE0aTRObiVgn+",
+	"z+wI5MAPERdbXLOg1VdqF6hCfIdlYCdwQbPUBO1oIBgNBKOBYHS7HzX0nfNU6Oaoo9/5Tu3Q0tceqxZF",
+	"fRXq96Sqr03ywMr62Owjv/398+/uf9I3Us15mjLxFAwEjSvUzngPMRLsumcxhnuIEN8c/6mrdXdfuK9S",
+	"sdtX1IgYDHbhGNgMRgwbMSzCJPTXRO5GMuz1JPHsafAsD4/gI5/0JfJJX40mdDhLtm/kJbPVkrrlH0EO",
+	"To4Jtm6m1alRIquCsG25JlJk21IRgvocrokjMrNegtQ5jPWV0Ebca6Vu/GPJdhbqI+EaCdeTJVz7f+D/",
+	"O0VLxa7k5UAS1kvsfCJUqbtUiVtjZCL/aSwv9znIHvBRo6nAYlmussmryf7k5veb/zMAQyUkdFKJAgA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -30,6 +30,20 @@ const (
 	ApplicationsSummaryStatusUnknown  ApplicationsSummaryStatusType = "Unknown"
 )
 
+// Defines values for AuditOutcome.
+const (
+	AuditOutcomeFailure AuditOutcome = "Failure"
+	AuditOutcomeSuccess AuditOutcome = "Success"
+)
+
+// Defines values for AuditVerb.
+const (
+	AuditVerbCreate AuditVerb = "create"
+	AuditVerbDelete AuditVerb = "delete"
+	AuditVerbPatch  AuditVerb = "patch"
+	AuditVerbUpdate AuditVerb = "update"
+)
+
 // Defines values for ConditionStatus.
 const (
 	ConditionStatusFalse   ConditionStatus = "False"
@@ -42,10 +56,13 @@ const (
 	CertificateSigningRequestApproved ConditionType = "Approved"
 	CertificateSigningRequestDenied   ConditionType = "Denied"
 	CertificateSigningRequestFailed   ConditionType = "Failed"
+	DeviceDisconnected                ConditionType = "Disconnected"
 	DeviceMultipleOwners              ConditionType = "MultipleOwners"
+	DevicePaused                      ConditionType = "Paused"
 	DeviceSpecValid                   ConditionType = "SpecValid"
 	DeviceUpdating                    ConditionType = "Updating"
 	EnrollmentRequestApproved         ConditionType = "Approved"
+	EnrollmentRequestTPMVerified      ConditionType = "TPMVerified"
 	FleetOverlappingSelectors         ConditionType = "OverlappingSelectors"
 	FleetValid                        ConditionType = "Valid"
 	RepositoryAccessible              ConditionType = "Accessible"
@@ -90,6 +107,23 @@ const (
 	DeviceUpdatedStatusUpdating  DeviceUpdatedStatusType = "Updating"
 )
 
+// Defines values for EventReason.
+const (
+	EventReasonDeviceDisconnected        EventReason = "DeviceDisconnected"
+	EventReasonDeviceReconnected         EventReason = "DeviceReconnected"
+	EventReasonEnrollmentRequestApproved EventReason = "EnrollmentRequestApproved"
+	EventReasonFleetChanged              EventReason = "FleetChanged"
+	EventReasonRenderFailed              EventReason = "RenderFailed"
+	EventReasonRenderedVersionChanged    EventReason = "RenderedVersionChanged"
+	EventReasonRolloutStarted            EventReason = "RolloutStarted"
+)
+
+// Defines values for EventType.
+const (
+	EventTypeNormal  EventType = "Normal"
+	EventTypeWarning EventType = "Warning"
+)
+
 // Defines values for FileOperation.
 const (
 	FileOperationCreate FileOperation = "Create"
@@ -132,8 +166,10 @@ const (
 
 // Defines values for RepoSpecType.
 const (
-	Git  RepoSpecType = "git"
-	Http RepoSpecType = "http"
+	Git   RepoSpecType = "git"
+	Http  RepoSpecType = "http"
+	Oci   RepoSpecType = "oci"
+	Vault RepoSpecType = "vault"
 )
 
 // Defines values for ResourceAlertSeverityType.
@@ -149,6 +185,18 @@ const (
 	Desc SortOrder = "Desc"
 )
 
+// Defines values for SystemdUnitStatusType.
+const (
+	SystemdUnitStatusActivating   SystemdUnitStatusType = "Activating"
+	SystemdUnitStatusActive       SystemdUnitStatusType = "Active"
+	SystemdUnitStatusDeactivating SystemdUnitStatusType = "Deactivating"
+	SystemdUnitStatusFailed       SystemdUnitStatusType = "Failed"
+	SystemdUnitStatusInactive     SystemdUnitStatusType = "Inactive"
+	SystemdUnitStatusRemoved      SystemdUnitStatusType = "Removed"
+	SystemdUnitStatusRunning      SystemdUnitStatusType = "Running"
+	SystemdUnitStatusUnknown      SystemdUnitStatusType = "Unknown"
+)
+
 // ApplicationEnvVars defines model for ApplicationEnvVars.
 type ApplicationEnvVars struct {
 	// EnvVars Environment variable key-value pairs, injected during runtime
@@ -171,6 +219,66 @@ type ApplicationStatusType string
 // ApplicationsSummaryStatusType defines model for ApplicationsSummaryStatusType.
 type ApplicationsSummaryStatusType string
 
+// AuditOutcome Whether the call succeeded, meaning that it returned a 2xx status code.
+type AuditOutcome string
+
+// AuditRecord AuditRecord records a mutating API call, who made it, and how it changed the resource.
+type AuditRecord struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+	ApiVersion string `json:"apiVersion"`
+
+	// Diff A JSON merge patch (RFC 7386) that turns the resource before the call into the resource after it. For a created resource this is the whole resource, and for a deleted resource it is the whole resource as it was before the call. Omitted for failed calls and calls that did not change the resource.
+	Diff *map[string]interface{} `json:"diff,omitempty"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Outcome Whether the call succeeded, meaning that it returned a 2xx status code.
+	Outcome AuditOutcome `json:"outcome"`
+
+	// RequestId The ID of the request, taken from its X-Request-Id header if it was set, and generated otherwise.
+	RequestId string `json:"requestId"`
+
+	// Resource ObjectReference refers to a resource.
+	Resource ObjectReference `json:"resource"`
+
+	// StatusCode The HTTP status code of the response.
+	StatusCode int32 `json:"statusCode"`
+
+	// Subresource The path below the resource that the call was made to, such as "status" or "approval".
+	Subresource *string `json:"subresource,omitempty"`
+
+	// Timestamp The time the call was received.
+	Timestamp time.Time `json:"timestamp"`
+
+	// User The identity that made the call, as established by the authentication provider. Empty if authentication is disabled.
+	User string `json:"user"`
+
+	// Verb The kind of call, after its HTTP method (POST, PUT, PATCH, or DELETE).
+	Verb AuditVerb `json:"verb"`
+}
+
+// AuditRecordList AuditRecordList is a list of AuditRecords.
+type AuditRecordList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+	ApiVersion string `json:"apiVersion"`
+
+	// Items List of AuditRecords.
+	Items []AuditRecord `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// AuditVerb The kind of call, after its HTTP method (POST, PUT, PATCH, or DELETE).
+type AuditVerb string
+
 // AuthConfig Auth config.
 type AuthConfig struct {
 	// AuthType Auth type
@@ -347,6 +455,57 @@ type DeviceApplicationsSummaryStatus struct {
 	Status ApplicationsSummaryStatusType `json:"status"`
 }
 
+// DeviceBootcInfo DeviceBootcInfo describes the bootc images of the device.
+type DeviceBootcInfo struct {
+	// BootedImage The image the device booted.
+	BootedImage string `json:"bootedImage"`
+
+	// BootedImageDigest The digest of the image the device booted.
+	BootedImageDigest *string `json:"bootedImageDigest,omitempty"`
+
+	// BootedImageTimestamp The build timestamp of the image the device booted.
+	BootedImageTimestamp *string `json:"bootedImageTimestamp,omitempty"`
+
+	// BootedImageVersion The version label of the image the device booted.
+	BootedImageVersion *string `json:"bootedImageVersion,omitempty"`
+
+	// RollbackImage The image the device can roll back to, if any.
+	RollbackImage *string `json:"rollbackImage,omitempty"`
+
+	// StagedImage The image staged to be booted next, if any.
+	StagedImage *string `json:"stagedImage,omitempty"`
+}
+
+// DeviceBundleRequest A request for the device to gather a diagnostic bundle and upload it to the service.
+type DeviceBundleRequest struct {
+	// RequestID The ID of the request, under which the device uploads the bundle.
+	RequestID string `json:"requestID"`
+}
+
+// DeviceBundleStatus The status of the diagnostic bundle requested from a device.
+type DeviceBundleStatus struct {
+	// RequestID The ID of the request.
+	RequestID string `json:"requestID"`
+
+	// RequestedAt The time the bundle was requested.
+	RequestedAt time.Time `json:"requestedAt"`
+
+	// Size The size of the uploaded bundle in bytes.
+	Size *int64 `json:"size,omitempty"`
+
+	// UploadedAt The time the device uploaded the bundle, unset until it does.
+	UploadedAt *time.Time `json:"uploadedAt,omitempty"`
+}
+
+// DeviceCPUInfo DeviceCPUInfo describes the CPUs of the device.
+type DeviceCPUInfo struct {
+	// Count The number of logical CPUs.
+	Count int `json:"count"`
+
+	// Model The model name of the CPU.
+	Model string `json:"model"`
+}
+
 // DeviceConfigStatus defines model for DeviceConfigStatus.
 type DeviceConfigStatus struct {
 	// RenderedVersion Version of the device rendered config.
@@ -359,6 +518,36 @@ type DeviceConsole struct {
 	SessionID    string `json:"sessionID"`
 }
 
+// DeviceDiskInfo DeviceDiskInfo describes a block device of the device.
+type DeviceDiskInfo struct {
+	// Model The model of the block device, if reported.
+	Model *string `json:"model,omitempty"`
+
+	// Name The kernel name of the block device, e.g. "sda" or "nvme0n1".
+	Name string `json:"name"`
+
+	// SizeBytes The size of the block device in bytes.
+	SizeBytes int64 `json:"sizeBytes"`
+}
+
+// DeviceFilesystemInfo DeviceFilesystemInfo describes a mounted filesystem of the device.
+type DeviceFilesystemInfo struct {
+	// Device The device the filesystem is mounted from.
+	Device string `json:"device"`
+
+	// FreeBytes The space available to unprivileged users in bytes.
+	FreeBytes int64 `json:"freeBytes"`
+
+	// MountPoint The path the filesystem is mounted at.
+	MountPoint string `json:"mountPoint"`
+
+	// SizeBytes The size of the filesystem in bytes.
+	SizeBytes int64 `json:"sizeBytes"`
+
+	// Type The type of the filesystem, e.g. "xfs".
+	Type string `json:"type"`
+}
+
 // DeviceHooksSpec defines model for DeviceHooksSpec.
 type DeviceHooksSpec struct {
 	// AfterRebooting Hooks executed after rebooting enable custom actions and integration with other systems
@@ -411,6 +600,44 @@ type DeviceList struct {
 	Summary *DevicesSummary `json:"summary,omitempty"`
 }
 
+// DeviceLogsRequest A request for the device to send its logs through the gRPC router.
+type DeviceLogsRequest struct {
+	// App Only send the logs of the containers of the application with this name.
+	App *string `json:"app,omitempty"`
+
+	// Follow Keep sending new logs until the session is closed.
+	Follow       *bool  `json:"follow,omitempty"`
+	GRPCEndpoint string `json:"gRPCEndpoint"`
+	SessionID    string `json:"sessionID"`
+
+	// Since Only send logs newer than this time.
+	Since *time.Time `json:"since,omitempty"`
+
+	// Tail Number of most recent lines to send.
+	Tail *int `json:"tail,omitempty"`
+
+	// Unit Only send the journal entries of the systemd unit with this name.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// DeviceMemoryInfo DeviceMemoryInfo describes the memory of the device.
+type DeviceMemoryInfo struct {
+	// TotalBytes The total usable memory in bytes.
+	TotalBytes int64 `json:"totalBytes"`
+}
+
+// DeviceNetworkInterfaceInfo DeviceNetworkInterfaceInfo describes a network interface of the device.
+type DeviceNetworkInterfaceInfo struct {
+	// IpAddresses The IP addresses of the network interface in CIDR notation.
+	IpAddresses []string `json:"ipAddresses"`
+
+	// MacAddress The MAC address of the network interface, if it has one.
+	MacAddress *string `json:"macAddress,omitempty"`
+
+	// Name The name of the network interface.
+	Name string `json:"name"`
+}
+
 // DeviceOSSpec defines model for DeviceOSSpec.
 type DeviceOSSpec struct {
 	// Image ostree image name or URL.
@@ -426,6 +653,94 @@ type DeviceOSStatus struct {
 	ImageDigest string `json:"imageDigest"`
 }
 
+// DeviceOverriddenItems DeviceOverriddenItems lists the items of a device spec that were taken from the overrides of the device rather than from the template of its fleet.
+type DeviceOverriddenItems struct {
+	// Applications The names of the applications taken from the overrides or whose environment variables were overridden.
+	Applications *[]string `json:"applications,omitempty"`
+
+	// Config The names of the config providers taken from the overrides.
+	Config *[]string `json:"config,omitempty"`
+
+	// Hooks The hooks taken from the overrides, as the phase and the name of the hook separated by a slash, e.g. beforeUpdating/reload.
+	Hooks *[]string `json:"hooks,omitempty"`
+
+	// Resources The resource monitors taken from the overrides, as the monitor type, followed by a colon and the path for Disk monitors, e.g. Disk:/var.
+	Resources *[]string `json:"resources,omitempty"`
+}
+
+// DeviceOverrides DeviceOverrides are merged over the template of the fleet a device belongs to when the template is rolled out to the device. They have the name of the device.
+type DeviceOverrides struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+	ApiVersion string `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec DeviceOverridesSpec lists the items merged over the fleet template. The hooks are added after the ones of the template for the same phase and replace the ones of the template with the same name, so they must be named.
+	Spec DeviceOverridesSpec `json:"spec"`
+}
+
+// DeviceOverridesSpec DeviceOverridesSpec lists the items merged over the fleet template. The hooks are added after the ones of the template for the same phase and replace the ones of the template with the same name, so they must be named.
+type DeviceOverridesSpec struct {
+	// ApplicationEnvVars Environment variables set on the applications by application name, replacing the variables of the same names. The applications must be part of the fleet template or of the overrides.
+	ApplicationEnvVars *map[string]map[string]string `json:"applicationEnvVars,omitempty"`
+
+	// Applications Applications added to the ones of the fleet template. An application replaces the one of the template with the same name.
+	Applications *[]ApplicationSpec `json:"applications,omitempty"`
+
+	// Config Config providers added to the ones of the fleet template. A config provider replaces the one of the template with the same name.
+	Config *[]ConfigProviderSpec `json:"config,omitempty"`
+	Hooks  *DeviceHooksSpec      `json:"hooks,omitempty"`
+
+	// Resources Resource monitors added to the ones of the fleet template. A monitor replaces the one of the template of the same type, and for Disk monitors of the same path.
+	Resources *[]ResourceMonitor `json:"resources,omitempty"`
+}
+
+// DeviceOwnershipDryRun DeviceOwnershipDryRun shows which fleet a device would belong to.
+type DeviceOwnershipDryRun struct {
+	// CurrentOwner The name of the fleet the device currently belongs to, if any.
+	CurrentOwner *string `json:"currentOwner,omitempty"`
+
+	// Device The name of the device.
+	Device string `json:"device"`
+
+	// MatchingFleets The names of the fleets whose selectors would match the device.
+	MatchingFleets []string `json:"matchingFleets"`
+
+	// Overlapping Whether several of the matching fleets would share the highest priority, so that the device would keep its owner.
+	Overlapping bool `json:"overlapping"`
+
+	// Owner The name of the fleet the device would belong to, if any.
+	Owner *string `json:"owner,omitempty"`
+}
+
+// DevicePackageInfo DevicePackageInfo describes an installed package.
+type DevicePackageInfo struct {
+	// Name The name of the package.
+	Name string `json:"name"`
+
+	// Version The version and release of the package.
+	Version string `json:"version"`
+}
+
+// DeviceProductInfo DeviceProductInfo identifies the product of the device. Fields the firmware does not report are omitted.
+type DeviceProductInfo struct {
+	// Name The product name of the device, as reported by the DMI.
+	Name *string `json:"name,omitempty"`
+
+	// SerialNumber The serial number of the device, as reported by the DMI.
+	SerialNumber *string `json:"serialNumber,omitempty"`
+
+	// Uuid The product UUID of the device, as reported by the DMI.
+	Uuid *string `json:"uuid,omitempty"`
+
+	// Vendor The vendor of the device, as reported by the DMI.
+	Vendor *string `json:"vendor,omitempty"`
+}
+
 // DeviceRebootHookSpec defines model for DeviceRebootHookSpec.
 type DeviceRebootHookSpec struct {
 	// Actions The actions taken before and after system reboots are observed. Each action is executed in the order they are defined.
@@ -439,11 +754,26 @@ type DeviceResourceStatus struct {
 	Cpu    DeviceResourceStatusType `json:"cpu"`
 	Disk   DeviceResourceStatusType `json:"disk"`
 	Memory DeviceResourceStatusType `json:"memory"`
+
+	// Usage The most recent usage samples collected by the resource monitors of the device, oldest first.
+	Usage *DeviceResourceUsage `json:"usage,omitempty"`
 }
 
 // DeviceResourceStatusType defines model for DeviceResourceStatusType.
 type DeviceResourceStatusType string
 
+// DeviceResourceUsage The most recent usage samples collected by the resource monitors of the device, oldest first.
+type DeviceResourceUsage struct {
+	// Cpu Samples of the device CPU usage.
+	Cpu []ResourceUsageSample `json:"cpu"`
+
+	// Disk Samples of the device disk usage.
+	Disk []ResourceUsageSample `json:"disk"`
+
+	// Memory Samples of the device memory usage.
+	Memory []ResourceUsageSample `json:"memory"`
+}
+
 // DeviceSpec defines model for DeviceSpec.
 type DeviceSpec struct {
 	// Applications List of applications.
@@ -476,9 +806,12 @@ type DeviceStatus struct {
 	Resources  DeviceResourceStatus  `json:"resources"`
 	Summary    DeviceSummaryStatus   `json:"summary"`
 
-	// SystemInfo DeviceSystemInfo is a set of ids/uuids to uniquely identify the device.
-	SystemInfo DeviceSystemInfo    `json:"systemInfo"`
-	Updated    DeviceUpdatedStatus `json:"updated"`
+	// SystemInfo DeviceSystemInfo is a set of ids/uuids to uniquely identify the device, along with its hardware and software inventory.
+	SystemInfo DeviceSystemInfo `json:"systemInfo"`
+
+	// Systemd List of status of the systemd units matching the match patterns of the device spec.
+	Systemd *[]DeviceSystemdUnitStatus `json:"systemd,omitempty"`
+	Updated DeviceUpdatedStatus        `json:"updated"`
 }
 
 // DeviceSummaryStatus defines model for DeviceSummaryStatus.
@@ -491,16 +824,71 @@ type DeviceSummaryStatus struct {
 // DeviceSummaryStatusType defines model for DeviceSummaryStatusType.
 type DeviceSummaryStatusType string
 
-// DeviceSystemInfo DeviceSystemInfo is a set of ids/uuids to uniquely identify the device.
+// DeviceSystemInfo DeviceSystemInfo is a set of ids/uuids to uniquely identify the device, along with its hardware and software inventory.
 type DeviceSystemInfo struct {
+	// AgentVersion The version of the agent running on the device.
+	AgentVersion *string `json:"agentVersion,omitempty"`
+
 	// Architecture The Architecture reported by the device.
 	Architecture string `json:"architecture"`
 
 	// BootID Boot ID reported by the device.
 	BootID string `json:"bootID"`
 
+	// Bootc DeviceBootcInfo describes the bootc images of the device.
+	Bootc *DeviceBootcInfo `json:"bootc,omitempty"`
+
+	// Cpu DeviceCPUInfo describes the CPUs of the device.
+	Cpu *DeviceCPUInfo `json:"cpu,omitempty"`
+
+	// Disks The block devices of the device.
+	Disks *[]DeviceDiskInfo `json:"disks,omitempty"`
+
+	// Filesystems The mounted filesystems of the device.
+	Filesystems *[]DeviceFilesystemInfo `json:"filesystems,omitempty"`
+
+	// Hostname The hostname of the device.
+	Hostname *string `json:"hostname,omitempty"`
+
+	// KernelVersion The version of the kernel the device booted.
+	KernelVersion *string `json:"kernelVersion,omitempty"`
+
+	// Memory DeviceMemoryInfo describes the memory of the device.
+	Memory *DeviceMemoryInfo `json:"memory,omitempty"`
+
+	// NetworkInterfaces The network interfaces of the device, excluding the loopback interface.
+	NetworkInterfaces *[]DeviceNetworkInterfaceInfo `json:"networkInterfaces,omitempty"`
+
 	// OperatingSystem The Operating System reported by the device.
 	OperatingSystem string `json:"operatingSystem"`
+
+	// Packages The RPM packages installed on the device.
+	Packages *[]DevicePackageInfo `json:"packages,omitempty"`
+
+	// Product DeviceProductInfo identifies the product of the device. Fields the firmware does not report are omitted.
+	Product *DeviceProductInfo `json:"product,omitempty"`
+}
+
+// DeviceSystemdUnitStatus defines model for DeviceSystemdUnitStatus.
+type DeviceSystemdUnitStatus struct {
+	// ActiveState Active state of the systemd unit, such as active, activating or failed.
+	ActiveState string `json:"activeState"`
+
+	// Description Human readable description of the systemd unit.
+	Description *string `json:"description,omitempty"`
+
+	// LoadState Load state of the systemd unit, such as loaded or not-found.
+	LoadState string `json:"loadState"`
+
+	// Name Name of the systemd unit, such as example.service.
+	Name string `json:"name"`
+
+	// Restarts Number of automatic restarts of the systemd unit since it was last started manually.
+	Restarts int                   `json:"restarts"`
+	Status   SystemdUnitStatusType `json:"status"`
+
+	// SubState Unit type specific sub-state of the systemd unit, such as running or exited.
+	SubState string `json:"subState"`
 }
 
 // DeviceUpdateHookSpec defines model for DeviceUpdateHookSpec.
@@ -580,6 +968,72 @@ type EnrollmentConfig struct {
 	GrpcManagementEndpoint string            `json:"grpc-management-endpoint"`
 }
 
+// EnrollmentPolicy EnrollmentPolicy automatically approves the enrollment requests that match its rules.
+type EnrollmentPolicy struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+	ApiVersion string `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec EnrollmentPolicySpec is a description of the rules of an EnrollmentPolicy. A request is approved if it matches all the rules that are set.
+	Spec EnrollmentPolicySpec `json:"spec"`
+
+	// Status EnrollmentPolicyStatus represents information about the status of an EnrollmentPolicy.
+	Status *EnrollmentPolicyStatus `json:"status,omitempty"`
+}
+
+// EnrollmentPolicyList EnrollmentPolicyList is a list of EnrollmentPolicy.
+type EnrollmentPolicyList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+	ApiVersion string `json:"apiVersion"`
+
+	// Items List of EnrollmentPolicy.
+	Items []EnrollmentPolicy `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// EnrollmentPolicySpec EnrollmentPolicySpec is a description of the rules of an EnrollmentPolicy. A request is approved if it matches all the rules that are set.
+type EnrollmentPolicySpec struct {
+	// AllowedPublicKeys PEM-encoded public keys that may be approved. If not set, requests are not restricted by the key in their CSR.
+	AllowedPublicKeys *[]string `json:"allowedPublicKeys,omitempty"`
+
+	// EnrollmentCertificateCommonNames Common names of the enrollment certificates that requests must be submitted with. If not set, requests may be submitted with any enrollment certificate.
+	EnrollmentCertificateCommonNames *[]string `json:"enrollmentCertificateCommonNames,omitempty"`
+
+	// Labels A set of labels to apply to the devices approved by this policy, in addition to the labels in the request.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// MaxApprovals Maximum number of requests approved by this policy. If not set, the number of approvals is not limited.
+	MaxApprovals *int64 `json:"maxApprovals,omitempty"`
+
+	// Selector A map of key,value pairs that are ANDed. Empty/null label selectors match nothing.
+	Selector *LabelSelector `json:"selector,omitempty"`
+
+	// ValidFrom Time from which requests are approved.
+	ValidFrom *time.Time `json:"validFrom,omitempty"`
+
+	// ValidUntil Time after which requests are no longer approved.
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+// EnrollmentPolicyStatus EnrollmentPolicyStatus represents information about the status of an EnrollmentPolicy.
+type EnrollmentPolicyStatus struct {
+	// ApprovedCount Number of requests approved by this policy.
+	ApprovedCount int64 `json:"approvedCount"`
+
+	// LastApprovedAt Time at which the policy last approved a request.
+	LastApprovedAt *time.Time `json:"lastApprovedAt,omitempty"`
+}
+
 // EnrollmentRequest EnrollmentRequest represents a request for approval to enroll a device.
 type EnrollmentRequest struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -638,6 +1092,9 @@ type EnrollmentRequestSpec struct {
 
 	// Labels A set of labels that the service will apply to this device when its enrollment is approved
 	Labels *map[string]string `json:"labels,omitempty"`
+
+	// TpmAttestation TPMAttestation proves that the identity key of a device was generated in and cannot leave a TPM.
+	TpmAttestation *TPMAttestation `json:"tpmAttestation,omitempty"`
 }
 
 // EnrollmentRequestStatus EnrollmentRequestStatus represents information about the status of a EnrollmentRequest.
@@ -649,6 +1106,9 @@ type EnrollmentRequestStatus struct {
 
 	// Conditions Current state of the EnrollmentRequest.
 	Conditions []Condition `json:"conditions"`
+
+	// TpmChallenge TPMCredentialChallenge is a secret that only the TPM holding both the endorsement key and the attestation key of a TPMAttestation can recover, using TPM2_ActivateCredential.
+	TpmChallenge *TPMCredentialChallenge `json:"tpmChallenge,omitempty"`
 }
 
 // EnrollmentService defines model for EnrollmentService.
@@ -676,6 +1136,57 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// Event Event records something that happened to a resource, such as a device going offline.
+type Event struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+	ApiVersion string `json:"apiVersion"`
+
+	// Count The number of times the event occurred.
+	Count int64 `json:"count"`
+
+	// InvolvedObject ObjectReference refers to a resource.
+	InvolvedObject ObjectReference `json:"involvedObject"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+
+	// Message Human readable description of what happened.
+	Message string `json:"message"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Reason The reason for the event, in CamelCase.
+	Reason EventReason `json:"reason"`
+
+	// Timestamp The time the event last occurred. The time it first occurred is the creation timestamp of the event.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Type Whether the event is part of the normal operation of the resource, or a warning that something went wrong.
+	Type EventType `json:"type"`
+}
+
+// EventList EventList is a list of Events.
+type EventList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+	ApiVersion string `json:"apiVersion"`
+
+	// Items List of Events.
+	Items []Event `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// EventReason The reason for the event, in CamelCase.
+type EventReason string
+
+// EventType Whether the event is part of the normal operation of the resource, or a warning that something went wrong.
+type EventType string
+
 // FileOperation The type of operation that was observed on the file.
 type FileOperation string
 
@@ -738,6 +1249,12 @@ type FleetList struct {
 	Metadata ListMeta `json:"metadata"`
 }
 
+// FleetOwnershipDryRun FleetOwnershipDryRun shows which fleet the devices would belong to if a fleet was created or replaced.
+type FleetOwnershipDryRun struct {
+	// Items The devices whose owner is or would be the fleet, or whose owner would change.
+	Items []DeviceOwnershipDryRun `json:"items"`
+}
+
 // FleetRolloutStatus defines model for FleetRolloutStatus.
 type FleetRolloutStatus struct {
 	CurrentBatch *int `json:"currentBatch,omitempty"`
@@ -745,6 +1262,12 @@ type FleetRolloutStatus struct {
 
 // FleetSpec FleetSpec is a description of a fleet's target state.
 type FleetSpec struct {
+	// DeviceDisconnectedTimeout The duration after which a device of the fleet that did not report its status is considered disconnected and its summary status is set to Unknown. Format is a positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours or 'd' for days. Defaults to the service-wide setting.
+	DeviceDisconnectedTimeout *string `json:"deviceDisconnectedTimeout,omitempty"`
+
+	// Priority The priority of the fleet when the selectors of several fleets match a device. The device belongs to the matching fleet with the highest priority; if several of them share the highest priority, the device keeps its owner and the fleets are marked as having overlapping selectors. Defaults to 0.
+	Priority *int32 `json:"priority,omitempty"`
+
 	// RolloutPolicy RolloutPolicy is the rollout policy of the fleet.
 	RolloutPolicy *RolloutPolicy `json:"rolloutPolicy,omitempty"`
 
@@ -790,6 +1313,11 @@ type GitConfigProviderSpec struct {
 
 	// Name The name of the config provider
 	Name string `json:"name"`
+
+	// When A template condition that is evaluated for each device of a fleet. The config provider is only
+	// included in the device's specification if the condition renders to "true". It can only be used
+	// in fleet templates.
+	When *string `json:"when,omitempty"`
 }
 
 // HookAction defines model for HookAction.
@@ -920,6 +1448,11 @@ type HttpConfigProviderSpec struct {
 
 	// Name The name of the config provider
 	Name string `json:"name"`
+
+	// When A template condition that is evaluated for each device of a fleet. The config provider is only
+	// included in the device's specification if the condition renders to "true". It can only be used
+	// in fleet templates.
+	When *string `json:"when,omitempty"`
 }
 
 // HttpRepoSpec defines model for HttpRepoSpec.
@@ -948,6 +1481,11 @@ type InlineConfigProviderSpec struct {
 
 	// Name The name of the config provider
 	Name string `json:"name"`
+
+	// When A template condition that is evaluated for each device of a fleet. The config provider is only
+	// included in the device's specification if the condition renders to "true". It can only be used
+	// in fleet templates.
+	When *string `json:"when,omitempty"`
 }
 
 // KubernetesSecretProviderSpec defines model for KubernetesSecretProviderSpec.
@@ -959,6 +1497,11 @@ type KubernetesSecretProviderSpec struct {
 		Name      string `json:"name"`
 		Namespace string `json:"namespace"`
 	} `json:"secretRef"`
+
+	// When A template condition that is evaluated for each device of a fleet. The config provider is only
+	// included in the device's specification if the condition renders to "true". It can only be used
+	// in fleet templates.
+	When *string `json:"when,omitempty"`
 }
 
 // LabelSelector A map of key,value pairs that are ANDed. Empty/null label selectors match nothing.
@@ -1015,27 +1558,90 @@ type ObjectMeta struct {
 	ResourceVersion *string `json:"resourceVersion,omitempty"`
 }
 
-// PatchRequest defines model for PatchRequest.
-type PatchRequest = []struct {
-	// Op The operation to perform.
-	Op PatchRequestOp `json:"op"`
-
-	// Path A JSON Pointer path.
-	Path string `json:"path"`
+// ObjectReference ObjectReference refers to a resource.
+type ObjectReference struct {
+	// Kind The kind of the resource.
+	Kind string `json:"kind"`
 
-	// Value The value to add or replace.
-	Value *interface{} `json:"value,omitempty"`
+	// Name The name of the resource.
+	Name string `json:"name"`
 }
 
-// PatchRequestOp The operation to perform.
-type PatchRequestOp string
+// OciConfig defines model for OciConfig.
+type OciConfig struct {
+	// CaCrt Base64 encoded root CA
+	CaCrt *string `json:"ca.crt,omitempty"`
 
-// Percentage Percentage is the string format representing percentage string.
-type Percentage = string
+	// Password The password for auth with the registry
+	Password *string `json:"password,omitempty"`
 
-// RenderedApplicationSpec defines model for RenderedApplicationSpec.
-type RenderedApplicationSpec struct {
-	// EnvVars Environment variable key-value pairs, injected during runtime
+	// SkipServerVerification Skip remote server verification
+	SkipServerVerification *bool `json:"skipServerVerification,omitempty"`
+
+	// Token The bearer token for auth with the registry
+	Token *string `json:"token,omitempty"`
+
+	// Username The username for auth with the registry
+	Username *string `json:"username,omitempty"`
+}
+
+// OciConfigProviderSpec defines model for OciConfigProviderSpec.
+type OciConfigProviderSpec struct {
+	// Name The name of the config provider
+	Name   string `json:"name"`
+	OciRef struct {
+		// Artifact The name of the artifact in the registry, e.g. myorg/configs/edge
+		Artifact string `json:"artifact"`
+
+		// MountPath The path of the directory in the filesystem of the device where the layers of the artifact are stored.
+		// Each layer is stored in a file named after its org.opencontainers.image.title annotation.
+		MountPath *string `json:"mountPath,omitempty"`
+
+		// Reference The tag or the digest of the artifact, e.g. v1 or sha256:<hex>
+		Reference string `json:"reference"`
+
+		// Repository The name of the repository resource of the registry to pull the artifact from
+		Repository string `json:"repository"`
+	} `json:"ociRef"`
+
+	// When A template condition that is evaluated for each device of a fleet. The config provider is only
+	// included in the device's specification if the condition renders to "true". It can only be used
+	// in fleet templates.
+	When *string `json:"when,omitempty"`
+}
+
+// OciRepoSpec defines model for OciRepoSpec.
+type OciRepoSpec struct {
+	OciConfig OciConfig `json:"ociConfig"`
+
+	// Type RepoSpecType is the type of the repository
+	Type RepoSpecType `json:"type"`
+
+	// Url The URL of the OCI registry, e.g. https://quay.io. The scheme defaults to https if omitted.
+	Url string `json:"url"`
+}
+
+// PatchRequest defines model for PatchRequest.
+type PatchRequest = []struct {
+	// Op The operation to perform.
+	Op PatchRequestOp `json:"op"`
+
+	// Path A JSON Pointer path.
+	Path string `json:"path"`
+
+	// Value The value to add or replace.
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// PatchRequestOp The operation to perform.
+type PatchRequestOp string
+
+// Percentage Percentage is the string format representing percentage string.
+type Percentage = string
+
+// RenderedApplicationSpec defines model for RenderedApplicationSpec.
+type RenderedApplicationSpec struct {
+	// EnvVars Environment variable key-value pairs, injected during runtime
 	EnvVars *map[string]string `json:"envVars,omitempty"`
 	Name    *string            `json:"name,omitempty"`
 	union   json.RawMessage
@@ -1043,12 +1649,21 @@ type RenderedApplicationSpec struct {
 
 // RenderedDeviceSpec defines model for RenderedDeviceSpec.
 type RenderedDeviceSpec struct {
-	Applications    *[]RenderedApplicationSpec `json:"applications,omitempty"`
-	Config          *string                    `json:"config,omitempty"`
-	Console         *DeviceConsole             `json:"console,omitempty"`
-	Hooks           *DeviceHooksSpec           `json:"hooks,omitempty"`
-	Os              *DeviceOSSpec              `json:"os,omitempty"`
-	RenderedVersion string                     `json:"renderedVersion"`
+	Applications *[]RenderedApplicationSpec `json:"applications,omitempty"`
+
+	// Bundle A request for the device to gather a diagnostic bundle and upload it to the service.
+	Bundle  *DeviceBundleRequest `json:"bundle,omitempty"`
+	Config  *string              `json:"config,omitempty"`
+	Console *DeviceConsole       `json:"console,omitempty"`
+	Hooks   *DeviceHooksSpec     `json:"hooks,omitempty"`
+
+	// Logs A request for the device to send its logs through the gRPC router.
+	Logs *DeviceLogsRequest `json:"logs,omitempty"`
+	Os   *DeviceOSSpec      `json:"os,omitempty"`
+
+	// Overridden DeviceOverriddenItems lists the items of a device spec that were taken from the overrides of the device rather than from the template of its fleet.
+	Overridden      *DeviceOverriddenItems `json:"overridden,omitempty"`
+	RenderedVersion string                 `json:"renderedVersion"`
 
 	// Resources Array of resource monitor configurations.
 	Resources *[]ResourceMonitor `json:"resources,omitempty"`
@@ -1060,7 +1675,7 @@ type RenderedDeviceSpec struct {
 // RepoSpecType RepoSpecType is the type of the repository
 type RepoSpecType string
 
-// Repository Repository represents a Git repository or an HTTP endpoint
+// Repository Repository represents a Git repository, an HTTP endpoint, an OCI registry or a Vault server
 type Repository struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
 	ApiVersion string `json:"apiVersion"`
@@ -1191,6 +1806,15 @@ type ResourceSyncStatus struct {
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
 }
 
+// ResourceUsageSample The usage of a device resource at a point in time.
+type ResourceUsageSample struct {
+	// Timestamp The time the sample was collected.
+	Timestamp time.Time `json:"timestamp"`
+
+	// UsedPercent The percentage of the resource in use.
+	UsedPercent int64 `json:"usedPercent"`
+}
+
 // RolloutDeviceSelection defines model for RolloutDeviceSelection.
 type RolloutDeviceSelection struct {
 	Strategy string `json:"strategy"`
@@ -1216,6 +1840,96 @@ type RolloutPolicy struct {
 	SuccessThreshold *Percentage `json:"successThreshold,omitempty"`
 }
 
+// ServiceAccount ServiceAccount is a non-interactive identity, such as a CI pipeline, that authenticates with long-lived API tokens.
+type ServiceAccount struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+	ApiVersion string `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec ServiceAccountSpec is a description of the permissions of a ServiceAccount.
+	Spec ServiceAccountSpec `json:"spec"`
+
+	// Status ServiceAccountStatus represents information about the status of a ServiceAccount.
+	Status *ServiceAccountStatus `json:"status,omitempty"`
+}
+
+// ServiceAccountList ServiceAccountList is a list of ServiceAccount.
+type ServiceAccountList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+	ApiVersion string `json:"apiVersion"`
+
+	// Items List of ServiceAccount.
+	Items []ServiceAccount `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// ServiceAccountSpec ServiceAccountSpec is a description of the permissions of a ServiceAccount.
+type ServiceAccountSpec struct {
+	// Scopes The scopes the tokens of the service account may be granted. A scope is either "read", allowing GET requests, or "write", allowing all requests, optionally prefixed by the resource collection it is restricted to, such as "devices:read".
+	Scopes []string `json:"scopes"`
+}
+
+// ServiceAccountStatus ServiceAccountStatus represents information about the status of a ServiceAccount.
+type ServiceAccountStatus struct {
+	// Tokens The API tokens of the service account that were not revoked.
+	Tokens []ServiceAccountTokenStatus `json:"tokens"`
+}
+
+// ServiceAccountToken ServiceAccountToken is a newly created API token of a ServiceAccount.
+type ServiceAccountToken struct {
+	// ExpiresAt Time after which the token is no longer accepted. If not set, the token does not expire.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Name The name of the token.
+	Name string `json:"name"`
+
+	// Scopes The scopes granted to the token.
+	Scopes []string `json:"scopes"`
+
+	// Token The bearer token. It is only stored hashed, so it cannot be retrieved again.
+	Token string `json:"token"`
+}
+
+// ServiceAccountTokenRequest ServiceAccountTokenRequest requests a new API token of a ServiceAccount.
+type ServiceAccountTokenRequest struct {
+	// ExpiresAt Time after which the token is no longer accepted. If not set, the token does not expire.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Name The name of the token, unique within the service account.
+	Name string `json:"name"`
+
+	// Scopes The scopes granted to the token, which must be allowed by the scopes of the service account. Defaults to the scopes of the service account.
+	Scopes *[]string `json:"scopes,omitempty"`
+}
+
+// ServiceAccountTokenStatus ServiceAccountTokenStatus describes an API token of a ServiceAccount, without the token itself.
+type ServiceAccountTokenStatus struct {
+	// CreatedAt Time at which the token was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt Time after which the token is no longer accepted. If not set, the token does not expire.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// LastUsedAt Time at which the token was last used, with a resolution of a minute.
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+
+	// Name The name of the token.
+	Name string `json:"name"`
+
+	// Scopes The scopes granted to the token.
+	Scopes []string `json:"scopes"`
+}
+
 // SortOrder Specifies the sort order.
 type SortOrder string
 
@@ -1254,6 +1968,42 @@ type Status struct {
 	Status *string `json:"status,omitempty"`
 }
 
+// SystemdUnitStatusType defines model for SystemdUnitStatusType.
+type SystemdUnitStatusType string
+
+// TPMAttestation TPMAttestation proves that the identity key of a device was generated in and cannot leave a TPM.
+type TPMAttestation struct {
+	// AttestationKeyPublic attestationKeyPublic is the TPMT_PUBLIC area of the attestation key (AK) that certified the identity key.
+	AttestationKeyPublic []byte `json:"attestationKeyPublic"`
+
+	// CertifyInfo certifyInfo is the TPMS_ATTEST structure of the certification of the identity key by the attestation key. Its extra data is the SHA-256 digest of the CSR.
+	CertifyInfo []byte `json:"certifyInfo"`
+
+	// CertifySignature certifySignature is the TPMT_SIGNATURE of certifyInfo by the attestation key.
+	CertifySignature []byte `json:"certifySignature"`
+
+	// EkCertificate ekCertificate is the PEM-encoded certificate of the endorsement key (EK) of the TPM, issued by the TPM manufacturer.
+	EkCertificate string `json:"ekCertificate"`
+
+	// IdentityKeyPublic identityKeyPublic is the TPMT_PUBLIC area of the identity key of the device, whose public key is the one of the CSR.
+	IdentityKeyPublic []byte `json:"identityKeyPublic"`
+}
+
+// TPMCredentialChallenge TPMCredentialChallenge is a secret that only the TPM holding both the endorsement key and the attestation key of a TPMAttestation can recover, using TPM2_ActivateCredential.
+type TPMCredentialChallenge struct {
+	// CredentialBlob credentialBlob is the TPM2B_ID_OBJECT protecting the secret.
+	CredentialBlob []byte `json:"credentialBlob"`
+
+	// EncryptedSecret encryptedSecret is the TPM2B_ENCRYPTED_SECRET with the seed encrypted to the endorsement key.
+	EncryptedSecret []byte `json:"encryptedSecret"`
+}
+
+// TPMCredentialChallengeResponse TPMCredentialChallengeResponse answers the TPMCredentialChallenge of an EnrollmentRequest.
+type TPMCredentialChallengeResponse struct {
+	// Secret secret is the secret recovered from the TPMCredentialChallenge.
+	Secret []byte `json:"secret"`
+}
+
 // TemplateVersion TemplateVersion represents a version of a template.
 type TemplateVersion struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -1310,6 +2060,110 @@ type TemplateVersionStatus struct {
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 }
 
+// VaultAppRoleAuth defines model for VaultAppRoleAuth.
+type VaultAppRoleAuth struct {
+	// MountPath The mount path of the AppRole auth method
+	MountPath *string `json:"mountPath,omitempty"`
+
+	// RoleId The role ID of the AppRole
+	RoleId string `json:"roleId"`
+
+	// SecretId The secret ID of the AppRole
+	SecretId string `json:"secretId"`
+}
+
+// VaultConfig defines model for VaultConfig.
+type VaultConfig struct {
+	AppRole *VaultAppRoleAuth `json:"appRole,omitempty"`
+
+	// CaCrt Base64 encoded root CA
+	CaCrt *string `json:"ca.crt,omitempty"`
+
+	// KvMountPath The mount path of the KV version 2 secrets engine
+	KvMountPath *string `json:"kvMountPath,omitempty"`
+
+	// Namespace The namespace of the secrets engine, for Vault servers supporting namespaces
+	Namespace *string `json:"namespace,omitempty"`
+
+	// SkipServerVerification Skip remote server verification
+	SkipServerVerification *bool `json:"skipServerVerification,omitempty"`
+
+	// Token The token for auth with the Vault server
+	Token *string `json:"token,omitempty"`
+}
+
+// VaultRepoSpec defines model for VaultRepoSpec.
+type VaultRepoSpec struct {
+	// Type RepoSpecType is the type of the repository
+	Type RepoSpecType `json:"type"`
+
+	// Url The URL of the Vault server, e.g. https://vault.example.com:8200
+	Url         string      `json:"url"`
+	VaultConfig VaultConfig `json:"vaultConfig"`
+}
+
+// VaultSecretFile defines model for VaultSecretFile.
+type VaultSecretFile struct {
+	// Group The file's group, specified either as a name or numeric ID. Defaults to "root".
+	Group *string `json:"group,omitempty"`
+
+	// Key The key of the secret whose value is the content of the file.
+	Key string `json:"key"`
+
+	// Mode The file’s permission mode. You may specify the more familiar octal with a leading zero (e.g., 0600) or as
+	// a decimal without a leading zero (e.g., 384). If not specified, the permission mode defaults to 0600.
+	Mode *int `json:"mode,omitempty"`
+
+	// Path The absolute path to the file on the device. Note that any existing file will be overwritten.
+	Path string `json:"path"`
+
+	// User The file's owner, specified either as a name or numeric ID. Defaults to "root".
+	User *string `json:"user,omitempty"`
+}
+
+// VaultSecretProviderSpec defines model for VaultSecretProviderSpec.
+type VaultSecretProviderSpec struct {
+	// Name The name of the config provider
+	Name     string `json:"name"`
+	VaultRef struct {
+		// Files The keys of the secret to store as files on the device.
+		Files []VaultSecretFile `json:"files"`
+
+		// Path The path of the secret within the KV secrets engine, e.g. edge/site-a/wifi
+		Path string `json:"path"`
+
+		// Repository The name of the repository resource of the Vault server to read the secret from
+		Repository string `json:"repository"`
+
+		// Version The version of the secret to read. Defaults to the latest version, in which case devices are
+		// updated whenever a new version of the secret is written.
+		Version *int `json:"version,omitempty"`
+	} `json:"vaultRef"`
+
+	// When A template condition that is evaluated for each device of a fleet. The config provider is only
+	// included in the device's specification if the condition renders to "true". It can only be used
+	// in fleet templates.
+	When *string `json:"when,omitempty"`
+}
+
+// ListAuditRecordsParams defines parameters for ListAuditRecords.
+type ListAuditRecordsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supports '=', '==', and '!='.(e.g. user=alice,resource.kind=Fleet,verb=delete).
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// SortBy Specifies the field to sort by.
+	SortBy *string `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// SortOrder Specifies the sort order.
+	SortOrder *SortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
 // AuthValidateParams defines parameters for AuthValidate.
 type AuthValidateParams struct {
 	Authentication *string `json:"Authentication,omitempty"`
@@ -1366,12 +2220,51 @@ type ListDevicesParams struct {
 	SortOrder *SortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
+// GetDeviceLogsParams defines parameters for GetDeviceLogs.
+type GetDeviceLogsParams struct {
+	// Unit Only return the journal entries of the systemd unit with this name. Mutually exclusive with app.
+	Unit *string `form:"unit,omitempty" json:"unit,omitempty"`
+
+	// App Only return the logs of the containers of the application with this name. Mutually exclusive with unit.
+	App *string `form:"app,omitempty" json:"app,omitempty"`
+
+	// Since Only return logs newer than a relative duration like 10m or 2h, or than an RFC 3339 timestamp.
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// Tail Number of most recent lines to return.
+	Tail *int `form:"tail,omitempty" json:"tail,omitempty"`
+
+	// Follow Keep streaming new logs until the request is closed.
+	Follow *bool `form:"follow,omitempty" json:"follow,omitempty"`
+}
+
 // GetRenderedDeviceSpecParams defines parameters for GetRenderedDeviceSpec.
 type GetRenderedDeviceSpecParams struct {
 	// KnownRenderedVersion The last known renderedVersion
 	KnownRenderedVersion *string `form:"knownRenderedVersion,omitempty" json:"knownRenderedVersion,omitempty"`
 }
 
+// ListEnrollmentPoliciesParams defines parameters for ListEnrollmentPolicies.
+type ListEnrollmentPoliciesParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supports '=', '==', and '!='.(e.g. key1=value1,key2!=value2).
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// SortBy Specifies the field to sort by.
+	SortBy *string `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// SortOrder Specifies the sort order.
+	SortOrder *SortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
 // ListEnrollmentRequestsParams defines parameters for ListEnrollmentRequests.
 type ListEnrollmentRequestsParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -1393,6 +2286,24 @@ type ListEnrollmentRequestsParams struct {
 	SortOrder *SortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
+// ListEventsParams defines parameters for ListEvents.
+type ListEventsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supports '=', '==', and '!='.(e.g. involvedObject.kind=Device,involvedObject.name=mydevice).
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// SortBy Specifies the field to sort by.
+	SortBy *string `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// SortOrder Specifies the sort order.
+	SortOrder *SortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
 // ListFleetsParams defines parameters for ListFleets.
 type ListFleetsParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -1492,6 +2403,27 @@ type ListResourceSyncParams struct {
 	SortOrder *SortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
 }
 
+// ListServiceAccountsParams defines parameters for ListServiceAccounts.
+type ListServiceAccountsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supports '=', '==', and '!='.(e.g. key1=value1,key2!=value2).
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// SortBy Specifies the field to sort by.
+	SortBy *string `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+
+	// SortOrder Specifies the sort order.
+	SortOrder *SortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
 // CreateCertificateSigningRequestJSONRequestBody defines body for CreateCertificateSigningRequest for application/json ContentType.
 type CreateCertificateSigningRequestJSONRequestBody = CertificateSigningRequest
 
@@ -1510,9 +2442,21 @@ type PatchDeviceApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceDeviceJSONRequestBody defines body for ReplaceDevice for application/json ContentType.
 type ReplaceDeviceJSONRequestBody = Device
 
+// ReplaceDeviceOverridesJSONRequestBody defines body for ReplaceDeviceOverrides for application/json ContentType.
+type ReplaceDeviceOverridesJSONRequestBody = DeviceOverrides
+
 // ReplaceDeviceStatusJSONRequestBody defines body for ReplaceDeviceStatus for application/json ContentType.
 type ReplaceDeviceStatusJSONRequestBody = Device
 
+// CreateEnrollmentPolicyJSONRequestBody defines body for CreateEnrollmentPolicy for application/json ContentType.
+type CreateEnrollmentPolicyJSONRequestBody = EnrollmentPolicy
+
+// PatchEnrollmentPolicyApplicationJSONPatchPlusJSONRequestBody defines body for PatchEnrollmentPolicy for application/json-patch+json ContentType.
+type PatchEnrollmentPolicyApplicationJSONPatchPlusJSONRequestBody = PatchRequest
+
+// ReplaceEnrollmentPolicyJSONRequestBody defines body for ReplaceEnrollmentPolicy for application/json ContentType.
+type ReplaceEnrollmentPolicyJSONRequestBody = EnrollmentPolicy
+
 // CreateEnrollmentRequestJSONRequestBody defines body for CreateEnrollmentRequest for application/json ContentType.
 type CreateEnrollmentRequestJSONRequestBody = EnrollmentRequest
 
@@ -1525,6 +2469,9 @@ type ApproveEnrollmentRequestJSONRequestBody = EnrollmentRequestApproval
 // ReplaceEnrollmentRequestStatusJSONRequestBody defines body for ReplaceEnrollmentRequestStatus for application/json ContentType.
 type ReplaceEnrollmentRequestStatusJSONRequestBody = EnrollmentRequest
 
+// AnswerEnrollmentRequestTPMChallengeJSONRequestBody defines body for AnswerEnrollmentRequestTPMChallenge for application/json ContentType.
+type AnswerEnrollmentRequestTPMChallengeJSONRequestBody = TPMCredentialChallengeResponse
+
 // CreateFleetJSONRequestBody defines body for CreateFleet for application/json ContentType.
 type CreateFleetJSONRequestBody = Fleet
 
@@ -1534,6 +2481,9 @@ type PatchFleetApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceFleetJSONRequestBody defines body for ReplaceFleet for application/json ContentType.
 type ReplaceFleetJSONRequestBody = Fleet
 
+// DryRunFleetOwnershipJSONRequestBody defines body for DryRunFleetOwnership for application/json ContentType.
+type DryRunFleetOwnershipJSONRequestBody = Fleet
+
 // ReplaceFleetStatusJSONRequestBody defines body for ReplaceFleetStatus for application/json ContentType.
 type ReplaceFleetStatusJSONRequestBody = Fleet
 
@@ -1555,6 +2505,15 @@ type PatchResourceSyncApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceResourceSyncJSONRequestBody defines body for ReplaceResourceSync for application/json ContentType.
 type ReplaceResourceSyncJSONRequestBody = ResourceSync
 
+// CreateServiceAccountJSONRequestBody defines body for CreateServiceAccount for application/json ContentType.
+type CreateServiceAccountJSONRequestBody = ServiceAccount
+
+// ReplaceServiceAccountJSONRequestBody defines body for ReplaceServiceAccount for application/json ContentType.
+type ReplaceServiceAccountJSONRequestBody = ServiceAccount
+
+// CreateServiceAccountTokenJSONRequestBody defines body for CreateServiceAccountToken for application/json ContentType.
+type CreateServiceAccountTokenJSONRequestBody = ServiceAccountTokenRequest
+
 // AsImageApplicationProvider returns the union data inside the ApplicationSpec as a ImageApplicationProvider
 func (t ApplicationSpec) AsImageApplicationProvider() (ImageApplicationProvider, error) {
 	var body ImageApplicationProvider
@@ -1805,6 +2764,58 @@ func (t *ConfigProviderSpec) MergeHttpConfigProviderSpec(v HttpConfigProviderSpe
 	return err
 }
 
+// AsOciConfigProviderSpec returns the union data inside the ConfigProviderSpec as a OciConfigProviderSpec
+func (t ConfigProviderSpec) AsOciConfigProviderSpec() (OciConfigProviderSpec, error) {
+	var body OciConfigProviderSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOciConfigProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided OciConfigProviderSpec
+func (t *ConfigProviderSpec) FromOciConfigProviderSpec(v OciConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOciConfigProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided OciConfigProviderSpec
+func (t *ConfigProviderSpec) MergeOciConfigProviderSpec(v OciConfigProviderSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsVaultSecretProviderSpec returns the union data inside the ConfigProviderSpec as a VaultSecretProviderSpec
+func (t ConfigProviderSpec) AsVaultSecretProviderSpec() (VaultSecretProviderSpec, error) {
+	var body VaultSecretProviderSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromVaultSecretProviderSpec overwrites any union data inside the ConfigProviderSpec as the provided VaultSecretProviderSpec
+func (t *ConfigProviderSpec) FromVaultSecretProviderSpec(v VaultSecretProviderSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeVaultSecretProviderSpec performs a merge with any union data inside the ConfigProviderSpec, using the provided VaultSecretProviderSpec
+func (t *ConfigProviderSpec) MergeVaultSecretProviderSpec(v VaultSecretProviderSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t ConfigProviderSpec) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -2039,6 +3050,58 @@ func (t *RepositorySpec) MergeSshRepoSpec(v SshRepoSpec) error {
 	return err
 }
 
+// AsOciRepoSpec returns the union data inside the RepositorySpec as a OciRepoSpec
+func (t RepositorySpec) AsOciRepoSpec() (OciRepoSpec, error) {
+	var body OciRepoSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOciRepoSpec overwrites any union data inside the RepositorySpec as the provided OciRepoSpec
+func (t *RepositorySpec) FromOciRepoSpec(v OciRepoSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOciRepoSpec performs a merge with any union data inside the RepositorySpec, using the provided OciRepoSpec
+func (t *RepositorySpec) MergeOciRepoSpec(v OciRepoSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsVaultRepoSpec returns the union data inside the RepositorySpec as a VaultRepoSpec
+func (t RepositorySpec) AsVaultRepoSpec() (VaultRepoSpec, error) {
+	var body VaultRepoSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromVaultRepoSpec overwrites any union data inside the RepositorySpec as the provided VaultRepoSpec
+func (t *RepositorySpec) FromVaultRepoSpec(v VaultRepoSpec) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeVaultRepoSpec performs a merge with any union data inside the RepositorySpec, using the provided VaultRepoSpec
+func (t *RepositorySpec) MergeVaultRepoSpec(v VaultRepoSpec) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t RepositorySpec) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
@@ -23,6 +23,15 @@ const (
 	HttpConfigProviderType       ConfigProviderType = "httpRef"
 	InlineConfigProviderType     ConfigProviderType = "inline"
 	KubernetesSecretProviderType ConfigProviderType = "secretRef"
+	OciConfigProviderType        ConfigProviderType = "ociRef"
+	VaultSecretProviderType      ConfigProviderType = "vaultRef"
+)
+
+const (
+	// FleetControllerLabel set to FleetControllerPaused on a device or a fleet pauses
+	// fleet management of the device or of all the devices of the fleet.
+	FleetControllerLabel  = "flightctl.io/fleet-controller"
+	FleetControllerPaused = "paused"
 )
 
 type ApplicationProviderType string
@@ -31,6 +40,11 @@ const (
 	ImageApplicationProviderType ApplicationProviderType = "image"
 )
 
+// IsFleetControllerPaused returns true if the labels pause fleet management.
+func IsFleetControllerPaused(labels *map[string]string) bool {
+	return lo.FromPtr(labels)[FleetControllerLabel] == FleetControllerPaused
+}
+
 // Type returns the type of the action.
 func (t HookAction) Type() (HookActionType, error) {
 	var data map[HookActionType]struct{}
@@ -61,6 +75,8 @@ func (c ConfigProviderSpec) Type() (ConfigProviderType, error) {
 		HttpConfigProviderType,
 		InlineConfigProviderType,
 		KubernetesSecretProviderType,
+		OciConfigProviderType,
+		VaultSecretProviderType,
 	}
 	for _, t := range types {
 		if _, exists := data[t]; exists {
@@ -149,6 +165,26 @@ func configsAreEqual(c1, c2 *[]ConfigProviderSpec) bool {
 				return false
 			}
 			return reflect.DeepEqual(c1, c2)
+		case OciConfigProviderType:
+			c1, err := item1.AsOciConfigProviderSpec()
+			if err != nil {
+				return false
+			}
+			c2, err := item2.AsOciConfigProviderSpec()
+			if err != nil {
+				return false
+			}
+			return reflect.DeepEqual(c1, c2)
+		case VaultSecretProviderType:
+			c1, err := item1.AsVaultSecretProviderSpec()
+			if err != nil {
+				return false
+			}
+			c2, err := item2.AsVaultSecretProviderSpec()
+			if err != nil {
+				return false
+			}
+			return reflect.DeepEqual(c1, c2)
 		default:
 			return false
 		}
@@ -239,9 +275,55 @@ func FleetSpecsAreEqual(f1, f2 FleetSpec) bool {
 		return false
 	}
 
+	if lo.FromPtr(f1.Priority) != lo.FromPtr(f2.Priority) {
+		return false
+	}
+
 	if !reflect.DeepEqual(f1.Template.Metadata, f2.Template.Metadata) {
 		return false
 	}
 
 	return DeviceSpecsAreEqual(f1.Template.Spec, f2.Template.Spec)
 }
+
+// IsEmpty returns whether the selector has neither labels nor expressions to
+// match.
+func (l *LabelSelector) IsEmpty() bool {
+	return l == nil || (len(lo.FromPtr(l.MatchLabels)) == 0 && len(lo.FromPtr(l.MatchExpressions)) == 0)
+}
+
+// Matches returns whether the labels match all the labels and expressions of
+// the selector. Unlike in Kubernetes, an empty selector matches nothing.
+func (l *LabelSelector) Matches(labels map[string]string) bool {
+	if l.IsEmpty() {
+		return false
+	}
+	for key, value := range lo.FromPtr(l.MatchLabels) {
+		if labelValue, ok := labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	for _, expression := range lo.FromPtr(l.MatchExpressions) {
+		if !expression.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches returns whether the labels match the expression.
+func (e MatchExpression) Matches(labels map[string]string) bool {
+	value, exists := labels[e.Key]
+	switch e.Operator {
+	case In:
+		return exists && slices.Contains(lo.FromPtr(e.Values), value)
+	case NotIn:
+		return !exists || !slices.Contains(lo.FromPtr(e.Values), value)
+	case Exists:
+		return exists
+	case DoesNotExist:
+		return !exists
+	default:
+		return false
+	}
+}
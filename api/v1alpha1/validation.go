@@ -48,6 +48,43 @@ func (r Device) Validate() []error {
 	return allErrs
 }
 
+func (r DeviceOverrides) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
+	for _, config := range lo.FromPtr(r.Spec.Config) {
+		allErrs = append(allErrs, config.Validate()...)
+	}
+	if r.Spec.Applications != nil {
+		allErrs = append(allErrs, validateApplications(*r.Spec.Applications)...)
+	}
+	for appName, envVars := range lo.FromPtr(r.Spec.ApplicationEnvVars) {
+		envVars := envVars
+		allErrs = append(allErrs, validation.ValidateStringMap(&envVars, fmt.Sprintf("spec.applicationEnvVars.%s", appName), 1, 256, nil, "")...)
+	}
+	if r.Spec.Hooks != nil {
+		// hooks replace the ones of the template by name, so they must be named
+		phases := []struct {
+			name  string
+			hooks []*string
+		}{
+			{"beforeUpdating", lo.Map(lo.FromPtr(r.Spec.Hooks.BeforeUpdating), func(h DeviceUpdateHookSpec, _ int) *string { return h.Name })},
+			{"afterUpdating", lo.Map(lo.FromPtr(r.Spec.Hooks.AfterUpdating), func(h DeviceUpdateHookSpec, _ int) *string { return h.Name })},
+			{"beforeRebooting", lo.Map(lo.FromPtr(r.Spec.Hooks.BeforeRebooting), func(h DeviceRebootHookSpec, _ int) *string { return h.Name })},
+			{"afterRebooting", lo.Map(lo.FromPtr(r.Spec.Hooks.AfterRebooting), func(h DeviceRebootHookSpec, _ int) *string { return h.Name })},
+		}
+		for _, phase := range phases {
+			for i, hookName := range phase.hooks {
+				name := lo.FromPtr(hookName)
+				allErrs = append(allErrs, validation.ValidateString(&name, fmt.Sprintf("spec.hooks.%s[%d].name", phase.name, i), 1, 256, nil, "")...)
+			}
+		}
+	}
+	for _, resource := range lo.FromPtr(r.Spec.Resources) {
+		allErrs = append(allErrs, resource.Validate()...)
+	}
+	return allErrs
+}
+
 func (c ConfigProviderSpec) Validate() []error {
 	allErrs := []error{}
 
@@ -87,6 +124,20 @@ func (c ConfigProviderSpec) Validate() []error {
 			break
 		}
 		allErrs = append(allErrs, provider.Validate()...)
+	case OciConfigProviderType:
+		provider, err := c.AsOciConfigProviderSpec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			break
+		}
+		allErrs = append(allErrs, provider.Validate()...)
+	case VaultSecretProviderType:
+		provider, err := c.AsVaultSecretProviderSpec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			break
+		}
+		allErrs = append(allErrs, provider.Validate()...)
 	default:
 		// if we hit this case, it means that the type should be added to the switch statement above
 		allErrs = append(allErrs, fmt.Errorf("unknown config provider type: %s", t))
@@ -218,6 +269,39 @@ func (h HttpConfigProviderSpec) Validate() []error {
 	return allErrs
 }
 
+func (o OciConfigProviderSpec) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateGenericName(&o.Name, "spec.config[].name")...)
+	allErrs = append(allErrs, validation.ValidateResourceNameReference(&o.OciRef.Repository, "spec.config[].ociRef.repository")...)
+	allErrs = append(allErrs, validation.ValidateOciArtifactName(&o.OciRef.Artifact, "spec.config[].ociRef.artifact")...)
+	allErrs = append(allErrs, validation.ValidateOciArtifactReference(&o.OciRef.Reference, "spec.config[].ociRef.reference")...)
+	if o.OciRef.MountPath != nil {
+		allErrs = append(allErrs, validation.ValidateFilePath(o.OciRef.MountPath, "spec.config[].ociRef.mountPath")...)
+	}
+	return allErrs
+}
+
+func (v VaultSecretProviderSpec) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateGenericName(&v.Name, "spec.config[].name")...)
+	allErrs = append(allErrs, validation.ValidateResourceNameReference(&v.VaultRef.Repository, "spec.config[].vaultRef.repository")...)
+	allErrs = append(allErrs, validation.ValidateRelativePath(&v.VaultRef.Path, "spec.config[].vaultRef.path", 1024)...)
+	if v.VaultRef.Version != nil && *v.VaultRef.Version < 1 {
+		allErrs = append(allErrs, fmt.Errorf("spec.config[].vaultRef.version must be a positive integer: %d", *v.VaultRef.Version))
+	}
+	if len(v.VaultRef.Files) == 0 {
+		allErrs = append(allErrs, fmt.Errorf("spec.config[].vaultRef.files must not be empty"))
+	}
+	for i := range v.VaultRef.Files {
+		allErrs = append(allErrs, validation.ValidateString(&v.VaultRef.Files[i].Key, fmt.Sprintf("spec.config[].vaultRef.files[%d].key", i), 1, 256, nil, "")...)
+		allErrs = append(allErrs, validation.ValidateFilePath(&v.VaultRef.Files[i].Path, fmt.Sprintf("spec.config[].vaultRef.files[%d].path", i))...)
+		allErrs = append(allErrs, validation.ValidateLinuxUserGroup(v.VaultRef.Files[i].User, fmt.Sprintf("spec.config[].vaultRef.files[%d].user", i))...)
+		allErrs = append(allErrs, validation.ValidateLinuxUserGroup(v.VaultRef.Files[i].Group, fmt.Sprintf("spec.config[].vaultRef.files[%d].group", i))...)
+		allErrs = append(allErrs, validation.ValidateLinuxFileMode(v.VaultRef.Files[i].Mode, fmt.Sprintf("spec.config[].vaultRef.files[%d].mode", i))...)
+	}
+	return allErrs
+}
+
 func (r EnrollmentRequest) Validate() []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
@@ -234,6 +318,61 @@ func (r EnrollmentRequestApproval) Validate() []error {
 	return allErrs
 }
 
+func (r EnrollmentPolicy) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
+	allErrs = append(allErrs, r.Spec.Selector.Validate()...)
+	if r.Spec.Selector != nil && r.Spec.Selector.MatchExpressions != nil {
+		allErrs = append(allErrs, errors.New("spec.selector.matchExpressions is not supported in enrollment policies"))
+	}
+	for i, key := range lo.FromPtr(r.Spec.AllowedPublicKeys) {
+		allErrs = append(allErrs, validation.ValidatePublicKey(&key, fmt.Sprintf("spec.allowedPublicKeys[%d]", i))...)
+	}
+	for i, cn := range lo.FromPtr(r.Spec.EnrollmentCertificateCommonNames) {
+		allErrs = append(allErrs, validation.ValidateString(&cn, fmt.Sprintf("spec.enrollmentCertificateCommonNames[%d]", i), 1, 64, nil, "")...)
+	}
+	if r.Spec.ValidFrom != nil && r.Spec.ValidUntil != nil && !r.Spec.ValidUntil.After(*r.Spec.ValidFrom) {
+		allErrs = append(allErrs, errors.New("spec.validUntil must be after spec.validFrom"))
+	}
+	if r.Spec.MaxApprovals != nil && *r.Spec.MaxApprovals < 1 {
+		allErrs = append(allErrs, fmt.Errorf("spec.maxApprovals must be a positive integer: %d", *r.Spec.MaxApprovals))
+	}
+	allErrs = append(allErrs, validation.ValidateLabelsWithPath(r.Spec.Labels, "spec.labels")...)
+	return allErrs
+}
+
+func (r ServiceAccount) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
+	if len(r.Spec.Scopes) == 0 {
+		allErrs = append(allErrs, errors.New("spec.scopes must not be empty"))
+	}
+	for i, scope := range r.Spec.Scopes {
+		if _, _, err := ParseServiceAccountScope(scope); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.scopes[%d]: %w", i, err))
+		}
+	}
+	return allErrs
+}
+
+func (r ServiceAccountTokenRequest) Validate() []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceNameReference(&r.Name, "name")...)
+	if r.Scopes != nil && len(*r.Scopes) == 0 {
+		allErrs = append(allErrs, errors.New("scopes must not be empty"))
+	}
+	for i, scope := range lo.FromPtr(r.Scopes) {
+		if _, _, err := ParseServiceAccountScope(scope); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("scopes[%d]: %w", i, err))
+		}
+	}
+	return allErrs
+}
+
 func (r CertificateSigningRequest) Validate() []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
@@ -252,6 +391,7 @@ func (r Fleet) Validate() []error {
 	allErrs = append(allErrs, validation.ValidateLabels(r.Metadata.Labels)...)
 	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
 	allErrs = append(allErrs, r.Spec.Selector.Validate()...)
+	allErrs = append(allErrs, validation.ValidateDuration(r.Spec.DeviceDisconnectedTimeout, "spec.deviceDisconnectedTimeout")...)
 	if r.Spec.RolloutPolicy != nil {
 		i, err := r.Spec.RolloutPolicy.DeviceSelection.ValueByDiscriminator()
 		if err != nil {
@@ -316,7 +456,21 @@ func (r Repository) Validate() []error {
 		allErrs = append(allErrs, validateSshConfig(&sshRepoSpec.SshConfig)...)
 	}
 
-	if genericErr != nil && httpErr != nil && sshErr != nil {
+	// Validate OciRepoSpec
+	ociRepoSpec, ociErr := r.Spec.GetOciRepoSpec()
+	if ociErr == nil {
+		allErrs = append(allErrs, validation.ValidateString(&ociRepoSpec.Url, "spec.url", 1, 2048, nil, "")...)
+		allErrs = append(allErrs, validateOciConfig(&ociRepoSpec.OciConfig)...)
+	}
+
+	// Validate VaultRepoSpec
+	vaultRepoSpec, vaultErr := r.Spec.GetVaultRepoSpec()
+	if vaultErr == nil {
+		allErrs = append(allErrs, validation.ValidateString(&vaultRepoSpec.Url, "spec.url", 1, 2048, nil, "")...)
+		allErrs = append(allErrs, validateVaultConfig(&vaultRepoSpec.VaultConfig)...)
+	}
+
+	if genericErr != nil && httpErr != nil && sshErr != nil && ociErr != nil && vaultErr != nil {
 		allErrs = append(allErrs, fmt.Errorf("invalid repository type: no valid spec found"))
 	}
 
@@ -335,10 +489,38 @@ func (r ResourceSync) Validate() []error {
 }
 
 func (l *LabelSelector) Validate() []error {
-	if l != nil && l.MatchExpressions == nil && l.MatchLabels == nil {
+	if l == nil {
+		return nil
+	}
+	if l.MatchExpressions == nil && l.MatchLabels == nil {
 		return []error{errors.New("At least one of [matchLabels,matchExpressions] must appear in a label selector")}
 	}
-	return nil
+	allErrs := []error{}
+	for i, expression := range lo.FromPtr(l.MatchExpressions) {
+		allErrs = append(allErrs, expression.Validate(fmt.Sprintf("matchExpressions[%d]", i))...)
+	}
+	return allErrs
+}
+
+func (e MatchExpression) Validate(path string) []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateLabelsWithPath(&map[string]string{e.Key: ""}, path+".key")...)
+	switch e.Operator {
+	case In, NotIn:
+		if len(lo.FromPtr(e.Values)) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.values must be specified for operator %s", path, e.Operator))
+		}
+		for _, value := range lo.FromPtr(e.Values) {
+			allErrs = append(allErrs, validation.ValidateLabelsWithPath(&map[string]string{e.Key: value}, path+".values")...)
+		}
+	case Exists, DoesNotExist:
+		if e.Values != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.values must not be specified for operator %s", path, e.Operator))
+		}
+	default:
+		allErrs = append(allErrs, fmt.Errorf("%s.operator must be one of [In,NotIn,Exists,DoesNotExist]", path))
+	}
+	return allErrs
 }
 
 func (d *DeviceSystemInfo) IsEmpty() bool {
@@ -376,6 +558,53 @@ func validateHttpConfig(config *HttpConfig) []error {
 	return errs
 }
 
+func validateOciConfig(config *OciConfig) []error {
+	var errs []error
+	if config != nil {
+		if config.CaCrt != nil {
+			errs = append(errs, validation.ValidateBase64Field(*config.CaCrt, "spec.ociConfig.CaCrt", maxBase64CertificateLength)...)
+		}
+
+		if (config.Username != nil && config.Password == nil) || (config.Username == nil && config.Password != nil) {
+			errs = append(errs, fmt.Errorf("both username and password must be provided together"))
+		}
+		if config.Username != nil && config.Password != nil {
+			errs = append(errs, validation.ValidateString(config.Username, "spec.ociConfig.username", 1, 256, nil, "")...)
+			errs = append(errs, validation.ValidateString(config.Password, "spec.ociConfig.password", 1, 256, nil, "")...)
+		}
+
+		if config.Token != nil {
+			errs = append(errs, validation.ValidateBearerToken(config.Token, "spec.ociConfig.token")...)
+		}
+	}
+	return errs
+}
+
+func validateVaultConfig(config *VaultConfig) []error {
+	var errs []error
+	if config != nil {
+		if config.CaCrt != nil {
+			errs = append(errs, validation.ValidateBase64Field(*config.CaCrt, "spec.vaultConfig.CaCrt", maxBase64CertificateLength)...)
+		}
+
+		if (config.Token == nil) == (config.AppRole == nil) {
+			errs = append(errs, fmt.Errorf("exactly one of spec.vaultConfig.token and spec.vaultConfig.appRole must be provided"))
+		}
+		if config.Token != nil {
+			errs = append(errs, validation.ValidateString(config.Token, "spec.vaultConfig.token", 1, 1024, nil, "")...)
+		}
+		if config.AppRole != nil {
+			errs = append(errs, validation.ValidateString(&config.AppRole.RoleId, "spec.vaultConfig.appRole.roleId", 1, 256, nil, "")...)
+			errs = append(errs, validation.ValidateString(&config.AppRole.SecretId, "spec.vaultConfig.appRole.secretId", 1, 256, nil, "")...)
+			errs = append(errs, validation.ValidateRelativePath(config.AppRole.MountPath, "spec.vaultConfig.appRole.mountPath", 256)...)
+		}
+
+		errs = append(errs, validation.ValidateRelativePath(config.KvMountPath, "spec.vaultConfig.kvMountPath", 256)...)
+		errs = append(errs, validation.ValidateString(config.Namespace, "spec.vaultConfig.namespace", 1, 256, nil, "")...)
+	}
+	return errs
+}
+
 func validateSshConfig(config *SshConfig) []error {
 	var errs []error
 	if config != nil {
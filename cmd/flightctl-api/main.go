@@ -14,6 +14,7 @@ import (
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/instrumentation"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/flightctl/flightctl/pkg/queues"
@@ -46,6 +47,17 @@ func main() {
 	}
 	log.SetLevel(logLvl)
 
+	shutdownTracing, err := tracing.Init(cfg, "flightctl-api", log)
+	if err != nil {
+		log.Fatalf("initializing tracing: %v", err)
+	}
+	defer shutdownTracing()
+
+	if len(os.Args) > 1 && os.Args[1] == rotateEncryptionKeysCommand {
+		rotateEncryptionKeys(log, cfg)
+		return
+	}
+
 	ca, _, err := crypto.EnsureCA(certFile(signerCertName), keyFile(signerCertName), "", signerCertName, caCertValidityDays)
 	if err != nil {
 		log.Fatalf("ensuring CA cert: %v", err)
@@ -78,7 +90,15 @@ func main() {
 		log.Fatalf("initializing data store: %v", err)
 	}
 
-	store := store.NewStore(db, log.WithField("pkg", "store"))
+	encrypter, err := store.NewSecretEncrypter(cfg)
+	if err != nil {
+		log.Fatalf("initializing encryption keys: %v", err)
+	}
+	if encrypter == nil {
+		log.Warn("no encryption keys configured, secrets are stored in cleartext")
+	}
+
+	store := store.NewStore(db, encrypter, log.WithField("pkg", "store"))
 	defer store.Close()
 
 	if err := store.InitialMigration(); err != nil {
@@ -93,6 +113,10 @@ func main() {
 
 	metrics := instrumentation.NewApiMetrics(cfg)
 
+	// the gRPC server routes sessions between devices and both the API clients and
+	// the API server itself
+	grpcServer := agentserver.NewAgentGrpcServer(log, cfg, grpcTlsConfig)
+
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGHUP, syscall.SIGTERM, syscall.SIGQUIT)
 	go func() {
 		listener, err := middleware.NewTLSListener(cfg.Service.Address, tlsConfig)
@@ -100,7 +124,7 @@ func main() {
 			log.Fatalf("creating listener: %s", err)
 		}
 
-		server := apiserver.New(log, cfg, store, ca, listener, provider, metrics)
+		server := apiserver.New(log, cfg, store, ca, listener, provider, metrics, grpcServer)
 		if err := server.Run(ctx); err != nil {
 			log.Fatalf("Error running server: %s", err)
 		}
@@ -113,7 +137,7 @@ func main() {
 			log.Fatalf("creating listener: %s", err)
 		}
 
-		agentserver := agentserver.New(log, cfg, store, ca, listener, metrics)
+		agentserver := agentserver.New(log, cfg, store, ca, listener, provider, metrics)
 		if err := agentserver.Run(ctx); err != nil {
 			log.Fatalf("Error running server: %s", err)
 		}
@@ -121,7 +145,6 @@ func main() {
 	}()
 
 	go func() {
-		grpcServer := agentserver.NewAgentGrpcServer(log, cfg, grpcTlsConfig)
 		if err := grpcServer.Run(ctx); err != nil {
 			log.Fatalf("Error running server: %s", err)
 		}
@@ -130,7 +153,7 @@ func main() {
 
 	if cfg.Prometheus != nil {
 		go func() {
-			metricsServer := instrumentation.NewMetricsServer(log, cfg, metrics)
+			metricsServer := instrumentation.NewMetricsServer(log, cfg, metrics, instrumentation.NewDeviceCollector(store, log))
 			if err := metricsServer.Run(ctx); err != nil {
 				log.Fatalf("Error running server: %s", err)
 			}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/sirupsen/logrus"
+)
+
+const rotateEncryptionKeysCommand = "rotate-encryption-keys"
+
+// rotateEncryptionKeys re-encrypts all secrets at rest with the first of the
+// configured encryption keys. Once it completes, the previous keys can be
+// removed from the configuration.
+func rotateEncryptionKeys(log *logrus.Logger, cfg *config.Config) {
+	encrypter, err := store.NewSecretEncrypter(cfg)
+	if err != nil {
+		log.Fatalf("initializing encryption keys: %v", err)
+	}
+	if encrypter == nil {
+		log.Fatal("no encryption keys configured")
+	}
+
+	db, err := store.InitDB(cfg, log)
+	if err != nil {
+		log.Fatalf("initializing data store: %v", err)
+	}
+	store := store.NewStore(db, encrypter, log.WithField("pkg", "store"))
+	defer store.Close()
+
+	rotated, err := store.Repository().RotateSecrets(context.Background())
+	if err != nil {
+		log.Fatalf("rotating repository secrets: %v", err)
+	}
+	log.Printf("Re-encrypted the secrets of %d repositories", rotated)
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	periodic "github.com/flightctl/flightctl/internal/periodic_checker"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/pkg/log"
@@ -24,13 +25,24 @@ func main() {
 	}
 	log.SetLevel(logLvl)
 
+	shutdownTracing, err := tracing.Init(cfg, "flightctl-periodic", log)
+	if err != nil {
+		log.Fatalf("initializing tracing: %v", err)
+	}
+	defer shutdownTracing()
+
 	log.Println("Initializing data store")
 	db, err := store.InitDB(cfg, log)
 	if err != nil {
 		log.Fatalf("initializing data store: %v", err)
 	}
 
-	store := store.NewStore(db, log.WithField("pkg", "store"))
+	encrypter, err := store.NewSecretEncrypter(cfg)
+	if err != nil {
+		log.Fatalf("initializing encryption keys: %v", err)
+	}
+
+	store := store.NewStore(db, encrypter, log.WithField("pkg", "store"))
 	defer store.Close()
 
 	server := periodic.New(cfg, log, store)
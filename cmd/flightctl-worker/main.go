@@ -2,6 +2,7 @@ package main
 
 import (
 	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/store"
 	workerserver "github.com/flightctl/flightctl/internal/worker_server"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
@@ -27,13 +28,24 @@ func main() {
 	}
 	log.SetLevel(logLvl)
 
+	shutdownTracing, err := tracing.Init(cfg, "flightctl-worker", log)
+	if err != nil {
+		log.Fatalf("initializing tracing: %v", err)
+	}
+	defer shutdownTracing()
+
 	log.Println("Initializing data store")
 	db, err := store.InitDB(cfg, log)
 	if err != nil {
 		log.Fatalf("initializing data store: %v", err)
 	}
 
-	store := store.NewStore(db, log.WithField("pkg", "store"))
+	encrypter, err := store.NewSecretEncrypter(cfg)
+	if err != nil {
+		log.Fatalf("initializing encryption keys: %v", err)
+	}
+
+	store := store.NewStore(db, encrypter, log.WithField("pkg", "store"))
 	defer store.Close()
 
 	provider := queues.NewAmqpProvider(cfg.Queue.AmqpURL, log)
@@ -25,13 +25,18 @@ func NewFlightCtlCommand() *cobra.Command {
 	}
 	cmd.AddCommand(cli.NewCmdGet())
 	cmd.AddCommand(cli.NewCmdApply())
+	cmd.AddCommand(cli.NewCmdCreate())
 	cmd.AddCommand(cli.NewCmdDelete())
 	cmd.AddCommand(cli.NewCmdApprove())
 	cmd.AddCommand(cli.NewCmdCSRConfig())
 	cmd.AddCommand(cli.NewCmdDeny())
+	cmd.AddCommand(cli.NewCmdPause())
+	cmd.AddCommand(cli.NewCmdResume())
 	cmd.AddCommand(cli.NewCmdLogin())
+	cmd.AddCommand(cli.NewCmdConfig())
 	cmd.AddCommand(cli.NewCmdVersion())
 	cmd.AddCommand(cli.NewConsoleCmd())
+	cmd.AddCommand(cli.NewCmdLogs())
 	cmd.AddCommand(cli.NewCmdCompletion())
 	cmd.AddCommand(cli.NewCmdEnrollmentConfig())
 	cmd.AddCommand(cli.NewCmdCertificate())
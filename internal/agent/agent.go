@@ -13,16 +13,20 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device"
 	"github.com/flightctl/flightctl/internal/agent/device/applications"
+	"github.com/flightctl/flightctl/internal/agent/device/bundle"
 	"github.com/flightctl/flightctl/internal/agent/device/config"
 	"github.com/flightctl/flightctl/internal/agent/device/console"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/internal/agent/device/hook"
+	"github.com/flightctl/flightctl/internal/agent/device/logs"
 	"github.com/flightctl/flightctl/internal/agent/device/resource"
 	"github.com/flightctl/flightctl/internal/agent/device/spec"
 	"github.com/flightctl/flightctl/internal/agent/device/status"
+	"github.com/flightctl/flightctl/internal/agent/instrumentation"
 	"github.com/flightctl/flightctl/internal/agent/shutdown"
 	"github.com/flightctl/flightctl/internal/container"
 	fcrypto "github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/tpm"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -32,6 +36,9 @@ import (
 const (
 	// TODO: expose via config
 	gracefulShutdownTimeout = 2 * time.Minute
+	// telemetryShutdownTimeout is how long exporting the remaining telemetry may
+	// take when the agent stops
+	telemetryShutdownTimeout = 10 * time.Second
 )
 
 // New creates a new agent.
@@ -67,9 +74,35 @@ func (a *Agent) Run(ctx context.Context) error {
 		a.config.ManagementService.Config.AuthInfo.ClientCertificate = filepath.Join(a.config.DataDir, DefaultCertsDirName, GeneratedCertFile)
 		a.config.ManagementService.Config.AuthInfo.ClientKey = filepath.Join(a.config.DataDir, DefaultCertsDirName, KeyFile)
 	}
-	publicKey, privateKey, _, err := fcrypto.EnsureKey(deviceReadWriter.PathFor(a.config.ManagementService.AuthInfo.ClientKey))
-	if err != nil {
-		return err
+	var publicKey crypto.PublicKey
+	var privateKey crypto.PrivateKey
+	// secretKey decrypts the secrets encrypted to the device's public key
+	var secretKey crypto.PrivateKey
+	var tpmAttester device.TPMAttester
+	if a.config.TPMIdentity {
+		tpmDevice, err := tpm.OpenTPM(a.config.TPMPath)
+		if err != nil {
+			return fmt.Errorf("opening TPM: %w", err)
+		}
+		defer tpmDevice.Close()
+		identity, err := a.loadTPMIdentity(tpmDevice, deviceReadWriter)
+		if err != nil {
+			return err
+		}
+		defer identity.Close()
+
+		// the identity key never leaves the TPM, so TLS connections sign with it
+		// and secrets encrypted to the device key are decrypted with it directly.
+		publicKey, privateKey, secretKey, tpmAttester = identity.PublicKey(), identity.Signer(), identity.ECDHKey(), identity
+		a.config.ManagementService.Config.AuthInfo.ClientKey = ""
+		a.config.ManagementService.Config.AuthInfo.ClientKeySigner = identity.Signer()
+	} else {
+		var err error
+		publicKey, privateKey, _, err = fcrypto.EnsureKey(deviceReadWriter.PathFor(a.config.ManagementService.AuthInfo.ClientKey))
+		if err != nil {
+			return err
+		}
+		secretKey = privateKey
 	}
 
 	publicKeyHash, err := fcrypto.HashPublicKey(publicKey)
@@ -83,6 +116,18 @@ func (a *Agent) Run(ctx context.Context) error {
 		return err
 	}
 
+	shutdownTelemetry, err := instrumentation.Setup(&a.config.Telemetry, deviceName, deviceReadWriter, a.log)
+	if err != nil {
+		return fmt.Errorf("setting up telemetry: %w", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), telemetryShutdownTimeout)
+		defer cancel()
+		if err := shutdownTelemetry(ctx); err != nil {
+			a.log.Warnf("Failed to flush telemetry: %v", err)
+		}
+	}()
+
 	executer := &executer.CommonExecuter{}
 
 	// create enrollment client
@@ -90,6 +135,7 @@ func (a *Agent) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	enrollmentClient.SetRPCMetricsCallback(a.enrollmentMetricsCallback)
 
 	// create bootc client
 	bootcClient := container.NewBootcCmd(executer)
@@ -146,6 +192,7 @@ func (a *Agent) Run(ctx context.Context) error {
 	configController := config.NewController(
 		hookManager,
 		deviceReadWriter,
+		secretKey,
 		a.log,
 	)
 
@@ -154,6 +201,7 @@ func (a *Agent) Run(ctx context.Context) error {
 		executer,
 		deviceReadWriter,
 		csr,
+		tpmAttester,
 		specManager,
 		statusManager,
 		hookManager,
@@ -198,6 +246,37 @@ func (a *Agent) Run(ctx context.Context) error {
 		a.log,
 	)
 
+	// create logs controller
+	logsController := logs.NewController(
+		grpcClient,
+		podmanClient,
+		deviceName,
+		executer,
+		a.log,
+	)
+
+	// create the management client for uploading bundles this must be done after bootstrap
+	managementClient, err := newManagementClient(a.config)
+	if err != nil {
+		a.log.Warnf("Failed to create management client: %v", err)
+	}
+
+	redactedConfig, err := a.config.redacted()
+	if err != nil {
+		return fmt.Errorf("redacting config: %w", err)
+	}
+
+	// create bundle controller
+	bundleController := bundle.NewController(
+		managementClient,
+		executer,
+		deviceReadWriter,
+		deviceName,
+		a.config.DataDir,
+		redactedConfig,
+		a.log,
+	)
+
 	applicationsController := applications.NewController(
 		podmanClient,
 		applicationManager,
@@ -220,6 +299,8 @@ func (a *Agent) Run(ctx context.Context) error {
 		osImageController,
 		resourceController,
 		consoleController,
+		logsController,
+		bundleController,
 		bootcClient,
 		podmanClient,
 		backoff,
@@ -236,6 +317,40 @@ func (a *Agent) Run(ctx context.Context) error {
 	return agent.Run(ctx)
 }
 
+// enrollmentMetricsCallback exports the timings of the calls to the enrollment
+// service and passes them on to the callback set in the config, if any.
+func (a *Agent) enrollmentMetricsCallback(operation string, durationSeconds float64, err error) {
+	instrumentation.RecordEnrollment(operation, durationSeconds, err)
+	if a.config.enrollmentMetricsCallback != nil {
+		a.config.enrollmentMetricsCallback(operation, durationSeconds, err)
+	}
+}
+
+// loadTPMIdentity loads the identity key of the device from the TPM, along with
+// the configured EK certificate if any.
+func (a *Agent) loadTPMIdentity(tpmDevice *tpm.TPM, reader fileio.Reader) (*tpm.Identity, error) {
+	var ekCertificate []byte
+	if a.config.TPMEKCertificate != "" {
+		var err error
+		if ekCertificate, err = reader.ReadFile(a.config.TPMEKCertificate); err != nil {
+			return nil, fmt.Errorf("reading EK certificate: %w", err)
+		}
+	}
+	identity, err := tpmDevice.NewIdentity(ekCertificate)
+	if err != nil {
+		return nil, fmt.Errorf("loading TPM identity: %w", err)
+	}
+	return identity, nil
+}
+
+func newManagementClient(cfg *Config) (client.Management, error) {
+	httpClient, err := client.NewFromConfig(&cfg.ManagementService.Config)
+	if err != nil {
+		return nil, err
+	}
+	return client.NewManagement(httpClient), nil
+}
+
 func newEnrollmentClient(cfg *Config) (client.Enrollment, error) {
 	httpClient, err := client.NewFromConfig(&cfg.EnrollmentService.Config)
 	if err != nil {
@@ -246,7 +361,7 @@ func newEnrollmentClient(cfg *Config) (client.Enrollment, error) {
 
 func newGrpcClient(cfg *Config) (grpc_v1.RouterServiceClient, error) {
 	if cfg.GrpcManagementEndpoint == "" {
-		return nil, fmt.Errorf("no gRPC endpoint, disabling console functionality")
+		return nil, fmt.Errorf("no gRPC endpoint, disabling console and logs functionality")
 	}
 	client, err := client.NewGRPCClientFromConfig(&cfg.ManagementService.Config, cfg.GrpcManagementEndpoint)
 	if err != nil {
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os/exec"
 	"strings"
@@ -10,6 +11,7 @@ import (
 
 	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
 	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/agent/instrumentation"
 	client "github.com/flightctl/flightctl/internal/api/client/agent"
 	baseclient "github.com/flightctl/flightctl/internal/client"
 	"github.com/flightctl/flightctl/pkg/executer"
@@ -24,6 +26,7 @@ func NewFromConfig(config *baseclient.Config) (*client.ClientWithResponses, erro
 	if err != nil {
 		return nil, fmt.Errorf("NewFromConfig: creating HTTP client %w", err)
 	}
+	httpClient.Transport = instrumentation.NewTransport(newRateLimitTransport(httpClient.Transport))
 	ref := client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
 		req.Header.Set(middleware.RequestIDHeader, reqid.GetReqID())
 		return nil
@@ -47,6 +50,7 @@ func NewDefault() *Config {
 type Management interface {
 	UpdateDeviceStatus(ctx context.Context, name string, device v1alpha1.Device, rcb ...client.RequestEditorFn) error
 	GetRenderedDeviceSpec(ctx context.Context, name string, params *v1alpha1.GetRenderedDeviceSpecParams, rcb ...client.RequestEditorFn) (*v1alpha1.RenderedDeviceSpec, int, error)
+	UploadDeviceBundle(ctx context.Context, name string, requestID string, bundle io.Reader, rcb ...client.RequestEditorFn) error
 }
 
 // Enrollment is client the interface for managing device enrollment.
@@ -54,6 +58,7 @@ type Enrollment interface {
 	SetRPCMetricsCallback(cb func(operation string, durationSeconds float64, err error))
 	CreateEnrollmentRequest(ctx context.Context, req v1alpha1.EnrollmentRequest, cb ...client.RequestEditorFn) (*v1alpha1.EnrollmentRequest, error)
 	GetEnrollmentRequest(ctx context.Context, id string, cb ...client.RequestEditorFn) (*v1alpha1.EnrollmentRequest, error)
+	AnswerEnrollmentRequestTPMChallenge(ctx context.Context, id string, answer v1alpha1.TPMCredentialChallengeResponse, cb ...client.RequestEditorFn) (*v1alpha1.EnrollmentRequest, error)
 }
 
 // IsCommandAvailable checks if a command is available in the PATH.
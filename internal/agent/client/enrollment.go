@@ -81,3 +81,28 @@ func (e *enrollment) GetEnrollmentRequest(ctx context.Context, id string, cb ...
 
 	return resp.JSON200, nil
 }
+
+func (e *enrollment) AnswerEnrollmentRequestTPMChallenge(ctx context.Context, id string, answer v1alpha1.TPMCredentialChallengeResponse, cb ...client.RequestEditorFn) (*v1alpha1.EnrollmentRequest, error) {
+	start := time.Now()
+	resp, err := e.client.AnswerEnrollmentRequestTPMChallengeWithResponse(ctx, id, answer, cb...)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HTTPResponse != nil {
+		defer resp.HTTPResponse.Body.Close()
+	}
+
+	if e.rpcMetricsCallbackFunc != nil {
+		e.rpcMetricsCallbackFunc("answer_enrollmentrequest_tpmchallenge_duration", time.Since(start).Seconds(), err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("answer enrollmentrequest tpm challenge failed: %s", resp.Status())
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("answer enrollmentrequest tpm challenge failed: %s", ErrEmptyResponse)
+	}
+
+	return resp.JSON200, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -79,3 +80,26 @@ func (m *management) GetRenderedDeviceSpec(ctx context.Context, name string, par
 
 	return nil, resp.StatusCode(), nil
 }
+
+// UploadDeviceBundle uploads the diagnostic bundle gathered for the bundle
+// request with the given ID.
+func (m *management) UploadDeviceBundle(ctx context.Context, name string, requestID string, bundle io.Reader, rcb ...client.RequestEditorFn) error {
+	start := time.Now()
+	resp, err := m.client.UploadDeviceBundleWithBodyWithResponse(ctx, name, requestID, "application/gzip", bundle, rcb...)
+	if err != nil {
+		return err
+	}
+	if resp.HTTPResponse != nil {
+		defer resp.HTTPResponse.Body.Close()
+	}
+
+	if m.rpcMetricsCallbackFunc != nil {
+		m.rpcMetricsCallbackFunc("upload_device_bundle_duration", time.Since(start).Seconds(), err)
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		return fmt.Errorf("upload device bundle failed: %s", resp.Status())
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ package client
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	v1alpha1 "github.com/flightctl/flightctl/api/v1alpha1"
@@ -81,6 +82,25 @@ func (mr *MockManagementMockRecorder) UpdateDeviceStatus(ctx, name, device any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeviceStatus", reflect.TypeOf((*MockManagement)(nil).UpdateDeviceStatus), varargs...)
 }
 
+// UploadDeviceBundle mocks base method.
+func (m *MockManagement) UploadDeviceBundle(ctx context.Context, name, requestID string, bundle io.Reader, rcb ...client.RequestEditorFn) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, requestID, bundle}
+	for _, a := range rcb {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadDeviceBundle", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadDeviceBundle indicates an expected call of UploadDeviceBundle.
+func (mr *MockManagementMockRecorder) UploadDeviceBundle(ctx, name, requestID, bundle any, rcb ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, requestID, bundle}, rcb...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadDeviceBundle", reflect.TypeOf((*MockManagement)(nil).UploadDeviceBundle), varargs...)
+}
+
 // MockEnrollment is a mock of Enrollment interface.
 type MockEnrollment struct {
 	ctrl     *gomock.Controller
@@ -104,6 +124,26 @@ func (m *MockEnrollment) EXPECT() *MockEnrollmentMockRecorder {
 	return m.recorder
 }
 
+// AnswerEnrollmentRequestTPMChallenge mocks base method.
+func (m *MockEnrollment) AnswerEnrollmentRequestTPMChallenge(ctx context.Context, id string, answer v1alpha1.TPMCredentialChallengeResponse, cb ...client.RequestEditorFn) (*v1alpha1.EnrollmentRequest, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, answer}
+	for _, a := range cb {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AnswerEnrollmentRequestTPMChallenge", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.EnrollmentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnswerEnrollmentRequestTPMChallenge indicates an expected call of AnswerEnrollmentRequestTPMChallenge.
+func (mr *MockEnrollmentMockRecorder) AnswerEnrollmentRequestTPMChallenge(ctx, id, answer any, cb ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, answer}, cb...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnswerEnrollmentRequestTPMChallenge", reflect.TypeOf((*MockEnrollment)(nil).AnswerEnrollmentRequestTPMChallenge), varargs...)
+}
+
 // CreateEnrollmentRequest mocks base method.
 func (m *MockEnrollment) CreateEnrollmentRequest(ctx context.Context, req v1alpha1.EnrollmentRequest, cb ...client.RequestEditorFn) (*v1alpha1.EnrollmentRequest, error) {
 	m.ctrl.T.Helper()
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strconv"
 	"strings"
 	"time"
 
@@ -83,6 +84,47 @@ func (p *Podman) EventsSinceCmd(ctx context.Context, events []string, sinceTime
 	return p.exec.CommandContext(ctx, podmanCmd, args...)
 }
 
+// ListContainers returns the names of the containers with the given labels.
+func (p *Podman) ListContainers(ctx context.Context, labels []string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	args := []string{"ps", "--all", "--format", "{{.Names}}"}
+	for _, label := range labels {
+		args = append(args, "--filter", fmt.Sprintf("label=%s", label))
+	}
+	stdout, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return nil, fmt.Errorf("failed to list containers %d: %s", exitCode, stderr)
+	}
+
+	var containers []string
+	for _, line := range strings.Split(stdout, "\n") {
+		if name := strings.TrimSpace(line); name != "" {
+			containers = append(containers, name)
+		}
+	}
+	return containers, nil
+}
+
+// LogsCmd returns a command to get the logs of the given containers, each line
+// prefixed with the name of its container. After creating the command, it
+// should be started with exec.Start().
+func (p *Podman) LogsCmd(ctx context.Context, containers []string, since *time.Time, tail *int, follow bool) *exec.Cmd {
+	args := []string{"logs", "--names"}
+	if since != nil {
+		args = append(args, "--since", since.Format(time.RFC3339))
+	}
+	if tail != nil {
+		args = append(args, "--tail", strconv.Itoa(*tail))
+	}
+	if follow {
+		args = append(args, "--follow")
+	}
+	args = append(args, containers...)
+	return p.exec.CommandContext(ctx, podmanCmd, args...)
+}
+
 func (p *Podman) Mount(ctx context.Context, image string) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, p.timeout)
 	defer cancel()
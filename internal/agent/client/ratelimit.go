@@ -0,0 +1,93 @@
+package client
+
+import (
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// rateLimitMaxRetries is how many times a request rejected by the rate limit of the service is retried.
+	rateLimitMaxRetries = 4
+	// rateLimitInitialBackoff is the wait before the first retry, doubled for every retry after it.
+	rateLimitInitialBackoff = time.Second
+	// rateLimitMaxBackoff caps the wait before a retry, including the wait asked for by the service.
+	rateLimitMaxBackoff = time.Minute
+)
+
+// rateLimitTransport retries requests rejected with 429 Too Many Requests, waiting for as long as the
+// Retry-After header of the response asks for, with an exponential backoff if it is longer.
+type rateLimitTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	backoff    time.Duration
+	maxBackoff time.Duration
+}
+
+func newRateLimitTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &rateLimitTransport{
+		next:       next,
+		maxRetries: rateLimitMaxRetries,
+		backoff:    rateLimitInitialBackoff,
+		maxBackoff: rateLimitMaxBackoff,
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	backoff := t.backoff
+	for retry := 0; ; retry++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || retry >= t.maxRetries {
+			return resp, err
+		}
+		// a request with a body can only be retried if the body can be read again
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return resp, nil
+		}
+
+		wait := max(backoff, retryAfter(resp))
+		// jitter spreads the retries of devices that were limited at the same time
+		wait = min(wait+time.Duration(rand.Int63n(int64(wait)/10+1)), t.maxBackoff)
+		backoff *= 2
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		// round trippers must not modify the request
+		req = req.Clone(req.Context())
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// retryAfter returns the wait the Retry-After header of the response asks for, in seconds or as a date,
+// or zero if there is none.
+func retryAfter(resp *http.Response) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(0, time.Until(date))
+	}
+	return 0
+}
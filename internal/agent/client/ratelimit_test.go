@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRateLimitTransport(maxRetries int) *rateLimitTransport {
+	return &rateLimitTransport{
+		next:       http.DefaultTransport,
+		maxRetries: maxRetries,
+		backoff:    time.Millisecond,
+		maxBackoff: 10 * time.Millisecond,
+	}
+}
+
+func TestRateLimitTransportRetriesWithBody(t *testing.T) {
+	require := require.New(t)
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		require.Equal("status", string(body))
+		if calls < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader("status"))
+	require.NoError(err)
+	resp, err := newTestRateLimitTransport(4).RoundTrip(req)
+	require.NoError(err)
+	defer resp.Body.Close()
+	require.Equal(http.StatusOK, resp.StatusCode)
+	require.Equal(3, calls)
+}
+
+func TestRateLimitTransportGivesUpAfterMaxRetries(t *testing.T) {
+	require := require.New(t)
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(err)
+	resp, err := newTestRateLimitTransport(2).RoundTrip(req)
+	require.NoError(err)
+	defer resp.Body.Close()
+	require.Equal(http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(3, calls)
+}
+
+func TestRateLimitTransportStopsWhenCanceled(t *testing.T) {
+	require := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(err)
+	transport := newTestRateLimitTransport(4)
+	transport.maxBackoff = time.Minute
+	_, err = transport.RoundTrip(req)
+	require.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func TestRetryAfter(t *testing.T) {
+	require := require.New(t)
+	resp := &http.Response{Header: http.Header{}}
+	require.Equal(time.Duration(0), retryAfter(resp))
+
+	resp.Header.Set("Retry-After", "3")
+	require.Equal(3*time.Second, retryAfter(resp))
+
+	resp.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	require.InDelta(time.Hour.Seconds(), retryAfter(resp).Seconds(), 2)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/instrumentation"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/sirupsen/logrus"
 	"k8s.io/klog/v2"
@@ -43,6 +44,8 @@ const (
 	EnrollmentCertFile = "client-enrollment.crt"
 	// name of the enrollment key file
 	EnrollmentKeyFile = "client-enrollment.key"
+	// DefaultTPMPath is the default path to the TPM device
+	DefaultTPMPath = "/dev/tpmrm0"
 	// TestRootDirEnvKey is the environment variable key used to set the file system root when testing.
 	TestRootDirEnvKey = "FLIGHTCTL_TEST_ROOT_DIR"
 )
@@ -70,6 +73,12 @@ type Config struct {
 
 	// TPMPath is the path to the TPM device
 	TPMPath string `json:"tpm-path,omitempty"`
+	// TPMIdentity generates the device's identity key in the TPM instead of
+	// writing it to the key file, and attests it when enrolling
+	TPMIdentity bool `json:"tpm-identity,omitempty"`
+	// TPMEKCertificate is the path to the PEM-encoded certificate of the TPM's
+	// endorsement key, for TPMs that do not store one in their NV storage
+	TPMEKCertificate string `json:"tpm-ek-certificate,omitempty"`
 
 	// LogLevel is the level of logging. can be:  "panic", "fatal", "error", "warn"/"warning",
 	// "info", "debug" or "trace", any other will be treated as "info"
@@ -77,6 +86,10 @@ type Config struct {
 	// LogPrefix is the log prefix used for testing
 	LogPrefix string `json:"log-prefix,omitempty"`
 
+	// Telemetry is the configuration for exporting the agent's metrics and traces
+	// to an OpenTelemetry collector
+	Telemetry instrumentation.Config `json:"telemetry,omitempty"`
+
 	// testRootDir is the root directory of the test agent
 	testRootDir string
 	// enrollmentMetricsCallback is a callback to report metrics about the enrollment process.
@@ -178,6 +191,9 @@ func (cfg *Config) Complete() error {
 		cfg.ManagementService.Config = *cfg.EnrollmentService.Config.DeepCopy()
 		cfg.ManagementService.Config.AuthInfo = client.AuthInfo{}
 	}
+	if cfg.TPMIdentity && cfg.TPMPath == "" {
+		cfg.TPMPath = DefaultTPMPath
+	}
 	return nil
 }
 
@@ -228,6 +244,9 @@ func (cfg *Config) ParseConfigFile(cfgFile string) error {
 	}
 	cfg.EnrollmentService.Config.SetBaseDir(filepath.Dir(cfgFile))
 	cfg.ManagementService.Config.SetBaseDir(filepath.Dir(cfgFile))
+	if cfg.Telemetry.CertificateAuthority != "" && !filepath.IsAbs(cfg.Telemetry.CertificateAuthority) {
+		cfg.Telemetry.CertificateAuthority = filepath.Join(filepath.Dir(cfgFile), cfg.Telemetry.CertificateAuthority)
+	}
 	return nil
 }
 
@@ -238,3 +257,24 @@ func (cfg *Config) String() string {
 	}
 	return string(contents)
 }
+
+// redacted returns the config as YAML without the credentials it holds, for
+// including it in diagnostic bundles.
+func (cfg *Config) redacted() ([]byte, error) {
+	redacted := *cfg
+	for _, authInfo := range []*client.AuthInfo{&redacted.EnrollmentService.AuthInfo, &redacted.ManagementService.AuthInfo} {
+		if len(authInfo.ClientKeyData) > 0 {
+			authInfo.ClientKeyData = []byte("REDACTED")
+		}
+		if authInfo.Token != "" {
+			authInfo.Token = "REDACTED"
+		}
+	}
+	if len(cfg.Telemetry.Headers) > 0 {
+		redacted.Telemetry.Headers = make(map[string]string, len(cfg.Telemetry.Headers))
+		for name := range cfg.Telemetry.Headers {
+			redacted.Telemetry.Headers[name] = "REDACTED"
+		}
+	}
+	return yaml.Marshal(&redacted)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
 )
 
 var yamlConfig = `enrollment-service:
@@ -63,3 +64,27 @@ func TestParseConfigFile_NoFile(t *testing.T) {
 	// Expect an error because the file does not exist
 	require.Error(err)
 }
+
+func TestRedactedConfig(t *testing.T) {
+	require := require.New(t)
+
+	cfg := NewDefault()
+	cfg.EnrollmentService.AuthInfo.ClientKeyData = []byte("enrollment-key")
+	cfg.ManagementService.AuthInfo.Token = "management-token"
+	cfg.Telemetry.Headers = map[string]string{"Authorization": "Bearer telemetry-token"}
+
+	redacted, err := cfg.redacted()
+	require.NoError(err)
+	require.NotContains(string(redacted), "management-token")
+	require.Contains(string(redacted), "token: REDACTED")
+
+	var parsed Config
+	require.NoError(yaml.Unmarshal(redacted, &parsed))
+	require.Equal([]byte("REDACTED"), parsed.EnrollmentService.AuthInfo.ClientKeyData)
+	require.Equal("REDACTED", parsed.Telemetry.Headers["Authorization"])
+
+	// the config itself keeps its credentials
+	require.Equal([]byte("enrollment-key"), cfg.EnrollmentService.AuthInfo.ClientKeyData)
+	require.Equal("management-token", cfg.ManagementService.AuthInfo.Token)
+	require.Equal("Bearer telemetry-token", cfg.Telemetry.Headers["Authorization"])
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/applications/lifecycle"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/instrumentation"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
@@ -454,12 +455,16 @@ func (m *PodmanMonitor) updateAppStatus(ctx context.Context, app Application, ev
 		container.Status = status
 		// restarts can only increase
 		if restarts > container.Restarts {
+			instrumentation.RecordApplicationRestarts(ctx, app.Name(), restarts-container.Restarts)
 			container.Restarts = restarts
 		}
 		return
 	}
 
 	// add new container
+	if restarts > 0 {
+		instrumentation.RecordApplicationRestarts(ctx, app.Name(), restarts)
+	}
 	app.AddContainer(Container{
 		ID:       event.ID,
 		Image:    event.Image,
@@ -28,6 +28,15 @@ import (
 // agent banner file
 const BannerFile = "/etc/issue.d/flightctl-banner.issue"
 
+// TPMAttester attests that the device's identity key was generated in the TPM.
+type TPMAttester interface {
+	// Attest returns the attestation of the identity key for the given CSR.
+	Attest(csr []byte) (*v1alpha1.TPMAttestation, error)
+	// ActivateCredential returns the secret of the credential challenge that
+	// the service issued for the attestation.
+	ActivateCredential(challenge *v1alpha1.TPMCredentialChallenge) ([]byte, error)
+}
+
 type Bootstrap struct {
 	deviceName           string
 	executer             executer.Executer
@@ -43,6 +52,7 @@ type Bootstrap struct {
 	managementClient        client.Management
 
 	enrollmentCSR []byte
+	tpmAttester   TPMAttester
 	log           *log.PrefixLogger
 
 	defaultLabels map[string]string
@@ -53,6 +63,7 @@ func NewBootstrap(
 	executer executer.Executer,
 	deviceReadWriter fileio.ReadWriter,
 	enrollmentCSR []byte,
+	tpmAttester TPMAttester,
 	specManager spec.Manager,
 	statusManager status.Manager,
 	hookManager hook.Manager,
@@ -68,6 +79,7 @@ func NewBootstrap(
 		executer:                executer,
 		deviceReadWriter:        deviceReadWriter,
 		enrollmentCSR:           enrollmentCSR,
+		tpmAttester:             tpmAttester,
 		specManager:             specManager,
 		statusManager:           statusManager,
 		hookManager:             hookManager,
@@ -292,6 +304,14 @@ func (b *Bootstrap) verifyEnrollment(ctx context.Context) (bool, error) {
 		b.log.Fatal("Enrollment request status or conditions field are nil")
 	}
 
+	if b.tpmAttester != nil && enrollmentRequest.Status.TpmChallenge != nil &&
+		!v1alpha1.IsStatusConditionTrue(enrollmentRequest.Status.Conditions, v1alpha1.EnrollmentRequestTPMVerified) {
+		if err := b.answerTPMChallenge(ctx, enrollmentRequest.Status.TpmChallenge); err != nil {
+			b.log.Errorf("Error answering TPM credential challenge: %v", err)
+		}
+		return false, nil
+	}
+
 	approved := false
 	for _, cond := range enrollmentRequest.Status.Conditions {
 		if cond.Type == "Denied" {
@@ -326,6 +346,16 @@ func (b *Bootstrap) verifyEnrollment(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+func (b *Bootstrap) answerTPMChallenge(ctx context.Context, challenge *v1alpha1.TPMCredentialChallenge) error {
+	b.log.Info("Answering TPM credential challenge")
+	secret, err := b.tpmAttester.ActivateCredential(challenge)
+	if err != nil {
+		return err
+	}
+	_, err = b.enrollmentClient.AnswerEnrollmentRequestTPMChallenge(ctx, b.deviceName, v1alpha1.TPMCredentialChallengeResponse{Secret: secret})
+	return err
+}
+
 // we want to look at the desired spec
 
 func (b *Bootstrap) writeEnrollmentBanner() error {
@@ -401,6 +431,13 @@ func (b *Bootstrap) enrollmentRequest(ctx context.Context) error {
 			Labels:       &b.defaultLabels,
 		},
 	}
+	if b.tpmAttester != nil {
+		attestation, err := b.tpmAttester.Attest(b.enrollmentCSR)
+		if err != nil {
+			return fmt.Errorf("attesting TPM identity key: %w", err)
+		}
+		req.Spec.TpmAttestation = attestation
+	}
 
 	err = wait.ExponentialBackoffWithContext(ctx, b.backoff, func() (bool, error) {
 		_, err := b.enrollmentClient.CreateEnrollmentRequest(ctx, req)
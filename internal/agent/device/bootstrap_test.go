@@ -224,3 +224,58 @@ func newTestDesiredSpec(image, version string) *v1alpha1.RenderedDeviceSpec {
 		RenderedVersion: version,
 	}
 }
+
+type fakeTPMAttester struct {
+	secret []byte
+}
+
+func (f *fakeTPMAttester) Attest(csr []byte) (*v1alpha1.TPMAttestation, error) {
+	return &v1alpha1.TPMAttestation{EkCertificate: "ek"}, nil
+}
+
+func (f *fakeTPMAttester) ActivateCredential(challenge *v1alpha1.TPMCredentialChallenge) ([]byte, error) {
+	return f.secret, nil
+}
+
+func TestBootstrapVerifyEnrollmentTPMChallenge(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.TODO()
+	mockEnrollmentClient := client.NewMockEnrollment(ctrl)
+	b := &Bootstrap{
+		deviceName:       "device",
+		enrollmentClient: mockEnrollmentClient,
+		tpmAttester:      &fakeTPMAttester{secret: []byte("secret")},
+		log:              log.NewPrefixLogger("test"),
+	}
+
+	challenged := &v1alpha1.EnrollmentRequest{
+		Status: &v1alpha1.EnrollmentRequestStatus{
+			Conditions: []v1alpha1.Condition{
+				{Type: v1alpha1.EnrollmentRequestTPMVerified, Status: v1alpha1.ConditionStatusFalse},
+			},
+			TpmChallenge: &v1alpha1.TPMCredentialChallenge{},
+		},
+	}
+	mockEnrollmentClient.EXPECT().GetEnrollmentRequest(ctx, "device").Return(challenged, nil)
+	mockEnrollmentClient.EXPECT().AnswerEnrollmentRequestTPMChallenge(ctx, "device", v1alpha1.TPMCredentialChallengeResponse{Secret: []byte("secret")}).Return(challenged, nil)
+	enrolled, err := b.verifyEnrollment(ctx)
+	require.NoError(err)
+	require.False(enrolled)
+
+	// the challenge is not answered again once verified
+	verified := &v1alpha1.EnrollmentRequest{
+		Status: &v1alpha1.EnrollmentRequestStatus{
+			Conditions: []v1alpha1.Condition{
+				{Type: v1alpha1.EnrollmentRequestTPMVerified, Status: v1alpha1.ConditionStatusTrue},
+			},
+			TpmChallenge: &v1alpha1.TPMCredentialChallenge{},
+		},
+	}
+	mockEnrollmentClient.EXPECT().GetEnrollmentRequest(ctx, "device").Return(verified, nil)
+	enrolled, err = b.verifyEnrollment(ctx)
+	require.NoError(err)
+	require.False(enrolled)
+}
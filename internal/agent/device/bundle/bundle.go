@@ -0,0 +1,206 @@
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/spec"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	// commandTimeout is how long a command gathering the output for a bundle
+	// may run.
+	commandTimeout = time.Minute
+	// journalSince is how far back the journal of the agent in a bundle goes.
+	journalSince = "24 hours ago"
+)
+
+// entry is a file in a bundle together with the way to gather its contents.
+type entry struct {
+	name string
+	// command writes the contents to its output if set, else they are read
+	// from path.
+	command []string
+	path    string
+}
+
+// entries are the files gathered into every bundle besides the agent's config.
+var entries = []entry{
+	{name: "specs/current.json", path: string(spec.Current) + ".json"},
+	{name: "specs/desired.json", path: string(spec.Desired) + ".json"},
+	{name: "specs/rollback.json", path: string(spec.Rollback) + ".json"},
+	{name: "journal/flightctl-agent.log", command: []string{"journalctl", "--no-pager", "--output", "short-iso", "--unit", "flightctl-agent", "--since", journalSince}},
+	{name: "podman/ps.txt", command: []string{"podman", "ps", "--all"}},
+	{name: "podman/images.txt", command: []string{"podman", "images"}},
+	{name: "podman/info.txt", command: []string{"podman", "info"}},
+	{name: "bootc/status.txt", command: []string{"bootc", "status"}},
+	{name: "resources/df.txt", command: []string{"df", "-h"}},
+	{name: "resources/top.txt", command: []string{"top", "-b", "-n", "1"}},
+	{name: "resources/meminfo", path: "/proc/meminfo"},
+	{name: "resources/loadavg", path: "/proc/loadavg"},
+}
+
+// BundleController gathers a diagnostic bundle of the device and uploads it
+// to the service when the service requests one.
+type BundleController struct {
+	managementClient client.Management
+	executor         executer.Executer
+	reader           fileio.Reader
+	deviceName       string
+	dataDir          string
+	// config is the agent's config without its credentials
+	config []byte
+	log    *log.PrefixLogger
+
+	mu sync.Mutex
+	// uploading are the requests a bundle is being gathered and uploaded for
+	uploading map[string]struct{}
+	// uploaded are the requests a bundle was uploaded for, which are not
+	// served again. requests whose upload failed are served again on the next
+	// sync.
+	uploaded map[string]struct{}
+}
+
+func NewController(
+	managementClient client.Management,
+	executor executer.Executer,
+	reader fileio.Reader,
+	deviceName string,
+	dataDir string,
+	config []byte,
+	log *log.PrefixLogger,
+) *BundleController {
+	return &BundleController{
+		managementClient: managementClient,
+		executor:         executor,
+		reader:           reader,
+		deviceName:       deviceName,
+		dataDir:          dataDir,
+		config:           config,
+		log:              log,
+		uploading:        make(map[string]struct{}),
+		uploaded:         make(map[string]struct{}),
+	}
+}
+
+func (c *BundleController) Sync(ctx context.Context, desired *v1alpha1.RenderedDeviceSpec) error {
+	if desired.Bundle == nil {
+		return nil
+	}
+	requestID := desired.Bundle.RequestID
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.uploaded[requestID]; ok {
+		c.log.Debugf("bundle for request %s was already uploaded", requestID)
+		return nil
+	}
+	if _, ok := c.uploading[requestID]; ok {
+		c.log.Debugf("bundle for request %s is being uploaded", requestID)
+		return nil
+	}
+
+	if c.managementClient == nil {
+		c.log.Errorf("no management client available, cannot upload bundle for request %s", requestID)
+		return nil
+	}
+	c.log.Infof("gathering bundle for request %s", requestID)
+	c.uploading[requestID] = struct{}{}
+
+	// gathering the bundle takes a while, so don't hold up the sync
+	go func() {
+		err := c.upload(ctx, requestID)
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		delete(c.uploading, requestID)
+		if err != nil {
+			c.log.Errorf("error uploading bundle for request %s: %v", requestID, err)
+			return
+		}
+		c.uploaded[requestID] = struct{}{}
+		c.log.Infof("uploaded bundle for request %s", requestID)
+	}()
+	return nil
+}
+
+func (c *BundleController) upload(ctx context.Context, requestID string) error {
+	var bundle bytes.Buffer
+	if err := c.gather(ctx, &bundle); err != nil {
+		return fmt.Errorf("gathering bundle: %w", err)
+	}
+	return c.managementClient.UploadDeviceBundle(ctx, c.deviceName, requestID, &bundle)
+}
+
+// gather writes the bundle as a gzip-compressed tarball. Files that can't be
+// gathered hold the error instead, so that the rest of the bundle is still of
+// use.
+func (c *BundleController) gather(ctx context.Context, w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+
+	if err := writeFile(tw, "config.yaml", c.config, now); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if err := writeFile(tw, e.name, c.contents(ctx, e), now); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func (c *BundleController) contents(ctx context.Context, e entry) []byte {
+	if len(e.command) == 0 {
+		path := e.path
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(c.dataDir, path)
+		}
+		contents, err := c.reader.ReadFile(path)
+		if err != nil {
+			return []byte(fmt.Sprintf("error: %v\n", err))
+		}
+		return contents
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, commandTimeout)
+	defer cancel()
+	stdout, stderr, exitCode := c.executor.ExecuteWithContext(ctx, e.command[0], e.command[1:]...)
+	if exitCode != 0 {
+		return []byte(fmt.Sprintf("%serror: exit code %d: %s\n", stdout, exitCode, stderr))
+	}
+	return []byte(stdout)
+}
+
+func writeFile(tw *tar.Writer, name string, contents []byte, modTime time.Time) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(contents)),
+		ModTime: modTime,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("writing header of %s: %w", name, err)
+	}
+	if _, err := tw.Write(contents); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	return nil
+}
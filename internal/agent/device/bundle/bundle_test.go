@@ -0,0 +1,142 @@
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	agentclient "github.com/flightctl/flightctl/internal/api/client/agent"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const dataDir = "/var/lib/flightctl"
+
+func newTestController(t *testing.T) (*BundleController, *client.MockManagement, *executer.MockExecuter, string) {
+	ctrl := gomock.NewController(t)
+	managementClient := client.NewMockManagement(ctrl)
+	execMock := executer.NewMockExecuter(ctrl)
+	tmpDir := t.TempDir()
+	readWriter := fileio.NewReadWriter(fileio.WithTestRootDir(tmpDir))
+	c := NewController(managementClient, execMock, readWriter, "test-device", dataDir, []byte("log-level: info\n"), log.NewPrefixLogger("TestBundleController"))
+	return c, managementClient, execMock, tmpDir
+}
+
+// readBundle returns the contents of the files in the bundle by name.
+func readBundle(t *testing.T, bundle io.Reader) map[string]string {
+	gz, err := gzip.NewReader(bundle)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	files := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+		require.NoError(t, err)
+		contents, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[header.Name] = string(contents)
+	}
+}
+
+func TestGather(t *testing.T) {
+	require := require.New(t)
+	c, _, execMock, tmpDir := newTestController(t)
+
+	require.NoError(os.MkdirAll(filepath.Join(tmpDir, dataDir), 0700))
+	require.NoError(os.WriteFile(filepath.Join(tmpDir, dataDir, "current.json"), []byte(`{"renderedVersion":"1"}`), 0600))
+	require.NoError(os.MkdirAll(filepath.Join(tmpDir, "proc"), 0700))
+	require.NoError(os.WriteFile(filepath.Join(tmpDir, "proc", "loadavg"), []byte("0.10 0.20 0.30 1/100 1000\n"), 0600))
+
+	execMock.EXPECT().ExecuteWithContext(gomock.Any(), "journalctl", "--no-pager", "--output", "short-iso", "--unit", "flightctl-agent", "--since", journalSince).
+		Return("agent started\n", "", 0)
+	execMock.EXPECT().ExecuteWithContext(gomock.Any(), "bootc", "status").
+		Return("", "bootc: command not found", 127)
+	execMock.EXPECT().ExecuteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return("output\n", "", 0).AnyTimes()
+
+	var bundle bytes.Buffer
+	require.NoError(c.gather(context.Background(), &bundle))
+	files := readBundle(t, &bundle)
+
+	require.Len(files, len(entries)+1)
+	require.Equal("log-level: info\n", files["config.yaml"])
+	require.Equal(`{"renderedVersion":"1"}`, files["specs/current.json"])
+	require.Contains(files["specs/desired.json"], "error:")
+	require.Equal("agent started\n", files["journal/flightctl-agent.log"])
+	require.Equal("output\n", files["podman/ps.txt"])
+	require.Equal("error: exit code 127: bootc: command not found\n", files["bootc/status.txt"])
+	require.Equal("0.10 0.20 0.30 1/100 1000\n", files["resources/loadavg"])
+}
+
+func TestSync(t *testing.T) {
+	require := require.New(t)
+	c, managementClient, execMock, _ := newTestController(t)
+
+	desired := &v1alpha1.RenderedDeviceSpec{
+		Bundle: &v1alpha1.DeviceBundleRequest{RequestID: "request-1"},
+	}
+	execMock.EXPECT().ExecuteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return("output\n", "", 0).AnyTimes()
+	uploaded := make(chan map[string]string, 1)
+	managementClient.EXPECT().UploadDeviceBundle(gomock.Any(), "test-device", "request-1", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, name string, requestID string, bundle io.Reader, rcb ...agentclient.RequestEditorFn) error {
+			uploaded <- readBundle(t, bundle)
+			return nil
+		})
+
+	require.NoError(c.Sync(context.Background(), desired))
+	select {
+	case files := <-uploaded:
+		require.Equal("log-level: info\n", files["config.yaml"])
+	case <-time.After(10 * time.Second):
+		require.Fail("bundle was not uploaded")
+	}
+
+	// the bundle of a request is only uploaded once
+	require.NoError(c.Sync(context.Background(), desired))
+	// no bundle is requested
+	require.NoError(c.Sync(context.Background(), &v1alpha1.RenderedDeviceSpec{}))
+}
+
+func TestSyncRetriesFailedUpload(t *testing.T) {
+	require := require.New(t)
+	c, managementClient, execMock, _ := newTestController(t)
+
+	desired := &v1alpha1.RenderedDeviceSpec{
+		Bundle: &v1alpha1.DeviceBundleRequest{RequestID: "request-1"},
+	}
+	execMock.EXPECT().ExecuteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return("output\n", "", 0).AnyTimes()
+	gomock.InOrder(
+		managementClient.EXPECT().UploadDeviceBundle(gomock.Any(), "test-device", "request-1", gomock.Any()).Return(errors.New("service unavailable")),
+		managementClient.EXPECT().UploadDeviceBundle(gomock.Any(), "test-device", "request-1", gomock.Any()).Return(nil),
+	)
+	uploadDone := func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return len(c.uploading) == 0
+	}
+
+	require.NoError(c.Sync(context.Background(), desired))
+	require.Eventually(uploadDone, 10*time.Second, 10*time.Millisecond)
+	require.NotContains(c.uploaded, "request-1")
+
+	// the failed upload is retried on the next sync
+	require.NoError(c.Sync(context.Background(), desired))
+	require.Eventually(uploadDone, 10*time.Second, 10*time.Millisecond)
+	require.Contains(c.uploaded, "request-1")
+
+	// and not again once it succeeded
+	require.NoError(c.Sync(context.Background(), desired))
+}
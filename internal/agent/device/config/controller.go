@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"crypto"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -11,8 +12,12 @@ import (
 	"github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/internal/agent/device/hook"
+	fcrypto "github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/ignition"
 	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/vincent-petithory/dataurl"
 )
 
 // Config controller is responsible for ensuring the device configuration is reconciled
@@ -20,18 +25,22 @@ import (
 type Controller struct {
 	hookManager  hook.Manager
 	deviceWriter fileio.Writer
-	log          *log.PrefixLogger
+	// privateKey decrypts the secrets that were encrypted to the device's public key
+	privateKey crypto.PrivateKey
+	log        *log.PrefixLogger
 }
 
 // NewController creates a new config controller.
 func NewController(
 	hookManager hook.Manager,
 	deviceWriter fileio.Writer,
+	privateKey crypto.PrivateKey,
 	log *log.PrefixLogger,
 ) *Controller {
 	return &Controller{
 		hookManager:  hookManager,
 		deviceWriter: deviceWriter,
+		privateKey:   privateKey,
 		log:          log,
 	}
 }
@@ -127,6 +136,10 @@ func (c *Controller) removeObsoleteFiles(ctx context.Context, currentFiles, desi
 
 func (c *Controller) writeIgnitionFiles(ctx context.Context, files []ignv3types.File) error {
 	for _, file := range files {
+		file, err := c.decryptSecretFile(file)
+		if err != nil {
+			return err
+		}
 		managedFile, err := c.deviceWriter.CreateManagedFile(file)
 		if err != nil {
 			return err
@@ -166,6 +179,27 @@ func (c *Controller) writeIgnitionFiles(ctx context.Context, files []ignv3types.
 	return nil
 }
 
+// decryptSecretFile returns the file with its contents decrypted if they were
+// encrypted to the device's public key, so that only the plaintext is passed to
+// the writer.
+func (c *Controller) decryptSecretFile(file ignv3types.File) (ignv3types.File, error) {
+	if file.Contents.Source == nil {
+		return file, nil
+	}
+	source, err := dataurl.DecodeString(*file.Contents.Source)
+	if err != nil || source.MediaType.ContentType() != ignition.DeviceSecretMediaType {
+		// other contents are decoded when the file is written
+		return file, nil
+	}
+	plaintext, err := fcrypto.DecryptDeviceSecret(c.privateKey, source.Data)
+	if err != nil {
+		return file, fmt.Errorf("failed to decrypt file %s: %w", file.Path, err)
+	}
+	file.Contents.Source = lo.ToPtr(dataurl.New(plaintext, "text/plain").String())
+	file.Contents.Compression = nil
+	return file, nil
+}
+
 func getFilePaths(currentFileList []ignv3types.File) []string {
 	result := make([]string, len(currentFileList))
 	for i, f := range currentFileList {
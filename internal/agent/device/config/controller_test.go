@@ -9,9 +9,12 @@ import (
 	"github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/internal/agent/device/hook"
+	fcrypto "github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/ignition"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/stretchr/testify/require"
+	"github.com/vincent-petithory/dataurl"
 	"go.uber.org/mock/gomock"
 )
 
@@ -93,6 +96,7 @@ func TestSync(t *testing.T) {
 			controller := NewController(
 				mockHookManager,
 				mockWriter,
+				nil,
 				log.NewPrefixLogger("test"),
 			)
 
@@ -172,6 +176,38 @@ func TestComputeRemoval(t *testing.T) {
 	}
 }
 
+func TestDecryptSecretFile(t *testing.T) {
+	require := require.New(t)
+	publicKey, privateKey, err := fcrypto.NewKeyPair()
+	require.NoError(err)
+	_, otherPrivateKey, err := fcrypto.NewKeyPair()
+	require.NoError(err)
+
+	ciphertext, err := fcrypto.EncryptDeviceSecret(publicKey, []byte("hunter2"))
+	require.NoError(err)
+	secretFile := ignv3types.File{Node: ignv3types.Node{Path: "/etc/example/secret"}}
+	secretFile.Contents.Source = util.StrToPtr(dataurl.New(ciphertext, ignition.DeviceSecretMediaType).String())
+
+	controller := NewController(nil, nil, privateKey, log.NewPrefixLogger("test"))
+	decrypted, err := controller.decryptSecretFile(secretFile)
+	require.NoError(err)
+	source, err := dataurl.DecodeString(*decrypted.Contents.Source)
+	require.NoError(err)
+	require.Equal("hunter2", string(source.Data))
+	// the desired config is left untouched
+	require.NotEqual(*secretFile.Contents.Source, *decrypted.Contents.Source)
+
+	plainFile := ignv3types.File{Node: ignv3types.Node{Path: "/etc/example/file1.txt"}}
+	plainFile.Contents.Source = util.StrToPtr("data:,File%201%20contents")
+	decrypted, err = controller.decryptSecretFile(plainFile)
+	require.NoError(err)
+	require.Equal(plainFile, decrypted)
+
+	controller = NewController(nil, nil, otherPrivateKey, log.NewPrefixLogger("test"))
+	_, err = controller.decryptSecretFile(secretFile)
+	require.Error(err)
+}
+
 func expectCreateFile(ctx context.Context, mockWriter *fileio.MockWriter, mockManagedFile *fileio.MockManagedFile, mockHookManager *hook.MockManager, f string) {
 	mockWriter.EXPECT().CreateManagedFile(gomock.Any()).Return(mockManagedFile, nil)
 	mockManagedFile.EXPECT().IsUpToDate().Return(false, nil)
@@ -8,14 +8,17 @@ import (
 	"github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/applications"
+	"github.com/flightctl/flightctl/internal/agent/device/bundle"
 	"github.com/flightctl/flightctl/internal/agent/device/config"
 	"github.com/flightctl/flightctl/internal/agent/device/console"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/internal/agent/device/hook"
+	"github.com/flightctl/flightctl/internal/agent/device/logs"
 	"github.com/flightctl/flightctl/internal/agent/device/resource"
 	"github.com/flightctl/flightctl/internal/agent/device/spec"
 	"github.com/flightctl/flightctl/internal/agent/device/status"
+	"github.com/flightctl/flightctl/internal/agent/instrumentation"
 	"github.com/flightctl/flightctl/internal/container"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/log"
@@ -36,6 +39,8 @@ type Agent struct {
 	osImageController      *OSImageController
 	resourceController     *resource.Controller
 	consoleController      *console.ConsoleController
+	logsController         *logs.LogsController
+	bundleController       *bundle.BundleController
 	bootcClient            container.BootcClient
 	podmanClient           *client.Podman
 
@@ -62,6 +67,8 @@ func NewAgent(
 	osImageController *OSImageController,
 	resourceController *resource.Controller,
 	consoleController *console.ConsoleController,
+	logsController *logs.LogsController,
+	bundleController *bundle.BundleController,
 	bootcClient container.BootcClient,
 	podmanClient *client.Podman,
 	backoff wait.Backoff,
@@ -81,6 +88,8 @@ func NewAgent(
 		osImageController:      osImageController,
 		resourceController:     resourceController,
 		consoleController:      consoleController,
+		logsController:         logsController,
+		bundleController:       bundleController,
 		bootcClient:            bootcClient,
 		podmanClient:           podmanClient,
 		backoff:                backoff,
@@ -135,14 +144,19 @@ func (a *Agent) sync(ctx context.Context, current, desired *v1alpha1.RenderedDev
 func (a *Agent) syncSpec(ctx context.Context, syncFn func(ctx context.Context, desired *v1alpha1.RenderedDeviceSpec) error) {
 	startTime := time.Now()
 	a.log.Debug("Starting sync of device spec")
+	ctx, span := instrumentation.StartSpan(ctx, "sync device spec")
+	var syncErr error
 	defer func() {
 		duration := time.Since(startTime)
 		a.log.Debugf("Completed sync of device spec in %v", duration)
+		instrumentation.EndSpan(span, syncErr)
 	}()
 
 	desired, requeue, err := a.specManager.GetDesired(ctx)
 	if err != nil {
 		a.log.Errorf("Failed to get desired spec: %v", err)
+		instrumentation.RecordSpecFetchError(ctx)
+		syncErr = err
 		return
 	}
 	if requeue {
@@ -150,8 +164,10 @@ func (a *Agent) syncSpec(ctx context.Context, syncFn func(ctx context.Context, d
 		return
 	}
 
-	if err := syncFn(ctx, desired); err != nil {
-		a.handleSyncError(ctx, desired, err)
+	syncErr = syncFn(ctx, desired)
+	instrumentation.RecordSync(ctx, time.Since(startTime), syncErr)
+	if syncErr != nil {
+		a.handleSyncError(ctx, desired, syncErr)
 		return
 	}
 
@@ -237,12 +253,15 @@ func (a *Agent) upgradeStatus(ctx context.Context, desired *v1alpha1.RenderedDev
 func (a *Agent) pushStatus(ctx context.Context) {
 	startTime := time.Now()
 	a.log.Debug("Started collecting device status")
+	ctx, span := instrumentation.StartSpan(ctx, "push device status")
+	var err error
 	defer func() {
 		duration := time.Since(startTime)
 		a.log.Debugf("Completed pushing device status in: %v", duration)
+		instrumentation.EndSpan(span, err)
 	}()
 
-	if err := a.statusManager.Sync(ctx); err != nil {
+	if err = a.statusManager.Sync(ctx); err != nil {
 		msg := err.Error()
 		_, updateErr := a.statusManager.Update(ctx, status.SetDeviceSummary(v1alpha1.DeviceSummaryStatus{
 			Status: v1alpha1.DeviceSummaryStatusDegraded,
@@ -319,6 +338,14 @@ func (a *Agent) syncDevice(ctx context.Context, current, desired *v1alpha1.Rende
 		a.log.Errorf("Failed to sync console configuration: %s", err)
 	}
 
+	if err := a.logsController.Sync(ctx, desired); err != nil {
+		a.log.Errorf("Failed to sync logs request: %s", err)
+	}
+
+	if err := a.bundleController.Sync(ctx, desired); err != nil {
+		a.log.Errorf("Failed to sync bundle request: %s", err)
+	}
+
 	if err := a.applicationsController.Sync(ctx, current, desired); err != nil {
 		return fmt.Errorf("applications: %w", err)
 	}
@@ -7,9 +7,11 @@ import (
 	"reflect"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/instrumentation"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
@@ -211,7 +213,10 @@ func (m *manager) runActionList(ctx context.Context, path string, actionHooks []
 	}
 	var errs []error
 	for _, actionHook := range actionHooks {
-		if err := actionHook.OnChange(ctx, path); err != nil {
+		start := time.Now()
+		err := actionHook.OnChange(ctx, path)
+		instrumentation.RecordHook(ctx, path, time.Since(start), err)
+		if err != nil {
 			m.log.Errorf("error while running hook for path %s: %+v", path, err)
 			errs = append(errs, fmt.Errorf("failed to run hook for path %s: %w", path, err))
 		}
@@ -0,0 +1,194 @@
+package logs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"sync"
+	"time"
+
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	journalctlCommand = "journalctl"
+	// journalctlTimeFormat is the format of the timestamps journalctl accepts.
+	journalctlTimeFormat = "2006-01-02 15:04:05 UTC"
+	// sessionTTL is how long a session logs were sent for is remembered. The
+	// service removes the request once the session ends, so only an outdated
+	// spec can request the logs of a session again.
+	sessionTTL = time.Hour
+)
+
+// LogsController sends the logs of the device through the gRPC router when the
+// service requests them.
+type LogsController struct {
+	grpcClient grpc_v1.RouterServiceClient
+	podman     *client.Podman
+	executor   executer.Executer
+	deviceName string
+	log        *log.PrefixLogger
+
+	mu sync.Mutex
+	// sessions are the sessions logs were sent for, and when, which are not
+	// opened again until they expire
+	sessions map[string]time.Time
+}
+
+func NewController(
+	grpcClient grpc_v1.RouterServiceClient,
+	podman *client.Podman,
+	deviceName string,
+	executor executer.Executer,
+	log *log.PrefixLogger,
+) *LogsController {
+	return &LogsController{
+		grpcClient: grpcClient,
+		podman:     podman,
+		deviceName: deviceName,
+		executor:   executor,
+		log:        log,
+		sessions:   make(map[string]time.Time),
+	}
+}
+
+func (c *LogsController) Sync(ctx context.Context, desired *v1alpha1.RenderedDeviceSpec) error {
+	if desired.Logs == nil {
+		return nil
+	}
+	request := *desired.Logs
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for sessionID, sentAt := range c.sessions {
+		if now.Sub(sentAt) > sessionTTL {
+			delete(c.sessions, sessionID)
+		}
+	}
+	if _, ok := c.sessions[request.SessionID]; ok {
+		c.log.Debugf("logs for session %s were already sent", request.SessionID)
+		return nil
+	}
+
+	if c.grpcClient == nil {
+		c.log.Errorf("no gRPC client available, cannot send logs to session %s", request.SessionID)
+		return nil
+	}
+	c.log.Infof("sending logs for session %s", request.SessionID)
+	ctx = metadata.AppendToOutgoingContext(ctx, consts.GrpcSessionIDKey, request.SessionID)
+	ctx = metadata.AppendToOutgoingContext(ctx, consts.GrpcClientNameKey, c.deviceName)
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := c.grpcClient.Stream(ctx)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("error creating logs stream client: %w", err)
+	}
+	c.sessions[request.SessionID] = now
+
+	go func() {
+		defer cancel()
+		if err := c.send(ctx, cancel, stream, &request); err != nil {
+			c.log.Errorf("error sending logs for session %s: %v", request.SessionID, err)
+		}
+		c.log.Infof("logs session %s ended", request.SessionID)
+	}()
+	return nil
+}
+
+// send sends the requested logs through the stream until they end or the
+// service closes the session, which cancels the context.
+func (c *LogsController) send(ctx context.Context, cancel context.CancelFunc, stream grpc_v1.RouterService_StreamClient, request *v1alpha1.DeviceLogsRequest) error {
+	defer func() {
+		_ = stream.Send(&grpc_v1.StreamRequest{Closed: true})
+		_ = stream.CloseSend()
+	}()
+
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil || msg.GetClosed() {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	cmd, err := c.command(ctx, request)
+	if err != nil {
+		// report the error to the client reading the logs
+		return stream.Send(&grpc_v1.StreamRequest{Payload: []byte(fmt.Sprintf("error: %v\n", err))})
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("error getting stdout pipe: %w", err)
+	}
+	cmd.Stderr = cmd.Stdout
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("error starting logs process: %w", err)
+	}
+	defer func() {
+		if err := cmd.Wait(); err != nil && ctx.Err() == nil {
+			c.log.Warnf("logs process for session %s exited: %v", request.SessionID, err)
+		}
+	}()
+
+	buffer := make([]byte, 4096)
+	for {
+		n, readErr := stdout.Read(buffer)
+		if n > 0 {
+			if err := stream.Send(&grpc_v1.StreamRequest{Payload: buffer[:n]}); err != nil {
+				cancel()
+				return fmt.Errorf("error sending logs: %w", err)
+			}
+		}
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrClosedPipe) {
+			return nil
+		}
+		if readErr != nil {
+			return fmt.Errorf("error reading logs: %w", readErr)
+		}
+	}
+}
+
+// command returns the command that writes the requested logs to its output,
+// from podman for an application or else from the journal.
+func (c *LogsController) command(ctx context.Context, request *v1alpha1.DeviceLogsRequest) (*exec.Cmd, error) {
+	if request.App != nil {
+		labels := []string{fmt.Sprintf("com.docker.compose.project=%s", *request.App)}
+		containers, err := c.podman.ListContainers(ctx, labels)
+		if err != nil {
+			return nil, err
+		}
+		if len(containers) == 0 {
+			return nil, fmt.Errorf("no containers found for application %s", *request.App)
+		}
+		return c.podman.LogsCmd(ctx, containers, request.Since, request.Tail, lo.FromPtr(request.Follow)), nil
+	}
+
+	args := []string{"--no-pager", "--output", "short-iso"}
+	if request.Unit != nil {
+		args = append(args, "--unit", *request.Unit)
+	}
+	if request.Since != nil {
+		args = append(args, "--since", request.Since.UTC().Format(journalctlTimeFormat))
+	}
+	if request.Tail != nil {
+		args = append(args, "--lines", strconv.Itoa(*request.Tail))
+	}
+	if lo.FromPtr(request.Follow) {
+		args = append(args, "--follow")
+	}
+	return c.executor.CommandContext(ctx, journalctlCommand, args...), nil
+}
@@ -0,0 +1,146 @@
+package logs
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"testing"
+	"time"
+
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/console"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestController(t *testing.T) (*LogsController, *console.MockRouterServiceClient, *executer.MockExecuter) {
+	ctrl := gomock.NewController(t)
+	logger := log.NewPrefixLogger("TestLogsController")
+	grpcClient := console.NewMockRouterServiceClient(ctrl)
+	execMock := executer.NewMockExecuter(ctrl)
+	podman := client.NewPodman(logger, execMock)
+	return NewController(grpcClient, podman, "test-device", execMock, logger), grpcClient, execMock
+}
+
+func TestLogsCommand(t *testing.T) {
+	since := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		request   v1alpha1.DeviceLogsRequest
+		setupMock func(execMock *executer.MockExecuter)
+		wantArgs  []string
+		wantErr   string
+	}{
+		{
+			name:     "journal",
+			wantArgs: []string{"journalctl", "--no-pager", "--output", "short-iso"},
+		},
+		{
+			name: "journal of unit",
+			request: v1alpha1.DeviceLogsRequest{
+				Unit:   lo.ToPtr("crio.service"),
+				Since:  &since,
+				Tail:   lo.ToPtr(10),
+				Follow: lo.ToPtr(true),
+			},
+			wantArgs: []string{"journalctl", "--no-pager", "--output", "short-iso", "--unit", "crio.service", "--since", "2024-05-01 12:00:00 UTC", "--lines", "10", "--follow"},
+		},
+		{
+			name: "application",
+			request: v1alpha1.DeviceLogsRequest{
+				App:  lo.ToPtr("app"),
+				Tail: lo.ToPtr(10),
+			},
+			setupMock: func(execMock *executer.MockExecuter) {
+				execMock.EXPECT().ExecuteWithContext(gomock.Any(), "podman", "ps", "--all", "--format", "{{.Names}}", "--filter", "label=com.docker.compose.project=app").
+					Return("app-db-1\napp-web-1\n", "", 0)
+			},
+			wantArgs: []string{"podman", "logs", "--names", "--tail", "10", "app-db-1", "app-web-1"},
+		},
+		{
+			name:    "application without containers",
+			request: v1alpha1.DeviceLogsRequest{App: lo.ToPtr("app")},
+			setupMock: func(execMock *executer.MockExecuter) {
+				execMock.EXPECT().ExecuteWithContext(gomock.Any(), "podman", "ps", "--all", "--format", "{{.Names}}", "--filter", "label=com.docker.compose.project=app").
+					Return("", "", 0)
+			},
+			wantErr: "no containers found for application app",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			c, _, execMock := newTestController(t)
+			if tt.setupMock != nil {
+				tt.setupMock(execMock)
+			}
+			var gotArgs []string
+			if tt.wantErr == "" {
+				execMock.EXPECT().CommandContext(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, command string, args ...string) *exec.Cmd {
+						gotArgs = append([]string{command}, args...)
+						return exec.Command("true")
+					})
+			}
+
+			_, err := c.command(context.Background(), &tt.request)
+			if tt.wantErr != "" {
+				require.ErrorContains(err, tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.wantArgs, gotArgs)
+		})
+	}
+}
+
+func TestLogsSync(t *testing.T) {
+	require := require.New(t)
+	c, grpcClient, execMock := newTestController(t)
+	ctrl := gomock.NewController(t)
+	stream := console.NewMockRouterService_StreamClient(ctrl)
+
+	desired := &v1alpha1.RenderedDeviceSpec{
+		Logs: &v1alpha1.DeviceLogsRequest{SessionID: "session-1"},
+	}
+	sent := make(chan []byte, 10)
+	done := make(chan struct{})
+	grpcClient.EXPECT().Stream(gomock.Any()).Return(stream, nil)
+	execMock.EXPECT().CommandContext(gomock.Any(), "journalctl", gomock.Any()).Return(exec.Command("echo", "line 1"))
+	stream.EXPECT().Recv().DoAndReturn(func() (*grpc_v1.StreamResponse, error) {
+		<-done
+		return nil, io.EOF
+	}).AnyTimes()
+	stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(msg *grpc_v1.StreamRequest) error {
+		if !msg.Closed {
+			sent <- msg.Payload
+		}
+		return nil
+	}).Times(2)
+	stream.EXPECT().CloseSend().DoAndReturn(func() error {
+		close(done)
+		return nil
+	})
+
+	// sessions sent long ago are forgotten
+	c.sessions["session-0"] = time.Now().Add(-2 * sessionTTL)
+	require.NoError(c.Sync(context.Background(), desired))
+	require.NotContains(c.sessions, "session-0")
+	require.Contains(c.sessions, "session-1")
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		require.Fail("logs were not sent")
+	}
+	require.Equal("line 1\n", string(<-sent))
+
+	// the logs of a session are only sent once
+	require.NoError(c.Sync(context.Background(), desired))
+	// no logs are requested
+	require.NoError(c.Sync(context.Background(), &v1alpha1.RenderedDeviceSpec{}))
+}
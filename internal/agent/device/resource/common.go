@@ -3,6 +3,7 @@ package resource
 import (
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/flightctl/flightctl/api/v1alpha1"
@@ -132,3 +133,33 @@ func getMonitorSpec(monitor *v1alpha1.ResourceMonitor) (*MonitorSpec, error) {
 		return nil, fmt.Errorf("unknown monitor type: %s", monitorType)
 	}
 }
+
+// usageSamples holds the most recent usage samples collected by a monitor.
+type usageSamples struct {
+	mu      sync.Mutex
+	samples []v1alpha1.ResourceUsageSample
+}
+
+// add records a sample, dropping the oldest one once MaxUsageSamples are held.
+func (s *usageSamples) add(usedPercent int64, timestamp time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.samples = append(s.samples, v1alpha1.ResourceUsageSample{
+		Timestamp:   timestamp,
+		UsedPercent: usedPercent,
+	})
+	if len(s.samples) > MaxUsageSamples {
+		s.samples = s.samples[len(s.samples)-MaxUsageSamples:]
+	}
+}
+
+// list returns a copy of the samples, oldest first.
+func (s *usageSamples) list() []v1alpha1.ResourceUsageSample {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	samples := make([]v1alpha1.ResourceUsageSample, len(s.samples))
+	copy(samples, s.samples)
+	return samples
+}
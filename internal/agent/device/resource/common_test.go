@@ -304,3 +304,25 @@ func newMockCPUResourceMonitor(require *require.Assertions, samplingInterval tim
 	require.NoError(err)
 	return rm
 }
+
+func TestUsageSamples(t *testing.T) {
+	require := require.New(t)
+	var samples usageSamples
+	require.Empty(samples.list())
+
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < MaxUsageSamples+5; i++ {
+		samples.add(int64(i), start.Add(time.Duration(i)*time.Minute))
+	}
+
+	// only the most recent samples are kept, oldest first
+	list := samples.list()
+	require.Len(list, MaxUsageSamples)
+	require.Equal(int64(5), list[0].UsedPercent)
+	require.Equal(start.Add(5*time.Minute), list[0].Timestamp)
+	require.Equal(int64(MaxUsageSamples+4), list[len(list)-1].UsedPercent)
+
+	// the returned samples are a copy
+	list[0].UsedPercent = 100
+	require.Equal(int64(5), samples.list()[0].UsedPercent)
+}
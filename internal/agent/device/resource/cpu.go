@@ -29,6 +29,7 @@ type CPUMonitor struct {
 
 	updateIntervalCh chan time.Duration
 	samplingInterval time.Duration
+	samples          usageSamples
 
 	log *log.PrefixLogger
 }
@@ -84,6 +85,11 @@ func (m *CPUMonitor) Alerts() []v1alpha1.ResourceAlertRule {
 	return firing
 }
 
+// Samples returns the most recent usage samples, oldest first.
+func (m *CPUMonitor) Samples() []v1alpha1.ResourceUsageSample {
+	return m.samples.list()
+}
+
 func (m *CPUMonitor) CollectUsage(ctx context.Context, usage *CPUUsage) error {
 	select {
 	case <-ctx.Done():
@@ -109,11 +115,6 @@ func (m *CPUMonitor) CollectUsage(ctx context.Context, usage *CPUUsage) error {
 }
 
 func (m *CPUMonitor) sync(ctx context.Context, usage *CPUUsage) {
-	if !m.hasAlertRules() {
-		m.log.Debug("Skipping CPU usage sync: no alert rules")
-		return
-	}
-
 	ctx, cancel := context.WithTimeout(ctx, DefaultCPUSyncTimeout)
 	defer cancel()
 
@@ -122,6 +123,12 @@ func (m *CPUMonitor) sync(ctx context.Context, usage *CPUUsage) {
 		return
 	}
 
+	m.samples.add(usage.UsedPercent, usage.lastCollectedAt)
+
+	if !m.hasAlertRules() {
+		m.log.Debug("Skipping CPU alerts sync: no alert rules")
+		return
+	}
 	m.ensureAlerts(usage.UsedPercent)
 }
 
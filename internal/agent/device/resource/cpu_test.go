@@ -103,4 +103,10 @@ func TestCPUMonitor(t *testing.T) {
 		alerts := cpuMonitor.Alerts()
 		return len(alerts) == 0
 	}, retryTimeout, retryInterval, "alert remove")
+
+	// usage is still sampled without alert rules
+	samples := len(cpuMonitor.Samples())
+	require.Eventually(func() bool {
+		return len(cpuMonitor.Samples()) > samples
+	}, retryTimeout, retryInterval, "usage samples")
 }
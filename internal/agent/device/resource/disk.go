@@ -25,6 +25,7 @@ type DiskMonitor struct {
 
 	updateIntervalCh chan time.Duration
 	samplingInterval time.Duration
+	samples          usageSamples
 
 	log *log.PrefixLogger
 }
@@ -94,6 +95,11 @@ func (m *DiskMonitor) Alerts() []v1alpha1.ResourceAlertRule {
 	return firing
 }
 
+// Samples returns the most recent usage samples, oldest first.
+func (m *DiskMonitor) Samples() []v1alpha1.ResourceUsageSample {
+	return m.samples.list()
+}
+
 func (m *DiskMonitor) CollectUsage(ctx context.Context, usage *DiskUsage) error {
 	select {
 	case <-ctx.Done():
@@ -120,8 +126,8 @@ func (m *DiskMonitor) getPath() string {
 }
 
 func (m *DiskMonitor) sync(ctx context.Context, usage *DiskUsage) {
-	if !m.hasAlertRules() {
-		m.log.Debug("Skipping disk usage sync: no alert rules")
+	if m.getPath() == "" {
+		m.log.Debug("Skipping disk usage sync: no path")
 		return
 	}
 
@@ -133,6 +139,12 @@ func (m *DiskMonitor) sync(ctx context.Context, usage *DiskUsage) {
 		return
 	}
 
+	m.samples.add(usage.UsedPercent, usage.lastCollectedAt)
+
+	if !m.hasAlertRules() {
+		m.log.Debug("Skipping disk alerts sync: no alert rules")
+		return
+	}
 	m.ensureAlerts(usage.UsedPercent)
 }
 
@@ -26,6 +26,7 @@ type MemoryMonitor struct {
 
 	updateIntervalCh chan time.Duration
 	samplingInterval time.Duration
+	samples          usageSamples
 
 	log *log.PrefixLogger
 }
@@ -82,6 +83,11 @@ func (m *MemoryMonitor) Alerts() []v1alpha1.ResourceAlertRule {
 	return firing
 }
 
+// Samples returns the most recent usage samples, oldest first.
+func (m *MemoryMonitor) Samples() []v1alpha1.ResourceUsageSample {
+	return m.samples.list()
+}
+
 func (m *MemoryMonitor) CollectUsage(ctx context.Context, usage *MemoryUsage) error {
 	select {
 	case <-ctx.Done():
@@ -98,11 +104,6 @@ func (m *MemoryMonitor) CollectUsage(ctx context.Context, usage *MemoryUsage) er
 }
 
 func (m *MemoryMonitor) sync(ctx context.Context, usage *MemoryUsage) {
-	if !m.hasAlertRules() {
-		m.log.Debug("Skipping memory usage sync: no alert rules")
-		return
-	}
-
 	ctx, cancel := context.WithTimeout(ctx, DefaultMemorySyncTimeout)
 	defer cancel()
 
@@ -111,6 +112,12 @@ func (m *MemoryMonitor) sync(ctx context.Context, usage *MemoryUsage) {
 		return
 	}
 
+	m.samples.add(usage.UsedPercent, usage.lastCollectedAt)
+
+	if !m.hasAlertRules() {
+		m.log.Debug("Skipping memory alerts sync: no alert rules")
+		return
+	}
 	m.ensureAlerts(usage.UsedPercent)
 }
 
@@ -95,6 +95,20 @@ func (mr *MockManagerMockRecorder) Update(monitor any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockManager)(nil).Update), monitor)
 }
 
+// Usage mocks base method.
+func (m *MockManager) Usage() *v1alpha1.DeviceResourceUsage {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Usage")
+	ret0, _ := ret[0].(*v1alpha1.DeviceResourceUsage)
+	return ret0
+}
+
+// Usage indicates an expected call of Usage.
+func (mr *MockManagerMockRecorder) Usage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Usage", reflect.TypeOf((*MockManager)(nil).Usage))
+}
+
 // MockMonitor is a mock of Monitor interface.
 type MockMonitor[T any] struct {
 	ctrl     *gomock.Controller
@@ -158,6 +172,20 @@ func (mr *MockMonitorMockRecorder[T]) Run(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockMonitor[T])(nil).Run), ctx)
 }
 
+// Samples mocks base method.
+func (m *MockMonitor[T]) Samples() []v1alpha1.ResourceUsageSample {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Samples")
+	ret0, _ := ret[0].([]v1alpha1.ResourceUsageSample)
+	return ret0
+}
+
+// Samples indicates an expected call of Samples.
+func (mr *MockMonitorMockRecorder[T]) Samples() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Samples", reflect.TypeOf((*MockMonitor[T])(nil).Samples))
+}
+
 // Update mocks base method.
 func (m *MockMonitor[T]) Update(monitor *v1alpha1.ResourceMonitor) (bool, error) {
 	m.ctrl.T.Helper()
@@ -19,6 +19,9 @@ const (
 	MemoryMonitorType = "Memory"
 
 	DefaultSamplingInterval = 1 * time.Minute
+	// MaxUsageSamples is the number of most recent usage samples each monitor
+	// keeps for the device status.
+	MaxUsageSamples = 15
 )
 
 type Manager interface {
@@ -27,6 +30,8 @@ type Manager interface {
 	// ResetAlertDefaults clears all alerts and resets the monitors to their default state.
 	ResetAlertDefaults() error
 	Alerts() *Alerts
+	// Usage returns the most recent usage samples of all monitors.
+	Usage() *v1alpha1.DeviceResourceUsage
 }
 
 type Monitor[T any] interface {
@@ -34,6 +39,7 @@ type Monitor[T any] interface {
 	Update(monitor *v1alpha1.ResourceMonitor) (bool, error)
 	CollectUsage(ctx context.Context, usage *T) error
 	Alerts() []v1alpha1.ResourceAlertRule
+	Samples() []v1alpha1.ResourceUsageSample
 }
 
 type ResourceManager struct {
@@ -141,6 +147,14 @@ func (m *ResourceManager) Alerts() *Alerts {
 	}
 }
 
+func (m *ResourceManager) Usage() *v1alpha1.DeviceResourceUsage {
+	return &v1alpha1.DeviceResourceUsage{
+		Cpu:    m.cpuMonitor.Samples(),
+		Disk:   m.diskMonitor.Samples(),
+		Memory: m.memoryMonitor.Samples(),
+	}
+}
+
 type Alerts struct {
 	DiskUsage   []v1alpha1.ResourceAlertRule
 	CPUUsage    []v1alpha1.ResourceAlertRule
@@ -4,6 +4,7 @@ package status
 
 import (
 	"os"
+	"syscall"
 
 	"github.com/flightctl/flightctl/internal/agent/device/applications"
 	"github.com/flightctl/flightctl/internal/agent/device/hook"
@@ -35,3 +36,11 @@ func getBootID(bootIDPath string) (string, error) {
 	}
 	return string(bootID), nil
 }
+
+func getFilesystemUsage(path string) (int64, int64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, 0, err
+	}
+	return int64(stat.Blocks) * int64(stat.Bsize), int64(stat.Bavail) * int64(stat.Bsize), nil
+}
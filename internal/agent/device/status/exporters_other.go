@@ -4,6 +4,7 @@ package status
 
 import (
 	"context"
+	"errors"
 
 	"github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/agent/device/applications"
@@ -33,6 +34,10 @@ func getBootID(_ string) (string, error) {
 	return "", nil
 }
 
+func getFilesystemUsage(_ string) (int64, int64, error) {
+	return 0, 0, errors.New("filesystem usage is not supported on this platform")
+}
+
 func newUnsupportedExporter(log *log.PrefixLogger, name string) Exporter {
 	log.Warnf("Status exporter %q is not supported on this platform", name)
 	return &unsupportedExporter{}
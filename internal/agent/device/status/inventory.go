@@ -0,0 +1,268 @@
+package status
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/container"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/version"
+)
+
+const (
+	// inventoryInterval is the minimum interval between two collections of the
+	// device inventory.
+	inventoryInterval = 15 * time.Minute
+	rpmCommand        = "rpm"
+	rpmCommandTimeout = 2 * time.Minute
+	// sectorSize is the unit of the sizes of block devices in sysfs.
+	sectorSize = 512
+)
+
+// virtualBlockDevicePrefixes are the prefixes of the block devices that are not
+// disks of the device.
+var virtualBlockDevicePrefixes = []string{"loop", "ram", "zram", "dm-", "md", "nbd", "sr"}
+
+// inventory collects the hardware and software inventory of the device. Each
+// part of the inventory is collected on a best effort basis, so parts that are
+// not available on the device are omitted.
+type inventory struct {
+	exec executer.Executer
+	// rootDir is the root of the file system procfs and sysfs are read from.
+	rootDir string
+	// networkInterfaces lists the network interfaces of the device.
+	networkInterfaces func() ([]v1alpha1.DeviceNetworkInterfaceInfo, error)
+	// filesystemUsage returns the size and the available space of the
+	// filesystem mounted at the given path.
+	filesystemUsage func(path string) (int64, int64, error)
+}
+
+func newInventory(exec executer.Executer) *inventory {
+	return &inventory{
+		exec:              exec,
+		networkInterfaces: listNetworkInterfaces,
+		filesystemUsage:   getFilesystemUsage,
+	}
+}
+
+// collect sets the inventory in the given system info.
+func (i *inventory) collect(ctx context.Context, info *v1alpha1.DeviceSystemInfo) {
+	info.AgentVersion = util.StrToPtrWithNilDefault(version.Get().String())
+	info.Hostname = util.StrToPtrWithNilDefault(i.readTrimmed("/proc/sys/kernel/hostname"))
+	info.KernelVersion = util.StrToPtrWithNilDefault(i.readTrimmed("/proc/sys/kernel/osrelease"))
+	info.Cpu = i.cpu()
+	info.Memory = i.memory()
+	info.Product = i.product()
+	info.Disks = nonEmpty(i.disks())
+	info.Filesystems = nonEmpty(i.filesystems())
+	if interfaces, err := i.networkInterfaces(); err == nil {
+		info.NetworkInterfaces = nonEmpty(interfaces)
+	}
+	info.Packages = nonEmpty(i.packages(ctx))
+}
+
+func (i *inventory) readTrimmed(path string) string {
+	contents, err := os.ReadFile(filepath.Join(i.rootDir, path))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(contents))
+}
+
+func (i *inventory) cpu() *v1alpha1.DeviceCPUInfo {
+	contents, err := os.ReadFile(filepath.Join(i.rootDir, "/proc/cpuinfo"))
+	if err != nil {
+		return nil
+	}
+	cpu := &v1alpha1.DeviceCPUInfo{}
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			continue
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		switch key {
+		case "processor":
+			cpu.Count++
+		// x86 reports the model per processor, ARM once for the whole device
+		case "model name", "Model", "cpu model":
+			if cpu.Model == "" {
+				cpu.Model = value
+			}
+		}
+	}
+	if cpu.Count == 0 {
+		return nil
+	}
+	return cpu
+}
+
+func (i *inventory) memory() *v1alpha1.DeviceMemoryInfo {
+	contents, err := os.ReadFile(filepath.Join(i.rootDir, "/proc/meminfo"))
+	if err != nil {
+		return nil
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "MemTotal:" {
+			continue
+		}
+		kiB, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil
+		}
+		return &v1alpha1.DeviceMemoryInfo{TotalBytes: kiB * 1024}
+	}
+	return nil
+}
+
+func (i *inventory) product() *v1alpha1.DeviceProductInfo {
+	product := v1alpha1.DeviceProductInfo{
+		Vendor:       util.StrToPtrWithNilDefault(i.readTrimmed("/sys/class/dmi/id/sys_vendor")),
+		Name:         util.StrToPtrWithNilDefault(i.readTrimmed("/sys/class/dmi/id/product_name")),
+		SerialNumber: util.StrToPtrWithNilDefault(i.readTrimmed("/sys/class/dmi/id/product_serial")),
+		Uuid:         util.StrToPtrWithNilDefault(i.readTrimmed("/sys/class/dmi/id/product_uuid")),
+	}
+	if product == (v1alpha1.DeviceProductInfo{}) {
+		return nil
+	}
+	return &product
+}
+
+func (i *inventory) disks() []v1alpha1.DeviceDiskInfo {
+	entries, err := os.ReadDir(filepath.Join(i.rootDir, "/sys/block"))
+	if err != nil {
+		return nil
+	}
+	var disks []v1alpha1.DeviceDiskInfo
+	for _, entry := range entries {
+		name := entry.Name()
+		if slices.ContainsFunc(virtualBlockDevicePrefixes, func(prefix string) bool { return strings.HasPrefix(name, prefix) }) {
+			continue
+		}
+		sectors, err := strconv.ParseInt(i.readTrimmed(filepath.Join("/sys/block", name, "size")), 10, 64)
+		if err != nil {
+			continue
+		}
+		disks = append(disks, v1alpha1.DeviceDiskInfo{
+			Name:      name,
+			SizeBytes: sectors * sectorSize,
+			Model:     util.StrToPtrWithNilDefault(i.readTrimmed(filepath.Join("/sys/block", name, "device/model"))),
+		})
+	}
+	return disks
+}
+
+func (i *inventory) filesystems() []v1alpha1.DeviceFilesystemInfo {
+	contents, err := os.ReadFile(filepath.Join(i.rootDir, "/proc/mounts"))
+	if err != nil {
+		return nil
+	}
+	var filesystems []v1alpha1.DeviceFilesystemInfo
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(contents))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		// only filesystems on block devices, once per mount point
+		if len(fields) < 3 || !strings.HasPrefix(fields[0], "/dev/") || seen[fields[1]] {
+			continue
+		}
+		seen[fields[1]] = true
+		size, free, err := i.filesystemUsage(filepath.Join(i.rootDir, fields[1]))
+		if err != nil {
+			continue
+		}
+		filesystems = append(filesystems, v1alpha1.DeviceFilesystemInfo{
+			Device:     fields[0],
+			MountPoint: fields[1],
+			Type:       fields[2],
+			SizeBytes:  size,
+			FreeBytes:  free,
+		})
+	}
+	return filesystems
+}
+
+func (i *inventory) packages(ctx context.Context) []v1alpha1.DevicePackageInfo {
+	rpmPath, err := i.exec.LookPath(rpmCommand)
+	if err != nil {
+		return nil
+	}
+	execCtx, cancel := context.WithTimeout(ctx, rpmCommandTimeout)
+	defer cancel()
+	out, _, exitCode := i.exec.ExecuteWithContext(execCtx, rpmPath, "--query", "--all", "--queryformat", "%{NAME} %{VERSION}-%{RELEASE}\n")
+	if exitCode != 0 {
+		return nil
+	}
+	var packages []v1alpha1.DevicePackageInfo
+	for _, line := range strings.Split(out, "\n") {
+		name, packageVersion, found := strings.Cut(strings.TrimSpace(line), " ")
+		if !found {
+			continue
+		}
+		packages = append(packages, v1alpha1.DevicePackageInfo{Name: name, Version: packageVersion})
+	}
+	slices.SortFunc(packages, func(a, b v1alpha1.DevicePackageInfo) int {
+		return strings.Compare(a.Name+" "+a.Version, b.Name+" "+b.Version)
+	})
+	return packages
+}
+
+// deviceBootcInfo returns the bootc images of the given bootc status.
+func deviceBootcInfo(host *container.BootcHost) *v1alpha1.DeviceBootcInfo {
+	booted := host.Status.Booted.Image
+	return &v1alpha1.DeviceBootcInfo{
+		BootedImage:          host.GetBootedImage(),
+		BootedImageDigest:    util.StrToPtrWithNilDefault(booted.ImageDigest),
+		BootedImageVersion:   util.StrToPtrWithNilDefault(booted.Version),
+		BootedImageTimestamp: util.StrToPtrWithNilDefault(booted.Timestamp),
+		StagedImage:          util.StrToPtrWithNilDefault(host.GetStagedImage()),
+		RollbackImage:        util.StrToPtrWithNilDefault(host.GetRollbackImage()),
+	}
+}
+
+func listNetworkInterfaces() ([]v1alpha1.DeviceNetworkInterfaceInfo, error) {
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	var result []v1alpha1.DeviceNetworkInterfaceInfo
+	for _, iface := range interfaces {
+		if iface.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+		info := v1alpha1.DeviceNetworkInterfaceInfo{
+			Name:        iface.Name,
+			MacAddress:  util.StrToPtrWithNilDefault(iface.HardwareAddr.String()),
+			IpAddresses: []string{},
+		}
+		addrs, err := iface.Addrs()
+		if err == nil {
+			for _, addr := range addrs {
+				info.IpAddresses = append(info.IpAddresses, addr.String())
+			}
+		}
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+// nonEmpty returns a pointer to the given slice, or nil if it is empty.
+func nonEmpty[T any](items []T) *[]T {
+	if len(items) == 0 {
+		return nil
+	}
+	return &items
+}
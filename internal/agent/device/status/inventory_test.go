@@ -0,0 +1,140 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testCPUInfo = `processor	: 0
+vendor_id	: GenuineIntel
+model name	: Intel(R) Core(TM) i7-8650U CPU @ 1.90GHz
+
+processor	: 1
+vendor_id	: GenuineIntel
+model name	: Intel(R) Core(TM) i7-8650U CPU @ 1.90GHz
+`
+
+const testMounts = `sysfs /sys sysfs rw,nosuid,nodev,noexec,relatime 0 0
+/dev/vda4 / xfs rw,relatime 0 0
+/dev/vda4 / xfs rw,relatime 0 0
+/dev/vda3 /boot ext4 ro,relatime 0 0
+tmpfs /run tmpfs rw,nosuid,nodev 0 0
+`
+
+func writeTestFiles(t *testing.T, rootDir string, files map[string]string) {
+	for path, contents := range files {
+		path = filepath.Join(rootDir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	}
+}
+
+func TestInventoryCollect(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rootDir := t.TempDir()
+	writeTestFiles(t, rootDir, map[string]string{
+		"/proc/sys/kernel/hostname":       "edge-1\n",
+		"/proc/sys/kernel/osrelease":      "5.14.0-427.el9.x86_64\n",
+		"/proc/cpuinfo":                   testCPUInfo,
+		"/proc/meminfo":                   "MemTotal:        8048576 kB\nMemFree:         1024 kB\n",
+		"/proc/mounts":                    testMounts,
+		"/sys/class/dmi/id/sys_vendor":    "LENOVO\n",
+		"/sys/class/dmi/id/product_name":  "20L8S2N800\n",
+		"/sys/class/dmi/id/product_uuid":  "4c4c4544-0042-3510-8052-b4c04f4b5032\n",
+		"/sys/block/vda/size":             "41943040\n",
+		"/sys/block/nvme0n1/size":         "1000215216\n",
+		"/sys/block/nvme0n1/device/model": "Samsung SSD 970 EVO Plus 500GB\n",
+		"/sys/block/loop0/size":           "8\n",
+		"/sys/block/dm-0/size":            "8\n",
+	})
+
+	execMock := executer.NewMockExecuter(ctrl)
+	execMock.EXPECT().LookPath("rpm").Return("/usr/bin/rpm", nil)
+	execMock.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/bin/rpm", "--query", "--all", "--queryformat", "%{NAME} %{VERSION}-%{RELEASE}\n").
+		Return("podman 4.9.4-1.el9\nbash 5.1.8-9.el9\n", "", 0)
+
+	i := &inventory{
+		exec:    execMock,
+		rootDir: rootDir,
+		networkInterfaces: func() ([]v1alpha1.DeviceNetworkInterfaceInfo, error) {
+			return []v1alpha1.DeviceNetworkInterfaceInfo{
+				{Name: "eth0", MacAddress: lo.ToPtr("52:54:00:12:34:56"), IpAddresses: []string{"192.168.122.10/24"}},
+			}, nil
+		},
+		filesystemUsage: func(path string) (int64, int64, error) {
+			if path == filepath.Join(rootDir, "/boot") {
+				return 0, 0, errors.New("permission denied")
+			}
+			return 20 << 30, 5 << 30, nil
+		},
+	}
+
+	var info v1alpha1.DeviceSystemInfo
+	i.collect(context.Background(), &info)
+
+	require.NotNil(info.AgentVersion)
+	require.Equal("edge-1", *info.Hostname)
+	require.Equal("5.14.0-427.el9.x86_64", *info.KernelVersion)
+	require.Equal(&v1alpha1.DeviceCPUInfo{Model: "Intel(R) Core(TM) i7-8650U CPU @ 1.90GHz", Count: 2}, info.Cpu)
+	require.Equal(&v1alpha1.DeviceMemoryInfo{TotalBytes: 8048576 * 1024}, info.Memory)
+	// the serial number is not readable
+	require.Equal(&v1alpha1.DeviceProductInfo{
+		Vendor: lo.ToPtr("LENOVO"),
+		Name:   lo.ToPtr("20L8S2N800"),
+		Uuid:   lo.ToPtr("4c4c4544-0042-3510-8052-b4c04f4b5032"),
+	}, info.Product)
+	require.Equal(&[]v1alpha1.DeviceDiskInfo{
+		{Name: "nvme0n1", SizeBytes: 1000215216 * 512, Model: lo.ToPtr("Samsung SSD 970 EVO Plus 500GB")},
+		{Name: "vda", SizeBytes: 41943040 * 512},
+	}, info.Disks)
+	require.Equal(&[]v1alpha1.DeviceFilesystemInfo{
+		{Device: "/dev/vda4", MountPoint: "/", Type: "xfs", SizeBytes: 20 << 30, FreeBytes: 5 << 30},
+	}, info.Filesystems)
+	require.Len(*info.NetworkInterfaces, 1)
+	require.Equal(&[]v1alpha1.DevicePackageInfo{
+		{Name: "bash", Version: "5.1.8-9.el9"},
+		{Name: "podman", Version: "4.9.4-1.el9"},
+	}, info.Packages)
+}
+
+func TestInventoryCollectUnavailable(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	execMock := executer.NewMockExecuter(ctrl)
+	execMock.EXPECT().LookPath("rpm").Return("", errors.New("not found"))
+
+	i := &inventory{
+		exec:    execMock,
+		rootDir: t.TempDir(),
+		networkInterfaces: func() ([]v1alpha1.DeviceNetworkInterfaceInfo, error) {
+			return nil, errors.New("not supported")
+		},
+		filesystemUsage: getFilesystemUsage,
+	}
+
+	var info v1alpha1.DeviceSystemInfo
+	i.collect(context.Background(), &info)
+
+	require.Nil(info.Hostname)
+	require.Nil(info.Cpu)
+	require.Nil(info.Memory)
+	require.Nil(info.Product)
+	require.Nil(info.Disks)
+	require.Nil(info.Filesystems)
+	require.Nil(info.NetworkInterfaces)
+	require.Nil(info.Packages)
+}
@@ -48,6 +48,9 @@ func (r *Resources) Export(ctx context.Context, status *v1alpha1.DeviceStatus) e
 	}
 	status.Resources.Memory = memoryStatus
 
+	// the recent usage samples, for graphing the usage of the device
+	status.Resources.Usage = r.manager.Usage()
+
 	// the alertMsg is a message that gets bubbled up to the summary.info status field
 	// if an alert is present.  these messages are not errors specifically but
 	// for now the presence of an error sets the device status to degraded.
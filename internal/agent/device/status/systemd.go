@@ -4,9 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/executer"
 )
 
@@ -14,7 +18,10 @@ const (
 	systemdCommand        = "/usr/bin/systemctl"
 	systemdCommandTimeout = 2 * time.Minute
 	systemdUnitLoaded     = "loaded"
+	systemdUnitNotFound   = "not-found"
 	systemdUnitActive     = "active"
+	systemdUnitInactive   = "inactive"
+	systemdUnitDead       = "dead"
 )
 
 var _ Exporter = (*SystemD)(nil)
@@ -23,11 +30,15 @@ var _ Exporter = (*SystemD)(nil)
 type SystemD struct {
 	exec          executer.Executer
 	matchPatterns []string
+	// knownUnits are the units that matched the match patterns since they were
+	// set, so that units that disappear are reported as removed.
+	knownUnits map[string]struct{}
 }
 
 func newSystemD(exec executer.Executer) *SystemD {
 	return &SystemD{
-		exec: exec,
+		exec:       exec,
+		knownUnits: make(map[string]struct{}),
 	}
 }
 
@@ -42,6 +53,7 @@ type SystemDUnitListEntry struct {
 
 func (c *SystemD) Export(ctx context.Context, status *v1alpha1.DeviceStatus) error {
 	if c.matchPatterns == nil {
+		status.Systemd = nil
 		return nil
 	}
 
@@ -58,20 +70,125 @@ func (c *SystemD) Export(ctx context.Context, status *v1alpha1.DeviceStatus) err
 		return fmt.Errorf("failed unmarshalling systemctl list-units output: %w", err)
 	}
 
-	// TODO: handle removed units and use appropriate status
+	restarts, err := c.restarts(execCtx, units)
+	if err != nil {
+		return err
+	}
+
+	unitStatuses := make([]v1alpha1.DeviceSystemdUnitStatus, 0, len(units))
+	listed := make(map[string]struct{}, len(units))
 	for _, u := range units {
-		status.Applications = append(status.Applications, v1alpha1.DeviceApplicationStatus{
-			Name:   u.Unit,
-			Status: v1alpha1.ApplicationStatusUnknown,
+		listed[u.Unit] = struct{}{}
+		c.knownUnits[u.Unit] = struct{}{}
+		unitStatuses = append(unitStatuses, v1alpha1.DeviceSystemdUnitStatus{
+			Name:        u.Unit,
+			Description: util.StrToPtrWithNilDefault(u.Description),
+			LoadState:   u.LoadState,
+			ActiveState: u.ActiveState,
+			SubState:    u.Sub,
+			Restarts:    restarts[u.Unit],
+			Status:      systemdUnitStatus(u),
+		})
+	}
+
+	// units that matched a wildcard pattern are no longer listed once their
+	// unit files are removed
+	for name := range c.knownUnits {
+		if _, ok := listed[name]; ok {
+			continue
+		}
+		unitStatuses = append(unitStatuses, v1alpha1.DeviceSystemdUnitStatus{
+			Name:        name,
+			LoadState:   systemdUnitNotFound,
+			ActiveState: systemdUnitInactive,
+			SubState:    systemdUnitDead,
+			Status:      v1alpha1.SystemdUnitStatusRemoved,
 		})
 	}
 
+	slices.SortFunc(unitStatuses, func(a, b v1alpha1.DeviceSystemdUnitStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	status.Systemd = &unitStatuses
 	return nil
 }
 
+// restarts returns the number of automatic restarts of the given units that
+// are loaded.
+func (c *SystemD) restarts(ctx context.Context, units SystemDUnitList) (map[string]int, error) {
+	restarts := make(map[string]int)
+	var loaded []string
+	for _, u := range units {
+		if u.LoadState == systemdUnitLoaded {
+			loaded = append(loaded, u.Unit)
+		}
+	}
+	if len(loaded) == 0 {
+		return restarts, nil
+	}
+
+	args := append([]string{"show", "--property", "Id,NRestarts"}, loaded...)
+	out, errOut, exitCode := c.exec.ExecuteWithContext(ctx, systemdCommand, args...)
+	if exitCode != 0 {
+		return nil, fmt.Errorf("failed showing systemd units with code %d: %s", exitCode, errOut)
+	}
+
+	// the properties of each unit are separated by an empty line
+	for _, block := range strings.Split(out, "\n\n") {
+		var id string
+		var nRestarts int
+		for _, line := range strings.Split(block, "\n") {
+			key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+			if !found {
+				continue
+			}
+			switch key {
+			case "Id":
+				id = value
+			case "NRestarts":
+				// NRestarts is empty for units that are not services
+				nRestarts, _ = strconv.Atoi(value)
+			}
+		}
+		if id != "" {
+			restarts[id] = nRestarts
+		}
+	}
+	return restarts, nil
+}
+
+// systemdUnitStatus maps the load, active and sub state of a unit to its status.
+func systemdUnitStatus(u SystemDUnitListEntry) v1alpha1.SystemdUnitStatusType {
+	if u.LoadState == systemdUnitNotFound {
+		return v1alpha1.SystemdUnitStatusRemoved
+	}
+	switch u.ActiveState {
+	case systemdUnitActive:
+		if u.Sub == "running" {
+			return v1alpha1.SystemdUnitStatusRunning
+		}
+		// e.g. oneshot services that exited, mounts and listening sockets
+		return v1alpha1.SystemdUnitStatusActive
+	case "activating", "reloading":
+		return v1alpha1.SystemdUnitStatusActivating
+	case "deactivating":
+		return v1alpha1.SystemdUnitStatusDeactivating
+	case systemdUnitInactive:
+		return v1alpha1.SystemdUnitStatusInactive
+	case "failed":
+		return v1alpha1.SystemdUnitStatusFailed
+	default:
+		return v1alpha1.SystemdUnitStatusUnknown
+	}
+}
+
 func (c *SystemD) SetProperties(spec *v1alpha1.RenderedDeviceSpec) {
-	if spec.Systemd == nil || spec.Systemd.MatchPatterns == nil {
-		return
+	var matchPatterns []string
+	if spec.Systemd != nil && spec.Systemd.MatchPatterns != nil {
+		matchPatterns = *spec.Systemd.MatchPatterns
+	}
+	if !slices.Equal(matchPatterns, c.matchPatterns) {
+		c.knownUnits = make(map[string]struct{})
 	}
-	c.matchPatterns = *spec.Systemd.MatchPatterns
+	c.matchPatterns = matchPatterns
 }
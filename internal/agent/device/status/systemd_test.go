@@ -25,10 +25,44 @@ const systemdUnitListResult = `
     "active": "active",
     "sub": "running",
     "description": "MicroShift"
+  },
+  {
+    "unit": "greenboot-healthcheck.service",
+    "load": "loaded",
+    "active": "active",
+    "sub": "exited",
+    "description": "greenboot Health Checks Runner"
+  },
+  {
+    "unit": "app.service",
+    "load": "loaded",
+    "active": "failed",
+    "sub": "failed",
+    "description": "Application"
+  },
+  {
+    "unit": "removed.service",
+    "load": "not-found",
+    "active": "inactive",
+    "sub": "dead",
+    "description": "removed.service"
   }
 ]
 `
 
+const systemdShowResult = `Id=crio.service
+NRestarts=0
+
+Id=microshift.service
+NRestarts=2
+
+Id=greenboot-healthcheck.service
+NRestarts=0
+
+Id=app.service
+NRestarts=5
+`
+
 var _ = Describe("containers controller", func() {
 	var (
 		systemD      *SystemD
@@ -48,10 +82,57 @@ var _ = Describe("containers controller", func() {
 	Context("systemd controller", func() {
 		It("list systemd units", func() {
 			execMock.EXPECT().ExecuteWithContext(gomock.Any(), systemdCommand, "list-units", "--all", "--output", "json", "crio.service", "microshift.service").Return(systemdUnitListResult, "", 0)
+			execMock.EXPECT().ExecuteWithContext(gomock.Any(), systemdCommand, "show", "--property", "Id,NRestarts", "crio.service", "microshift.service", "greenboot-healthcheck.service", "app.service").Return(systemdShowResult, "", 0)
 			err := systemD.Export(context.TODO(), &deviceStatus)
 			Expect(err).ToNot(HaveOccurred())
 
-			Expect(len(deviceStatus.Applications)).To(Equal(2))
+			Expect(deviceStatus.Applications).To(BeEmpty())
+			Expect(deviceStatus.Systemd).ToNot(BeNil())
+			units := *deviceStatus.Systemd
+			Expect(units).To(HaveLen(5))
+			statuses := map[string]v1alpha1.SystemdUnitStatusType{}
+			restarts := map[string]int{}
+			for _, u := range units {
+				statuses[u.Name] = u.Status
+				restarts[u.Name] = u.Restarts
+			}
+			Expect(statuses).To(Equal(map[string]v1alpha1.SystemdUnitStatusType{
+				"crio.service":                  v1alpha1.SystemdUnitStatusRunning,
+				"microshift.service":            v1alpha1.SystemdUnitStatusRunning,
+				"greenboot-healthcheck.service": v1alpha1.SystemdUnitStatusActive,
+				"app.service":                   v1alpha1.SystemdUnitStatusFailed,
+				"removed.service":               v1alpha1.SystemdUnitStatusRemoved,
+			}))
+			Expect(restarts["microshift.service"]).To(Equal(2))
+			Expect(restarts["app.service"]).To(Equal(5))
+		})
+
+		It("reports units that disappear as removed", func() {
+			systemD.matchPatterns = []string{"app*.service"}
+			gomock.InOrder(
+				execMock.EXPECT().ExecuteWithContext(gomock.Any(), systemdCommand, "list-units", "--all", "--output", "json", "app*.service").
+					Return(`[{"unit": "app.service", "load": "loaded", "active": "activating", "sub": "start"}]`, "", 0),
+				execMock.EXPECT().ExecuteWithContext(gomock.Any(), systemdCommand, "show", "--property", "Id,NRestarts", "app.service").
+					Return("Id=app.service\nNRestarts=1\n", "", 0),
+				execMock.EXPECT().ExecuteWithContext(gomock.Any(), systemdCommand, "list-units", "--all", "--output", "json", "app*.service").
+					Return(`[]`, "", 0),
+			)
+
+			Expect(systemD.Export(context.TODO(), &deviceStatus)).To(Succeed())
+			Expect(*deviceStatus.Systemd).To(HaveLen(1))
+			Expect((*deviceStatus.Systemd)[0].Status).To(Equal(v1alpha1.SystemdUnitStatusActivating))
+
+			Expect(systemD.Export(context.TODO(), &deviceStatus)).To(Succeed())
+			Expect(*deviceStatus.Systemd).To(HaveLen(1))
+			Expect((*deviceStatus.Systemd)[0].Name).To(Equal("app.service"))
+			Expect((*deviceStatus.Systemd)[0].Status).To(Equal(v1alpha1.SystemdUnitStatusRemoved))
+		})
+
+		It("clears the status when the match patterns are removed", func() {
+			deviceStatus.Systemd = &[]v1alpha1.DeviceSystemdUnitStatus{{Name: "crio.service"}}
+			systemD.SetProperties(&v1alpha1.RenderedDeviceSpec{})
+			Expect(systemD.Export(context.TODO(), &deviceStatus)).To(Succeed())
+			Expect(deviceStatus.Systemd).To(BeNil())
 		})
 	})
 })
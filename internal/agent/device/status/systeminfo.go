@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"time"
 
 	"github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/container"
@@ -17,19 +18,23 @@ const (
 
 var _ Exporter = (*SystemInfo)(nil)
 
-// SystemInfo collects system information.
+// SystemInfo collects system information and the inventory of the device,
+// which is collected again once it is older than inventoryInterval.
 type SystemInfo struct {
 	bootcClient *container.BootcCmd
+	inventory   *inventory
+	collectedAt time.Time
 }
 
 func newSystemInfo(exec executer.Executer) *SystemInfo {
 	return &SystemInfo{
 		bootcClient: container.NewBootcCmd(exec),
+		inventory:   newInventory(exec),
 	}
 }
 
 func (s *SystemInfo) Export(ctx context.Context, status *v1alpha1.DeviceStatus) error {
-	if !status.SystemInfo.IsEmpty() {
+	if !status.SystemInfo.IsEmpty() && time.Since(s.collectedAt) < inventoryInterval {
 		return nil
 	}
 
@@ -38,11 +43,14 @@ func (s *SystemInfo) Export(ctx context.Context, status *v1alpha1.DeviceStatus)
 		return fmt.Errorf("getting boot ID: %w", err)
 	}
 
-	status.SystemInfo = v1alpha1.DeviceSystemInfo{
+	systemInfo := v1alpha1.DeviceSystemInfo{
 		Architecture:    runtime.GOARCH,
 		OperatingSystem: runtime.GOOS,
 		BootID:          bootID,
 	}
+	s.inventory.collect(ctx, &systemInfo)
+	status.SystemInfo = systemInfo
+	s.collectedAt = time.Now()
 
 	bootcInfo, err := s.bootcClient.Status(ctx)
 	if err != nil {
@@ -56,6 +64,7 @@ func (s *SystemInfo) Export(ctx context.Context, status *v1alpha1.DeviceStatus)
 
 	status.Os.Image = osImage
 	status.Os.ImageDigest = bootcInfo.GetBootedImageDigest()
+	status.SystemInfo.Bootc = deviceBootcInfo(bootcInfo)
 
 	return nil
 }
@@ -0,0 +1,120 @@
+// Package instrumentation exports the agent's metrics and traces to an
+// OpenTelemetry collector.
+package instrumentation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/instrumentation/otlp"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+const (
+	// DefaultMetricsInterval is the default interval between two exports of the metrics
+	DefaultMetricsInterval = util.Duration(60 * time.Second)
+	// serviceName identifies the agent's telemetry in the collector
+	serviceName = "flightctl-agent"
+	// instrumentationName is the name of the meter and tracer of the agent
+	instrumentationName = "github.com/flightctl/flightctl/internal/agent"
+)
+
+// Config is the configuration for exporting the agent's telemetry over
+// OTLP/HTTP.
+type Config struct {
+	// Endpoint is the base URL of the OTLP/HTTP receiver of the collector, e.g.
+	// "https://collector.example.com:4318". Telemetry is only exported if set.
+	Endpoint string `json:"endpoint,omitempty"`
+	// CertificateAuthority is the path to the CA bundle used to verify the
+	// collector's certificate, by default the system's CA bundle is used
+	CertificateAuthority string `json:"certificate-authority,omitempty"`
+	// Headers are sent with every export, e.g. to authenticate with the collector
+	Headers map[string]string `json:"headers,omitempty"`
+	// MetricsInterval is the interval between two exports of the metrics
+	MetricsInterval util.Duration `json:"metrics-interval,omitempty"`
+	// DisableMetrics stops the metrics from being exported
+	DisableMetrics bool `json:"disable-metrics,omitempty"`
+	// DisableTraces stops the traces from being exported
+	DisableTraces bool `json:"disable-traces,omitempty"`
+}
+
+// Setup installs the providers exporting the agent's metrics and traces to the
+// configured collector, and the propagator passing the trace context on to the
+// service. The returned function flushes the remaining telemetry and stops the
+// export.
+func Setup(cfg *Config, deviceName string, reader fileio.Reader, log *log.PrefixLogger) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	if cfg.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var caBundle []byte
+	if cfg.CertificateAuthority != "" {
+		var err error
+		if caBundle, err = reader.ReadFile(cfg.CertificateAuthority); err != nil {
+			return nil, fmt.Errorf("reading telemetry CA bundle: %w", err)
+		}
+	}
+	exporterConfig := otlp.Config{Endpoint: cfg.Endpoint, CABundle: caBundle, Headers: cfg.Headers}
+
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceInstanceID(deviceName),
+		semconv.ServiceVersion(version.Get().String()),
+	)
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		log.Warnf("Telemetry: %v", err)
+	}))
+
+	var shutdowns []func(context.Context) error
+	if !cfg.DisableTraces {
+		exporter, err := otlp.NewTraceExporter(context.Background(), exporterConfig)
+		if err != nil {
+			return nil, fmt.Errorf("creating trace exporter: %w", err)
+		}
+		tracerProvider := sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter),
+			sdktrace.WithResource(res),
+		)
+		otel.SetTracerProvider(tracerProvider)
+		shutdowns = append(shutdowns, tracerProvider.Shutdown)
+	}
+	if !cfg.DisableMetrics {
+		interval := cfg.MetricsInterval
+		if interval == 0 {
+			interval = DefaultMetricsInterval
+		}
+		exporter, err := otlp.NewMetricExporter(context.Background(), exporterConfig)
+		if err != nil {
+			return nil, fmt.Errorf("creating metric exporter: %w", err)
+		}
+		meterProvider := sdkmetric.NewMeterProvider(
+			sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter, sdkmetric.WithInterval(time.Duration(interval)))),
+			sdkmetric.WithResource(res),
+		)
+		otel.SetMeterProvider(meterProvider)
+		shutdowns = append(shutdowns, meterProvider.Shutdown)
+	}
+	log.Infof("Exporting telemetry to %s", cfg.Endpoint)
+
+	return func(ctx context.Context) error {
+		var errs []error
+		for _, shutdown := range shutdowns {
+			if err := shutdown(ctx); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}, nil
+}
@@ -0,0 +1,85 @@
+package instrumentation
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	resultOk    = "ok"
+	resultError = "error"
+)
+
+// The instruments are created from the global meter provider, so they record
+// nothing until Setup installs a provider exporting them.
+var (
+	meter = otel.Meter(instrumentationName)
+
+	syncDuration, _ = meter.Float64Histogram(
+		"flightctl.agent.sync.duration",
+		metric.WithDescription("Duration of syncing the device to its desired spec"),
+		metric.WithUnit("s"),
+	)
+	specFetchErrors, _ = meter.Int64Counter(
+		"flightctl.agent.spec_fetch.errors",
+		metric.WithDescription("Number of failures fetching the desired spec of the device"),
+	)
+	hookDuration, _ = meter.Float64Histogram(
+		"flightctl.agent.hook.duration",
+		metric.WithDescription("Duration of executing the actions of device lifecycle hooks"),
+		metric.WithUnit("s"),
+	)
+	applicationRestarts, _ = meter.Int64Counter(
+		"flightctl.agent.application.restarts",
+		metric.WithDescription("Number of restarts of the containers of applications"),
+	)
+	enrollmentDuration, _ = meter.Float64Histogram(
+		"flightctl.agent.enrollment.duration",
+		metric.WithDescription("Duration of the calls to the enrollment service"),
+		metric.WithUnit("s"),
+	)
+)
+
+// RecordSync records the duration of a sync of the device spec.
+func RecordSync(ctx context.Context, duration time.Duration, err error) {
+	syncDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(resultAttribute(err)))
+}
+
+// RecordSpecFetchError records a failure fetching the desired spec.
+func RecordSpecFetchError(ctx context.Context) {
+	specFetchErrors.Add(ctx, 1)
+}
+
+// RecordHook records the duration of executing a hook action on the path.
+func RecordHook(ctx context.Context, path string, duration time.Duration, err error) {
+	hookDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(
+		attribute.String("path", path),
+		resultAttribute(err),
+	))
+}
+
+// RecordApplicationRestarts records restarts of the containers of the
+// application.
+func RecordApplicationRestarts(ctx context.Context, application string, restarts int) {
+	applicationRestarts.Add(ctx, int64(restarts), metric.WithAttributes(attribute.String("application", application)))
+}
+
+// RecordEnrollment records the duration of a call to the enrollment service,
+// in the form of the enrollment client's metrics callback.
+func RecordEnrollment(operation string, durationSeconds float64, err error) {
+	enrollmentDuration.Record(context.Background(), durationSeconds, metric.WithAttributes(
+		attribute.String("operation", operation),
+		resultAttribute(err),
+	))
+}
+
+func resultAttribute(err error) attribute.KeyValue {
+	if err != nil {
+		return attribute.String("result", resultError)
+	}
+	return attribute.String("result", resultOk)
+}
@@ -0,0 +1,57 @@
+package instrumentation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTransport(t *testing.T) {
+	require := require.New(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() { _ = tracerProvider.Shutdown(context.Background()) }()
+
+	traceparent := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent <- r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	httpClient := &http.Client{Transport: NewTransport(nil)}
+
+	ctx, span := StartSpan(context.Background(), "sync device spec")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/devices/foo/rendered", nil)
+	require.NoError(err)
+	resp, err := httpClient.Do(req)
+	require.NoError(err)
+	resp.Body.Close()
+	EndSpan(span, errors.New("sync failed"))
+
+	// the request is untouched, while the service receives the trace context
+	require.Empty(req.Header.Get("traceparent"))
+	header := <-traceparent
+	require.Contains(header, span.SpanContext().TraceID().String())
+
+	spans := recorder.Ended()
+	require.Len(spans, 2)
+	clientSpan := spans[0]
+	require.Equal("HTTP GET", clientSpan.Name())
+	require.Equal(span.SpanContext().SpanID(), clientSpan.Parent().SpanID())
+	require.Contains(header, clientSpan.SpanContext().SpanID().String())
+	require.Equal(codes.Error, clientSpan.Status().Code)
+	require.Equal("sync device spec", spans[1].Name())
+	require.Equal(codes.Error, spans[1].Status().Code)
+}
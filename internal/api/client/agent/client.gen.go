@@ -91,6 +91,9 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// UploadDeviceBundleWithBody request with any body
+	UploadDeviceBundleWithBody(ctx context.Context, name string, requestID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetRenderedDeviceSpec request
 	GetRenderedDeviceSpec(ctx context.Context, name string, params *GetRenderedDeviceSpecParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -106,6 +109,23 @@ type ClientInterface interface {
 
 	// ReadEnrollmentRequest request
 	ReadEnrollmentRequest(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AnswerEnrollmentRequestTPMChallengeWithBody request with any body
+	AnswerEnrollmentRequestTPMChallengeWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AnswerEnrollmentRequestTPMChallenge(ctx context.Context, name string, body AnswerEnrollmentRequestTPMChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) UploadDeviceBundleWithBody(ctx context.Context, name string, requestID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadDeviceBundleRequestWithBody(c.Server, name, requestID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) GetRenderedDeviceSpec(ctx context.Context, name string, params *GetRenderedDeviceSpecParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -180,6 +200,73 @@ func (c *Client) ReadEnrollmentRequest(ctx context.Context, name string, reqEdit
 	return c.Client.Do(req)
 }
 
+func (c *Client) AnswerEnrollmentRequestTPMChallengeWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnswerEnrollmentRequestTPMChallengeRequestWithBody(c.Server, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AnswerEnrollmentRequestTPMChallenge(ctx context.Context, name string, body AnswerEnrollmentRequestTPMChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnswerEnrollmentRequestTPMChallengeRequest(c.Server, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewUploadDeviceBundleRequestWithBody generates requests for UploadDeviceBundle with any type of body
+func NewUploadDeviceBundleRequestWithBody(server string, name string, requestID string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "requestID", runtime.ParamLocationPath, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/devices/%s/bundle/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewGetRenderedDeviceSpecRequest generates requests for GetRenderedDeviceSpec
 func NewGetRenderedDeviceSpecRequest(server string, name string, params *GetRenderedDeviceSpecParams) (*http.Request, error) {
 	var err error
@@ -357,6 +444,53 @@ func NewReadEnrollmentRequestRequest(server string, name string) (*http.Request,
 	return req, nil
 }
 
+// NewAnswerEnrollmentRequestTPMChallengeRequest calls the generic AnswerEnrollmentRequestTPMChallenge builder with application/json body
+func NewAnswerEnrollmentRequestTPMChallengeRequest(server string, name string, body AnswerEnrollmentRequestTPMChallengeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAnswerEnrollmentRequestTPMChallengeRequestWithBody(server, name, "application/json", bodyReader)
+}
+
+// NewAnswerEnrollmentRequestTPMChallengeRequestWithBody generates requests for AnswerEnrollmentRequestTPMChallenge with any type of body
+func NewAnswerEnrollmentRequestTPMChallengeRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/enrollmentrequests/%s/tpmchallenge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -400,6 +534,9 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// UploadDeviceBundleWithBodyWithResponse request with any body
+	UploadDeviceBundleWithBodyWithResponse(ctx context.Context, name string, requestID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadDeviceBundleResponse, error)
+
 	// GetRenderedDeviceSpecWithResponse request
 	GetRenderedDeviceSpecWithResponse(ctx context.Context, name string, params *GetRenderedDeviceSpecParams, reqEditors ...RequestEditorFn) (*GetRenderedDeviceSpecResponse, error)
 
@@ -415,6 +552,35 @@ type ClientWithResponsesInterface interface {
 
 	// ReadEnrollmentRequestWithResponse request
 	ReadEnrollmentRequestWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*ReadEnrollmentRequestResponse, error)
+
+	// AnswerEnrollmentRequestTPMChallengeWithBodyWithResponse request with any body
+	AnswerEnrollmentRequestTPMChallengeWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnswerEnrollmentRequestTPMChallengeResponse, error)
+
+	AnswerEnrollmentRequestTPMChallengeWithResponse(ctx context.Context, name string, body AnswerEnrollmentRequestTPMChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*AnswerEnrollmentRequestTPMChallengeResponse, error)
+}
+
+type UploadDeviceBundleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *externalRef0.Error
+	JSON404      *externalRef0.Error
+	JSON413      *externalRef0.Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadDeviceBundleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadDeviceBundleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
 type GetRenderedDeviceSpecResponse struct {
@@ -517,6 +683,40 @@ func (r ReadEnrollmentRequestResponse) StatusCode() int {
 	return 0
 }
 
+type AnswerEnrollmentRequestTPMChallengeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *externalRef0.EnrollmentRequest
+	JSON400      *externalRef0.Error
+	JSON401      *externalRef0.Error
+	JSON404      *externalRef0.Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AnswerEnrollmentRequestTPMChallengeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AnswerEnrollmentRequestTPMChallengeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// UploadDeviceBundleWithBodyWithResponse request with arbitrary body returning *UploadDeviceBundleResponse
+func (c *ClientWithResponses) UploadDeviceBundleWithBodyWithResponse(ctx context.Context, name string, requestID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadDeviceBundleResponse, error) {
+	rsp, err := c.UploadDeviceBundleWithBody(ctx, name, requestID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadDeviceBundleResponse(rsp)
+}
+
 // GetRenderedDeviceSpecWithResponse request returning *GetRenderedDeviceSpecResponse
 func (c *ClientWithResponses) GetRenderedDeviceSpecWithResponse(ctx context.Context, name string, params *GetRenderedDeviceSpecParams, reqEditors ...RequestEditorFn) (*GetRenderedDeviceSpecResponse, error) {
 	rsp, err := c.GetRenderedDeviceSpec(ctx, name, params, reqEditors...)
@@ -569,6 +769,63 @@ func (c *ClientWithResponses) ReadEnrollmentRequestWithResponse(ctx context.Cont
 	return ParseReadEnrollmentRequestResponse(rsp)
 }
 
+// AnswerEnrollmentRequestTPMChallengeWithBodyWithResponse request with arbitrary body returning *AnswerEnrollmentRequestTPMChallengeResponse
+func (c *ClientWithResponses) AnswerEnrollmentRequestTPMChallengeWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnswerEnrollmentRequestTPMChallengeResponse, error) {
+	rsp, err := c.AnswerEnrollmentRequestTPMChallengeWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnswerEnrollmentRequestTPMChallengeResponse(rsp)
+}
+
+func (c *ClientWithResponses) AnswerEnrollmentRequestTPMChallengeWithResponse(ctx context.Context, name string, body AnswerEnrollmentRequestTPMChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*AnswerEnrollmentRequestTPMChallengeResponse, error) {
+	rsp, err := c.AnswerEnrollmentRequestTPMChallenge(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnswerEnrollmentRequestTPMChallengeResponse(rsp)
+}
+
+// ParseUploadDeviceBundleResponse parses an HTTP response from a UploadDeviceBundleWithResponse call
+func ParseUploadDeviceBundleResponse(rsp *http.Response) (*UploadDeviceBundleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadDeviceBundleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest externalRef0.Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetRenderedDeviceSpecResponse parses an HTTP response from a GetRenderedDeviceSpecWithResponse call
 func ParseGetRenderedDeviceSpecResponse(rsp *http.Response) (*GetRenderedDeviceSpecResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -756,3 +1013,50 @@ func ParseReadEnrollmentRequestResponse(rsp *http.Response) (*ReadEnrollmentRequ
 
 	return response, nil
 }
+
+// ParseAnswerEnrollmentRequestTPMChallengeResponse parses an HTTP response from a AnswerEnrollmentRequestTPMChallengeWithResponse call
+func ParseAnswerEnrollmentRequestTPMChallengeResponse(rsp *http.Response) (*AnswerEnrollmentRequestTPMChallengeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AnswerEnrollmentRequestTPMChallengeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest externalRef0.EnrollmentRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
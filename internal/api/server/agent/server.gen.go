@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	. "github.com/flightctl/flightctl/api/v1alpha1"
@@ -19,6 +20,9 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (PUT /api/v1/devices/{name}/bundle/{requestID})
+	UploadDeviceBundle(w http.ResponseWriter, r *http.Request, name string, requestID string)
+
 	// (GET /api/v1/devices/{name}/rendered)
 	GetRenderedDeviceSpec(w http.ResponseWriter, r *http.Request, name string, params GetRenderedDeviceSpecParams)
 
@@ -30,12 +34,20 @@ type ServerInterface interface {
 
 	// (GET /api/v1/enrollmentrequests/{name})
 	ReadEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string)
+
+	// (POST /api/v1/enrollmentrequests/{name}/tpmchallenge)
+	AnswerEnrollmentRequestTPMChallenge(w http.ResponseWriter, r *http.Request, name string)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// (PUT /api/v1/devices/{name}/bundle/{requestID})
+func (_ Unimplemented) UploadDeviceBundle(w http.ResponseWriter, r *http.Request, name string, requestID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/devices/{name}/rendered)
 func (_ Unimplemented) GetRenderedDeviceSpec(w http.ResponseWriter, r *http.Request, name string, params GetRenderedDeviceSpecParams) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -56,6 +68,11 @@ func (_ Unimplemented) ReadEnrollmentRequest(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (POST /api/v1/enrollmentrequests/{name}/tpmchallenge)
+func (_ Unimplemented) AnswerEnrollmentRequestTPMChallenge(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -65,6 +82,41 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// UploadDeviceBundle operation middleware
+func (siw *ServerInterfaceWrapper) UploadDeviceBundle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "requestID" -------------
+	var requestID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "requestID", chi.URLParam(r, "requestID"), &requestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requestID", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadDeviceBundle(w, r, name, requestID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetRenderedDeviceSpec operation middleware
 func (siw *ServerInterfaceWrapper) GetRenderedDeviceSpec(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -169,6 +221,32 @@ func (siw *ServerInterfaceWrapper) ReadEnrollmentRequest(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// AnswerEnrollmentRequestTPMChallenge operation middleware
+func (siw *ServerInterfaceWrapper) AnswerEnrollmentRequestTPMChallenge(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AnswerEnrollmentRequestTPMChallenge(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -282,6 +360,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/devices/{name}/bundle/{requestID}", wrapper.UploadDeviceBundle)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/devices/{name}/rendered", wrapper.GetRenderedDeviceSpec)
 	})
@@ -294,10 +375,58 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/enrollmentrequests/{name}", wrapper.ReadEnrollmentRequest)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/enrollmentrequests/{name}/tpmchallenge", wrapper.AnswerEnrollmentRequestTPMChallenge)
+	})
 
 	return r
 }
 
+type UploadDeviceBundleRequestObject struct {
+	Name      string `json:"name"`
+	RequestID string `json:"requestID"`
+	Body      io.Reader
+}
+
+type UploadDeviceBundleResponseObject interface {
+	VisitUploadDeviceBundleResponse(w http.ResponseWriter) error
+}
+
+type UploadDeviceBundle204Response struct {
+}
+
+func (response UploadDeviceBundle204Response) VisitUploadDeviceBundleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UploadDeviceBundle401JSONResponse externalRef0.Error
+
+func (response UploadDeviceBundle401JSONResponse) VisitUploadDeviceBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDeviceBundle404JSONResponse externalRef0.Error
+
+func (response UploadDeviceBundle404JSONResponse) VisitUploadDeviceBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDeviceBundle413JSONResponse externalRef0.Error
+
+func (response UploadDeviceBundle413JSONResponse) VisitUploadDeviceBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetRenderedDeviceSpecRequestObject struct {
 	Name   string `json:"name"`
 	Params GetRenderedDeviceSpecParams
@@ -484,9 +613,57 @@ func (response ReadEnrollmentRequest404JSONResponse) VisitReadEnrollmentRequestR
 	return json.NewEncoder(w).Encode(response)
 }
 
+type AnswerEnrollmentRequestTPMChallengeRequestObject struct {
+	Name string `json:"name"`
+	Body *AnswerEnrollmentRequestTPMChallengeJSONRequestBody
+}
+
+type AnswerEnrollmentRequestTPMChallengeResponseObject interface {
+	VisitAnswerEnrollmentRequestTPMChallengeResponse(w http.ResponseWriter) error
+}
+
+type AnswerEnrollmentRequestTPMChallenge200JSONResponse externalRef0.EnrollmentRequest
+
+func (response AnswerEnrollmentRequestTPMChallenge200JSONResponse) VisitAnswerEnrollmentRequestTPMChallengeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnswerEnrollmentRequestTPMChallenge400JSONResponse externalRef0.Error
+
+func (response AnswerEnrollmentRequestTPMChallenge400JSONResponse) VisitAnswerEnrollmentRequestTPMChallengeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnswerEnrollmentRequestTPMChallenge401JSONResponse externalRef0.Error
+
+func (response AnswerEnrollmentRequestTPMChallenge401JSONResponse) VisitAnswerEnrollmentRequestTPMChallengeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnswerEnrollmentRequestTPMChallenge404JSONResponse externalRef0.Error
+
+func (response AnswerEnrollmentRequestTPMChallenge404JSONResponse) VisitAnswerEnrollmentRequestTPMChallengeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 
+	// (PUT /api/v1/devices/{name}/bundle/{requestID})
+	UploadDeviceBundle(ctx context.Context, request UploadDeviceBundleRequestObject) (UploadDeviceBundleResponseObject, error)
+
 	// (GET /api/v1/devices/{name}/rendered)
 	GetRenderedDeviceSpec(ctx context.Context, request GetRenderedDeviceSpecRequestObject) (GetRenderedDeviceSpecResponseObject, error)
 
@@ -498,6 +675,9 @@ type StrictServerInterface interface {
 
 	// (GET /api/v1/enrollmentrequests/{name})
 	ReadEnrollmentRequest(ctx context.Context, request ReadEnrollmentRequestRequestObject) (ReadEnrollmentRequestResponseObject, error)
+
+	// (POST /api/v1/enrollmentrequests/{name}/tpmchallenge)
+	AnswerEnrollmentRequestTPMChallenge(ctx context.Context, request AnswerEnrollmentRequestTPMChallengeRequestObject) (AnswerEnrollmentRequestTPMChallengeResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -529,6 +709,35 @@ type strictHandler struct {
 	options     StrictHTTPServerOptions
 }
 
+// UploadDeviceBundle operation middleware
+func (sh *strictHandler) UploadDeviceBundle(w http.ResponseWriter, r *http.Request, name string, requestID string) {
+	var request UploadDeviceBundleRequestObject
+
+	request.Name = name
+	request.RequestID = requestID
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadDeviceBundle(ctx, request.(UploadDeviceBundleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadDeviceBundle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadDeviceBundleResponseObject); ok {
+		if err := validResponse.VisitUploadDeviceBundleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetRenderedDeviceSpec operation middleware
 func (sh *strictHandler) GetRenderedDeviceSpec(w http.ResponseWriter, r *http.Request, name string, params GetRenderedDeviceSpecParams) {
 	var request GetRenderedDeviceSpecRequestObject
@@ -645,3 +854,36 @@ func (sh *strictHandler) ReadEnrollmentRequest(w http.ResponseWriter, r *http.Re
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// AnswerEnrollmentRequestTPMChallenge operation middleware
+func (sh *strictHandler) AnswerEnrollmentRequestTPMChallenge(w http.ResponseWriter, r *http.Request, name string) {
+	var request AnswerEnrollmentRequestTPMChallengeRequestObject
+
+	request.Name = name
+
+	var body AnswerEnrollmentRequestTPMChallengeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AnswerEnrollmentRequestTPMChallenge(ctx, request.(AnswerEnrollmentRequestTPMChallengeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AnswerEnrollmentRequestTPMChallenge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AnswerEnrollmentRequestTPMChallengeResponseObject); ok {
+		if err := validResponse.VisitAnswerEnrollmentRequestTPMChallengeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	. "github.com/flightctl/flightctl/api/v1alpha1"
@@ -18,6 +19,9 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /api/v1/auditrecords)
+	ListAuditRecords(w http.ResponseWriter, r *http.Request, params ListAuditRecordsParams)
+
 	// (GET /api/v1/auth/config)
 	AuthConfig(w http.ResponseWriter, r *http.Request)
 
@@ -72,9 +76,24 @@ type ServerInterface interface {
 	// (PUT /api/v1/devices/{name})
 	ReplaceDevice(w http.ResponseWriter, r *http.Request, name string)
 
+	// (GET /api/v1/devices/{name}/bundle)
+	GetDeviceBundle(w http.ResponseWriter, r *http.Request, name string)
+
+	// (POST /api/v1/devices/{name}/bundle)
+	RequestDeviceBundle(w http.ResponseWriter, r *http.Request, name string)
+
 	// (GET /api/v1/devices/{name}/console)
 	RequestConsole(w http.ResponseWriter, r *http.Request, name string)
 
+	// (GET /api/v1/devices/{name}/logs)
+	GetDeviceLogs(w http.ResponseWriter, r *http.Request, name string, params GetDeviceLogsParams)
+
+	// (GET /api/v1/devices/{name}/overrides)
+	ReadDeviceOverrides(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PUT /api/v1/devices/{name}/overrides)
+	ReplaceDeviceOverrides(w http.ResponseWriter, r *http.Request, name string)
+
 	// (GET /api/v1/devices/{name}/rendered)
 	GetRenderedDeviceSpec(w http.ResponseWriter, r *http.Request, name string, params GetRenderedDeviceSpecParams)
 
@@ -87,6 +106,27 @@ type ServerInterface interface {
 	// (GET /api/v1/enrollmentconfig/{name})
 	EnrollmentConfig(w http.ResponseWriter, r *http.Request, name string)
 
+	// (DELETE /api/v1/enrollmentpolicies)
+	DeleteEnrollmentPolicies(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/enrollmentpolicies)
+	ListEnrollmentPolicies(w http.ResponseWriter, r *http.Request, params ListEnrollmentPoliciesParams)
+
+	// (POST /api/v1/enrollmentpolicies)
+	CreateEnrollmentPolicy(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/enrollmentpolicies/{name})
+	DeleteEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /api/v1/enrollmentpolicies/{name})
+	ReadEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PATCH /api/v1/enrollmentpolicies/{name})
+	PatchEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PUT /api/v1/enrollmentpolicies/{name})
+	ReplaceEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string)
+
 	// (DELETE /api/v1/enrollmentrequests)
 	DeleteEnrollmentRequests(w http.ResponseWriter, r *http.Request)
 
@@ -114,6 +154,12 @@ type ServerInterface interface {
 	// (PUT /api/v1/enrollmentrequests/{name}/status)
 	ReplaceEnrollmentRequestStatus(w http.ResponseWriter, r *http.Request, name string)
 
+	// (POST /api/v1/enrollmentrequests/{name}/tpmchallenge)
+	AnswerEnrollmentRequestTPMChallenge(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /api/v1/events)
+	ListEvents(w http.ResponseWriter, r *http.Request, params ListEventsParams)
+
 	// (DELETE /api/v1/fleets)
 	DeleteFleets(w http.ResponseWriter, r *http.Request)
 
@@ -147,6 +193,9 @@ type ServerInterface interface {
 	// (PUT /api/v1/fleets/{name})
 	ReplaceFleet(w http.ResponseWriter, r *http.Request, name string)
 
+	// (POST /api/v1/fleets/{name}/ownershipdryrun)
+	DryRunFleetOwnership(w http.ResponseWriter, r *http.Request, name string)
+
 	// (GET /api/v1/fleets/{name}/status)
 	ReadFleetStatus(w http.ResponseWriter, r *http.Request, name string)
 
@@ -194,12 +243,41 @@ type ServerInterface interface {
 
 	// (PUT /api/v1/resourcesyncs/{name})
 	ReplaceResourceSync(w http.ResponseWriter, r *http.Request, name string)
+
+	// (DELETE /api/v1/serviceaccounts)
+	DeleteServiceAccounts(w http.ResponseWriter, r *http.Request)
+
+	// (GET /api/v1/serviceaccounts)
+	ListServiceAccounts(w http.ResponseWriter, r *http.Request, params ListServiceAccountsParams)
+
+	// (POST /api/v1/serviceaccounts)
+	CreateServiceAccount(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /api/v1/serviceaccounts/{name})
+	DeleteServiceAccount(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /api/v1/serviceaccounts/{name})
+	ReadServiceAccount(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PUT /api/v1/serviceaccounts/{name})
+	ReplaceServiceAccount(w http.ResponseWriter, r *http.Request, name string)
+
+	// (POST /api/v1/serviceaccounts/{name}/tokens)
+	CreateServiceAccountToken(w http.ResponseWriter, r *http.Request, name string)
+
+	// (DELETE /api/v1/serviceaccounts/{name}/tokens/{token})
+	DeleteServiceAccountToken(w http.ResponseWriter, r *http.Request, name string, token string)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// (GET /api/v1/auditrecords)
+func (_ Unimplemented) ListAuditRecords(w http.ResponseWriter, r *http.Request, params ListAuditRecordsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/auth/config)
 func (_ Unimplemented) AuthConfig(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -290,11 +368,36 @@ func (_ Unimplemented) ReplaceDevice(w http.ResponseWriter, r *http.Request, nam
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/devices/{name}/bundle)
+func (_ Unimplemented) GetDeviceBundle(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/devices/{name}/bundle)
+func (_ Unimplemented) RequestDeviceBundle(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/devices/{name}/console)
 func (_ Unimplemented) RequestConsole(w http.ResponseWriter, r *http.Request, name string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/devices/{name}/logs)
+func (_ Unimplemented) GetDeviceLogs(w http.ResponseWriter, r *http.Request, name string, params GetDeviceLogsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/devices/{name}/overrides)
+func (_ Unimplemented) ReadDeviceOverrides(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/v1/devices/{name}/overrides)
+func (_ Unimplemented) ReplaceDeviceOverrides(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/devices/{name}/rendered)
 func (_ Unimplemented) GetRenderedDeviceSpec(w http.ResponseWriter, r *http.Request, name string, params GetRenderedDeviceSpecParams) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -315,6 +418,41 @@ func (_ Unimplemented) EnrollmentConfig(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (DELETE /api/v1/enrollmentpolicies)
+func (_ Unimplemented) DeleteEnrollmentPolicies(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/enrollmentpolicies)
+func (_ Unimplemented) ListEnrollmentPolicies(w http.ResponseWriter, r *http.Request, params ListEnrollmentPoliciesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/enrollmentpolicies)
+func (_ Unimplemented) CreateEnrollmentPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/v1/enrollmentpolicies/{name})
+func (_ Unimplemented) DeleteEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/enrollmentpolicies/{name})
+func (_ Unimplemented) ReadEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PATCH /api/v1/enrollmentpolicies/{name})
+func (_ Unimplemented) PatchEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/v1/enrollmentpolicies/{name})
+func (_ Unimplemented) ReplaceEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (DELETE /api/v1/enrollmentrequests)
 func (_ Unimplemented) DeleteEnrollmentRequests(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -360,6 +498,16 @@ func (_ Unimplemented) ReplaceEnrollmentRequestStatus(w http.ResponseWriter, r *
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (POST /api/v1/enrollmentrequests/{name}/tpmchallenge)
+func (_ Unimplemented) AnswerEnrollmentRequestTPMChallenge(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/events)
+func (_ Unimplemented) ListEvents(w http.ResponseWriter, r *http.Request, params ListEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (DELETE /api/v1/fleets)
 func (_ Unimplemented) DeleteFleets(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -415,6 +563,11 @@ func (_ Unimplemented) ReplaceFleet(w http.ResponseWriter, r *http.Request, name
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (POST /api/v1/fleets/{name}/ownershipdryrun)
+func (_ Unimplemented) DryRunFleetOwnership(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/fleets/{name}/status)
 func (_ Unimplemented) ReadFleetStatus(w http.ResponseWriter, r *http.Request, name string) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -495,6 +648,46 @@ func (_ Unimplemented) ReplaceResourceSync(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (DELETE /api/v1/serviceaccounts)
+func (_ Unimplemented) DeleteServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/serviceaccounts)
+func (_ Unimplemented) ListServiceAccounts(w http.ResponseWriter, r *http.Request, params ListServiceAccountsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/serviceaccounts)
+func (_ Unimplemented) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/v1/serviceaccounts/{name})
+func (_ Unimplemented) DeleteServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/serviceaccounts/{name})
+func (_ Unimplemented) ReadServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/v1/serviceaccounts/{name})
+func (_ Unimplemented) ReplaceServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/serviceaccounts/{name}/tokens)
+func (_ Unimplemented) CreateServiceAccountToken(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/v1/serviceaccounts/{name}/tokens/{token})
+func (_ Unimplemented) DeleteServiceAccountToken(w http.ResponseWriter, r *http.Request, name string, token string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -504,6 +697,66 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// ListAuditRecords operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditRecords(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditRecordsParams
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuditRecords(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // AuthConfig operation middleware
 func (siw *ServerInterfaceWrapper) AuthConfig(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1040,8 +1293,8 @@ func (siw *ServerInterfaceWrapper) ReplaceDevice(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// RequestConsole operation middleware
-func (siw *ServerInterfaceWrapper) RequestConsole(w http.ResponseWriter, r *http.Request) {
+// GetDeviceBundle operation middleware
+func (siw *ServerInterfaceWrapper) GetDeviceBundle(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1056,7 +1309,7 @@ func (siw *ServerInterfaceWrapper) RequestConsole(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RequestConsole(w, r, name)
+		siw.Handler.GetDeviceBundle(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1066,8 +1319,8 @@ func (siw *ServerInterfaceWrapper) RequestConsole(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetRenderedDeviceSpec operation middleware
-func (siw *ServerInterfaceWrapper) GetRenderedDeviceSpec(w http.ResponseWriter, r *http.Request) {
+// RequestDeviceBundle operation middleware
+func (siw *ServerInterfaceWrapper) RequestDeviceBundle(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1081,19 +1334,8 @@ func (siw *ServerInterfaceWrapper) GetRenderedDeviceSpec(w http.ResponseWriter,
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetRenderedDeviceSpecParams
-
-	// ------------- Optional query parameter "knownRenderedVersion" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "knownRenderedVersion", r.URL.Query(), &params.KnownRenderedVersion)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "knownRenderedVersion", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRenderedDeviceSpec(w, r, name, params)
+		siw.Handler.RequestDeviceBundle(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1103,8 +1345,8 @@ func (siw *ServerInterfaceWrapper) GetRenderedDeviceSpec(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReadDeviceStatus operation middleware
-func (siw *ServerInterfaceWrapper) ReadDeviceStatus(w http.ResponseWriter, r *http.Request) {
+// RequestConsole operation middleware
+func (siw *ServerInterfaceWrapper) RequestConsole(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1119,7 +1361,7 @@ func (siw *ServerInterfaceWrapper) ReadDeviceStatus(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReadDeviceStatus(w, r, name)
+		siw.Handler.RequestConsole(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1129,8 +1371,8 @@ func (siw *ServerInterfaceWrapper) ReadDeviceStatus(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReplaceDeviceStatus operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceDeviceStatus(w http.ResponseWriter, r *http.Request) {
+// GetDeviceLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetDeviceLogs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1144,8 +1386,51 @@ func (siw *ServerInterfaceWrapper) ReplaceDeviceStatus(w http.ResponseWriter, r
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDeviceLogsParams
+
+	// ------------- Optional query parameter "unit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unit", r.URL.Query(), &params.Unit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "app" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "app", r.URL.Query(), &params.App)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "app", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tail" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tail", r.URL.Query(), &params.Tail)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tail", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "follow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "follow", r.URL.Query(), &params.Follow)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "follow", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceDeviceStatus(w, r, name)
+		siw.Handler.GetDeviceLogs(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1155,8 +1440,8 @@ func (siw *ServerInterfaceWrapper) ReplaceDeviceStatus(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// EnrollmentConfig operation middleware
-func (siw *ServerInterfaceWrapper) EnrollmentConfig(w http.ResponseWriter, r *http.Request) {
+// ReadDeviceOverrides operation middleware
+func (siw *ServerInterfaceWrapper) ReadDeviceOverrides(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1171,7 +1456,7 @@ func (siw *ServerInterfaceWrapper) EnrollmentConfig(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.EnrollmentConfig(w, r, name)
+		siw.Handler.ReadDeviceOverrides(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1181,12 +1466,23 @@ func (siw *ServerInterfaceWrapper) EnrollmentConfig(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteEnrollmentRequests operation middleware
-func (siw *ServerInterfaceWrapper) DeleteEnrollmentRequests(w http.ResponseWriter, r *http.Request) {
+// ReplaceDeviceOverrides operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceDeviceOverrides(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteEnrollmentRequests(w, r)
+		siw.Handler.ReplaceDeviceOverrides(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1196,65 +1492,60 @@ func (siw *ServerInterfaceWrapper) DeleteEnrollmentRequests(w http.ResponseWrite
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListEnrollmentRequests operation middleware
-func (siw *ServerInterfaceWrapper) ListEnrollmentRequests(w http.ResponseWriter, r *http.Request) {
+// GetRenderedDeviceSpec operation middleware
+func (siw *ServerInterfaceWrapper) GetRenderedDeviceSpec(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListEnrollmentRequestsParams
-
-	// ------------- Optional query parameter "continue" -------------
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "labelSelector" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRenderedDeviceSpecParams
 
-	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	// ------------- Optional query parameter "knownRenderedVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "knownRenderedVersion", r.URL.Query(), &params.KnownRenderedVersion)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "knownRenderedVersion", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "fieldSelector" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRenderedDeviceSpec(w, r, name, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	// ------------- Optional query parameter "sortBy" -------------
+// ReadDeviceStatus operation middleware
+func (siw *ServerInterfaceWrapper) ReadDeviceStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
-		return
-	}
+	var err error
 
-	// ------------- Optional query parameter "sortOrder" -------------
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListEnrollmentRequests(w, r, params)
+		siw.Handler.ReadDeviceStatus(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1264,12 +1555,23 @@ func (siw *ServerInterfaceWrapper) ListEnrollmentRequests(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateEnrollmentRequest operation middleware
-func (siw *ServerInterfaceWrapper) CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+// ReplaceDeviceStatus operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceDeviceStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateEnrollmentRequest(w, r)
+		siw.Handler.ReplaceDeviceStatus(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1279,8 +1581,8 @@ func (siw *ServerInterfaceWrapper) CreateEnrollmentRequest(w http.ResponseWriter
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteEnrollmentRequest operation middleware
-func (siw *ServerInterfaceWrapper) DeleteEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+// EnrollmentConfig operation middleware
+func (siw *ServerInterfaceWrapper) EnrollmentConfig(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1295,7 +1597,7 @@ func (siw *ServerInterfaceWrapper) DeleteEnrollmentRequest(w http.ResponseWriter
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteEnrollmentRequest(w, r, name)
+		siw.Handler.EnrollmentConfig(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1305,23 +1607,80 @@ func (siw *ServerInterfaceWrapper) DeleteEnrollmentRequest(w http.ResponseWriter
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReadEnrollmentRequest operation middleware
-func (siw *ServerInterfaceWrapper) ReadEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+// DeleteEnrollmentPolicies operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEnrollmentPolicies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteEnrollmentPolicies(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListEnrollmentPolicies operation middleware
+func (siw *ServerInterfaceWrapper) ListEnrollmentPolicies(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEnrollmentPoliciesParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReadEnrollmentRequest(w, r, name)
+		siw.Handler.ListEnrollmentPolicies(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1331,8 +1690,23 @@ func (siw *ServerInterfaceWrapper) ReadEnrollmentRequest(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReplaceEnrollmentRequest operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+// CreateEnrollmentPolicy operation middleware
+func (siw *ServerInterfaceWrapper) CreateEnrollmentPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEnrollmentPolicy(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteEnrollmentPolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEnrollmentPolicy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1347,7 +1721,7 @@ func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequest(w http.ResponseWrite
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceEnrollmentRequest(w, r, name)
+		siw.Handler.DeleteEnrollmentPolicy(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1357,8 +1731,8 @@ func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequest(w http.ResponseWrite
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ApproveEnrollmentRequest operation middleware
-func (siw *ServerInterfaceWrapper) ApproveEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+// ReadEnrollmentPolicy operation middleware
+func (siw *ServerInterfaceWrapper) ReadEnrollmentPolicy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1373,7 +1747,7 @@ func (siw *ServerInterfaceWrapper) ApproveEnrollmentRequest(w http.ResponseWrite
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ApproveEnrollmentRequest(w, r, name)
+		siw.Handler.ReadEnrollmentPolicy(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1383,8 +1757,8 @@ func (siw *ServerInterfaceWrapper) ApproveEnrollmentRequest(w http.ResponseWrite
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReadEnrollmentRequestStatus operation middleware
-func (siw *ServerInterfaceWrapper) ReadEnrollmentRequestStatus(w http.ResponseWriter, r *http.Request) {
+// PatchEnrollmentPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PatchEnrollmentPolicy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1399,7 +1773,7 @@ func (siw *ServerInterfaceWrapper) ReadEnrollmentRequestStatus(w http.ResponseWr
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReadEnrollmentRequestStatus(w, r, name)
+		siw.Handler.PatchEnrollmentPolicy(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1409,8 +1783,8 @@ func (siw *ServerInterfaceWrapper) ReadEnrollmentRequestStatus(w http.ResponseWr
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReplaceEnrollmentRequestStatus operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequestStatus(w http.ResponseWriter, r *http.Request) {
+// ReplaceEnrollmentPolicy operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceEnrollmentPolicy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1425,7 +1799,7 @@ func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequestStatus(w http.Respons
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceEnrollmentRequestStatus(w, r, name)
+		siw.Handler.ReplaceEnrollmentPolicy(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1435,12 +1809,12 @@ func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequestStatus(w http.Respons
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteFleets operation middleware
-func (siw *ServerInterfaceWrapper) DeleteFleets(w http.ResponseWriter, r *http.Request) {
+// DeleteEnrollmentRequests operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEnrollmentRequests(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteFleets(w, r)
+		siw.Handler.DeleteEnrollmentRequests(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1450,14 +1824,14 @@ func (siw *ServerInterfaceWrapper) DeleteFleets(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListFleets operation middleware
-func (siw *ServerInterfaceWrapper) ListFleets(w http.ResponseWriter, r *http.Request) {
+// ListEnrollmentRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListEnrollmentRequests(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListFleetsParams
+	var params ListEnrollmentRequestsParams
 
 	// ------------- Optional query parameter "continue" -------------
 
@@ -1491,22 +1865,6 @@ func (siw *ServerInterfaceWrapper) ListFleets(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// ------------- Optional query parameter "owner" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "owner", r.URL.Query(), &params.Owner)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "addDevicesCount" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "addDevicesCount", r.URL.Query(), &params.AddDevicesCount)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "addDevicesCount", Err: err})
-		return
-	}
-
 	// ------------- Optional query parameter "sortBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
@@ -1524,7 +1882,7 @@ func (siw *ServerInterfaceWrapper) ListFleets(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListFleets(w, r, params)
+		siw.Handler.ListEnrollmentRequests(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1534,12 +1892,12 @@ func (siw *ServerInterfaceWrapper) ListFleets(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateFleet operation middleware
-func (siw *ServerInterfaceWrapper) CreateFleet(w http.ResponseWriter, r *http.Request) {
+// CreateEnrollmentRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateFleet(w, r)
+		siw.Handler.CreateEnrollmentRequest(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1549,23 +1907,23 @@ func (siw *ServerInterfaceWrapper) CreateFleet(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteTemplateVersions operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTemplateVersions(w http.ResponseWriter, r *http.Request) {
+// DeleteEnrollmentRequest operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "fleet" -------------
-	var fleet string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "fleet", chi.URLParam(r, "fleet"), &fleet, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fleet", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTemplateVersions(w, r, fleet)
+		siw.Handler.DeleteEnrollmentRequest(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1575,74 +1933,75 @@ func (siw *ServerInterfaceWrapper) DeleteTemplateVersions(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListTemplateVersions operation middleware
-func (siw *ServerInterfaceWrapper) ListTemplateVersions(w http.ResponseWriter, r *http.Request) {
+// ReadEnrollmentRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReadEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "fleet" -------------
-	var fleet string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "fleet", chi.URLParam(r, "fleet"), &fleet, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fleet", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTemplateVersionsParams
-
-	// ------------- Optional query parameter "continue" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReadEnrollmentRequest(w, r, name)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "labelSelector" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
-		return
-	}
+// ReplaceEnrollmentRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "fieldSelector" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceEnrollmentRequest(w, r, name)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "sortBy" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
-		return
-	}
+// ApproveEnrollmentRequest operation middleware
+func (siw *ServerInterfaceWrapper) ApproveEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "sortOrder" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTemplateVersions(w, r, fleet, params)
+		siw.Handler.ApproveEnrollmentRequest(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1652,21 +2011,12 @@ func (siw *ServerInterfaceWrapper) ListTemplateVersions(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteTemplateVersion operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTemplateVersion(w http.ResponseWriter, r *http.Request) {
+// ReadEnrollmentRequestStatus operation middleware
+func (siw *ServerInterfaceWrapper) ReadEnrollmentRequestStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "fleet" -------------
-	var fleet string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "fleet", chi.URLParam(r, "fleet"), &fleet, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fleet", Err: err})
-		return
-	}
-
 	// ------------- Path parameter "name" -------------
 	var name string
 
@@ -1677,7 +2027,7 @@ func (siw *ServerInterfaceWrapper) DeleteTemplateVersion(w http.ResponseWriter,
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTemplateVersion(w, r, fleet, name)
+		siw.Handler.ReadEnrollmentRequestStatus(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1687,21 +2037,12 @@ func (siw *ServerInterfaceWrapper) DeleteTemplateVersion(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReadTemplateVersion operation middleware
-func (siw *ServerInterfaceWrapper) ReadTemplateVersion(w http.ResponseWriter, r *http.Request) {
+// ReplaceEnrollmentRequestStatus operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequestStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "fleet" -------------
-	var fleet string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "fleet", chi.URLParam(r, "fleet"), &fleet, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fleet", Err: err})
-		return
-	}
-
 	// ------------- Path parameter "name" -------------
 	var name string
 
@@ -1712,7 +2053,7 @@ func (siw *ServerInterfaceWrapper) ReadTemplateVersion(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReadTemplateVersion(w, r, fleet, name)
+		siw.Handler.ReplaceEnrollmentRequestStatus(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1722,8 +2063,8 @@ func (siw *ServerInterfaceWrapper) ReadTemplateVersion(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteFleet operation middleware
-func (siw *ServerInterfaceWrapper) DeleteFleet(w http.ResponseWriter, r *http.Request) {
+// AnswerEnrollmentRequestTPMChallenge operation middleware
+func (siw *ServerInterfaceWrapper) AnswerEnrollmentRequestTPMChallenge(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1738,7 +2079,7 @@ func (siw *ServerInterfaceWrapper) DeleteFleet(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteFleet(w, r, name)
+		siw.Handler.AnswerEnrollmentRequestTPMChallenge(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1748,34 +2089,57 @@ func (siw *ServerInterfaceWrapper) DeleteFleet(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReadFleet operation middleware
-func (siw *ServerInterfaceWrapper) ReadFleet(w http.ResponseWriter, r *http.Request) {
+// ListEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListEvents(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEventsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ReadFleetParams
+	// ------------- Optional query parameter "fieldSelector" -------------
 
-	// ------------- Optional query parameter "addDevicesSummary" -------------
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "addDevicesSummary", r.URL.Query(), &params.AddDevicesSummary)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "addDevicesSummary", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReadFleet(w, r, name, params)
+		siw.Handler.ListEvents(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1785,23 +2149,12 @@ func (siw *ServerInterfaceWrapper) ReadFleet(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchFleet operation middleware
-func (siw *ServerInterfaceWrapper) PatchFleet(w http.ResponseWriter, r *http.Request) {
+// DeleteFleets operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFleets(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "name" -------------
-	var name string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchFleet(w, r, name)
+		siw.Handler.DeleteFleets(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1811,49 +2164,81 @@ func (siw *ServerInterfaceWrapper) PatchFleet(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReplaceFleet operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceFleet(w http.ResponseWriter, r *http.Request) {
+// ListFleets operation middleware
+func (siw *ServerInterfaceWrapper) ListFleets(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFleetsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceFleet(w, r, name)
-	}))
+	// ------------- Optional query parameter "labelSelector" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "fieldSelector" -------------
 
-// ReadFleetStatus operation middleware
-func (siw *ServerInterfaceWrapper) ReadFleetStatus(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "limit" -------------
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "owner" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "owner", r.URL.Query(), &params.Owner)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "addDevicesCount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "addDevicesCount", r.URL.Query(), &params.AddDevicesCount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "addDevicesCount", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReadFleetStatus(w, r, name)
+		siw.Handler.ListFleets(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1863,23 +2248,12 @@ func (siw *ServerInterfaceWrapper) ReadFleetStatus(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReplaceFleetStatus operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceFleetStatus(w http.ResponseWriter, r *http.Request) {
+// CreateFleet operation middleware
+func (siw *ServerInterfaceWrapper) CreateFleet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "name" -------------
-	var name string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceFleetStatus(w, r, name)
+		siw.Handler.CreateFleet(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1889,12 +2263,23 @@ func (siw *ServerInterfaceWrapper) ReplaceFleetStatus(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteRepositories operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRepositories(w http.ResponseWriter, r *http.Request) {
+// DeleteTemplateVersions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTemplateVersions(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "fleet" -------------
+	var fleet string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fleet", chi.URLParam(r, "fleet"), &fleet, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fleet", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteRepositories(w, r)
+		siw.Handler.DeleteTemplateVersions(w, r, fleet)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1904,14 +2289,23 @@ func (siw *ServerInterfaceWrapper) DeleteRepositories(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListRepositories operation middleware
-func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *http.Request) {
+// ListTemplateVersions operation middleware
+func (siw *ServerInterfaceWrapper) ListTemplateVersions(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "fleet" -------------
+	var fleet string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fleet", chi.URLParam(r, "fleet"), &fleet, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fleet", Err: err})
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRepositoriesParams
+	var params ListTemplateVersionsParams
 
 	// ------------- Optional query parameter "continue" -------------
 
@@ -1962,7 +2356,7 @@ func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListRepositories(w, r, params)
+		siw.Handler.ListTemplateVersions(w, r, fleet, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1972,26 +2366,20 @@ func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateRepository operation middleware
-func (siw *ServerInterfaceWrapper) CreateRepository(w http.ResponseWriter, r *http.Request) {
+// DeleteTemplateVersion operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTemplateVersion(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateRepository(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	var err error
 
-// DeleteRepository operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Path parameter "fleet" -------------
+	var fleet string
 
-	var err error
+	err = runtime.BindStyledParameterWithOptions("simple", "fleet", chi.URLParam(r, "fleet"), &fleet, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fleet", Err: err})
+		return
+	}
 
 	// ------------- Path parameter "name" -------------
 	var name string
@@ -2003,7 +2391,7 @@ func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteRepository(w, r, name)
+		siw.Handler.DeleteTemplateVersion(w, r, fleet, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2013,12 +2401,21 @@ func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReadRepository operation middleware
-func (siw *ServerInterfaceWrapper) ReadRepository(w http.ResponseWriter, r *http.Request) {
+// ReadTemplateVersion operation middleware
+func (siw *ServerInterfaceWrapper) ReadTemplateVersion(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "fleet" -------------
+	var fleet string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fleet", chi.URLParam(r, "fleet"), &fleet, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fleet", Err: err})
+		return
+	}
+
 	// ------------- Path parameter "name" -------------
 	var name string
 
@@ -2029,7 +2426,7 @@ func (siw *ServerInterfaceWrapper) ReadRepository(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReadRepository(w, r, name)
+		siw.Handler.ReadTemplateVersion(w, r, fleet, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2039,8 +2436,8 @@ func (siw *ServerInterfaceWrapper) ReadRepository(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchRepository operation middleware
-func (siw *ServerInterfaceWrapper) PatchRepository(w http.ResponseWriter, r *http.Request) {
+// DeleteFleet operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFleet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2055,7 +2452,7 @@ func (siw *ServerInterfaceWrapper) PatchRepository(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchRepository(w, r, name)
+		siw.Handler.DeleteFleet(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2065,8 +2462,8 @@ func (siw *ServerInterfaceWrapper) PatchRepository(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReplaceRepository operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceRepository(w http.ResponseWriter, r *http.Request) {
+// ReadFleet operation middleware
+func (siw *ServerInterfaceWrapper) ReadFleet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2080,8 +2477,19 @@ func (siw *ServerInterfaceWrapper) ReplaceRepository(w http.ResponseWriter, r *h
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReadFleetParams
+
+	// ------------- Optional query parameter "addDevicesSummary" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "addDevicesSummary", r.URL.Query(), &params.AddDevicesSummary)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "addDevicesSummary", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceRepository(w, r, name)
+		siw.Handler.ReadFleet(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2091,12 +2499,23 @@ func (siw *ServerInterfaceWrapper) ReplaceRepository(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteResourceSyncs operation middleware
-func (siw *ServerInterfaceWrapper) DeleteResourceSyncs(w http.ResponseWriter, r *http.Request) {
+// PatchFleet operation middleware
+func (siw *ServerInterfaceWrapper) PatchFleet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteResourceSyncs(w, r)
+		siw.Handler.PatchFleet(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2106,14 +2525,133 @@ func (siw *ServerInterfaceWrapper) DeleteResourceSyncs(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListResourceSync operation middleware
-func (siw *ServerInterfaceWrapper) ListResourceSync(w http.ResponseWriter, r *http.Request) {
+// ReplaceFleet operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceFleet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceFleet(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DryRunFleetOwnership operation middleware
+func (siw *ServerInterfaceWrapper) DryRunFleetOwnership(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DryRunFleetOwnership(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReadFleetStatus operation middleware
+func (siw *ServerInterfaceWrapper) ReadFleetStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReadFleetStatus(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReplaceFleetStatus operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceFleetStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceFleetStatus(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteRepositories operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRepositories(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRepositories(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListRepositories operation middleware
+func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListResourceSyncParams
+	var params ListRepositoriesParams
 
 	// ------------- Optional query parameter "continue" -------------
 
@@ -2147,14 +2685,6 @@ func (siw *ServerInterfaceWrapper) ListResourceSync(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Optional query parameter "repository" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "repository", r.URL.Query(), &params.Repository)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
-		return
-	}
-
 	// ------------- Optional query parameter "sortBy" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
@@ -2172,7 +2702,7 @@ func (siw *ServerInterfaceWrapper) ListResourceSync(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListResourceSync(w, r, params)
+		siw.Handler.ListRepositories(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2182,12 +2712,12 @@ func (siw *ServerInterfaceWrapper) ListResourceSync(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateResourceSync operation middleware
-func (siw *ServerInterfaceWrapper) CreateResourceSync(w http.ResponseWriter, r *http.Request) {
+// CreateRepository operation middleware
+func (siw *ServerInterfaceWrapper) CreateRepository(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateResourceSync(w, r)
+		siw.Handler.CreateRepository(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2197,8 +2727,8 @@ func (siw *ServerInterfaceWrapper) CreateResourceSync(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteResourceSync operation middleware
-func (siw *ServerInterfaceWrapper) DeleteResourceSync(w http.ResponseWriter, r *http.Request) {
+// DeleteRepository operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRepository(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2213,7 +2743,7 @@ func (siw *ServerInterfaceWrapper) DeleteResourceSync(w http.ResponseWriter, r *
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteResourceSync(w, r, name)
+		siw.Handler.DeleteRepository(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2223,8 +2753,8 @@ func (siw *ServerInterfaceWrapper) DeleteResourceSync(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReadResourceSync operation middleware
-func (siw *ServerInterfaceWrapper) ReadResourceSync(w http.ResponseWriter, r *http.Request) {
+// ReadRepository operation middleware
+func (siw *ServerInterfaceWrapper) ReadRepository(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2239,7 +2769,7 @@ func (siw *ServerInterfaceWrapper) ReadResourceSync(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReadResourceSync(w, r, name)
+		siw.Handler.ReadRepository(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2249,8 +2779,8 @@ func (siw *ServerInterfaceWrapper) ReadResourceSync(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchResourceSync operation middleware
-func (siw *ServerInterfaceWrapper) PatchResourceSync(w http.ResponseWriter, r *http.Request) {
+// PatchRepository operation middleware
+func (siw *ServerInterfaceWrapper) PatchRepository(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2265,7 +2795,7 @@ func (siw *ServerInterfaceWrapper) PatchResourceSync(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchResourceSync(w, r, name)
+		siw.Handler.PatchRepository(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2275,8 +2805,8 @@ func (siw *ServerInterfaceWrapper) PatchResourceSync(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReplaceResourceSync operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceResourceSync(w http.ResponseWriter, r *http.Request) {
+// ReplaceRepository operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceRepository(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2291,7 +2821,7 @@ func (siw *ServerInterfaceWrapper) ReplaceResourceSync(w http.ResponseWriter, r
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceResourceSync(w, r, name)
+		siw.Handler.ReplaceRepository(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2301,120 +2831,570 @@ func (siw *ServerInterfaceWrapper) ReplaceResourceSync(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// DeleteResourceSyncs operation middleware
+func (siw *ServerInterfaceWrapper) DeleteResourceSyncs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteResourceSyncs(w, r)
+	}))
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+// ListResourceSync operation middleware
+func (siw *ServerInterfaceWrapper) ListResourceSync(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	var err error
 
-type RequiredParamError struct {
-	ParamName string
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListResourceSyncParams
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// ------------- Optional query parameter "continue" -------------
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// ------------- Optional query parameter "labelSelector" -------------
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Optional query parameter "fieldSelector" -------------
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository", r.URL.Query(), &params.Repository)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListResourceSync(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// CreateResourceSync operation middleware
+func (siw *ServerInterfaceWrapper) CreateResourceSync(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateResourceSync(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+// DeleteResourceSync operation middleware
+func (siw *ServerInterfaceWrapper) DeleteResourceSync(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteResourceSync(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// ReadResourceSync operation middleware
+func (siw *ServerInterfaceWrapper) ReadResourceSync(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReadResourceSync(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// PatchResourceSync operation middleware
+func (siw *ServerInterfaceWrapper) PatchResourceSync(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchResourceSync(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// ReplaceResourceSync operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceResourceSync(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceResourceSync(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// DeleteServiceAccounts operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	if r == nil {
-		r = chi.NewRouter()
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteServiceAccounts(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListServiceAccounts operation middleware
+func (siw *ServerInterfaceWrapper) ListServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListServiceAccountsParams
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
 	}
 
-	r.Group(func(r chi.Router) {
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListServiceAccounts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateServiceAccount(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteServiceAccount(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReadServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) ReadServiceAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReadServiceAccount(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReplaceServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceServiceAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceServiceAccount(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateServiceAccountToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateServiceAccountToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateServiceAccountToken(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteServiceAccountToken operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServiceAccountToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteServiceAccountToken(w, r, name, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/auditrecords", wrapper.ListAuditRecords)
+	})
+	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/auth/config", wrapper.AuthConfig)
 	})
 	r.Group(func(r chi.Router) {
@@ -2468,9 +3448,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/devices/{name}", wrapper.ReplaceDevice)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/devices/{name}/bundle", wrapper.GetDeviceBundle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/devices/{name}/bundle", wrapper.RequestDeviceBundle)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/devices/{name}/console", wrapper.RequestConsole)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/devices/{name}/logs", wrapper.GetDeviceLogs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/devices/{name}/overrides", wrapper.ReadDeviceOverrides)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/devices/{name}/overrides", wrapper.ReplaceDeviceOverrides)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/devices/{name}/rendered", wrapper.GetRenderedDeviceSpec)
 	})
@@ -2483,6 +3478,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/enrollmentconfig/{name}", wrapper.EnrollmentConfig)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/enrollmentpolicies", wrapper.DeleteEnrollmentPolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/enrollmentpolicies", wrapper.ListEnrollmentPolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/enrollmentpolicies", wrapper.CreateEnrollmentPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/enrollmentpolicies/{name}", wrapper.DeleteEnrollmentPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/enrollmentpolicies/{name}", wrapper.ReadEnrollmentPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/v1/enrollmentpolicies/{name}", wrapper.PatchEnrollmentPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/enrollmentpolicies/{name}", wrapper.ReplaceEnrollmentPolicy)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/api/v1/enrollmentrequests", wrapper.DeleteEnrollmentRequests)
 	})
@@ -2510,6 +3526,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/enrollmentrequests/{name}/status", wrapper.ReplaceEnrollmentRequestStatus)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/enrollmentrequests/{name}/tpmchallenge", wrapper.AnswerEnrollmentRequestTPMChallenge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/events", wrapper.ListEvents)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/api/v1/fleets", wrapper.DeleteFleets)
 	})
@@ -2543,6 +3565,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/fleets/{name}", wrapper.ReplaceFleet)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/fleets/{name}/ownershipdryrun", wrapper.DryRunFleetOwnership)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/fleets/{name}/status", wrapper.ReadFleetStatus)
 	})
@@ -2591,2715 +3616,4233 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/resourcesyncs/{name}", wrapper.ReplaceResourceSync)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/serviceaccounts", wrapper.DeleteServiceAccounts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/serviceaccounts", wrapper.ListServiceAccounts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/serviceaccounts", wrapper.CreateServiceAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/serviceaccounts/{name}", wrapper.DeleteServiceAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/serviceaccounts/{name}", wrapper.ReadServiceAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/serviceaccounts/{name}", wrapper.ReplaceServiceAccount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/serviceaccounts/{name}/tokens", wrapper.CreateServiceAccountToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/serviceaccounts/{name}/tokens/{token}", wrapper.DeleteServiceAccountToken)
+	})
+
+	return r
+}
+
+type ListAuditRecordsRequestObject struct {
+	Params ListAuditRecordsParams
+}
+
+type ListAuditRecordsResponseObject interface {
+	VisitListAuditRecordsResponse(w http.ResponseWriter) error
+}
+
+type ListAuditRecords200JSONResponse AuditRecordList
+
+func (response ListAuditRecords200JSONResponse) VisitListAuditRecordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditRecords400JSONResponse Error
+
+func (response ListAuditRecords400JSONResponse) VisitListAuditRecordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditRecords401JSONResponse Error
+
+func (response ListAuditRecords401JSONResponse) VisitListAuditRecordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditRecords403JSONResponse Error
+
+func (response ListAuditRecords403JSONResponse) VisitListAuditRecordsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthConfigRequestObject struct {
+}
+
+type AuthConfigResponseObject interface {
+	VisitAuthConfigResponse(w http.ResponseWriter) error
+}
+
+type AuthConfig200JSONResponse AuthConfig
+
+func (response AuthConfig200JSONResponse) VisitAuthConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthConfig418Response struct {
+}
+
+func (response AuthConfig418Response) VisitAuthConfigResponse(w http.ResponseWriter) error {
+	w.WriteHeader(418)
+	return nil
+}
+
+type AuthValidateRequestObject struct {
+	Params AuthValidateParams
+}
+
+type AuthValidateResponseObject interface {
+	VisitAuthValidateResponse(w http.ResponseWriter) error
+}
+
+type AuthValidate200Response struct {
+}
+
+func (response AuthValidate200Response) VisitAuthValidateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type AuthValidate401Response struct {
+}
+
+func (response AuthValidate401Response) VisitAuthValidateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AuthValidate418Response struct {
+}
+
+func (response AuthValidate418Response) VisitAuthValidateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(418)
+	return nil
+}
+
+type AuthValidate500JSONResponse Error
+
+func (response AuthValidate500JSONResponse) VisitAuthValidateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCertificateSigningRequestsRequestObject struct {
+}
+
+type DeleteCertificateSigningRequestsResponseObject interface {
+	VisitDeleteCertificateSigningRequestsResponse(w http.ResponseWriter) error
+}
+
+type DeleteCertificateSigningRequests200JSONResponse Status
+
+func (response DeleteCertificateSigningRequests200JSONResponse) VisitDeleteCertificateSigningRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCertificateSigningRequests401JSONResponse Error
+
+func (response DeleteCertificateSigningRequests401JSONResponse) VisitDeleteCertificateSigningRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCertificateSigningRequestsRequestObject struct {
+	Params ListCertificateSigningRequestsParams
+}
+
+type ListCertificateSigningRequestsResponseObject interface {
+	VisitListCertificateSigningRequestsResponse(w http.ResponseWriter) error
+}
+
+type ListCertificateSigningRequests200JSONResponse CertificateSigningRequestList
+
+func (response ListCertificateSigningRequests200JSONResponse) VisitListCertificateSigningRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCertificateSigningRequests400JSONResponse Error
+
+func (response ListCertificateSigningRequests400JSONResponse) VisitListCertificateSigningRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCertificateSigningRequests401JSONResponse Error
+
+func (response ListCertificateSigningRequests401JSONResponse) VisitListCertificateSigningRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCertificateSigningRequestRequestObject struct {
+	Body *CreateCertificateSigningRequestJSONRequestBody
+}
+
+type CreateCertificateSigningRequestResponseObject interface {
+	VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error
+}
+
+type CreateCertificateSigningRequest200JSONResponse CertificateSigningRequest
+
+func (response CreateCertificateSigningRequest200JSONResponse) VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCertificateSigningRequest201JSONResponse CertificateSigningRequest
+
+func (response CreateCertificateSigningRequest201JSONResponse) VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCertificateSigningRequest202JSONResponse CertificateSigningRequest
+
+func (response CreateCertificateSigningRequest202JSONResponse) VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCertificateSigningRequest208JSONResponse EnrollmentRequest
+
+func (response CreateCertificateSigningRequest208JSONResponse) VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(208)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCertificateSigningRequest400JSONResponse Error
+
+func (response CreateCertificateSigningRequest400JSONResponse) VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCertificateSigningRequest401JSONResponse Error
+
+func (response CreateCertificateSigningRequest401JSONResponse) VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCertificateSigningRequestRequestObject struct {
+	Name string `json:"name"`
+}
+
+type DeleteCertificateSigningRequestResponseObject interface {
+	VisitDeleteCertificateSigningRequestResponse(w http.ResponseWriter) error
+}
+
+type DeleteCertificateSigningRequest200JSONResponse CertificateSigningRequest
+
+func (response DeleteCertificateSigningRequest200JSONResponse) VisitDeleteCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCertificateSigningRequest401JSONResponse Error
+
+func (response DeleteCertificateSigningRequest401JSONResponse) VisitDeleteCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCertificateSigningRequest404JSONResponse Error
+
+func (response DeleteCertificateSigningRequest404JSONResponse) VisitDeleteCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadCertificateSigningRequestRequestObject struct {
+	Name string `json:"name"`
+}
+
+type ReadCertificateSigningRequestResponseObject interface {
+	VisitReadCertificateSigningRequestResponse(w http.ResponseWriter) error
+}
+
+type ReadCertificateSigningRequest200JSONResponse CertificateSigningRequest
+
+func (response ReadCertificateSigningRequest200JSONResponse) VisitReadCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadCertificateSigningRequest401JSONResponse Error
+
+func (response ReadCertificateSigningRequest401JSONResponse) VisitReadCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadCertificateSigningRequest404JSONResponse Error
+
+func (response ReadCertificateSigningRequest404JSONResponse) VisitReadCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCertificateSigningRequestRequestObject struct {
+	Name string `json:"name"`
+	Body *PatchCertificateSigningRequestApplicationJSONPatchPlusJSONRequestBody
+}
+
+type PatchCertificateSigningRequestResponseObject interface {
+	VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error
+}
+
+type PatchCertificateSigningRequest200JSONResponse CertificateSigningRequest
+
+func (response PatchCertificateSigningRequest200JSONResponse) VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCertificateSigningRequest201JSONResponse CertificateSigningRequest
+
+func (response PatchCertificateSigningRequest201JSONResponse) VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCertificateSigningRequest400JSONResponse Error
+
+func (response PatchCertificateSigningRequest400JSONResponse) VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCertificateSigningRequest401JSONResponse Error
+
+func (response PatchCertificateSigningRequest401JSONResponse) VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCertificateSigningRequest404JSONResponse Error
+
+func (response PatchCertificateSigningRequest404JSONResponse) VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCertificateSigningRequest409JSONResponse Error
+
+func (response PatchCertificateSigningRequest409JSONResponse) VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceCertificateSigningRequestRequestObject struct {
+	Name string `json:"name"`
+	Body *ReplaceCertificateSigningRequestJSONRequestBody
+}
+
+type ReplaceCertificateSigningRequestResponseObject interface {
+	VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error
+}
+
+type ReplaceCertificateSigningRequest200JSONResponse CertificateSigningRequest
+
+func (response ReplaceCertificateSigningRequest200JSONResponse) VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceCertificateSigningRequest201JSONResponse CertificateSigningRequest
+
+func (response ReplaceCertificateSigningRequest201JSONResponse) VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceCertificateSigningRequest400JSONResponse Error
+
+func (response ReplaceCertificateSigningRequest400JSONResponse) VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceCertificateSigningRequest401JSONResponse Error
+
+func (response ReplaceCertificateSigningRequest401JSONResponse) VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceCertificateSigningRequest404JSONResponse Error
+
+func (response ReplaceCertificateSigningRequest404JSONResponse) VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceCertificateSigningRequest409JSONResponse Error
+
+func (response ReplaceCertificateSigningRequest409JSONResponse) VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DenyCertificateSigningRequestRequestObject struct {
+	Name string `json:"name"`
+}
+
+type DenyCertificateSigningRequestResponseObject interface {
+	VisitDenyCertificateSigningRequestResponse(w http.ResponseWriter) error
+}
+
+type DenyCertificateSigningRequest200JSONResponse CertificateSigningRequest
+
+func (response DenyCertificateSigningRequest200JSONResponse) VisitDenyCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DenyCertificateSigningRequest401JSONResponse Error
+
+func (response DenyCertificateSigningRequest401JSONResponse) VisitDenyCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DenyCertificateSigningRequest404JSONResponse Error
+
+func (response DenyCertificateSigningRequest404JSONResponse) VisitDenyCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DenyCertificateSigningRequest409JSONResponse Error
+
+func (response DenyCertificateSigningRequest409JSONResponse) VisitDenyCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveCertificateSigningRequestRequestObject struct {
+	Name string `json:"name"`
+}
+
+type ApproveCertificateSigningRequestResponseObject interface {
+	VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error
+}
+
+type ApproveCertificateSigningRequest200JSONResponse CertificateSigningRequest
+
+func (response ApproveCertificateSigningRequest200JSONResponse) VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveCertificateSigningRequest400JSONResponse Error
+
+func (response ApproveCertificateSigningRequest400JSONResponse) VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveCertificateSigningRequest401JSONResponse Error
+
+func (response ApproveCertificateSigningRequest401JSONResponse) VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveCertificateSigningRequest404JSONResponse Error
+
+func (response ApproveCertificateSigningRequest404JSONResponse) VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveCertificateSigningRequest409JSONResponse Error
+
+func (response ApproveCertificateSigningRequest409JSONResponse) VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveCertificateSigningRequest500JSONResponse Error
+
+func (response ApproveCertificateSigningRequest500JSONResponse) VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDevicesRequestObject struct {
+}
+
+type DeleteDevicesResponseObject interface {
+	VisitDeleteDevicesResponse(w http.ResponseWriter) error
+}
+
+type DeleteDevices200JSONResponse Status
+
+func (response DeleteDevices200JSONResponse) VisitDeleteDevicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDevices401JSONResponse Error
+
+func (response DeleteDevices401JSONResponse) VisitDeleteDevicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDevicesRequestObject struct {
+	Params ListDevicesParams
+}
+
+type ListDevicesResponseObject interface {
+	VisitListDevicesResponse(w http.ResponseWriter) error
+}
+
+type ListDevices200JSONResponse DeviceList
+
+func (response ListDevices200JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDevices400JSONResponse Error
+
+func (response ListDevices400JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDevices401JSONResponse Error
+
+func (response ListDevices401JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDevices403JSONResponse Error
+
+func (response ListDevices403JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDeviceRequestObject struct {
+	Body *CreateDeviceJSONRequestBody
+}
+
+type CreateDeviceResponseObject interface {
+	VisitCreateDeviceResponse(w http.ResponseWriter) error
+}
+
+type CreateDevice201JSONResponse Device
+
+func (response CreateDevice201JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDevice400JSONResponse Error
+
+func (response CreateDevice400JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDevice401JSONResponse Error
+
+func (response CreateDevice401JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDevice409JSONResponse Error
+
+func (response CreateDevice409JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDeviceRequestObject struct {
+	Name string `json:"name"`
+}
+
+type DeleteDeviceResponseObject interface {
+	VisitDeleteDeviceResponse(w http.ResponseWriter) error
+}
+
+type DeleteDevice200JSONResponse Device
+
+func (response DeleteDevice200JSONResponse) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDevice401JSONResponse Error
+
+func (response DeleteDevice401JSONResponse) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDevice404JSONResponse Error
+
+func (response DeleteDevice404JSONResponse) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadDeviceRequestObject struct {
+	Name string `json:"name"`
+}
+
+type ReadDeviceResponseObject interface {
+	VisitReadDeviceResponse(w http.ResponseWriter) error
+}
+
+type ReadDevice200JSONResponse Device
+
+func (response ReadDevice200JSONResponse) VisitReadDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadDevice401JSONResponse Error
+
+func (response ReadDevice401JSONResponse) VisitReadDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadDevice404JSONResponse Error
+
+func (response ReadDevice404JSONResponse) VisitReadDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDeviceRequestObject struct {
+	Name string `json:"name"`
+	Body *PatchDeviceApplicationJSONPatchPlusJSONRequestBody
+}
+
+type PatchDeviceResponseObject interface {
+	VisitPatchDeviceResponse(w http.ResponseWriter) error
+}
+
+type PatchDevice200JSONResponse Device
+
+func (response PatchDevice200JSONResponse) VisitPatchDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDevice400JSONResponse Error
+
+func (response PatchDevice400JSONResponse) VisitPatchDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDevice401JSONResponse Error
+
+func (response PatchDevice401JSONResponse) VisitPatchDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDevice404JSONResponse Error
+
+func (response PatchDevice404JSONResponse) VisitPatchDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchDevice409JSONResponse Error
+
+func (response PatchDevice409JSONResponse) VisitPatchDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceDeviceRequestObject struct {
+	Name string `json:"name"`
+	Body *ReplaceDeviceJSONRequestBody
+}
+
+type ReplaceDeviceResponseObject interface {
+	VisitReplaceDeviceResponse(w http.ResponseWriter) error
+}
+
+type ReplaceDevice200JSONResponse Device
+
+func (response ReplaceDevice200JSONResponse) VisitReplaceDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceDevice201JSONResponse Device
+
+func (response ReplaceDevice201JSONResponse) VisitReplaceDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceDevice400JSONResponse Error
+
+func (response ReplaceDevice400JSONResponse) VisitReplaceDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceDevice401JSONResponse Error
+
+func (response ReplaceDevice401JSONResponse) VisitReplaceDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceDevice404JSONResponse Error
+
+func (response ReplaceDevice404JSONResponse) VisitReplaceDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceDevice409JSONResponse Error
+
+func (response ReplaceDevice409JSONResponse) VisitReplaceDeviceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeviceBundleRequestObject struct {
+	Name string `json:"name"`
+}
+
+type GetDeviceBundleResponseObject interface {
+	VisitGetDeviceBundleResponse(w http.ResponseWriter) error
+}
+
+type GetDeviceBundle200ApplicationgzipResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetDeviceBundle200ApplicationgzipResponse) VisitGetDeviceBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/gzip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetDeviceBundle202JSONResponse DeviceBundleStatus
+
+func (response GetDeviceBundle202JSONResponse) VisitGetDeviceBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeviceBundle401JSONResponse Error
+
+func (response GetDeviceBundle401JSONResponse) VisitGetDeviceBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeviceBundle404JSONResponse Error
+
+func (response GetDeviceBundle404JSONResponse) VisitGetDeviceBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestDeviceBundleRequestObject struct {
+	Name string `json:"name"`
+}
+
+type RequestDeviceBundleResponseObject interface {
+	VisitRequestDeviceBundleResponse(w http.ResponseWriter) error
+}
+
+type RequestDeviceBundle201JSONResponse DeviceBundleStatus
+
+func (response RequestDeviceBundle201JSONResponse) VisitRequestDeviceBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestDeviceBundle401JSONResponse Error
+
+func (response RequestDeviceBundle401JSONResponse) VisitRequestDeviceBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestDeviceBundle404JSONResponse Error
+
+func (response RequestDeviceBundle404JSONResponse) VisitRequestDeviceBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestConsoleRequestObject struct {
+	Name string `json:"name"`
+}
+
+type RequestConsoleResponseObject interface {
+	VisitRequestConsoleResponse(w http.ResponseWriter) error
+}
+
+type RequestConsole200JSONResponse DeviceConsole
+
+func (response RequestConsole200JSONResponse) VisitRequestConsoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestConsole401JSONResponse Error
+
+func (response RequestConsole401JSONResponse) VisitRequestConsoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestConsole404JSONResponse Error
+
+func (response RequestConsole404JSONResponse) VisitRequestConsoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestConsole409JSONResponse Error
+
+func (response RequestConsole409JSONResponse) VisitRequestConsoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeviceLogsRequestObject struct {
+	Name   string `json:"name"`
+	Params GetDeviceLogsParams
+}
+
+type GetDeviceLogsResponseObject interface {
+	VisitGetDeviceLogsResponse(w http.ResponseWriter) error
+}
+
+type GetDeviceLogs200TextResponse string
+
+func (response GetDeviceLogs200TextResponse) VisitGetDeviceLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(200)
+
+	_, err := w.Write([]byte(response))
+	return err
+}
+
+type GetDeviceLogs400JSONResponse Error
+
+func (response GetDeviceLogs400JSONResponse) VisitGetDeviceLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeviceLogs401JSONResponse Error
+
+func (response GetDeviceLogs401JSONResponse) VisitGetDeviceLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeviceLogs404JSONResponse Error
+
+func (response GetDeviceLogs404JSONResponse) VisitGetDeviceLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeviceLogs503JSONResponse Error
+
+func (response GetDeviceLogs503JSONResponse) VisitGetDeviceLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeviceLogs504JSONResponse Error
+
+func (response GetDeviceLogs504JSONResponse) VisitGetDeviceLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadDeviceOverridesRequestObject struct {
+	Name string `json:"name"`
+}
+
+type ReadDeviceOverridesResponseObject interface {
+	VisitReadDeviceOverridesResponse(w http.ResponseWriter) error
+}
+
+type ReadDeviceOverrides200JSONResponse DeviceOverrides
+
+func (response ReadDeviceOverrides200JSONResponse) VisitReadDeviceOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadDeviceOverrides401JSONResponse Error
+
+func (response ReadDeviceOverrides401JSONResponse) VisitReadDeviceOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadDeviceOverrides404JSONResponse Error
+
+func (response ReadDeviceOverrides404JSONResponse) VisitReadDeviceOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceDeviceOverridesRequestObject struct {
+	Name string `json:"name"`
+	Body *ReplaceDeviceOverridesJSONRequestBody
+}
+
+type ReplaceDeviceOverridesResponseObject interface {
+	VisitReplaceDeviceOverridesResponse(w http.ResponseWriter) error
+}
+
+type ReplaceDeviceOverrides200JSONResponse DeviceOverrides
+
+func (response ReplaceDeviceOverrides200JSONResponse) VisitReplaceDeviceOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceDeviceOverrides400JSONResponse Error
+
+func (response ReplaceDeviceOverrides400JSONResponse) VisitReplaceDeviceOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceDeviceOverrides401JSONResponse Error
+
+func (response ReplaceDeviceOverrides401JSONResponse) VisitReplaceDeviceOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceDeviceOverrides404JSONResponse Error
+
+func (response ReplaceDeviceOverrides404JSONResponse) VisitReplaceDeviceOverridesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRenderedDeviceSpecRequestObject struct {
+	Name   string `json:"name"`
+	Params GetRenderedDeviceSpecParams
+}
+
+type GetRenderedDeviceSpecResponseObject interface {
+	VisitGetRenderedDeviceSpecResponse(w http.ResponseWriter) error
+}
+
+type GetRenderedDeviceSpec200JSONResponse RenderedDeviceSpec
+
+func (response GetRenderedDeviceSpec200JSONResponse) VisitGetRenderedDeviceSpecResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRenderedDeviceSpec204Response struct {
+}
+
+func (response GetRenderedDeviceSpec204Response) VisitGetRenderedDeviceSpecResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type GetRenderedDeviceSpec401JSONResponse Error
+
+func (response GetRenderedDeviceSpec401JSONResponse) VisitGetRenderedDeviceSpecResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRenderedDeviceSpec404JSONResponse Error
+
+func (response GetRenderedDeviceSpec404JSONResponse) VisitGetRenderedDeviceSpecResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRenderedDeviceSpec409JSONResponse Error
+
+func (response GetRenderedDeviceSpec409JSONResponse) VisitGetRenderedDeviceSpecResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadDeviceStatusRequestObject struct {
+	Name string `json:"name"`
+}
+
+type ReadDeviceStatusResponseObject interface {
+	VisitReadDeviceStatusResponse(w http.ResponseWriter) error
+}
+
+type ReadDeviceStatus200JSONResponse Device
+
+func (response ReadDeviceStatus200JSONResponse) VisitReadDeviceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadDeviceStatus401JSONResponse Error
 
-	return r
+func (response ReadDeviceStatus401JSONResponse) VisitReadDeviceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthConfigRequestObject struct {
+type ReadDeviceStatus404JSONResponse Error
+
+func (response ReadDeviceStatus404JSONResponse) VisitReadDeviceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthConfigResponseObject interface {
-	VisitAuthConfigResponse(w http.ResponseWriter) error
+type ReplaceDeviceStatusRequestObject struct {
+	Name string `json:"name"`
+	Body *ReplaceDeviceStatusJSONRequestBody
 }
 
-type AuthConfig200JSONResponse AuthConfig
+type ReplaceDeviceStatusResponseObject interface {
+	VisitReplaceDeviceStatusResponse(w http.ResponseWriter) error
+}
 
-func (response AuthConfig200JSONResponse) VisitAuthConfigResponse(w http.ResponseWriter) error {
+type ReplaceDeviceStatus200JSONResponse Device
+
+func (response ReplaceDeviceStatus200JSONResponse) VisitReplaceDeviceStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthConfig418Response struct {
+type ReplaceDeviceStatus400JSONResponse Error
+
+func (response ReplaceDeviceStatus400JSONResponse) VisitReplaceDeviceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response AuthConfig418Response) VisitAuthConfigResponse(w http.ResponseWriter) error {
-	w.WriteHeader(418)
-	return nil
+type ReplaceDeviceStatus401JSONResponse Error
+
+func (response ReplaceDeviceStatus401JSONResponse) VisitReplaceDeviceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthValidateRequestObject struct {
-	Params AuthValidateParams
+type ReplaceDeviceStatus404JSONResponse Error
+
+func (response ReplaceDeviceStatus404JSONResponse) VisitReplaceDeviceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthValidateResponseObject interface {
-	VisitAuthValidateResponse(w http.ResponseWriter) error
+type EnrollmentConfigRequestObject struct {
+	Name string `json:"name"`
 }
 
-type AuthValidate200Response struct {
+type EnrollmentConfigResponseObject interface {
+	VisitEnrollmentConfigResponse(w http.ResponseWriter) error
 }
 
-func (response AuthValidate200Response) VisitAuthValidateResponse(w http.ResponseWriter) error {
+type EnrollmentConfig200JSONResponse EnrollmentConfig
+
+func (response EnrollmentConfig200JSONResponse) VisitEnrollmentConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
-}
 
-type AuthValidate401Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response AuthValidate401Response) VisitAuthValidateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+type EnrollmentConfig400JSONResponse Error
 
-type AuthValidate418Response struct {
+func (response EnrollmentConfig400JSONResponse) VisitEnrollmentConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response AuthValidate418Response) VisitAuthValidateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(418)
-	return nil
+type EnrollmentConfig401JSONResponse Error
+
+func (response EnrollmentConfig401JSONResponse) VisitEnrollmentConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthValidate500JSONResponse Error
+type EnrollmentConfig404JSONResponse Error
 
-func (response AuthValidate500JSONResponse) VisitAuthValidateResponse(w http.ResponseWriter) error {
+func (response EnrollmentConfig404JSONResponse) VisitEnrollmentConfigResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCertificateSigningRequestsRequestObject struct {
+type DeleteEnrollmentPoliciesRequestObject struct {
 }
 
-type DeleteCertificateSigningRequestsResponseObject interface {
-	VisitDeleteCertificateSigningRequestsResponse(w http.ResponseWriter) error
+type DeleteEnrollmentPoliciesResponseObject interface {
+	VisitDeleteEnrollmentPoliciesResponse(w http.ResponseWriter) error
 }
 
-type DeleteCertificateSigningRequests200JSONResponse Status
+type DeleteEnrollmentPolicies200JSONResponse Status
 
-func (response DeleteCertificateSigningRequests200JSONResponse) VisitDeleteCertificateSigningRequestsResponse(w http.ResponseWriter) error {
+func (response DeleteEnrollmentPolicies200JSONResponse) VisitDeleteEnrollmentPoliciesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCertificateSigningRequests401JSONResponse Error
+type DeleteEnrollmentPolicies401JSONResponse Error
 
-func (response DeleteCertificateSigningRequests401JSONResponse) VisitDeleteCertificateSigningRequestsResponse(w http.ResponseWriter) error {
+func (response DeleteEnrollmentPolicies401JSONResponse) VisitDeleteEnrollmentPoliciesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCertificateSigningRequestsRequestObject struct {
-	Params ListCertificateSigningRequestsParams
+type ListEnrollmentPoliciesRequestObject struct {
+	Params ListEnrollmentPoliciesParams
 }
 
-type ListCertificateSigningRequestsResponseObject interface {
-	VisitListCertificateSigningRequestsResponse(w http.ResponseWriter) error
+type ListEnrollmentPoliciesResponseObject interface {
+	VisitListEnrollmentPoliciesResponse(w http.ResponseWriter) error
 }
 
-type ListCertificateSigningRequests200JSONResponse CertificateSigningRequestList
+type ListEnrollmentPolicies200JSONResponse EnrollmentPolicyList
 
-func (response ListCertificateSigningRequests200JSONResponse) VisitListCertificateSigningRequestsResponse(w http.ResponseWriter) error {
+func (response ListEnrollmentPolicies200JSONResponse) VisitListEnrollmentPoliciesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCertificateSigningRequests400JSONResponse Error
+type ListEnrollmentPolicies400JSONResponse Error
 
-func (response ListCertificateSigningRequests400JSONResponse) VisitListCertificateSigningRequestsResponse(w http.ResponseWriter) error {
+func (response ListEnrollmentPolicies400JSONResponse) VisitListEnrollmentPoliciesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCertificateSigningRequests401JSONResponse Error
+type ListEnrollmentPolicies401JSONResponse Error
 
-func (response ListCertificateSigningRequests401JSONResponse) VisitListCertificateSigningRequestsResponse(w http.ResponseWriter) error {
+func (response ListEnrollmentPolicies401JSONResponse) VisitListEnrollmentPoliciesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCertificateSigningRequestRequestObject struct {
-	Body *CreateCertificateSigningRequestJSONRequestBody
+type CreateEnrollmentPolicyRequestObject struct {
+	Body *CreateEnrollmentPolicyJSONRequestBody
 }
 
-type CreateCertificateSigningRequestResponseObject interface {
-	VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error
+type CreateEnrollmentPolicyResponseObject interface {
+	VisitCreateEnrollmentPolicyResponse(w http.ResponseWriter) error
 }
 
-type CreateCertificateSigningRequest200JSONResponse CertificateSigningRequest
+type CreateEnrollmentPolicy201JSONResponse EnrollmentPolicy
 
-func (response CreateCertificateSigningRequest200JSONResponse) VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response CreateEnrollmentPolicy201JSONResponse) VisitCreateEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCertificateSigningRequest201JSONResponse CertificateSigningRequest
+type CreateEnrollmentPolicy400JSONResponse Error
 
-func (response CreateCertificateSigningRequest201JSONResponse) VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response CreateEnrollmentPolicy400JSONResponse) VisitCreateEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCertificateSigningRequest202JSONResponse CertificateSigningRequest
+type CreateEnrollmentPolicy401JSONResponse Error
 
-func (response CreateCertificateSigningRequest202JSONResponse) VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response CreateEnrollmentPolicy401JSONResponse) VisitCreateEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCertificateSigningRequest208JSONResponse EnrollmentRequest
+type CreateEnrollmentPolicy409JSONResponse Error
 
-func (response CreateCertificateSigningRequest208JSONResponse) VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response CreateEnrollmentPolicy409JSONResponse) VisitCreateEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(208)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCertificateSigningRequest400JSONResponse Error
+type DeleteEnrollmentPolicyRequestObject struct {
+	Name string `json:"name"`
+}
 
-func (response CreateCertificateSigningRequest400JSONResponse) VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error {
+type DeleteEnrollmentPolicyResponseObject interface {
+	VisitDeleteEnrollmentPolicyResponse(w http.ResponseWriter) error
+}
+
+type DeleteEnrollmentPolicy200JSONResponse EnrollmentPolicy
+
+func (response DeleteEnrollmentPolicy200JSONResponse) VisitDeleteEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCertificateSigningRequest401JSONResponse Error
+type DeleteEnrollmentPolicy401JSONResponse Error
 
-func (response CreateCertificateSigningRequest401JSONResponse) VisitCreateCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response DeleteEnrollmentPolicy401JSONResponse) VisitDeleteEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCertificateSigningRequestRequestObject struct {
+type DeleteEnrollmentPolicy404JSONResponse Error
+
+func (response DeleteEnrollmentPolicy404JSONResponse) VisitDeleteEnrollmentPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReadEnrollmentPolicyRequestObject struct {
 	Name string `json:"name"`
 }
 
-type DeleteCertificateSigningRequestResponseObject interface {
-	VisitDeleteCertificateSigningRequestResponse(w http.ResponseWriter) error
+type ReadEnrollmentPolicyResponseObject interface {
+	VisitReadEnrollmentPolicyResponse(w http.ResponseWriter) error
 }
 
-type DeleteCertificateSigningRequest200JSONResponse CertificateSigningRequest
+type ReadEnrollmentPolicy200JSONResponse EnrollmentPolicy
 
-func (response DeleteCertificateSigningRequest200JSONResponse) VisitDeleteCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReadEnrollmentPolicy200JSONResponse) VisitReadEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCertificateSigningRequest401JSONResponse Error
+type ReadEnrollmentPolicy401JSONResponse Error
 
-func (response DeleteCertificateSigningRequest401JSONResponse) VisitDeleteCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReadEnrollmentPolicy401JSONResponse) VisitReadEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCertificateSigningRequest404JSONResponse Error
+type ReadEnrollmentPolicy404JSONResponse Error
 
-func (response DeleteCertificateSigningRequest404JSONResponse) VisitDeleteCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReadEnrollmentPolicy404JSONResponse) VisitReadEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadCertificateSigningRequestRequestObject struct {
+type PatchEnrollmentPolicyRequestObject struct {
 	Name string `json:"name"`
+	Body *PatchEnrollmentPolicyApplicationJSONPatchPlusJSONRequestBody
 }
 
-type ReadCertificateSigningRequestResponseObject interface {
-	VisitReadCertificateSigningRequestResponse(w http.ResponseWriter) error
+type PatchEnrollmentPolicyResponseObject interface {
+	VisitPatchEnrollmentPolicyResponse(w http.ResponseWriter) error
 }
 
-type ReadCertificateSigningRequest200JSONResponse CertificateSigningRequest
+type PatchEnrollmentPolicy200JSONResponse EnrollmentPolicy
 
-func (response ReadCertificateSigningRequest200JSONResponse) VisitReadCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response PatchEnrollmentPolicy200JSONResponse) VisitPatchEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadCertificateSigningRequest401JSONResponse Error
+type PatchEnrollmentPolicy400JSONResponse Error
 
-func (response ReadCertificateSigningRequest401JSONResponse) VisitReadCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response PatchEnrollmentPolicy400JSONResponse) VisitPatchEnrollmentPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchEnrollmentPolicy401JSONResponse Error
+
+func (response PatchEnrollmentPolicy401JSONResponse) VisitPatchEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadCertificateSigningRequest404JSONResponse Error
+type PatchEnrollmentPolicy404JSONResponse Error
 
-func (response ReadCertificateSigningRequest404JSONResponse) VisitReadCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response PatchEnrollmentPolicy404JSONResponse) VisitPatchEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchCertificateSigningRequestRequestObject struct {
+type PatchEnrollmentPolicy409JSONResponse Error
+
+func (response PatchEnrollmentPolicy409JSONResponse) VisitPatchEnrollmentPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceEnrollmentPolicyRequestObject struct {
 	Name string `json:"name"`
-	Body *PatchCertificateSigningRequestApplicationJSONPatchPlusJSONRequestBody
+	Body *ReplaceEnrollmentPolicyJSONRequestBody
 }
 
-type PatchCertificateSigningRequestResponseObject interface {
-	VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error
+type ReplaceEnrollmentPolicyResponseObject interface {
+	VisitReplaceEnrollmentPolicyResponse(w http.ResponseWriter) error
 }
 
-type PatchCertificateSigningRequest200JSONResponse CertificateSigningRequest
+type ReplaceEnrollmentPolicy200JSONResponse EnrollmentPolicy
 
-func (response PatchCertificateSigningRequest200JSONResponse) VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceEnrollmentPolicy200JSONResponse) VisitReplaceEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchCertificateSigningRequest201JSONResponse CertificateSigningRequest
+type ReplaceEnrollmentPolicy201JSONResponse EnrollmentPolicy
 
-func (response PatchCertificateSigningRequest201JSONResponse) VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceEnrollmentPolicy201JSONResponse) VisitReplaceEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchCertificateSigningRequest400JSONResponse Error
+type ReplaceEnrollmentPolicy400JSONResponse Error
 
-func (response PatchCertificateSigningRequest400JSONResponse) VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceEnrollmentPolicy400JSONResponse) VisitReplaceEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchCertificateSigningRequest401JSONResponse Error
+type ReplaceEnrollmentPolicy401JSONResponse Error
 
-func (response PatchCertificateSigningRequest401JSONResponse) VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceEnrollmentPolicy401JSONResponse) VisitReplaceEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchCertificateSigningRequest404JSONResponse Error
+type ReplaceEnrollmentPolicy404JSONResponse Error
 
-func (response PatchCertificateSigningRequest404JSONResponse) VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceEnrollmentPolicy404JSONResponse) VisitReplaceEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchCertificateSigningRequest409JSONResponse Error
+type ReplaceEnrollmentPolicy409JSONResponse Error
 
-func (response PatchCertificateSigningRequest409JSONResponse) VisitPatchCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceEnrollmentPolicy409JSONResponse) VisitReplaceEnrollmentPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceCertificateSigningRequestRequestObject struct {
-	Name string `json:"name"`
-	Body *ReplaceCertificateSigningRequestJSONRequestBody
+type DeleteEnrollmentRequestsRequestObject struct {
 }
 
-type ReplaceCertificateSigningRequestResponseObject interface {
-	VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error
+type DeleteEnrollmentRequestsResponseObject interface {
+	VisitDeleteEnrollmentRequestsResponse(w http.ResponseWriter) error
 }
 
-type ReplaceCertificateSigningRequest200JSONResponse CertificateSigningRequest
+type DeleteEnrollmentRequests200JSONResponse Status
 
-func (response ReplaceCertificateSigningRequest200JSONResponse) VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response DeleteEnrollmentRequests200JSONResponse) VisitDeleteEnrollmentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteEnrollmentRequests401JSONResponse Error
+
+func (response DeleteEnrollmentRequests401JSONResponse) VisitDeleteEnrollmentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEnrollmentRequestsRequestObject struct {
+	Params ListEnrollmentRequestsParams
+}
+
+type ListEnrollmentRequestsResponseObject interface {
+	VisitListEnrollmentRequestsResponse(w http.ResponseWriter) error
+}
+
+type ListEnrollmentRequests200JSONResponse EnrollmentRequestList
+
+func (response ListEnrollmentRequests200JSONResponse) VisitListEnrollmentRequestsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceCertificateSigningRequest201JSONResponse CertificateSigningRequest
+type ListEnrollmentRequests400JSONResponse Error
+
+func (response ListEnrollmentRequests400JSONResponse) VisitListEnrollmentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEnrollmentRequests401JSONResponse Error
+
+func (response ListEnrollmentRequests401JSONResponse) VisitListEnrollmentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEnrollmentRequestRequestObject struct {
+	Body *CreateEnrollmentRequestJSONRequestBody
+}
 
-func (response ReplaceCertificateSigningRequest201JSONResponse) VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error {
+type CreateEnrollmentRequestResponseObject interface {
+	VisitCreateEnrollmentRequestResponse(w http.ResponseWriter) error
+}
+
+type CreateEnrollmentRequest201JSONResponse EnrollmentRequest
+
+func (response CreateEnrollmentRequest201JSONResponse) VisitCreateEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceCertificateSigningRequest400JSONResponse Error
+type CreateEnrollmentRequest208JSONResponse EnrollmentRequest
 
-func (response ReplaceCertificateSigningRequest400JSONResponse) VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response CreateEnrollmentRequest208JSONResponse) VisitCreateEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(208)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceCertificateSigningRequest401JSONResponse Error
+type CreateEnrollmentRequest400JSONResponse Error
 
-func (response ReplaceCertificateSigningRequest401JSONResponse) VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response CreateEnrollmentRequest400JSONResponse) VisitCreateEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceCertificateSigningRequest404JSONResponse Error
+type CreateEnrollmentRequest401JSONResponse Error
 
-func (response ReplaceCertificateSigningRequest404JSONResponse) VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response CreateEnrollmentRequest401JSONResponse) VisitCreateEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceCertificateSigningRequest409JSONResponse Error
+type CreateEnrollmentRequest409JSONResponse Error
 
-func (response ReplaceCertificateSigningRequest409JSONResponse) VisitReplaceCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response CreateEnrollmentRequest409JSONResponse) VisitCreateEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DenyCertificateSigningRequestRequestObject struct {
+type DeleteEnrollmentRequestRequestObject struct {
 	Name string `json:"name"`
 }
 
-type DenyCertificateSigningRequestResponseObject interface {
-	VisitDenyCertificateSigningRequestResponse(w http.ResponseWriter) error
+type DeleteEnrollmentRequestResponseObject interface {
+	VisitDeleteEnrollmentRequestResponse(w http.ResponseWriter) error
 }
 
-type DenyCertificateSigningRequest200JSONResponse CertificateSigningRequest
+type DeleteEnrollmentRequest200JSONResponse EnrollmentRequest
 
-func (response DenyCertificateSigningRequest200JSONResponse) VisitDenyCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response DeleteEnrollmentRequest200JSONResponse) VisitDeleteEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DenyCertificateSigningRequest401JSONResponse Error
+type DeleteEnrollmentRequest401JSONResponse Error
 
-func (response DenyCertificateSigningRequest401JSONResponse) VisitDenyCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response DeleteEnrollmentRequest401JSONResponse) VisitDeleteEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DenyCertificateSigningRequest404JSONResponse Error
+type DeleteEnrollmentRequest404JSONResponse Error
 
-func (response DenyCertificateSigningRequest404JSONResponse) VisitDenyCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response DeleteEnrollmentRequest404JSONResponse) VisitDeleteEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DenyCertificateSigningRequest409JSONResponse Error
-
-func (response DenyCertificateSigningRequest409JSONResponse) VisitDenyCertificateSigningRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ApproveCertificateSigningRequestRequestObject struct {
+type ReadEnrollmentRequestRequestObject struct {
 	Name string `json:"name"`
 }
 
-type ApproveCertificateSigningRequestResponseObject interface {
-	VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error
+type ReadEnrollmentRequestResponseObject interface {
+	VisitReadEnrollmentRequestResponse(w http.ResponseWriter) error
 }
 
-type ApproveCertificateSigningRequest200JSONResponse CertificateSigningRequest
+type ReadEnrollmentRequest200JSONResponse EnrollmentRequest
 
-func (response ApproveCertificateSigningRequest200JSONResponse) VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReadEnrollmentRequest200JSONResponse) VisitReadEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApproveCertificateSigningRequest400JSONResponse Error
+type ReadEnrollmentRequest401JSONResponse Error
 
-func (response ApproveCertificateSigningRequest400JSONResponse) VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReadEnrollmentRequest401JSONResponse) VisitReadEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApproveCertificateSigningRequest401JSONResponse Error
+type ReadEnrollmentRequest404JSONResponse Error
 
-func (response ApproveCertificateSigningRequest401JSONResponse) VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReadEnrollmentRequest404JSONResponse) VisitReadEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApproveCertificateSigningRequest404JSONResponse Error
+type ReplaceEnrollmentRequestRequestObject struct {
+	Name string `json:"name"`
+	Body *ReplaceEnrollmentRequestJSONRequestBody
+}
 
-func (response ApproveCertificateSigningRequest404JSONResponse) VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error {
+type ReplaceEnrollmentRequestResponseObject interface {
+	VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error
+}
+
+type ReplaceEnrollmentRequest200JSONResponse EnrollmentRequest
+
+func (response ReplaceEnrollmentRequest200JSONResponse) VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApproveCertificateSigningRequest409JSONResponse Error
+type ReplaceEnrollmentRequest201JSONResponse EnrollmentRequest
 
-func (response ApproveCertificateSigningRequest409JSONResponse) VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceEnrollmentRequest201JSONResponse) VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApproveCertificateSigningRequest500JSONResponse Error
+type ReplaceEnrollmentRequest400JSONResponse Error
 
-func (response ApproveCertificateSigningRequest500JSONResponse) VisitApproveCertificateSigningRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceEnrollmentRequest400JSONResponse) VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDevicesRequestObject struct {
-}
+type ReplaceEnrollmentRequest401JSONResponse Error
 
-type DeleteDevicesResponseObject interface {
-	VisitDeleteDevicesResponse(w http.ResponseWriter) error
+func (response ReplaceEnrollmentRequest401JSONResponse) VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDevices200JSONResponse Status
+type ReplaceEnrollmentRequest404JSONResponse Error
 
-func (response DeleteDevices200JSONResponse) VisitDeleteDevicesResponse(w http.ResponseWriter) error {
+func (response ReplaceEnrollmentRequest404JSONResponse) VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDevices401JSONResponse Error
+type ReplaceEnrollmentRequest409JSONResponse Error
 
-func (response DeleteDevices401JSONResponse) VisitDeleteDevicesResponse(w http.ResponseWriter) error {
+func (response ReplaceEnrollmentRequest409JSONResponse) VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListDevicesRequestObject struct {
-	Params ListDevicesParams
+type ApproveEnrollmentRequestRequestObject struct {
+	Name string `json:"name"`
+	Body *ApproveEnrollmentRequestJSONRequestBody
 }
 
-type ListDevicesResponseObject interface {
-	VisitListDevicesResponse(w http.ResponseWriter) error
+type ApproveEnrollmentRequestResponseObject interface {
+	VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error
 }
 
-type ListDevices200JSONResponse DeviceList
+type ApproveEnrollmentRequest200JSONResponse EnrollmentRequestApproval
 
-func (response ListDevices200JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+func (response ApproveEnrollmentRequest200JSONResponse) VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListDevices400JSONResponse Error
+type ApproveEnrollmentRequest400JSONResponse Error
 
-func (response ListDevices400JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+func (response ApproveEnrollmentRequest400JSONResponse) VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListDevices401JSONResponse Error
+type ApproveEnrollmentRequest401JSONResponse Error
 
-func (response ListDevices401JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+func (response ApproveEnrollmentRequest401JSONResponse) VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListDevices403JSONResponse Error
+type ApproveEnrollmentRequest404JSONResponse Error
 
-func (response ListDevices403JSONResponse) VisitListDevicesResponse(w http.ResponseWriter) error {
+func (response ApproveEnrollmentRequest404JSONResponse) VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDeviceRequestObject struct {
-	Body *CreateDeviceJSONRequestBody
-}
+type ApproveEnrollmentRequest422JSONResponse Error
 
-type CreateDeviceResponseObject interface {
-	VisitCreateDeviceResponse(w http.ResponseWriter) error
+func (response ApproveEnrollmentRequest422JSONResponse) VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDevice201JSONResponse Device
+type ApproveEnrollmentRequest500JSONResponse Error
 
-func (response CreateDevice201JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+func (response ApproveEnrollmentRequest500JSONResponse) VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDevice400JSONResponse Error
+type ReadEnrollmentRequestStatusRequestObject struct {
+	Name string `json:"name"`
+}
 
-func (response CreateDevice400JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+type ReadEnrollmentRequestStatusResponseObject interface {
+	VisitReadEnrollmentRequestStatusResponse(w http.ResponseWriter) error
+}
+
+type ReadEnrollmentRequestStatus200JSONResponse EnrollmentRequest
+
+func (response ReadEnrollmentRequestStatus200JSONResponse) VisitReadEnrollmentRequestStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDevice401JSONResponse Error
+type ReadEnrollmentRequestStatus401JSONResponse Error
 
-func (response CreateDevice401JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+func (response ReadEnrollmentRequestStatus401JSONResponse) VisitReadEnrollmentRequestStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDevice409JSONResponse Error
+type ReadEnrollmentRequestStatus404JSONResponse Error
 
-func (response CreateDevice409JSONResponse) VisitCreateDeviceResponse(w http.ResponseWriter) error {
+func (response ReadEnrollmentRequestStatus404JSONResponse) VisitReadEnrollmentRequestStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDeviceRequestObject struct {
+type ReplaceEnrollmentRequestStatusRequestObject struct {
 	Name string `json:"name"`
+	Body *ReplaceEnrollmentRequestStatusJSONRequestBody
 }
 
-type DeleteDeviceResponseObject interface {
-	VisitDeleteDeviceResponse(w http.ResponseWriter) error
+type ReplaceEnrollmentRequestStatusResponseObject interface {
+	VisitReplaceEnrollmentRequestStatusResponse(w http.ResponseWriter) error
 }
 
-type DeleteDevice200JSONResponse Device
+type ReplaceEnrollmentRequestStatus200JSONResponse EnrollmentRequest
 
-func (response DeleteDevice200JSONResponse) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
+func (response ReplaceEnrollmentRequestStatus200JSONResponse) VisitReplaceEnrollmentRequestStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDevice401JSONResponse Error
+type ReplaceEnrollmentRequestStatus401JSONResponse Error
 
-func (response DeleteDevice401JSONResponse) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
+func (response ReplaceEnrollmentRequestStatus401JSONResponse) VisitReplaceEnrollmentRequestStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDevice404JSONResponse Error
+type ReplaceEnrollmentRequestStatus404JSONResponse Error
 
-func (response DeleteDevice404JSONResponse) VisitDeleteDeviceResponse(w http.ResponseWriter) error {
+func (response ReplaceEnrollmentRequestStatus404JSONResponse) VisitReplaceEnrollmentRequestStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadDeviceRequestObject struct {
+type AnswerEnrollmentRequestTPMChallengeRequestObject struct {
 	Name string `json:"name"`
+	Body *AnswerEnrollmentRequestTPMChallengeJSONRequestBody
 }
 
-type ReadDeviceResponseObject interface {
-	VisitReadDeviceResponse(w http.ResponseWriter) error
+type AnswerEnrollmentRequestTPMChallengeResponseObject interface {
+	VisitAnswerEnrollmentRequestTPMChallengeResponse(w http.ResponseWriter) error
 }
 
-type ReadDevice200JSONResponse Device
+type AnswerEnrollmentRequestTPMChallenge200JSONResponse EnrollmentRequest
 
-func (response ReadDevice200JSONResponse) VisitReadDeviceResponse(w http.ResponseWriter) error {
+func (response AnswerEnrollmentRequestTPMChallenge200JSONResponse) VisitAnswerEnrollmentRequestTPMChallengeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadDevice401JSONResponse Error
+type AnswerEnrollmentRequestTPMChallenge400JSONResponse Error
 
-func (response ReadDevice401JSONResponse) VisitReadDeviceResponse(w http.ResponseWriter) error {
+func (response AnswerEnrollmentRequestTPMChallenge400JSONResponse) VisitAnswerEnrollmentRequestTPMChallengeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnswerEnrollmentRequestTPMChallenge401JSONResponse Error
+
+func (response AnswerEnrollmentRequestTPMChallenge401JSONResponse) VisitAnswerEnrollmentRequestTPMChallengeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadDevice404JSONResponse Error
+type AnswerEnrollmentRequestTPMChallenge404JSONResponse Error
 
-func (response ReadDevice404JSONResponse) VisitReadDeviceResponse(w http.ResponseWriter) error {
+func (response AnswerEnrollmentRequestTPMChallenge404JSONResponse) VisitAnswerEnrollmentRequestTPMChallengeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchDeviceRequestObject struct {
-	Name string `json:"name"`
-	Body *PatchDeviceApplicationJSONPatchPlusJSONRequestBody
+type ListEventsRequestObject struct {
+	Params ListEventsParams
 }
 
-type PatchDeviceResponseObject interface {
-	VisitPatchDeviceResponse(w http.ResponseWriter) error
+type ListEventsResponseObject interface {
+	VisitListEventsResponse(w http.ResponseWriter) error
 }
 
-type PatchDevice200JSONResponse Device
+type ListEvents200JSONResponse EventList
 
-func (response PatchDevice200JSONResponse) VisitPatchDeviceResponse(w http.ResponseWriter) error {
+func (response ListEvents200JSONResponse) VisitListEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchDevice400JSONResponse Error
+type ListEvents400JSONResponse Error
 
-func (response PatchDevice400JSONResponse) VisitPatchDeviceResponse(w http.ResponseWriter) error {
+func (response ListEvents400JSONResponse) VisitListEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchDevice401JSONResponse Error
+type ListEvents401JSONResponse Error
 
-func (response PatchDevice401JSONResponse) VisitPatchDeviceResponse(w http.ResponseWriter) error {
+func (response ListEvents401JSONResponse) VisitListEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchDevice404JSONResponse Error
+type DeleteFleetsRequestObject struct {
+}
 
-func (response PatchDevice404JSONResponse) VisitPatchDeviceResponse(w http.ResponseWriter) error {
+type DeleteFleetsResponseObject interface {
+	VisitDeleteFleetsResponse(w http.ResponseWriter) error
+}
+
+type DeleteFleets200JSONResponse Status
+
+func (response DeleteFleets200JSONResponse) VisitDeleteFleetsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchDevice409JSONResponse Error
+type DeleteFleets401JSONResponse Error
 
-func (response PatchDevice409JSONResponse) VisitPatchDeviceResponse(w http.ResponseWriter) error {
+func (response DeleteFleets401JSONResponse) VisitDeleteFleetsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceDeviceRequestObject struct {
-	Name string `json:"name"`
-	Body *ReplaceDeviceJSONRequestBody
+type ListFleetsRequestObject struct {
+	Params ListFleetsParams
 }
 
-type ReplaceDeviceResponseObject interface {
-	VisitReplaceDeviceResponse(w http.ResponseWriter) error
+type ListFleetsResponseObject interface {
+	VisitListFleetsResponse(w http.ResponseWriter) error
 }
 
-type ReplaceDevice200JSONResponse Device
+type ListFleets200JSONResponse FleetList
 
-func (response ReplaceDevice200JSONResponse) VisitReplaceDeviceResponse(w http.ResponseWriter) error {
+func (response ListFleets200JSONResponse) VisitListFleetsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceDevice201JSONResponse Device
+type ListFleets400JSONResponse Error
 
-func (response ReplaceDevice201JSONResponse) VisitReplaceDeviceResponse(w http.ResponseWriter) error {
+func (response ListFleets400JSONResponse) VisitListFleetsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceDevice400JSONResponse Error
+type ListFleets401JSONResponse Error
 
-func (response ReplaceDevice400JSONResponse) VisitReplaceDeviceResponse(w http.ResponseWriter) error {
+func (response ListFleets401JSONResponse) VisitListFleetsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceDevice401JSONResponse Error
+type CreateFleetRequestObject struct {
+	Body *CreateFleetJSONRequestBody
+}
 
-func (response ReplaceDevice401JSONResponse) VisitReplaceDeviceResponse(w http.ResponseWriter) error {
+type CreateFleetResponseObject interface {
+	VisitCreateFleetResponse(w http.ResponseWriter) error
+}
+
+type CreateFleet201JSONResponse Fleet
+
+func (response CreateFleet201JSONResponse) VisitCreateFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceDevice404JSONResponse Error
+type CreateFleet400JSONResponse Error
 
-func (response ReplaceDevice404JSONResponse) VisitReplaceDeviceResponse(w http.ResponseWriter) error {
+func (response CreateFleet400JSONResponse) VisitCreateFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceDevice409JSONResponse Error
+type CreateFleet401JSONResponse Error
 
-func (response ReplaceDevice409JSONResponse) VisitReplaceDeviceResponse(w http.ResponseWriter) error {
+func (response CreateFleet401JSONResponse) VisitCreateFleetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateFleet409JSONResponse Error
+
+func (response CreateFleet409JSONResponse) VisitCreateFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RequestConsoleRequestObject struct {
-	Name string `json:"name"`
+type DeleteTemplateVersionsRequestObject struct {
+	Fleet string `json:"fleet"`
 }
 
-type RequestConsoleResponseObject interface {
-	VisitRequestConsoleResponse(w http.ResponseWriter) error
+type DeleteTemplateVersionsResponseObject interface {
+	VisitDeleteTemplateVersionsResponse(w http.ResponseWriter) error
 }
 
-type RequestConsole200JSONResponse DeviceConsole
+type DeleteTemplateVersions200JSONResponse Status
 
-func (response RequestConsole200JSONResponse) VisitRequestConsoleResponse(w http.ResponseWriter) error {
+func (response DeleteTemplateVersions200JSONResponse) VisitDeleteTemplateVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RequestConsole401JSONResponse Error
+type DeleteTemplateVersions401JSONResponse Error
 
-func (response RequestConsole401JSONResponse) VisitRequestConsoleResponse(w http.ResponseWriter) error {
+func (response DeleteTemplateVersions401JSONResponse) VisitDeleteTemplateVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RequestConsole404JSONResponse Error
-
-func (response RequestConsole404JSONResponse) VisitRequestConsoleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+type ListTemplateVersionsRequestObject struct {
+	Fleet  string `json:"fleet"`
+	Params ListTemplateVersionsParams
+}
 
-	return json.NewEncoder(w).Encode(response)
+type ListTemplateVersionsResponseObject interface {
+	VisitListTemplateVersionsResponse(w http.ResponseWriter) error
 }
 
-type RequestConsole409JSONResponse Error
+type ListTemplateVersions200JSONResponse TemplateVersionList
 
-func (response RequestConsole409JSONResponse) VisitRequestConsoleResponse(w http.ResponseWriter) error {
+func (response ListTemplateVersions200JSONResponse) VisitListTemplateVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRenderedDeviceSpecRequestObject struct {
-	Name   string `json:"name"`
-	Params GetRenderedDeviceSpecParams
-}
+type ListTemplateVersions400JSONResponse Error
 
-type GetRenderedDeviceSpecResponseObject interface {
-	VisitGetRenderedDeviceSpecResponse(w http.ResponseWriter) error
+func (response ListTemplateVersions400JSONResponse) VisitListTemplateVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRenderedDeviceSpec200JSONResponse RenderedDeviceSpec
+type ListTemplateVersions401JSONResponse Error
 
-func (response GetRenderedDeviceSpec200JSONResponse) VisitGetRenderedDeviceSpecResponse(w http.ResponseWriter) error {
+func (response ListTemplateVersions401JSONResponse) VisitListTemplateVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRenderedDeviceSpec204Response struct {
+type DeleteTemplateVersionRequestObject struct {
+	Fleet string `json:"fleet"`
+	Name  string `json:"name"`
 }
 
-func (response GetRenderedDeviceSpec204Response) VisitGetRenderedDeviceSpecResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type DeleteTemplateVersionResponseObject interface {
+	VisitDeleteTemplateVersionResponse(w http.ResponseWriter) error
 }
 
-type GetRenderedDeviceSpec401JSONResponse Error
+type DeleteTemplateVersion200JSONResponse TemplateVersion
 
-func (response GetRenderedDeviceSpec401JSONResponse) VisitGetRenderedDeviceSpecResponse(w http.ResponseWriter) error {
+func (response DeleteTemplateVersion200JSONResponse) VisitDeleteTemplateVersionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRenderedDeviceSpec404JSONResponse Error
+type DeleteTemplateVersion401JSONResponse Error
 
-func (response GetRenderedDeviceSpec404JSONResponse) VisitGetRenderedDeviceSpecResponse(w http.ResponseWriter) error {
+func (response DeleteTemplateVersion401JSONResponse) VisitDeleteTemplateVersionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRenderedDeviceSpec409JSONResponse Error
+type DeleteTemplateVersion404JSONResponse Error
 
-func (response GetRenderedDeviceSpec409JSONResponse) VisitGetRenderedDeviceSpecResponse(w http.ResponseWriter) error {
+func (response DeleteTemplateVersion404JSONResponse) VisitDeleteTemplateVersionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadDeviceStatusRequestObject struct {
-	Name string `json:"name"`
+type ReadTemplateVersionRequestObject struct {
+	Fleet string `json:"fleet"`
+	Name  string `json:"name"`
 }
 
-type ReadDeviceStatusResponseObject interface {
-	VisitReadDeviceStatusResponse(w http.ResponseWriter) error
+type ReadTemplateVersionResponseObject interface {
+	VisitReadTemplateVersionResponse(w http.ResponseWriter) error
 }
 
-type ReadDeviceStatus200JSONResponse Device
+type ReadTemplateVersion200JSONResponse TemplateVersion
 
-func (response ReadDeviceStatus200JSONResponse) VisitReadDeviceStatusResponse(w http.ResponseWriter) error {
+func (response ReadTemplateVersion200JSONResponse) VisitReadTemplateVersionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadDeviceStatus401JSONResponse Error
+type ReadTemplateVersion401JSONResponse Error
 
-func (response ReadDeviceStatus401JSONResponse) VisitReadDeviceStatusResponse(w http.ResponseWriter) error {
+func (response ReadTemplateVersion401JSONResponse) VisitReadTemplateVersionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadDeviceStatus404JSONResponse Error
+type ReadTemplateVersion404JSONResponse Error
 
-func (response ReadDeviceStatus404JSONResponse) VisitReadDeviceStatusResponse(w http.ResponseWriter) error {
+func (response ReadTemplateVersion404JSONResponse) VisitReadTemplateVersionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceDeviceStatusRequestObject struct {
+type DeleteFleetRequestObject struct {
 	Name string `json:"name"`
-	Body *ReplaceDeviceStatusJSONRequestBody
 }
 
-type ReplaceDeviceStatusResponseObject interface {
-	VisitReplaceDeviceStatusResponse(w http.ResponseWriter) error
+type DeleteFleetResponseObject interface {
+	VisitDeleteFleetResponse(w http.ResponseWriter) error
 }
 
-type ReplaceDeviceStatus200JSONResponse Device
+type DeleteFleet200JSONResponse Fleet
 
-func (response ReplaceDeviceStatus200JSONResponse) VisitReplaceDeviceStatusResponse(w http.ResponseWriter) error {
+func (response DeleteFleet200JSONResponse) VisitDeleteFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceDeviceStatus400JSONResponse Error
+type DeleteFleet401JSONResponse Error
 
-func (response ReplaceDeviceStatus400JSONResponse) VisitReplaceDeviceStatusResponse(w http.ResponseWriter) error {
+func (response DeleteFleet401JSONResponse) VisitDeleteFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceDeviceStatus401JSONResponse Error
+type DeleteFleet404JSONResponse Error
 
-func (response ReplaceDeviceStatus401JSONResponse) VisitReplaceDeviceStatusResponse(w http.ResponseWriter) error {
+func (response DeleteFleet404JSONResponse) VisitDeleteFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceDeviceStatus404JSONResponse Error
+type DeleteFleet409JSONResponse Error
 
-func (response ReplaceDeviceStatus404JSONResponse) VisitReplaceDeviceStatusResponse(w http.ResponseWriter) error {
+func (response DeleteFleet409JSONResponse) VisitDeleteFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EnrollmentConfigRequestObject struct {
-	Name string `json:"name"`
+type ReadFleetRequestObject struct {
+	Name   string `json:"name"`
+	Params ReadFleetParams
 }
 
-type EnrollmentConfigResponseObject interface {
-	VisitEnrollmentConfigResponse(w http.ResponseWriter) error
+type ReadFleetResponseObject interface {
+	VisitReadFleetResponse(w http.ResponseWriter) error
 }
 
-type EnrollmentConfig200JSONResponse EnrollmentConfig
+type ReadFleet200JSONResponse Fleet
 
-func (response EnrollmentConfig200JSONResponse) VisitEnrollmentConfigResponse(w http.ResponseWriter) error {
+func (response ReadFleet200JSONResponse) VisitReadFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EnrollmentConfig400JSONResponse Error
-
-func (response EnrollmentConfig400JSONResponse) VisitEnrollmentConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type EnrollmentConfig401JSONResponse Error
+type ReadFleet401JSONResponse Error
 
-func (response EnrollmentConfig401JSONResponse) VisitEnrollmentConfigResponse(w http.ResponseWriter) error {
+func (response ReadFleet401JSONResponse) VisitReadFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type EnrollmentConfig404JSONResponse Error
+type ReadFleet404JSONResponse Error
 
-func (response EnrollmentConfig404JSONResponse) VisitEnrollmentConfigResponse(w http.ResponseWriter) error {
+func (response ReadFleet404JSONResponse) VisitReadFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteEnrollmentRequestsRequestObject struct {
+type PatchFleetRequestObject struct {
+	Name string `json:"name"`
+	Body *PatchFleetApplicationJSONPatchPlusJSONRequestBody
 }
 
-type DeleteEnrollmentRequestsResponseObject interface {
-	VisitDeleteEnrollmentRequestsResponse(w http.ResponseWriter) error
+type PatchFleetResponseObject interface {
+	VisitPatchFleetResponse(w http.ResponseWriter) error
 }
 
-type DeleteEnrollmentRequests200JSONResponse Status
+type PatchFleet200JSONResponse Fleet
 
-func (response DeleteEnrollmentRequests200JSONResponse) VisitDeleteEnrollmentRequestsResponse(w http.ResponseWriter) error {
+func (response PatchFleet200JSONResponse) VisitPatchFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteEnrollmentRequests401JSONResponse Error
+type PatchFleet400JSONResponse Error
 
-func (response DeleteEnrollmentRequests401JSONResponse) VisitDeleteEnrollmentRequestsResponse(w http.ResponseWriter) error {
+func (response PatchFleet400JSONResponse) VisitPatchFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListEnrollmentRequestsRequestObject struct {
-	Params ListEnrollmentRequestsParams
-}
-
-type ListEnrollmentRequestsResponseObject interface {
-	VisitListEnrollmentRequestsResponse(w http.ResponseWriter) error
-}
-
-type ListEnrollmentRequests200JSONResponse EnrollmentRequestList
+type PatchFleet401JSONResponse Error
 
-func (response ListEnrollmentRequests200JSONResponse) VisitListEnrollmentRequestsResponse(w http.ResponseWriter) error {
+func (response PatchFleet401JSONResponse) VisitPatchFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListEnrollmentRequests400JSONResponse Error
+type PatchFleet404JSONResponse Error
 
-func (response ListEnrollmentRequests400JSONResponse) VisitListEnrollmentRequestsResponse(w http.ResponseWriter) error {
+func (response PatchFleet404JSONResponse) VisitPatchFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListEnrollmentRequests401JSONResponse Error
+type PatchFleet409JSONResponse Error
 
-func (response ListEnrollmentRequests401JSONResponse) VisitListEnrollmentRequestsResponse(w http.ResponseWriter) error {
+func (response PatchFleet409JSONResponse) VisitPatchFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateEnrollmentRequestRequestObject struct {
-	Body *CreateEnrollmentRequestJSONRequestBody
+type ReplaceFleetRequestObject struct {
+	Name string `json:"name"`
+	Body *ReplaceFleetJSONRequestBody
 }
 
-type CreateEnrollmentRequestResponseObject interface {
-	VisitCreateEnrollmentRequestResponse(w http.ResponseWriter) error
+type ReplaceFleetResponseObject interface {
+	VisitReplaceFleetResponse(w http.ResponseWriter) error
 }
 
-type CreateEnrollmentRequest201JSONResponse EnrollmentRequest
+type ReplaceFleet200JSONResponse Fleet
 
-func (response CreateEnrollmentRequest201JSONResponse) VisitCreateEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceFleet200JSONResponse) VisitReplaceFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateEnrollmentRequest208JSONResponse EnrollmentRequest
+type ReplaceFleet201JSONResponse Fleet
 
-func (response CreateEnrollmentRequest208JSONResponse) VisitCreateEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceFleet201JSONResponse) VisitReplaceFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(208)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateEnrollmentRequest400JSONResponse Error
+type ReplaceFleet400JSONResponse Error
 
-func (response CreateEnrollmentRequest400JSONResponse) VisitCreateEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceFleet400JSONResponse) VisitReplaceFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateEnrollmentRequest401JSONResponse Error
+type ReplaceFleet401JSONResponse Error
 
-func (response CreateEnrollmentRequest401JSONResponse) VisitCreateEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceFleet401JSONResponse) VisitReplaceFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateEnrollmentRequest409JSONResponse Error
+type ReplaceFleet404JSONResponse Error
 
-func (response CreateEnrollmentRequest409JSONResponse) VisitCreateEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceFleet404JSONResponse) VisitReplaceFleetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceFleet409JSONResponse Error
+
+func (response ReplaceFleet409JSONResponse) VisitReplaceFleetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteEnrollmentRequestRequestObject struct {
+type DryRunFleetOwnershipRequestObject struct {
 	Name string `json:"name"`
+	Body *DryRunFleetOwnershipJSONRequestBody
 }
 
-type DeleteEnrollmentRequestResponseObject interface {
-	VisitDeleteEnrollmentRequestResponse(w http.ResponseWriter) error
+type DryRunFleetOwnershipResponseObject interface {
+	VisitDryRunFleetOwnershipResponse(w http.ResponseWriter) error
 }
 
-type DeleteEnrollmentRequest200JSONResponse EnrollmentRequest
+type DryRunFleetOwnership200JSONResponse FleetOwnershipDryRun
 
-func (response DeleteEnrollmentRequest200JSONResponse) VisitDeleteEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response DryRunFleetOwnership200JSONResponse) VisitDryRunFleetOwnershipResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteEnrollmentRequest401JSONResponse Error
+type DryRunFleetOwnership400JSONResponse Error
 
-func (response DeleteEnrollmentRequest401JSONResponse) VisitDeleteEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response DryRunFleetOwnership400JSONResponse) VisitDryRunFleetOwnershipResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteEnrollmentRequest404JSONResponse Error
+type DryRunFleetOwnership401JSONResponse Error
 
-func (response DeleteEnrollmentRequest404JSONResponse) VisitDeleteEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response DryRunFleetOwnership401JSONResponse) VisitDryRunFleetOwnershipResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadEnrollmentRequestRequestObject struct {
+type ReadFleetStatusRequestObject struct {
 	Name string `json:"name"`
 }
 
-type ReadEnrollmentRequestResponseObject interface {
-	VisitReadEnrollmentRequestResponse(w http.ResponseWriter) error
+type ReadFleetStatusResponseObject interface {
+	VisitReadFleetStatusResponse(w http.ResponseWriter) error
 }
 
-type ReadEnrollmentRequest200JSONResponse EnrollmentRequest
+type ReadFleetStatus200JSONResponse Fleet
 
-func (response ReadEnrollmentRequest200JSONResponse) VisitReadEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ReadFleetStatus200JSONResponse) VisitReadFleetStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadEnrollmentRequest401JSONResponse Error
+type ReadFleetStatus401JSONResponse Error
 
-func (response ReadEnrollmentRequest401JSONResponse) VisitReadEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ReadFleetStatus401JSONResponse) VisitReadFleetStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadEnrollmentRequest404JSONResponse Error
+type ReadFleetStatus404JSONResponse Error
 
-func (response ReadEnrollmentRequest404JSONResponse) VisitReadEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ReadFleetStatus404JSONResponse) VisitReadFleetStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceEnrollmentRequestRequestObject struct {
+type ReplaceFleetStatusRequestObject struct {
 	Name string `json:"name"`
-	Body *ReplaceEnrollmentRequestJSONRequestBody
+	Body *ReplaceFleetStatusJSONRequestBody
 }
 
-type ReplaceEnrollmentRequestResponseObject interface {
-	VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error
+type ReplaceFleetStatusResponseObject interface {
+	VisitReplaceFleetStatusResponse(w http.ResponseWriter) error
 }
 
-type ReplaceEnrollmentRequest200JSONResponse EnrollmentRequest
+type ReplaceFleetStatus200JSONResponse Fleet
 
-func (response ReplaceEnrollmentRequest200JSONResponse) VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceFleetStatus200JSONResponse) VisitReplaceFleetStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceEnrollmentRequest201JSONResponse EnrollmentRequest
+type ReplaceFleetStatus401JSONResponse Error
 
-func (response ReplaceEnrollmentRequest201JSONResponse) VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceFleetStatus401JSONResponse) VisitReplaceFleetStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceEnrollmentRequest400JSONResponse Error
+type ReplaceFleetStatus404JSONResponse Error
 
-func (response ReplaceEnrollmentRequest400JSONResponse) VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ReplaceFleetStatus404JSONResponse) VisitReplaceFleetStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceEnrollmentRequest401JSONResponse Error
-
-func (response ReplaceEnrollmentRequest401JSONResponse) VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+type DeleteRepositoriesRequestObject struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+type DeleteRepositoriesResponseObject interface {
+	VisitDeleteRepositoriesResponse(w http.ResponseWriter) error
 }
 
-type ReplaceEnrollmentRequest404JSONResponse Error
+type DeleteRepositories200JSONResponse Status
 
-func (response ReplaceEnrollmentRequest404JSONResponse) VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response DeleteRepositories200JSONResponse) VisitDeleteRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceEnrollmentRequest409JSONResponse Error
+type DeleteRepositories401JSONResponse Error
 
-func (response ReplaceEnrollmentRequest409JSONResponse) VisitReplaceEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response DeleteRepositories401JSONResponse) VisitDeleteRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApproveEnrollmentRequestRequestObject struct {
-	Name string `json:"name"`
-	Body *ApproveEnrollmentRequestJSONRequestBody
+type ListRepositoriesRequestObject struct {
+	Params ListRepositoriesParams
 }
 
-type ApproveEnrollmentRequestResponseObject interface {
-	VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error
+type ListRepositoriesResponseObject interface {
+	VisitListRepositoriesResponse(w http.ResponseWriter) error
 }
 
-type ApproveEnrollmentRequest200JSONResponse EnrollmentRequestApproval
+type ListRepositories200JSONResponse RepositoryList
 
-func (response ApproveEnrollmentRequest200JSONResponse) VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ListRepositories200JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApproveEnrollmentRequest400JSONResponse Error
+type ListRepositories400JSONResponse Error
 
-func (response ApproveEnrollmentRequest400JSONResponse) VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ListRepositories400JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApproveEnrollmentRequest401JSONResponse Error
+type ListRepositories401JSONResponse Error
 
-func (response ApproveEnrollmentRequest401JSONResponse) VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response ListRepositories401JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ApproveEnrollmentRequest404JSONResponse Error
-
-func (response ApproveEnrollmentRequest404JSONResponse) VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
+type CreateRepositoryRequestObject struct {
+	Body *CreateRepositoryJSONRequestBody
 }
 
-type ApproveEnrollmentRequest422JSONResponse Error
-
-func (response ApproveEnrollmentRequest422JSONResponse) VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
-
-	return json.NewEncoder(w).Encode(response)
+type CreateRepositoryResponseObject interface {
+	VisitCreateRepositoryResponse(w http.ResponseWriter) error
 }
 
-type ApproveEnrollmentRequest500JSONResponse Error
+type CreateRepository201JSONResponse Repository
 
-func (response ApproveEnrollmentRequest500JSONResponse) VisitApproveEnrollmentRequestResponse(w http.ResponseWriter) error {
+func (response CreateRepository201JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadEnrollmentRequestStatusRequestObject struct {
-	Name string `json:"name"`
-}
-
-type ReadEnrollmentRequestStatusResponseObject interface {
-	VisitReadEnrollmentRequestStatusResponse(w http.ResponseWriter) error
-}
-
-type ReadEnrollmentRequestStatus200JSONResponse EnrollmentRequest
+type CreateRepository400JSONResponse Error
 
-func (response ReadEnrollmentRequestStatus200JSONResponse) VisitReadEnrollmentRequestStatusResponse(w http.ResponseWriter) error {
+func (response CreateRepository400JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadEnrollmentRequestStatus401JSONResponse Error
+type CreateRepository401JSONResponse Error
 
-func (response ReadEnrollmentRequestStatus401JSONResponse) VisitReadEnrollmentRequestStatusResponse(w http.ResponseWriter) error {
+func (response CreateRepository401JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadEnrollmentRequestStatus404JSONResponse Error
+type CreateRepository409JSONResponse Error
 
-func (response ReadEnrollmentRequestStatus404JSONResponse) VisitReadEnrollmentRequestStatusResponse(w http.ResponseWriter) error {
+func (response CreateRepository409JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceEnrollmentRequestStatusRequestObject struct {
+type DeleteRepositoryRequestObject struct {
 	Name string `json:"name"`
-	Body *ReplaceEnrollmentRequestStatusJSONRequestBody
 }
 
-type ReplaceEnrollmentRequestStatusResponseObject interface {
-	VisitReplaceEnrollmentRequestStatusResponse(w http.ResponseWriter) error
+type DeleteRepositoryResponseObject interface {
+	VisitDeleteRepositoryResponse(w http.ResponseWriter) error
 }
 
-type ReplaceEnrollmentRequestStatus200JSONResponse EnrollmentRequest
+type DeleteRepository200JSONResponse Repository
 
-func (response ReplaceEnrollmentRequestStatus200JSONResponse) VisitReplaceEnrollmentRequestStatusResponse(w http.ResponseWriter) error {
+func (response DeleteRepository200JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceEnrollmentRequestStatus401JSONResponse Error
+type DeleteRepository401JSONResponse Error
 
-func (response ReplaceEnrollmentRequestStatus401JSONResponse) VisitReplaceEnrollmentRequestStatusResponse(w http.ResponseWriter) error {
+func (response DeleteRepository401JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceEnrollmentRequestStatus404JSONResponse Error
+type DeleteRepository404JSONResponse Error
 
-func (response ReplaceEnrollmentRequestStatus404JSONResponse) VisitReplaceEnrollmentRequestStatusResponse(w http.ResponseWriter) error {
+func (response DeleteRepository404JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFleetsRequestObject struct {
-}
-
-type DeleteFleetsResponseObject interface {
-	VisitDeleteFleetsResponse(w http.ResponseWriter) error
-}
-
-type DeleteFleets200JSONResponse Status
-
-func (response DeleteFleets200JSONResponse) VisitDeleteFleetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type DeleteFleets401JSONResponse Error
-
-func (response DeleteFleets401JSONResponse) VisitDeleteFleetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ListFleetsRequestObject struct {
-	Params ListFleetsParams
+type ReadRepositoryRequestObject struct {
+	Name string `json:"name"`
 }
 
-type ListFleetsResponseObject interface {
-	VisitListFleetsResponse(w http.ResponseWriter) error
+type ReadRepositoryResponseObject interface {
+	VisitReadRepositoryResponse(w http.ResponseWriter) error
 }
 
-type ListFleets200JSONResponse FleetList
+type ReadRepository200JSONResponse Repository
 
-func (response ListFleets200JSONResponse) VisitListFleetsResponse(w http.ResponseWriter) error {
+func (response ReadRepository200JSONResponse) VisitReadRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListFleets400JSONResponse Error
+type ReadRepository401JSONResponse Error
 
-func (response ListFleets400JSONResponse) VisitListFleetsResponse(w http.ResponseWriter) error {
+func (response ReadRepository401JSONResponse) VisitReadRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListFleets401JSONResponse Error
+type ReadRepository404JSONResponse Error
 
-func (response ListFleets401JSONResponse) VisitListFleetsResponse(w http.ResponseWriter) error {
+func (response ReadRepository404JSONResponse) VisitReadRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateFleetRequestObject struct {
-	Body *CreateFleetJSONRequestBody
+type PatchRepositoryRequestObject struct {
+	Name string `json:"name"`
+	Body *PatchRepositoryApplicationJSONPatchPlusJSONRequestBody
 }
 
-type CreateFleetResponseObject interface {
-	VisitCreateFleetResponse(w http.ResponseWriter) error
+type PatchRepositoryResponseObject interface {
+	VisitPatchRepositoryResponse(w http.ResponseWriter) error
 }
 
-type CreateFleet201JSONResponse Fleet
+type PatchRepository200JSONResponse Repository
 
-func (response CreateFleet201JSONResponse) VisitCreateFleetResponse(w http.ResponseWriter) error {
+func (response PatchRepository200JSONResponse) VisitPatchRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateFleet400JSONResponse Error
+type PatchRepository400JSONResponse Error
 
-func (response CreateFleet400JSONResponse) VisitCreateFleetResponse(w http.ResponseWriter) error {
+func (response PatchRepository400JSONResponse) VisitPatchRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateFleet401JSONResponse Error
+type PatchRepository401JSONResponse Error
 
-func (response CreateFleet401JSONResponse) VisitCreateFleetResponse(w http.ResponseWriter) error {
+func (response PatchRepository401JSONResponse) VisitPatchRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateFleet409JSONResponse Error
+type PatchRepository404JSONResponse Error
 
-func (response CreateFleet409JSONResponse) VisitCreateFleetResponse(w http.ResponseWriter) error {
+func (response PatchRepository404JSONResponse) VisitPatchRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchRepository409JSONResponse Error
+
+func (response PatchRepository409JSONResponse) VisitPatchRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteTemplateVersionsRequestObject struct {
-	Fleet string `json:"fleet"`
+type ReplaceRepositoryRequestObject struct {
+	Name string `json:"name"`
+	Body *ReplaceRepositoryJSONRequestBody
 }
 
-type DeleteTemplateVersionsResponseObject interface {
-	VisitDeleteTemplateVersionsResponse(w http.ResponseWriter) error
+type ReplaceRepositoryResponseObject interface {
+	VisitReplaceRepositoryResponse(w http.ResponseWriter) error
 }
 
-type DeleteTemplateVersions200JSONResponse Status
+type ReplaceRepository200JSONResponse Repository
 
-func (response DeleteTemplateVersions200JSONResponse) VisitDeleteTemplateVersionsResponse(w http.ResponseWriter) error {
+func (response ReplaceRepository200JSONResponse) VisitReplaceRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteTemplateVersions401JSONResponse Error
+type ReplaceRepository201JSONResponse Repository
 
-func (response DeleteTemplateVersions401JSONResponse) VisitDeleteTemplateVersionsResponse(w http.ResponseWriter) error {
+func (response ReplaceRepository201JSONResponse) VisitReplaceRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListTemplateVersionsRequestObject struct {
-	Fleet  string `json:"fleet"`
-	Params ListTemplateVersionsParams
-}
+type ReplaceRepository400JSONResponse Error
 
-type ListTemplateVersionsResponseObject interface {
-	VisitListTemplateVersionsResponse(w http.ResponseWriter) error
+func (response ReplaceRepository400JSONResponse) VisitReplaceRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListTemplateVersions200JSONResponse TemplateVersionList
+type ReplaceRepository401JSONResponse Error
 
-func (response ListTemplateVersions200JSONResponse) VisitListTemplateVersionsResponse(w http.ResponseWriter) error {
+func (response ReplaceRepository401JSONResponse) VisitReplaceRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListTemplateVersions400JSONResponse Error
+type ReplaceRepository404JSONResponse Error
 
-func (response ListTemplateVersions400JSONResponse) VisitListTemplateVersionsResponse(w http.ResponseWriter) error {
+func (response ReplaceRepository404JSONResponse) VisitReplaceRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListTemplateVersions401JSONResponse Error
+type ReplaceRepository409JSONResponse Error
 
-func (response ListTemplateVersions401JSONResponse) VisitListTemplateVersionsResponse(w http.ResponseWriter) error {
+func (response ReplaceRepository409JSONResponse) VisitReplaceRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteTemplateVersionRequestObject struct {
-	Fleet string `json:"fleet"`
-	Name  string `json:"name"`
+type DeleteResourceSyncsRequestObject struct {
 }
 
-type DeleteTemplateVersionResponseObject interface {
-	VisitDeleteTemplateVersionResponse(w http.ResponseWriter) error
+type DeleteResourceSyncsResponseObject interface {
+	VisitDeleteResourceSyncsResponse(w http.ResponseWriter) error
 }
 
-type DeleteTemplateVersion200JSONResponse TemplateVersion
+type DeleteResourceSyncs200JSONResponse Status
 
-func (response DeleteTemplateVersion200JSONResponse) VisitDeleteTemplateVersionResponse(w http.ResponseWriter) error {
+func (response DeleteResourceSyncs200JSONResponse) VisitDeleteResourceSyncsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteTemplateVersion401JSONResponse Error
+type DeleteResourceSyncs401JSONResponse Error
 
-func (response DeleteTemplateVersion401JSONResponse) VisitDeleteTemplateVersionResponse(w http.ResponseWriter) error {
+func (response DeleteResourceSyncs401JSONResponse) VisitDeleteResourceSyncsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteTemplateVersion404JSONResponse Error
-
-func (response DeleteTemplateVersion404JSONResponse) VisitDeleteTemplateVersionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ReadTemplateVersionRequestObject struct {
-	Fleet string `json:"fleet"`
-	Name  string `json:"name"`
+type ListResourceSyncRequestObject struct {
+	Params ListResourceSyncParams
 }
 
-type ReadTemplateVersionResponseObject interface {
-	VisitReadTemplateVersionResponse(w http.ResponseWriter) error
+type ListResourceSyncResponseObject interface {
+	VisitListResourceSyncResponse(w http.ResponseWriter) error
 }
 
-type ReadTemplateVersion200JSONResponse TemplateVersion
+type ListResourceSync200JSONResponse ResourceSyncList
 
-func (response ReadTemplateVersion200JSONResponse) VisitReadTemplateVersionResponse(w http.ResponseWriter) error {
+func (response ListResourceSync200JSONResponse) VisitListResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadTemplateVersion401JSONResponse Error
+type ListResourceSync400JSONResponse Error
 
-func (response ReadTemplateVersion401JSONResponse) VisitReadTemplateVersionResponse(w http.ResponseWriter) error {
+func (response ListResourceSync400JSONResponse) VisitListResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadTemplateVersion404JSONResponse Error
+type ListResourceSync401JSONResponse Error
 
-func (response ReadTemplateVersion404JSONResponse) VisitReadTemplateVersionResponse(w http.ResponseWriter) error {
+func (response ListResourceSync401JSONResponse) VisitListResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFleetRequestObject struct {
-	Name string `json:"name"`
+type CreateResourceSyncRequestObject struct {
+	Body *CreateResourceSyncJSONRequestBody
 }
 
-type DeleteFleetResponseObject interface {
-	VisitDeleteFleetResponse(w http.ResponseWriter) error
+type CreateResourceSyncResponseObject interface {
+	VisitCreateResourceSyncResponse(w http.ResponseWriter) error
 }
 
-type DeleteFleet200JSONResponse Fleet
+type CreateResourceSync201JSONResponse ResourceSync
 
-func (response DeleteFleet200JSONResponse) VisitDeleteFleetResponse(w http.ResponseWriter) error {
+func (response CreateResourceSync201JSONResponse) VisitCreateResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFleet401JSONResponse Error
+type CreateResourceSync400JSONResponse Error
 
-func (response DeleteFleet401JSONResponse) VisitDeleteFleetResponse(w http.ResponseWriter) error {
+func (response CreateResourceSync400JSONResponse) VisitCreateResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFleet404JSONResponse Error
+type CreateResourceSync401JSONResponse Error
 
-func (response DeleteFleet404JSONResponse) VisitDeleteFleetResponse(w http.ResponseWriter) error {
+func (response CreateResourceSync401JSONResponse) VisitCreateResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteFleet409JSONResponse Error
+type CreateResourceSync409JSONResponse Error
 
-func (response DeleteFleet409JSONResponse) VisitDeleteFleetResponse(w http.ResponseWriter) error {
+func (response CreateResourceSync409JSONResponse) VisitCreateResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadFleetRequestObject struct {
-	Name   string `json:"name"`
-	Params ReadFleetParams
+type DeleteResourceSyncRequestObject struct {
+	Name string `json:"name"`
 }
 
-type ReadFleetResponseObject interface {
-	VisitReadFleetResponse(w http.ResponseWriter) error
+type DeleteResourceSyncResponseObject interface {
+	VisitDeleteResourceSyncResponse(w http.ResponseWriter) error
 }
 
-type ReadFleet200JSONResponse Fleet
+type DeleteResourceSync200JSONResponse ResourceSync
 
-func (response ReadFleet200JSONResponse) VisitReadFleetResponse(w http.ResponseWriter) error {
+func (response DeleteResourceSync200JSONResponse) VisitDeleteResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadFleet401JSONResponse Error
+type DeleteResourceSync401JSONResponse Error
 
-func (response ReadFleet401JSONResponse) VisitReadFleetResponse(w http.ResponseWriter) error {
+func (response DeleteResourceSync401JSONResponse) VisitDeleteResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadFleet404JSONResponse Error
+type DeleteResourceSync404JSONResponse Error
 
-func (response ReadFleet404JSONResponse) VisitReadFleetResponse(w http.ResponseWriter) error {
+func (response DeleteResourceSync404JSONResponse) VisitDeleteResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchFleetRequestObject struct {
+type ReadResourceSyncRequestObject struct {
 	Name string `json:"name"`
-	Body *PatchFleetApplicationJSONPatchPlusJSONRequestBody
 }
 
-type PatchFleetResponseObject interface {
-	VisitPatchFleetResponse(w http.ResponseWriter) error
+type ReadResourceSyncResponseObject interface {
+	VisitReadResourceSyncResponse(w http.ResponseWriter) error
 }
 
-type PatchFleet200JSONResponse Fleet
+type ReadResourceSync200JSONResponse ResourceSync
 
-func (response PatchFleet200JSONResponse) VisitPatchFleetResponse(w http.ResponseWriter) error {
+func (response ReadResourceSync200JSONResponse) VisitReadResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchFleet400JSONResponse Error
-
-func (response PatchFleet400JSONResponse) VisitPatchFleetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type PatchFleet401JSONResponse Error
+type ReadResourceSync401JSONResponse Error
 
-func (response PatchFleet401JSONResponse) VisitPatchFleetResponse(w http.ResponseWriter) error {
+func (response ReadResourceSync401JSONResponse) VisitReadResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchFleet404JSONResponse Error
-
-func (response PatchFleet404JSONResponse) VisitPatchFleetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type PatchFleet409JSONResponse Error
-
-func (response PatchFleet409JSONResponse) VisitPatchFleetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ReplaceFleetRequestObject struct {
-	Name string `json:"name"`
-	Body *ReplaceFleetJSONRequestBody
-}
-
-type ReplaceFleetResponseObject interface {
-	VisitReplaceFleetResponse(w http.ResponseWriter) error
-}
-
-type ReplaceFleet200JSONResponse Fleet
+type ReadResourceSync404JSONResponse Error
 
-func (response ReplaceFleet200JSONResponse) VisitReplaceFleetResponse(w http.ResponseWriter) error {
+func (response ReadResourceSync404JSONResponse) VisitReadResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceFleet201JSONResponse Fleet
+type PatchResourceSyncRequestObject struct {
+	Name string `json:"name"`
+	Body *PatchResourceSyncApplicationJSONPatchPlusJSONRequestBody
+}
 
-func (response ReplaceFleet201JSONResponse) VisitReplaceFleetResponse(w http.ResponseWriter) error {
+type PatchResourceSyncResponseObject interface {
+	VisitPatchResourceSyncResponse(w http.ResponseWriter) error
+}
+
+type PatchResourceSync200JSONResponse ResourceSync
+
+func (response PatchResourceSync200JSONResponse) VisitPatchResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceFleet400JSONResponse Error
+type PatchResourceSync400JSONResponse Error
 
-func (response ReplaceFleet400JSONResponse) VisitReplaceFleetResponse(w http.ResponseWriter) error {
+func (response PatchResourceSync400JSONResponse) VisitPatchResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceFleet401JSONResponse Error
+type PatchResourceSync401JSONResponse Error
 
-func (response ReplaceFleet401JSONResponse) VisitReplaceFleetResponse(w http.ResponseWriter) error {
+func (response PatchResourceSync401JSONResponse) VisitPatchResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceFleet404JSONResponse Error
+type PatchResourceSync404JSONResponse Error
 
-func (response ReplaceFleet404JSONResponse) VisitReplaceFleetResponse(w http.ResponseWriter) error {
+func (response PatchResourceSync404JSONResponse) VisitPatchResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceFleet409JSONResponse Error
+type PatchResourceSync409JSONResponse Error
 
-func (response ReplaceFleet409JSONResponse) VisitReplaceFleetResponse(w http.ResponseWriter) error {
+func (response PatchResourceSync409JSONResponse) VisitPatchResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadFleetStatusRequestObject struct {
+type ReplaceResourceSyncRequestObject struct {
 	Name string `json:"name"`
+	Body *ReplaceResourceSyncJSONRequestBody
 }
 
-type ReadFleetStatusResponseObject interface {
-	VisitReadFleetStatusResponse(w http.ResponseWriter) error
+type ReplaceResourceSyncResponseObject interface {
+	VisitReplaceResourceSyncResponse(w http.ResponseWriter) error
 }
 
-type ReadFleetStatus200JSONResponse Fleet
+type ReplaceResourceSync200JSONResponse ResourceSync
 
-func (response ReadFleetStatus200JSONResponse) VisitReadFleetStatusResponse(w http.ResponseWriter) error {
+func (response ReplaceResourceSync200JSONResponse) VisitReplaceResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadFleetStatus401JSONResponse Error
+type ReplaceResourceSync201JSONResponse ResourceSync
 
-func (response ReadFleetStatus401JSONResponse) VisitReadFleetStatusResponse(w http.ResponseWriter) error {
+func (response ReplaceResourceSync201JSONResponse) VisitReplaceResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadFleetStatus404JSONResponse Error
+type ReplaceResourceSync400JSONResponse Error
 
-func (response ReadFleetStatus404JSONResponse) VisitReadFleetStatusResponse(w http.ResponseWriter) error {
+func (response ReplaceResourceSync400JSONResponse) VisitReplaceResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceFleetStatusRequestObject struct {
-	Name string `json:"name"`
-	Body *ReplaceFleetStatusJSONRequestBody
-}
-
-type ReplaceFleetStatusResponseObject interface {
-	VisitReplaceFleetStatusResponse(w http.ResponseWriter) error
-}
-
-type ReplaceFleetStatus200JSONResponse Fleet
+type ReplaceResourceSync401JSONResponse Error
 
-func (response ReplaceFleetStatus200JSONResponse) VisitReplaceFleetStatusResponse(w http.ResponseWriter) error {
+func (response ReplaceResourceSync401JSONResponse) VisitReplaceResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceFleetStatus401JSONResponse Error
+type ReplaceResourceSync404JSONResponse Error
 
-func (response ReplaceFleetStatus401JSONResponse) VisitReplaceFleetStatusResponse(w http.ResponseWriter) error {
+func (response ReplaceResourceSync404JSONResponse) VisitReplaceResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceFleetStatus404JSONResponse Error
+type ReplaceResourceSync409JSONResponse Error
 
-func (response ReplaceFleetStatus404JSONResponse) VisitReplaceFleetStatusResponse(w http.ResponseWriter) error {
+func (response ReplaceResourceSync409JSONResponse) VisitReplaceResourceSyncResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRepositoriesRequestObject struct {
+type DeleteServiceAccountsRequestObject struct {
 }
 
-type DeleteRepositoriesResponseObject interface {
-	VisitDeleteRepositoriesResponse(w http.ResponseWriter) error
+type DeleteServiceAccountsResponseObject interface {
+	VisitDeleteServiceAccountsResponse(w http.ResponseWriter) error
 }
 
-type DeleteRepositories200JSONResponse Status
+type DeleteServiceAccounts200JSONResponse Status
 
-func (response DeleteRepositories200JSONResponse) VisitDeleteRepositoriesResponse(w http.ResponseWriter) error {
+func (response DeleteServiceAccounts200JSONResponse) VisitDeleteServiceAccountsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRepositories401JSONResponse Error
+type DeleteServiceAccounts401JSONResponse Error
 
-func (response DeleteRepositories401JSONResponse) VisitDeleteRepositoriesResponse(w http.ResponseWriter) error {
+func (response DeleteServiceAccounts401JSONResponse) VisitDeleteServiceAccountsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRepositoriesRequestObject struct {
-	Params ListRepositoriesParams
+type ListServiceAccountsRequestObject struct {
+	Params ListServiceAccountsParams
 }
 
-type ListRepositoriesResponseObject interface {
-	VisitListRepositoriesResponse(w http.ResponseWriter) error
+type ListServiceAccountsResponseObject interface {
+	VisitListServiceAccountsResponse(w http.ResponseWriter) error
 }
 
-type ListRepositories200JSONResponse RepositoryList
+type ListServiceAccounts200JSONResponse ServiceAccountList
 
-func (response ListRepositories200JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
+func (response ListServiceAccounts200JSONResponse) VisitListServiceAccountsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRepositories400JSONResponse Error
+type ListServiceAccounts400JSONResponse Error
 
-func (response ListRepositories400JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
+func (response ListServiceAccounts400JSONResponse) VisitListServiceAccountsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRepositories401JSONResponse Error
+type ListServiceAccounts401JSONResponse Error
 
-func (response ListRepositories401JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
+func (response ListServiceAccounts401JSONResponse) VisitListServiceAccountsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRepositoryRequestObject struct {
-	Body *CreateRepositoryJSONRequestBody
+type CreateServiceAccountRequestObject struct {
+	Body *CreateServiceAccountJSONRequestBody
 }
 
-type CreateRepositoryResponseObject interface {
-	VisitCreateRepositoryResponse(w http.ResponseWriter) error
+type CreateServiceAccountResponseObject interface {
+	VisitCreateServiceAccountResponse(w http.ResponseWriter) error
 }
 
-type CreateRepository201JSONResponse Repository
+type CreateServiceAccount201JSONResponse ServiceAccount
 
-func (response CreateRepository201JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
+func (response CreateServiceAccount201JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRepository400JSONResponse Error
+type CreateServiceAccount400JSONResponse Error
 
-func (response CreateRepository400JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
+func (response CreateServiceAccount400JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRepository401JSONResponse Error
+type CreateServiceAccount401JSONResponse Error
 
-func (response CreateRepository401JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
+func (response CreateServiceAccount401JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRepository409JSONResponse Error
+type CreateServiceAccount403JSONResponse Error
 
-func (response CreateRepository409JSONResponse) VisitCreateRepositoryResponse(w http.ResponseWriter) error {
+func (response CreateServiceAccount403JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount409JSONResponse Error
+
+func (response CreateServiceAccount409JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRepositoryRequestObject struct {
+type DeleteServiceAccountRequestObject struct {
 	Name string `json:"name"`
 }
 
-type DeleteRepositoryResponseObject interface {
-	VisitDeleteRepositoryResponse(w http.ResponseWriter) error
+type DeleteServiceAccountResponseObject interface {
+	VisitDeleteServiceAccountResponse(w http.ResponseWriter) error
 }
 
-type DeleteRepository200JSONResponse Repository
+type DeleteServiceAccount200JSONResponse ServiceAccount
 
-func (response DeleteRepository200JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+func (response DeleteServiceAccount200JSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRepository401JSONResponse Error
+type DeleteServiceAccount401JSONResponse Error
 
-func (response DeleteRepository401JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+func (response DeleteServiceAccount401JSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRepository404JSONResponse Error
+type DeleteServiceAccount404JSONResponse Error
 
-func (response DeleteRepository404JSONResponse) VisitDeleteRepositoryResponse(w http.ResponseWriter) error {
+func (response DeleteServiceAccount404JSONResponse) VisitDeleteServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadRepositoryRequestObject struct {
+type ReadServiceAccountRequestObject struct {
 	Name string `json:"name"`
 }
 
-type ReadRepositoryResponseObject interface {
-	VisitReadRepositoryResponse(w http.ResponseWriter) error
+type ReadServiceAccountResponseObject interface {
+	VisitReadServiceAccountResponse(w http.ResponseWriter) error
 }
 
-type ReadRepository200JSONResponse Repository
+type ReadServiceAccount200JSONResponse ServiceAccount
 
-func (response ReadRepository200JSONResponse) VisitReadRepositoryResponse(w http.ResponseWriter) error {
+func (response ReadServiceAccount200JSONResponse) VisitReadServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadRepository401JSONResponse Error
+type ReadServiceAccount401JSONResponse Error
 
-func (response ReadRepository401JSONResponse) VisitReadRepositoryResponse(w http.ResponseWriter) error {
+func (response ReadServiceAccount401JSONResponse) VisitReadServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReadRepository404JSONResponse Error
+type ReadServiceAccount404JSONResponse Error
 
-func (response ReadRepository404JSONResponse) VisitReadRepositoryResponse(w http.ResponseWriter) error {
+func (response ReadServiceAccount404JSONResponse) VisitReadServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchRepositoryRequestObject struct {
+type ReplaceServiceAccountRequestObject struct {
 	Name string `json:"name"`
-	Body *PatchRepositoryApplicationJSONPatchPlusJSONRequestBody
+	Body *ReplaceServiceAccountJSONRequestBody
 }
 
-type PatchRepositoryResponseObject interface {
-	VisitPatchRepositoryResponse(w http.ResponseWriter) error
+type ReplaceServiceAccountResponseObject interface {
+	VisitReplaceServiceAccountResponse(w http.ResponseWriter) error
 }
 
-type PatchRepository200JSONResponse Repository
+type ReplaceServiceAccount200JSONResponse ServiceAccount
 
-func (response PatchRepository200JSONResponse) VisitPatchRepositoryResponse(w http.ResponseWriter) error {
+func (response ReplaceServiceAccount200JSONResponse) VisitReplaceServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchRepository400JSONResponse Error
+type ReplaceServiceAccount201JSONResponse ServiceAccount
 
-func (response PatchRepository400JSONResponse) VisitPatchRepositoryResponse(w http.ResponseWriter) error {
+func (response ReplaceServiceAccount201JSONResponse) VisitReplaceServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceServiceAccount400JSONResponse Error
+
+func (response ReplaceServiceAccount400JSONResponse) VisitReplaceServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchRepository401JSONResponse Error
+type ReplaceServiceAccount401JSONResponse Error
 
-func (response PatchRepository401JSONResponse) VisitPatchRepositoryResponse(w http.ResponseWriter) error {
+func (response ReplaceServiceAccount401JSONResponse) VisitReplaceServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchRepository404JSONResponse Error
+type ReplaceServiceAccount403JSONResponse Error
 
-func (response PatchRepository404JSONResponse) VisitPatchRepositoryResponse(w http.ResponseWriter) error {
+func (response ReplaceServiceAccount403JSONResponse) VisitReplaceServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaceServiceAccount404JSONResponse Error
+
+func (response ReplaceServiceAccount404JSONResponse) VisitReplaceServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchRepository409JSONResponse Error
+type ReplaceServiceAccount409JSONResponse Error
 
-func (response PatchRepository409JSONResponse) VisitPatchRepositoryResponse(w http.ResponseWriter) error {
+func (response ReplaceServiceAccount409JSONResponse) VisitReplaceServiceAccountResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceRepositoryRequestObject struct {
+type CreateServiceAccountTokenRequestObject struct {
 	Name string `json:"name"`
-	Body *ReplaceRepositoryJSONRequestBody
+	Body *CreateServiceAccountTokenJSONRequestBody
 }
 
-type ReplaceRepositoryResponseObject interface {
-	VisitReplaceRepositoryResponse(w http.ResponseWriter) error
+type CreateServiceAccountTokenResponseObject interface {
+	VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error
 }
 
-type ReplaceRepository200JSONResponse Repository
+type CreateServiceAccountToken201JSONResponse ServiceAccountToken
 
-func (response ReplaceRepository200JSONResponse) VisitReplaceRepositoryResponse(w http.ResponseWriter) error {
+func (response CreateServiceAccountToken201JSONResponse) VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceRepository201JSONResponse Repository
+type CreateServiceAccountToken400JSONResponse Error
 
-func (response ReplaceRepository201JSONResponse) VisitReplaceRepositoryResponse(w http.ResponseWriter) error {
+func (response CreateServiceAccountToken400JSONResponse) VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceRepository400JSONResponse Error
+type CreateServiceAccountToken401JSONResponse Error
 
-func (response ReplaceRepository400JSONResponse) VisitReplaceRepositoryResponse(w http.ResponseWriter) error {
+func (response CreateServiceAccountToken401JSONResponse) VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceRepository401JSONResponse Error
+type CreateServiceAccountToken403JSONResponse Error
 
-func (response ReplaceRepository401JSONResponse) VisitReplaceRepositoryResponse(w http.ResponseWriter) error {
+func (response CreateServiceAccountToken403JSONResponse) VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceRepository404JSONResponse Error
+type CreateServiceAccountToken404JSONResponse Error
 
-func (response ReplaceRepository404JSONResponse) VisitReplaceRepositoryResponse(w http.ResponseWriter) error {
+func (response CreateServiceAccountToken404JSONResponse) VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReplaceRepository409JSONResponse Error
+type CreateServiceAccountToken409JSONResponse Error
 
-func (response ReplaceRepository409JSONResponse) VisitReplaceRepositoryResponse(w http.ResponseWriter) error {
+func (response CreateServiceAccountToken409JSONResponse) VisitCreateServiceAccountTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteResourceSyncsRequestObject struct {
+type DeleteServiceAccountTokenRequestObject struct {
+	Name  string `json:"name"`
+	Token string `json:"token"`
 }
 
-type DeleteResourceSyncsResponseObject interface {
-	VisitDeleteResourceSyncsResponse(w http.ResponseWriter) error
+type DeleteServiceAccountTokenResponseObject interface {
+	VisitDeleteServiceAccountTokenResponse(w http.ResponseWriter) error
 }
 
-type DeleteResourceSyncs200JSONResponse Status
+type DeleteServiceAccountToken200JSONResponse Status
 
-func (response DeleteResourceSyncs200JSONResponse) VisitDeleteResourceSyncsResponse(w http.ResponseWriter) error {
+func (response DeleteServiceAccountToken200JSONResponse) VisitDeleteServiceAccountTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteResourceSyncs401JSONResponse Error
+type DeleteServiceAccountToken401JSONResponse Error
 
-func (response DeleteResourceSyncs401JSONResponse) VisitDeleteResourceSyncsResponse(w http.ResponseWriter) error {
+func (response DeleteServiceAccountToken401JSONResponse) VisitDeleteServiceAccountTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListResourceSyncRequestObject struct {
-	Params ListResourceSyncParams
-}
-
-type ListResourceSyncResponseObject interface {
-	VisitListResourceSyncResponse(w http.ResponseWriter) error
-}
-
-type ListResourceSync200JSONResponse ResourceSyncList
+type DeleteServiceAccountToken404JSONResponse Error
 
-func (response ListResourceSync200JSONResponse) VisitListResourceSyncResponse(w http.ResponseWriter) error {
+func (response DeleteServiceAccountToken404JSONResponse) VisitDeleteServiceAccountTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListResourceSync400JSONResponse Error
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
 
-func (response ListResourceSync400JSONResponse) VisitListResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// (GET /api/v1/auditrecords)
+	ListAuditRecords(ctx context.Context, request ListAuditRecordsRequestObject) (ListAuditRecordsResponseObject, error)
+
+	// (GET /api/v1/auth/config)
+	AuthConfig(ctx context.Context, request AuthConfigRequestObject) (AuthConfigResponseObject, error)
+
+	// (GET /api/v1/auth/validate)
+	AuthValidate(ctx context.Context, request AuthValidateRequestObject) (AuthValidateResponseObject, error)
+
+	// (DELETE /api/v1/certificatesigningrequests)
+	DeleteCertificateSigningRequests(ctx context.Context, request DeleteCertificateSigningRequestsRequestObject) (DeleteCertificateSigningRequestsResponseObject, error)
+
+	// (GET /api/v1/certificatesigningrequests)
+	ListCertificateSigningRequests(ctx context.Context, request ListCertificateSigningRequestsRequestObject) (ListCertificateSigningRequestsResponseObject, error)
+
+	// (POST /api/v1/certificatesigningrequests)
+	CreateCertificateSigningRequest(ctx context.Context, request CreateCertificateSigningRequestRequestObject) (CreateCertificateSigningRequestResponseObject, error)
+
+	// (DELETE /api/v1/certificatesigningrequests/{name})
+	DeleteCertificateSigningRequest(ctx context.Context, request DeleteCertificateSigningRequestRequestObject) (DeleteCertificateSigningRequestResponseObject, error)
+
+	// (GET /api/v1/certificatesigningrequests/{name})
+	ReadCertificateSigningRequest(ctx context.Context, request ReadCertificateSigningRequestRequestObject) (ReadCertificateSigningRequestResponseObject, error)
+
+	// (PATCH /api/v1/certificatesigningrequests/{name})
+	PatchCertificateSigningRequest(ctx context.Context, request PatchCertificateSigningRequestRequestObject) (PatchCertificateSigningRequestResponseObject, error)
+
+	// (PUT /api/v1/certificatesigningrequests/{name})
+	ReplaceCertificateSigningRequest(ctx context.Context, request ReplaceCertificateSigningRequestRequestObject) (ReplaceCertificateSigningRequestResponseObject, error)
+
+	// (DELETE /api/v1/certificatesigningrequests/{name}/approval)
+	DenyCertificateSigningRequest(ctx context.Context, request DenyCertificateSigningRequestRequestObject) (DenyCertificateSigningRequestResponseObject, error)
+
+	// (POST /api/v1/certificatesigningrequests/{name}/approval)
+	ApproveCertificateSigningRequest(ctx context.Context, request ApproveCertificateSigningRequestRequestObject) (ApproveCertificateSigningRequestResponseObject, error)
+
+	// (DELETE /api/v1/devices)
+	DeleteDevices(ctx context.Context, request DeleteDevicesRequestObject) (DeleteDevicesResponseObject, error)
+
+	// (GET /api/v1/devices)
+	ListDevices(ctx context.Context, request ListDevicesRequestObject) (ListDevicesResponseObject, error)
+
+	// (POST /api/v1/devices)
+	CreateDevice(ctx context.Context, request CreateDeviceRequestObject) (CreateDeviceResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /api/v1/devices/{name})
+	DeleteDevice(ctx context.Context, request DeleteDeviceRequestObject) (DeleteDeviceResponseObject, error)
 
-type ListResourceSync401JSONResponse Error
+	// (GET /api/v1/devices/{name})
+	ReadDevice(ctx context.Context, request ReadDeviceRequestObject) (ReadDeviceResponseObject, error)
 
-func (response ListResourceSync401JSONResponse) VisitListResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// (PATCH /api/v1/devices/{name})
+	PatchDevice(ctx context.Context, request PatchDeviceRequestObject) (PatchDeviceResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (PUT /api/v1/devices/{name})
+	ReplaceDevice(ctx context.Context, request ReplaceDeviceRequestObject) (ReplaceDeviceResponseObject, error)
 
-type CreateResourceSyncRequestObject struct {
-	Body *CreateResourceSyncJSONRequestBody
-}
+	// (GET /api/v1/devices/{name}/bundle)
+	GetDeviceBundle(ctx context.Context, request GetDeviceBundleRequestObject) (GetDeviceBundleResponseObject, error)
 
-type CreateResourceSyncResponseObject interface {
-	VisitCreateResourceSyncResponse(w http.ResponseWriter) error
-}
+	// (POST /api/v1/devices/{name}/bundle)
+	RequestDeviceBundle(ctx context.Context, request RequestDeviceBundleRequestObject) (RequestDeviceBundleResponseObject, error)
 
-type CreateResourceSync201JSONResponse ResourceSync
+	// (GET /api/v1/devices/{name}/console)
+	RequestConsole(ctx context.Context, request RequestConsoleRequestObject) (RequestConsoleResponseObject, error)
 
-func (response CreateResourceSync201JSONResponse) VisitCreateResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// (GET /api/v1/devices/{name}/logs)
+	GetDeviceLogs(ctx context.Context, request GetDeviceLogsRequestObject) (GetDeviceLogsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/devices/{name}/overrides)
+	ReadDeviceOverrides(ctx context.Context, request ReadDeviceOverridesRequestObject) (ReadDeviceOverridesResponseObject, error)
 
-type CreateResourceSync400JSONResponse Error
+	// (PUT /api/v1/devices/{name}/overrides)
+	ReplaceDeviceOverrides(ctx context.Context, request ReplaceDeviceOverridesRequestObject) (ReplaceDeviceOverridesResponseObject, error)
 
-func (response CreateResourceSync400JSONResponse) VisitCreateResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// (GET /api/v1/devices/{name}/rendered)
+	GetRenderedDeviceSpec(ctx context.Context, request GetRenderedDeviceSpecRequestObject) (GetRenderedDeviceSpecResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/devices/{name}/status)
+	ReadDeviceStatus(ctx context.Context, request ReadDeviceStatusRequestObject) (ReadDeviceStatusResponseObject, error)
 
-type CreateResourceSync401JSONResponse Error
+	// (PUT /api/v1/devices/{name}/status)
+	ReplaceDeviceStatus(ctx context.Context, request ReplaceDeviceStatusRequestObject) (ReplaceDeviceStatusResponseObject, error)
 
-func (response CreateResourceSync401JSONResponse) VisitCreateResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// (GET /api/v1/enrollmentconfig/{name})
+	EnrollmentConfig(ctx context.Context, request EnrollmentConfigRequestObject) (EnrollmentConfigResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /api/v1/enrollmentpolicies)
+	DeleteEnrollmentPolicies(ctx context.Context, request DeleteEnrollmentPoliciesRequestObject) (DeleteEnrollmentPoliciesResponseObject, error)
 
-type CreateResourceSync409JSONResponse Error
+	// (GET /api/v1/enrollmentpolicies)
+	ListEnrollmentPolicies(ctx context.Context, request ListEnrollmentPoliciesRequestObject) (ListEnrollmentPoliciesResponseObject, error)
 
-func (response CreateResourceSync409JSONResponse) VisitCreateResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	// (POST /api/v1/enrollmentpolicies)
+	CreateEnrollmentPolicy(ctx context.Context, request CreateEnrollmentPolicyRequestObject) (CreateEnrollmentPolicyResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /api/v1/enrollmentpolicies/{name})
+	DeleteEnrollmentPolicy(ctx context.Context, request DeleteEnrollmentPolicyRequestObject) (DeleteEnrollmentPolicyResponseObject, error)
 
-type DeleteResourceSyncRequestObject struct {
-	Name string `json:"name"`
-}
+	// (GET /api/v1/enrollmentpolicies/{name})
+	ReadEnrollmentPolicy(ctx context.Context, request ReadEnrollmentPolicyRequestObject) (ReadEnrollmentPolicyResponseObject, error)
 
-type DeleteResourceSyncResponseObject interface {
-	VisitDeleteResourceSyncResponse(w http.ResponseWriter) error
-}
+	// (PATCH /api/v1/enrollmentpolicies/{name})
+	PatchEnrollmentPolicy(ctx context.Context, request PatchEnrollmentPolicyRequestObject) (PatchEnrollmentPolicyResponseObject, error)
 
-type DeleteResourceSync200JSONResponse ResourceSync
+	// (PUT /api/v1/enrollmentpolicies/{name})
+	ReplaceEnrollmentPolicy(ctx context.Context, request ReplaceEnrollmentPolicyRequestObject) (ReplaceEnrollmentPolicyResponseObject, error)
 
-func (response DeleteResourceSync200JSONResponse) VisitDeleteResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (DELETE /api/v1/enrollmentrequests)
+	DeleteEnrollmentRequests(ctx context.Context, request DeleteEnrollmentRequestsRequestObject) (DeleteEnrollmentRequestsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/enrollmentrequests)
+	ListEnrollmentRequests(ctx context.Context, request ListEnrollmentRequestsRequestObject) (ListEnrollmentRequestsResponseObject, error)
 
-type DeleteResourceSync401JSONResponse Error
+	// (POST /api/v1/enrollmentrequests)
+	CreateEnrollmentRequest(ctx context.Context, request CreateEnrollmentRequestRequestObject) (CreateEnrollmentRequestResponseObject, error)
 
-func (response DeleteResourceSync401JSONResponse) VisitDeleteResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// (DELETE /api/v1/enrollmentrequests/{name})
+	DeleteEnrollmentRequest(ctx context.Context, request DeleteEnrollmentRequestRequestObject) (DeleteEnrollmentRequestResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/enrollmentrequests/{name})
+	ReadEnrollmentRequest(ctx context.Context, request ReadEnrollmentRequestRequestObject) (ReadEnrollmentRequestResponseObject, error)
 
-type DeleteResourceSync404JSONResponse Error
+	// (PUT /api/v1/enrollmentrequests/{name})
+	ReplaceEnrollmentRequest(ctx context.Context, request ReplaceEnrollmentRequestRequestObject) (ReplaceEnrollmentRequestResponseObject, error)
 
-func (response DeleteResourceSync404JSONResponse) VisitDeleteResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// (POST /api/v1/enrollmentrequests/{name}/approval)
+	ApproveEnrollmentRequest(ctx context.Context, request ApproveEnrollmentRequestRequestObject) (ApproveEnrollmentRequestResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/enrollmentrequests/{name}/status)
+	ReadEnrollmentRequestStatus(ctx context.Context, request ReadEnrollmentRequestStatusRequestObject) (ReadEnrollmentRequestStatusResponseObject, error)
 
-type ReadResourceSyncRequestObject struct {
-	Name string `json:"name"`
-}
+	// (PUT /api/v1/enrollmentrequests/{name}/status)
+	ReplaceEnrollmentRequestStatus(ctx context.Context, request ReplaceEnrollmentRequestStatusRequestObject) (ReplaceEnrollmentRequestStatusResponseObject, error)
 
-type ReadResourceSyncResponseObject interface {
-	VisitReadResourceSyncResponse(w http.ResponseWriter) error
-}
+	// (POST /api/v1/enrollmentrequests/{name}/tpmchallenge)
+	AnswerEnrollmentRequestTPMChallenge(ctx context.Context, request AnswerEnrollmentRequestTPMChallengeRequestObject) (AnswerEnrollmentRequestTPMChallengeResponseObject, error)
 
-type ReadResourceSync200JSONResponse ResourceSync
+	// (GET /api/v1/events)
+	ListEvents(ctx context.Context, request ListEventsRequestObject) (ListEventsResponseObject, error)
 
-func (response ReadResourceSync200JSONResponse) VisitReadResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (DELETE /api/v1/fleets)
+	DeleteFleets(ctx context.Context, request DeleteFleetsRequestObject) (DeleteFleetsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/fleets)
+	ListFleets(ctx context.Context, request ListFleetsRequestObject) (ListFleetsResponseObject, error)
 
-type ReadResourceSync401JSONResponse Error
+	// (POST /api/v1/fleets)
+	CreateFleet(ctx context.Context, request CreateFleetRequestObject) (CreateFleetResponseObject, error)
 
-func (response ReadResourceSync401JSONResponse) VisitReadResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// (DELETE /api/v1/fleets/{fleet}/templateversions)
+	DeleteTemplateVersions(ctx context.Context, request DeleteTemplateVersionsRequestObject) (DeleteTemplateVersionsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/fleets/{fleet}/templateversions)
+	ListTemplateVersions(ctx context.Context, request ListTemplateVersionsRequestObject) (ListTemplateVersionsResponseObject, error)
 
-type ReadResourceSync404JSONResponse Error
+	// (DELETE /api/v1/fleets/{fleet}/templateversions/{name})
+	DeleteTemplateVersion(ctx context.Context, request DeleteTemplateVersionRequestObject) (DeleteTemplateVersionResponseObject, error)
 
-func (response ReadResourceSync404JSONResponse) VisitReadResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// (GET /api/v1/fleets/{fleet}/templateversions/{name})
+	ReadTemplateVersion(ctx context.Context, request ReadTemplateVersionRequestObject) (ReadTemplateVersionResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /api/v1/fleets/{name})
+	DeleteFleet(ctx context.Context, request DeleteFleetRequestObject) (DeleteFleetResponseObject, error)
 
-type PatchResourceSyncRequestObject struct {
-	Name string `json:"name"`
-	Body *PatchResourceSyncApplicationJSONPatchPlusJSONRequestBody
-}
+	// (GET /api/v1/fleets/{name})
+	ReadFleet(ctx context.Context, request ReadFleetRequestObject) (ReadFleetResponseObject, error)
 
-type PatchResourceSyncResponseObject interface {
-	VisitPatchResourceSyncResponse(w http.ResponseWriter) error
-}
+	// (PATCH /api/v1/fleets/{name})
+	PatchFleet(ctx context.Context, request PatchFleetRequestObject) (PatchFleetResponseObject, error)
 
-type PatchResourceSync200JSONResponse ResourceSync
+	// (PUT /api/v1/fleets/{name})
+	ReplaceFleet(ctx context.Context, request ReplaceFleetRequestObject) (ReplaceFleetResponseObject, error)
 
-func (response PatchResourceSync200JSONResponse) VisitPatchResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (POST /api/v1/fleets/{name}/ownershipdryrun)
+	DryRunFleetOwnership(ctx context.Context, request DryRunFleetOwnershipRequestObject) (DryRunFleetOwnershipResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/fleets/{name}/status)
+	ReadFleetStatus(ctx context.Context, request ReadFleetStatusRequestObject) (ReadFleetStatusResponseObject, error)
 
-type PatchResourceSync400JSONResponse Error
+	// (PUT /api/v1/fleets/{name}/status)
+	ReplaceFleetStatus(ctx context.Context, request ReplaceFleetStatusRequestObject) (ReplaceFleetStatusResponseObject, error)
 
-func (response PatchResourceSync400JSONResponse) VisitPatchResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// (DELETE /api/v1/repositories)
+	DeleteRepositories(ctx context.Context, request DeleteRepositoriesRequestObject) (DeleteRepositoriesResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/repositories)
+	ListRepositories(ctx context.Context, request ListRepositoriesRequestObject) (ListRepositoriesResponseObject, error)
 
-type PatchResourceSync401JSONResponse Error
+	// (POST /api/v1/repositories)
+	CreateRepository(ctx context.Context, request CreateRepositoryRequestObject) (CreateRepositoryResponseObject, error)
 
-func (response PatchResourceSync401JSONResponse) VisitPatchResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// (DELETE /api/v1/repositories/{name})
+	DeleteRepository(ctx context.Context, request DeleteRepositoryRequestObject) (DeleteRepositoryResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/repositories/{name})
+	ReadRepository(ctx context.Context, request ReadRepositoryRequestObject) (ReadRepositoryResponseObject, error)
 
-type PatchResourceSync404JSONResponse Error
+	// (PATCH /api/v1/repositories/{name})
+	PatchRepository(ctx context.Context, request PatchRepositoryRequestObject) (PatchRepositoryResponseObject, error)
 
-func (response PatchResourceSync404JSONResponse) VisitPatchResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// (PUT /api/v1/repositories/{name})
+	ReplaceRepository(ctx context.Context, request ReplaceRepositoryRequestObject) (ReplaceRepositoryResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /api/v1/resourcesyncs)
+	DeleteResourceSyncs(ctx context.Context, request DeleteResourceSyncsRequestObject) (DeleteResourceSyncsResponseObject, error)
 
-type PatchResourceSync409JSONResponse Error
+	// (GET /api/v1/resourcesyncs)
+	ListResourceSync(ctx context.Context, request ListResourceSyncRequestObject) (ListResourceSyncResponseObject, error)
 
-func (response PatchResourceSync409JSONResponse) VisitPatchResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	// (POST /api/v1/resourcesyncs)
+	CreateResourceSync(ctx context.Context, request CreateResourceSyncRequestObject) (CreateResourceSyncResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (DELETE /api/v1/resourcesyncs/{name})
+	DeleteResourceSync(ctx context.Context, request DeleteResourceSyncRequestObject) (DeleteResourceSyncResponseObject, error)
 
-type ReplaceResourceSyncRequestObject struct {
-	Name string `json:"name"`
-	Body *ReplaceResourceSyncJSONRequestBody
-}
+	// (GET /api/v1/resourcesyncs/{name})
+	ReadResourceSync(ctx context.Context, request ReadResourceSyncRequestObject) (ReadResourceSyncResponseObject, error)
 
-type ReplaceResourceSyncResponseObject interface {
-	VisitReplaceResourceSyncResponse(w http.ResponseWriter) error
-}
+	// (PATCH /api/v1/resourcesyncs/{name})
+	PatchResourceSync(ctx context.Context, request PatchResourceSyncRequestObject) (PatchResourceSyncResponseObject, error)
 
-type ReplaceResourceSync200JSONResponse ResourceSync
+	// (PUT /api/v1/resourcesyncs/{name})
+	ReplaceResourceSync(ctx context.Context, request ReplaceResourceSyncRequestObject) (ReplaceResourceSyncResponseObject, error)
 
-func (response ReplaceResourceSync200JSONResponse) VisitReplaceResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// (DELETE /api/v1/serviceaccounts)
+	DeleteServiceAccounts(ctx context.Context, request DeleteServiceAccountsRequestObject) (DeleteServiceAccountsResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/serviceaccounts)
+	ListServiceAccounts(ctx context.Context, request ListServiceAccountsRequestObject) (ListServiceAccountsResponseObject, error)
 
-type ReplaceResourceSync201JSONResponse ResourceSync
+	// (POST /api/v1/serviceaccounts)
+	CreateServiceAccount(ctx context.Context, request CreateServiceAccountRequestObject) (CreateServiceAccountResponseObject, error)
 
-func (response ReplaceResourceSync201JSONResponse) VisitReplaceResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// (DELETE /api/v1/serviceaccounts/{name})
+	DeleteServiceAccount(ctx context.Context, request DeleteServiceAccountRequestObject) (DeleteServiceAccountResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// (GET /api/v1/serviceaccounts/{name})
+	ReadServiceAccount(ctx context.Context, request ReadServiceAccountRequestObject) (ReadServiceAccountResponseObject, error)
 
-type ReplaceResourceSync400JSONResponse Error
+	// (PUT /api/v1/serviceaccounts/{name})
+	ReplaceServiceAccount(ctx context.Context, request ReplaceServiceAccountRequestObject) (ReplaceServiceAccountResponseObject, error)
 
-func (response ReplaceResourceSync400JSONResponse) VisitReplaceResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// (POST /api/v1/serviceaccounts/{name}/tokens)
+	CreateServiceAccountToken(ctx context.Context, request CreateServiceAccountTokenRequestObject) (CreateServiceAccountTokenResponseObject, error)
 
-	return json.NewEncoder(w).Encode(response)
+	// (DELETE /api/v1/serviceaccounts/{name}/tokens/{token})
+	DeleteServiceAccountToken(ctx context.Context, request DeleteServiceAccountTokenRequestObject) (DeleteServiceAccountTokenResponseObject, error)
 }
 
-type ReplaceResourceSync401JSONResponse Error
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
 
-func (response ReplaceResourceSync401JSONResponse) VisitReplaceResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
 
-	return json.NewEncoder(w).Encode(response)
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-type ReplaceResourceSync404JSONResponse Error
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
 
-func (response ReplaceResourceSync404JSONResponse) VisitReplaceResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// ListAuditRecords operation middleware
+func (sh *strictHandler) ListAuditRecords(w http.ResponseWriter, r *http.Request, params ListAuditRecordsParams) {
+	var request ListAuditRecordsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type ReplaceResourceSync409JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAuditRecords(ctx, request.(ListAuditRecordsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAuditRecords")
+	}
 
-func (response ReplaceResourceSync409JSONResponse) VisitReplaceResourceSyncResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAuditRecordsResponseObject); ok {
+		if err := validResponse.VisitListAuditRecordsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-
-	// (GET /api/v1/auth/config)
-	AuthConfig(ctx context.Context, request AuthConfigRequestObject) (AuthConfigResponseObject, error)
+// AuthConfig operation middleware
+func (sh *strictHandler) AuthConfig(w http.ResponseWriter, r *http.Request) {
+	var request AuthConfigRequestObject
 
-	// (GET /api/v1/auth/validate)
-	AuthValidate(ctx context.Context, request AuthValidateRequestObject) (AuthValidateResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthConfig(ctx, request.(AuthConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthConfig")
+	}
 
-	// (DELETE /api/v1/certificatesigningrequests)
-	DeleteCertificateSigningRequests(ctx context.Context, request DeleteCertificateSigningRequestsRequestObject) (DeleteCertificateSigningRequestsResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /api/v1/certificatesigningrequests)
-	ListCertificateSigningRequests(ctx context.Context, request ListCertificateSigningRequestsRequestObject) (ListCertificateSigningRequestsResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthConfigResponseObject); ok {
+		if err := validResponse.VisitAuthConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (POST /api/v1/certificatesigningrequests)
-	CreateCertificateSigningRequest(ctx context.Context, request CreateCertificateSigningRequestRequestObject) (CreateCertificateSigningRequestResponseObject, error)
+// AuthValidate operation middleware
+func (sh *strictHandler) AuthValidate(w http.ResponseWriter, r *http.Request, params AuthValidateParams) {
+	var request AuthValidateRequestObject
 
-	// (DELETE /api/v1/certificatesigningrequests/{name})
-	DeleteCertificateSigningRequest(ctx context.Context, request DeleteCertificateSigningRequestRequestObject) (DeleteCertificateSigningRequestResponseObject, error)
+	request.Params = params
 
-	// (GET /api/v1/certificatesigningrequests/{name})
-	ReadCertificateSigningRequest(ctx context.Context, request ReadCertificateSigningRequestRequestObject) (ReadCertificateSigningRequestResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthValidate(ctx, request.(AuthValidateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthValidate")
+	}
 
-	// (PATCH /api/v1/certificatesigningrequests/{name})
-	PatchCertificateSigningRequest(ctx context.Context, request PatchCertificateSigningRequestRequestObject) (PatchCertificateSigningRequestResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (PUT /api/v1/certificatesigningrequests/{name})
-	ReplaceCertificateSigningRequest(ctx context.Context, request ReplaceCertificateSigningRequestRequestObject) (ReplaceCertificateSigningRequestResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthValidateResponseObject); ok {
+		if err := validResponse.VisitAuthValidateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (DELETE /api/v1/certificatesigningrequests/{name}/approval)
-	DenyCertificateSigningRequest(ctx context.Context, request DenyCertificateSigningRequestRequestObject) (DenyCertificateSigningRequestResponseObject, error)
+// DeleteCertificateSigningRequests operation middleware
+func (sh *strictHandler) DeleteCertificateSigningRequests(w http.ResponseWriter, r *http.Request) {
+	var request DeleteCertificateSigningRequestsRequestObject
 
-	// (POST /api/v1/certificatesigningrequests/{name}/approval)
-	ApproveCertificateSigningRequest(ctx context.Context, request ApproveCertificateSigningRequestRequestObject) (ApproveCertificateSigningRequestResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCertificateSigningRequests(ctx, request.(DeleteCertificateSigningRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCertificateSigningRequests")
+	}
 
-	// (DELETE /api/v1/devices)
-	DeleteDevices(ctx context.Context, request DeleteDevicesRequestObject) (DeleteDevicesResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /api/v1/devices)
-	ListDevices(ctx context.Context, request ListDevicesRequestObject) (ListDevicesResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCertificateSigningRequestsResponseObject); ok {
+		if err := validResponse.VisitDeleteCertificateSigningRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (POST /api/v1/devices)
-	CreateDevice(ctx context.Context, request CreateDeviceRequestObject) (CreateDeviceResponseObject, error)
+// ListCertificateSigningRequests operation middleware
+func (sh *strictHandler) ListCertificateSigningRequests(w http.ResponseWriter, r *http.Request, params ListCertificateSigningRequestsParams) {
+	var request ListCertificateSigningRequestsRequestObject
 
-	// (DELETE /api/v1/devices/{name})
-	DeleteDevice(ctx context.Context, request DeleteDeviceRequestObject) (DeleteDeviceResponseObject, error)
+	request.Params = params
 
-	// (GET /api/v1/devices/{name})
-	ReadDevice(ctx context.Context, request ReadDeviceRequestObject) (ReadDeviceResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCertificateSigningRequests(ctx, request.(ListCertificateSigningRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCertificateSigningRequests")
+	}
 
-	// (PATCH /api/v1/devices/{name})
-	PatchDevice(ctx context.Context, request PatchDeviceRequestObject) (PatchDeviceResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (PUT /api/v1/devices/{name})
-	ReplaceDevice(ctx context.Context, request ReplaceDeviceRequestObject) (ReplaceDeviceResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCertificateSigningRequestsResponseObject); ok {
+		if err := validResponse.VisitListCertificateSigningRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (GET /api/v1/devices/{name}/console)
-	RequestConsole(ctx context.Context, request RequestConsoleRequestObject) (RequestConsoleResponseObject, error)
+// CreateCertificateSigningRequest operation middleware
+func (sh *strictHandler) CreateCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
+	var request CreateCertificateSigningRequestRequestObject
 
-	// (GET /api/v1/devices/{name}/rendered)
-	GetRenderedDeviceSpec(ctx context.Context, request GetRenderedDeviceSpecRequestObject) (GetRenderedDeviceSpecResponseObject, error)
+	var body CreateCertificateSigningRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// (GET /api/v1/devices/{name}/status)
-	ReadDeviceStatus(ctx context.Context, request ReadDeviceStatusRequestObject) (ReadDeviceStatusResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCertificateSigningRequest(ctx, request.(CreateCertificateSigningRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCertificateSigningRequest")
+	}
 
-	// (PUT /api/v1/devices/{name}/status)
-	ReplaceDeviceStatus(ctx context.Context, request ReplaceDeviceStatusRequestObject) (ReplaceDeviceStatusResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /api/v1/enrollmentconfig/{name})
-	EnrollmentConfig(ctx context.Context, request EnrollmentConfigRequestObject) (EnrollmentConfigResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCertificateSigningRequestResponseObject); ok {
+		if err := validResponse.VisitCreateCertificateSigningRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (DELETE /api/v1/enrollmentrequests)
-	DeleteEnrollmentRequests(ctx context.Context, request DeleteEnrollmentRequestsRequestObject) (DeleteEnrollmentRequestsResponseObject, error)
+// DeleteCertificateSigningRequest operation middleware
+func (sh *strictHandler) DeleteCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
+	var request DeleteCertificateSigningRequestRequestObject
 
-	// (GET /api/v1/enrollmentrequests)
-	ListEnrollmentRequests(ctx context.Context, request ListEnrollmentRequestsRequestObject) (ListEnrollmentRequestsResponseObject, error)
+	request.Name = name
 
-	// (POST /api/v1/enrollmentrequests)
-	CreateEnrollmentRequest(ctx context.Context, request CreateEnrollmentRequestRequestObject) (CreateEnrollmentRequestResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCertificateSigningRequest(ctx, request.(DeleteCertificateSigningRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCertificateSigningRequest")
+	}
 
-	// (DELETE /api/v1/enrollmentrequests/{name})
-	DeleteEnrollmentRequest(ctx context.Context, request DeleteEnrollmentRequestRequestObject) (DeleteEnrollmentRequestResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /api/v1/enrollmentrequests/{name})
-	ReadEnrollmentRequest(ctx context.Context, request ReadEnrollmentRequestRequestObject) (ReadEnrollmentRequestResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCertificateSigningRequestResponseObject); ok {
+		if err := validResponse.VisitDeleteCertificateSigningRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (PUT /api/v1/enrollmentrequests/{name})
-	ReplaceEnrollmentRequest(ctx context.Context, request ReplaceEnrollmentRequestRequestObject) (ReplaceEnrollmentRequestResponseObject, error)
+// ReadCertificateSigningRequest operation middleware
+func (sh *strictHandler) ReadCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
+	var request ReadCertificateSigningRequestRequestObject
 
-	// (POST /api/v1/enrollmentrequests/{name}/approval)
-	ApproveEnrollmentRequest(ctx context.Context, request ApproveEnrollmentRequestRequestObject) (ApproveEnrollmentRequestResponseObject, error)
+	request.Name = name
 
-	// (GET /api/v1/enrollmentrequests/{name}/status)
-	ReadEnrollmentRequestStatus(ctx context.Context, request ReadEnrollmentRequestStatusRequestObject) (ReadEnrollmentRequestStatusResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadCertificateSigningRequest(ctx, request.(ReadCertificateSigningRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadCertificateSigningRequest")
+	}
 
-	// (PUT /api/v1/enrollmentrequests/{name}/status)
-	ReplaceEnrollmentRequestStatus(ctx context.Context, request ReplaceEnrollmentRequestStatusRequestObject) (ReplaceEnrollmentRequestStatusResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (DELETE /api/v1/fleets)
-	DeleteFleets(ctx context.Context, request DeleteFleetsRequestObject) (DeleteFleetsResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReadCertificateSigningRequestResponseObject); ok {
+		if err := validResponse.VisitReadCertificateSigningRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (GET /api/v1/fleets)
-	ListFleets(ctx context.Context, request ListFleetsRequestObject) (ListFleetsResponseObject, error)
+// PatchCertificateSigningRequest operation middleware
+func (sh *strictHandler) PatchCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
+	var request PatchCertificateSigningRequestRequestObject
 
-	// (POST /api/v1/fleets)
-	CreateFleet(ctx context.Context, request CreateFleetRequestObject) (CreateFleetResponseObject, error)
+	request.Name = name
 
-	// (DELETE /api/v1/fleets/{fleet}/templateversions)
-	DeleteTemplateVersions(ctx context.Context, request DeleteTemplateVersionsRequestObject) (DeleteTemplateVersionsResponseObject, error)
+	var body PatchCertificateSigningRequestApplicationJSONPatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// (GET /api/v1/fleets/{fleet}/templateversions)
-	ListTemplateVersions(ctx context.Context, request ListTemplateVersionsRequestObject) (ListTemplateVersionsResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCertificateSigningRequest(ctx, request.(PatchCertificateSigningRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCertificateSigningRequest")
+	}
 
-	// (DELETE /api/v1/fleets/{fleet}/templateversions/{name})
-	DeleteTemplateVersion(ctx context.Context, request DeleteTemplateVersionRequestObject) (DeleteTemplateVersionResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /api/v1/fleets/{fleet}/templateversions/{name})
-	ReadTemplateVersion(ctx context.Context, request ReadTemplateVersionRequestObject) (ReadTemplateVersionResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchCertificateSigningRequestResponseObject); ok {
+		if err := validResponse.VisitPatchCertificateSigningRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (DELETE /api/v1/fleets/{name})
-	DeleteFleet(ctx context.Context, request DeleteFleetRequestObject) (DeleteFleetResponseObject, error)
+// ReplaceCertificateSigningRequest operation middleware
+func (sh *strictHandler) ReplaceCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
+	var request ReplaceCertificateSigningRequestRequestObject
 
-	// (GET /api/v1/fleets/{name})
-	ReadFleet(ctx context.Context, request ReadFleetRequestObject) (ReadFleetResponseObject, error)
+	request.Name = name
 
-	// (PATCH /api/v1/fleets/{name})
-	PatchFleet(ctx context.Context, request PatchFleetRequestObject) (PatchFleetResponseObject, error)
+	var body ReplaceCertificateSigningRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	// (PUT /api/v1/fleets/{name})
-	ReplaceFleet(ctx context.Context, request ReplaceFleetRequestObject) (ReplaceFleetResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaceCertificateSigningRequest(ctx, request.(ReplaceCertificateSigningRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaceCertificateSigningRequest")
+	}
 
-	// (GET /api/v1/fleets/{name}/status)
-	ReadFleetStatus(ctx context.Context, request ReadFleetStatusRequestObject) (ReadFleetStatusResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (PUT /api/v1/fleets/{name}/status)
-	ReplaceFleetStatus(ctx context.Context, request ReplaceFleetStatusRequestObject) (ReplaceFleetStatusResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReplaceCertificateSigningRequestResponseObject); ok {
+		if err := validResponse.VisitReplaceCertificateSigningRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (DELETE /api/v1/repositories)
-	DeleteRepositories(ctx context.Context, request DeleteRepositoriesRequestObject) (DeleteRepositoriesResponseObject, error)
+// DenyCertificateSigningRequest operation middleware
+func (sh *strictHandler) DenyCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
+	var request DenyCertificateSigningRequestRequestObject
 
-	// (GET /api/v1/repositories)
-	ListRepositories(ctx context.Context, request ListRepositoriesRequestObject) (ListRepositoriesResponseObject, error)
+	request.Name = name
 
-	// (POST /api/v1/repositories)
-	CreateRepository(ctx context.Context, request CreateRepositoryRequestObject) (CreateRepositoryResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DenyCertificateSigningRequest(ctx, request.(DenyCertificateSigningRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DenyCertificateSigningRequest")
+	}
 
-	// (DELETE /api/v1/repositories/{name})
-	DeleteRepository(ctx context.Context, request DeleteRepositoryRequestObject) (DeleteRepositoryResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (GET /api/v1/repositories/{name})
-	ReadRepository(ctx context.Context, request ReadRepositoryRequestObject) (ReadRepositoryResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DenyCertificateSigningRequestResponseObject); ok {
+		if err := validResponse.VisitDenyCertificateSigningRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (PATCH /api/v1/repositories/{name})
-	PatchRepository(ctx context.Context, request PatchRepositoryRequestObject) (PatchRepositoryResponseObject, error)
+// ApproveCertificateSigningRequest operation middleware
+func (sh *strictHandler) ApproveCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
+	var request ApproveCertificateSigningRequestRequestObject
 
-	// (PUT /api/v1/repositories/{name})
-	ReplaceRepository(ctx context.Context, request ReplaceRepositoryRequestObject) (ReplaceRepositoryResponseObject, error)
+	request.Name = name
 
-	// (DELETE /api/v1/resourcesyncs)
-	DeleteResourceSyncs(ctx context.Context, request DeleteResourceSyncsRequestObject) (DeleteResourceSyncsResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveCertificateSigningRequest(ctx, request.(ApproveCertificateSigningRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveCertificateSigningRequest")
+	}
 
-	// (GET /api/v1/resourcesyncs)
-	ListResourceSync(ctx context.Context, request ListResourceSyncRequestObject) (ListResourceSyncResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (POST /api/v1/resourcesyncs)
-	CreateResourceSync(ctx context.Context, request CreateResourceSyncRequestObject) (CreateResourceSyncResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApproveCertificateSigningRequestResponseObject); ok {
+		if err := validResponse.VisitApproveCertificateSigningRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (DELETE /api/v1/resourcesyncs/{name})
-	DeleteResourceSync(ctx context.Context, request DeleteResourceSyncRequestObject) (DeleteResourceSyncResponseObject, error)
+// DeleteDevices operation middleware
+func (sh *strictHandler) DeleteDevices(w http.ResponseWriter, r *http.Request) {
+	var request DeleteDevicesRequestObject
 
-	// (GET /api/v1/resourcesyncs/{name})
-	ReadResourceSync(ctx context.Context, request ReadResourceSyncRequestObject) (ReadResourceSyncResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDevices(ctx, request.(DeleteDevicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDevices")
+	}
 
-	// (PATCH /api/v1/resourcesyncs/{name})
-	PatchResourceSync(ctx context.Context, request PatchResourceSyncRequestObject) (PatchResourceSyncResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
 
-	// (PUT /api/v1/resourcesyncs/{name})
-	ReplaceResourceSync(ctx context.Context, request ReplaceResourceSyncRequestObject) (ReplaceResourceSyncResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteDevicesResponseObject); ok {
+		if err := validResponse.VisitDeleteDevicesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// ListDevices operation middleware
+func (sh *strictHandler) ListDevices(w http.ResponseWriter, r *http.Request, params ListDevicesParams) {
+	var request ListDevicesRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDevices(ctx, request.(ListDevicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDevices")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDevicesResponseObject); ok {
+		if err := validResponse.VisitListDevicesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// AuthConfig operation middleware
-func (sh *strictHandler) AuthConfig(w http.ResponseWriter, r *http.Request) {
-	var request AuthConfigRequestObject
+// CreateDevice operation middleware
+func (sh *strictHandler) CreateDevice(w http.ResponseWriter, r *http.Request) {
+	var request CreateDeviceRequestObject
+
+	var body CreateDeviceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthConfig(ctx, request.(AuthConfigRequestObject))
+		return sh.ssi.CreateDevice(ctx, request.(CreateDeviceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthConfig")
+		handler = middleware(handler, "CreateDevice")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthConfigResponseObject); ok {
-		if err := validResponse.VisitAuthConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateDeviceResponseObject); ok {
+		if err := validResponse.VisitCreateDeviceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5307,25 +7850,25 @@ func (sh *strictHandler) AuthConfig(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// AuthValidate operation middleware
-func (sh *strictHandler) AuthValidate(w http.ResponseWriter, r *http.Request, params AuthValidateParams) {
-	var request AuthValidateRequestObject
+// DeleteDevice operation middleware
+func (sh *strictHandler) DeleteDevice(w http.ResponseWriter, r *http.Request, name string) {
+	var request DeleteDeviceRequestObject
 
-	request.Params = params
+	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthValidate(ctx, request.(AuthValidateRequestObject))
+		return sh.ssi.DeleteDevice(ctx, request.(DeleteDeviceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthValidate")
+		handler = middleware(handler, "DeleteDevice")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthValidateResponseObject); ok {
-		if err := validResponse.VisitAuthValidateResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteDeviceResponseObject); ok {
+		if err := validResponse.VisitDeleteDeviceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5333,23 +7876,25 @@ func (sh *strictHandler) AuthValidate(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// DeleteCertificateSigningRequests operation middleware
-func (sh *strictHandler) DeleteCertificateSigningRequests(w http.ResponseWriter, r *http.Request) {
-	var request DeleteCertificateSigningRequestsRequestObject
+// ReadDevice operation middleware
+func (sh *strictHandler) ReadDevice(w http.ResponseWriter, r *http.Request, name string) {
+	var request ReadDeviceRequestObject
+
+	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCertificateSigningRequests(ctx, request.(DeleteCertificateSigningRequestsRequestObject))
+		return sh.ssi.ReadDevice(ctx, request.(ReadDeviceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCertificateSigningRequests")
+		handler = middleware(handler, "ReadDevice")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCertificateSigningRequestsResponseObject); ok {
-		if err := validResponse.VisitDeleteCertificateSigningRequestsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReadDeviceResponseObject); ok {
+		if err := validResponse.VisitReadDeviceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5357,25 +7902,32 @@ func (sh *strictHandler) DeleteCertificateSigningRequests(w http.ResponseWriter,
 	}
 }
 
-// ListCertificateSigningRequests operation middleware
-func (sh *strictHandler) ListCertificateSigningRequests(w http.ResponseWriter, r *http.Request, params ListCertificateSigningRequestsParams) {
-	var request ListCertificateSigningRequestsRequestObject
+// PatchDevice operation middleware
+func (sh *strictHandler) PatchDevice(w http.ResponseWriter, r *http.Request, name string) {
+	var request PatchDeviceRequestObject
 
-	request.Params = params
+	request.Name = name
+
+	var body PatchDeviceApplicationJSONPatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCertificateSigningRequests(ctx, request.(ListCertificateSigningRequestsRequestObject))
+		return sh.ssi.PatchDevice(ctx, request.(PatchDeviceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCertificateSigningRequests")
+		handler = middleware(handler, "PatchDevice")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCertificateSigningRequestsResponseObject); ok {
-		if err := validResponse.VisitListCertificateSigningRequestsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchDeviceResponseObject); ok {
+		if err := validResponse.VisitPatchDeviceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5383,11 +7935,13 @@ func (sh *strictHandler) ListCertificateSigningRequests(w http.ResponseWriter, r
 	}
 }
 
-// CreateCertificateSigningRequest operation middleware
-func (sh *strictHandler) CreateCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
-	var request CreateCertificateSigningRequestRequestObject
+// ReplaceDevice operation middleware
+func (sh *strictHandler) ReplaceDevice(w http.ResponseWriter, r *http.Request, name string) {
+	var request ReplaceDeviceRequestObject
 
-	var body CreateCertificateSigningRequestJSONRequestBody
+	request.Name = name
+
+	var body ReplaceDeviceJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5395,18 +7949,18 @@ func (sh *strictHandler) CreateCertificateSigningRequest(w http.ResponseWriter,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCertificateSigningRequest(ctx, request.(CreateCertificateSigningRequestRequestObject))
+		return sh.ssi.ReplaceDevice(ctx, request.(ReplaceDeviceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCertificateSigningRequest")
+		handler = middleware(handler, "ReplaceDevice")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCertificateSigningRequestResponseObject); ok {
-		if err := validResponse.VisitCreateCertificateSigningRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReplaceDeviceResponseObject); ok {
+		if err := validResponse.VisitReplaceDeviceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5414,25 +7968,25 @@ func (sh *strictHandler) CreateCertificateSigningRequest(w http.ResponseWriter,
 	}
 }
 
-// DeleteCertificateSigningRequest operation middleware
-func (sh *strictHandler) DeleteCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
-	var request DeleteCertificateSigningRequestRequestObject
+// GetDeviceBundle operation middleware
+func (sh *strictHandler) GetDeviceBundle(w http.ResponseWriter, r *http.Request, name string) {
+	var request GetDeviceBundleRequestObject
 
 	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCertificateSigningRequest(ctx, request.(DeleteCertificateSigningRequestRequestObject))
+		return sh.ssi.GetDeviceBundle(ctx, request.(GetDeviceBundleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCertificateSigningRequest")
+		handler = middleware(handler, "GetDeviceBundle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCertificateSigningRequestResponseObject); ok {
-		if err := validResponse.VisitDeleteCertificateSigningRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetDeviceBundleResponseObject); ok {
+		if err := validResponse.VisitGetDeviceBundleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5440,25 +7994,25 @@ func (sh *strictHandler) DeleteCertificateSigningRequest(w http.ResponseWriter,
 	}
 }
 
-// ReadCertificateSigningRequest operation middleware
-func (sh *strictHandler) ReadCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
-	var request ReadCertificateSigningRequestRequestObject
+// RequestDeviceBundle operation middleware
+func (sh *strictHandler) RequestDeviceBundle(w http.ResponseWriter, r *http.Request, name string) {
+	var request RequestDeviceBundleRequestObject
 
 	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ReadCertificateSigningRequest(ctx, request.(ReadCertificateSigningRequestRequestObject))
+		return sh.ssi.RequestDeviceBundle(ctx, request.(RequestDeviceBundleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReadCertificateSigningRequest")
+		handler = middleware(handler, "RequestDeviceBundle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ReadCertificateSigningRequestResponseObject); ok {
-		if err := validResponse.VisitReadCertificateSigningRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(RequestDeviceBundleResponseObject); ok {
+		if err := validResponse.VisitRequestDeviceBundleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5466,32 +8020,25 @@ func (sh *strictHandler) ReadCertificateSigningRequest(w http.ResponseWriter, r
 	}
 }
 
-// PatchCertificateSigningRequest operation middleware
-func (sh *strictHandler) PatchCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
-	var request PatchCertificateSigningRequestRequestObject
+// RequestConsole operation middleware
+func (sh *strictHandler) RequestConsole(w http.ResponseWriter, r *http.Request, name string) {
+	var request RequestConsoleRequestObject
 
 	request.Name = name
 
-	var body PatchCertificateSigningRequestApplicationJSONPatchPlusJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchCertificateSigningRequest(ctx, request.(PatchCertificateSigningRequestRequestObject))
+		return sh.ssi.RequestConsole(ctx, request.(RequestConsoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchCertificateSigningRequest")
+		handler = middleware(handler, "RequestConsole")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchCertificateSigningRequestResponseObject); ok {
-		if err := validResponse.VisitPatchCertificateSigningRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(RequestConsoleResponseObject); ok {
+		if err := validResponse.VisitRequestConsoleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5499,32 +8046,26 @@ func (sh *strictHandler) PatchCertificateSigningRequest(w http.ResponseWriter, r
 	}
 }
 
-// ReplaceCertificateSigningRequest operation middleware
-func (sh *strictHandler) ReplaceCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
-	var request ReplaceCertificateSigningRequestRequestObject
+// GetDeviceLogs operation middleware
+func (sh *strictHandler) GetDeviceLogs(w http.ResponseWriter, r *http.Request, name string, params GetDeviceLogsParams) {
+	var request GetDeviceLogsRequestObject
 
 	request.Name = name
-
-	var body ReplaceCertificateSigningRequestJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ReplaceCertificateSigningRequest(ctx, request.(ReplaceCertificateSigningRequestRequestObject))
+		return sh.ssi.GetDeviceLogs(ctx, request.(GetDeviceLogsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReplaceCertificateSigningRequest")
+		handler = middleware(handler, "GetDeviceLogs")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ReplaceCertificateSigningRequestResponseObject); ok {
-		if err := validResponse.VisitReplaceCertificateSigningRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetDeviceLogsResponseObject); ok {
+		if err := validResponse.VisitGetDeviceLogsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5532,25 +8073,25 @@ func (sh *strictHandler) ReplaceCertificateSigningRequest(w http.ResponseWriter,
 	}
 }
 
-// DenyCertificateSigningRequest operation middleware
-func (sh *strictHandler) DenyCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
-	var request DenyCertificateSigningRequestRequestObject
+// ReadDeviceOverrides operation middleware
+func (sh *strictHandler) ReadDeviceOverrides(w http.ResponseWriter, r *http.Request, name string) {
+	var request ReadDeviceOverridesRequestObject
 
 	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DenyCertificateSigningRequest(ctx, request.(DenyCertificateSigningRequestRequestObject))
+		return sh.ssi.ReadDeviceOverrides(ctx, request.(ReadDeviceOverridesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DenyCertificateSigningRequest")
+		handler = middleware(handler, "ReadDeviceOverrides")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DenyCertificateSigningRequestResponseObject); ok {
-		if err := validResponse.VisitDenyCertificateSigningRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReadDeviceOverridesResponseObject); ok {
+		if err := validResponse.VisitReadDeviceOverridesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5558,25 +8099,32 @@ func (sh *strictHandler) DenyCertificateSigningRequest(w http.ResponseWriter, r
 	}
 }
 
-// ApproveCertificateSigningRequest operation middleware
-func (sh *strictHandler) ApproveCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
-	var request ApproveCertificateSigningRequestRequestObject
+// ReplaceDeviceOverrides operation middleware
+func (sh *strictHandler) ReplaceDeviceOverrides(w http.ResponseWriter, r *http.Request, name string) {
+	var request ReplaceDeviceOverridesRequestObject
 
 	request.Name = name
 
+	var body ReplaceDeviceOverridesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ApproveCertificateSigningRequest(ctx, request.(ApproveCertificateSigningRequestRequestObject))
+		return sh.ssi.ReplaceDeviceOverrides(ctx, request.(ReplaceDeviceOverridesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ApproveCertificateSigningRequest")
+		handler = middleware(handler, "ReplaceDeviceOverrides")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ApproveCertificateSigningRequestResponseObject); ok {
-		if err := validResponse.VisitApproveCertificateSigningRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReplaceDeviceOverridesResponseObject); ok {
+		if err := validResponse.VisitReplaceDeviceOverridesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5584,23 +8132,26 @@ func (sh *strictHandler) ApproveCertificateSigningRequest(w http.ResponseWriter,
 	}
 }
 
-// DeleteDevices operation middleware
-func (sh *strictHandler) DeleteDevices(w http.ResponseWriter, r *http.Request) {
-	var request DeleteDevicesRequestObject
+// GetRenderedDeviceSpec operation middleware
+func (sh *strictHandler) GetRenderedDeviceSpec(w http.ResponseWriter, r *http.Request, name string, params GetRenderedDeviceSpecParams) {
+	var request GetRenderedDeviceSpecRequestObject
+
+	request.Name = name
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteDevices(ctx, request.(DeleteDevicesRequestObject))
+		return sh.ssi.GetRenderedDeviceSpec(ctx, request.(GetRenderedDeviceSpecRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteDevices")
+		handler = middleware(handler, "GetRenderedDeviceSpec")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteDevicesResponseObject); ok {
-		if err := validResponse.VisitDeleteDevicesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRenderedDeviceSpecResponseObject); ok {
+		if err := validResponse.VisitGetRenderedDeviceSpecResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5608,25 +8159,25 @@ func (sh *strictHandler) DeleteDevices(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListDevices operation middleware
-func (sh *strictHandler) ListDevices(w http.ResponseWriter, r *http.Request, params ListDevicesParams) {
-	var request ListDevicesRequestObject
+// ReadDeviceStatus operation middleware
+func (sh *strictHandler) ReadDeviceStatus(w http.ResponseWriter, r *http.Request, name string) {
+	var request ReadDeviceStatusRequestObject
 
-	request.Params = params
+	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListDevices(ctx, request.(ListDevicesRequestObject))
+		return sh.ssi.ReadDeviceStatus(ctx, request.(ReadDeviceStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListDevices")
+		handler = middleware(handler, "ReadDeviceStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListDevicesResponseObject); ok {
-		if err := validResponse.VisitListDevicesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReadDeviceStatusResponseObject); ok {
+		if err := validResponse.VisitReadDeviceStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5634,11 +8185,13 @@ func (sh *strictHandler) ListDevices(w http.ResponseWriter, r *http.Request, par
 	}
 }
 
-// CreateDevice operation middleware
-func (sh *strictHandler) CreateDevice(w http.ResponseWriter, r *http.Request) {
-	var request CreateDeviceRequestObject
+// ReplaceDeviceStatus operation middleware
+func (sh *strictHandler) ReplaceDeviceStatus(w http.ResponseWriter, r *http.Request, name string) {
+	var request ReplaceDeviceStatusRequestObject
 
-	var body CreateDeviceJSONRequestBody
+	request.Name = name
+
+	var body ReplaceDeviceStatusJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5646,18 +8199,18 @@ func (sh *strictHandler) CreateDevice(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateDevice(ctx, request.(CreateDeviceRequestObject))
+		return sh.ssi.ReplaceDeviceStatus(ctx, request.(ReplaceDeviceStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateDevice")
+		handler = middleware(handler, "ReplaceDeviceStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateDeviceResponseObject); ok {
-		if err := validResponse.VisitCreateDeviceResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReplaceDeviceStatusResponseObject); ok {
+		if err := validResponse.VisitReplaceDeviceStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5665,25 +8218,25 @@ func (sh *strictHandler) CreateDevice(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteDevice operation middleware
-func (sh *strictHandler) DeleteDevice(w http.ResponseWriter, r *http.Request, name string) {
-	var request DeleteDeviceRequestObject
+// EnrollmentConfig operation middleware
+func (sh *strictHandler) EnrollmentConfig(w http.ResponseWriter, r *http.Request, name string) {
+	var request EnrollmentConfigRequestObject
 
 	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteDevice(ctx, request.(DeleteDeviceRequestObject))
+		return sh.ssi.EnrollmentConfig(ctx, request.(EnrollmentConfigRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteDevice")
+		handler = middleware(handler, "EnrollmentConfig")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteDeviceResponseObject); ok {
-		if err := validResponse.VisitDeleteDeviceResponse(w); err != nil {
+	} else if validResponse, ok := response.(EnrollmentConfigResponseObject); ok {
+		if err := validResponse.VisitEnrollmentConfigResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5691,25 +8244,23 @@ func (sh *strictHandler) DeleteDevice(w http.ResponseWriter, r *http.Request, na
 	}
 }
 
-// ReadDevice operation middleware
-func (sh *strictHandler) ReadDevice(w http.ResponseWriter, r *http.Request, name string) {
-	var request ReadDeviceRequestObject
-
-	request.Name = name
+// DeleteEnrollmentPolicies operation middleware
+func (sh *strictHandler) DeleteEnrollmentPolicies(w http.ResponseWriter, r *http.Request) {
+	var request DeleteEnrollmentPoliciesRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ReadDevice(ctx, request.(ReadDeviceRequestObject))
+		return sh.ssi.DeleteEnrollmentPolicies(ctx, request.(DeleteEnrollmentPoliciesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReadDevice")
+		handler = middleware(handler, "DeleteEnrollmentPolicies")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ReadDeviceResponseObject); ok {
-		if err := validResponse.VisitReadDeviceResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteEnrollmentPoliciesResponseObject); ok {
+		if err := validResponse.VisitDeleteEnrollmentPoliciesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5717,32 +8268,25 @@ func (sh *strictHandler) ReadDevice(w http.ResponseWriter, r *http.Request, name
 	}
 }
 
-// PatchDevice operation middleware
-func (sh *strictHandler) PatchDevice(w http.ResponseWriter, r *http.Request, name string) {
-	var request PatchDeviceRequestObject
-
-	request.Name = name
+// ListEnrollmentPolicies operation middleware
+func (sh *strictHandler) ListEnrollmentPolicies(w http.ResponseWriter, r *http.Request, params ListEnrollmentPoliciesParams) {
+	var request ListEnrollmentPoliciesRequestObject
 
-	var body PatchDeviceApplicationJSONPatchPlusJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchDevice(ctx, request.(PatchDeviceRequestObject))
+		return sh.ssi.ListEnrollmentPolicies(ctx, request.(ListEnrollmentPoliciesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchDevice")
+		handler = middleware(handler, "ListEnrollmentPolicies")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PatchDeviceResponseObject); ok {
-		if err := validResponse.VisitPatchDeviceResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListEnrollmentPoliciesResponseObject); ok {
+		if err := validResponse.VisitListEnrollmentPoliciesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5750,13 +8294,11 @@ func (sh *strictHandler) PatchDevice(w http.ResponseWriter, r *http.Request, nam
 	}
 }
 
-// ReplaceDevice operation middleware
-func (sh *strictHandler) ReplaceDevice(w http.ResponseWriter, r *http.Request, name string) {
-	var request ReplaceDeviceRequestObject
-
-	request.Name = name
+// CreateEnrollmentPolicy operation middleware
+func (sh *strictHandler) CreateEnrollmentPolicy(w http.ResponseWriter, r *http.Request) {
+	var request CreateEnrollmentPolicyRequestObject
 
-	var body ReplaceDeviceJSONRequestBody
+	var body CreateEnrollmentPolicyJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5764,44 +8306,18 @@ func (sh *strictHandler) ReplaceDevice(w http.ResponseWriter, r *http.Request, n
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ReplaceDevice(ctx, request.(ReplaceDeviceRequestObject))
-	}
-	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReplaceDevice")
-	}
-
-	response, err := handler(r.Context(), w, r, request)
-
-	if err != nil {
-		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ReplaceDeviceResponseObject); ok {
-		if err := validResponse.VisitReplaceDeviceResponse(w); err != nil {
-			sh.options.ResponseErrorHandlerFunc(w, r, err)
-		}
-	} else if response != nil {
-		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
-	}
-}
-
-// RequestConsole operation middleware
-func (sh *strictHandler) RequestConsole(w http.ResponseWriter, r *http.Request, name string) {
-	var request RequestConsoleRequestObject
-
-	request.Name = name
-
-	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RequestConsole(ctx, request.(RequestConsoleRequestObject))
+		return sh.ssi.CreateEnrollmentPolicy(ctx, request.(CreateEnrollmentPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RequestConsole")
+		handler = middleware(handler, "CreateEnrollmentPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RequestConsoleResponseObject); ok {
-		if err := validResponse.VisitRequestConsoleResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateEnrollmentPolicyResponseObject); ok {
+		if err := validResponse.VisitCreateEnrollmentPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5809,26 +8325,25 @@ func (sh *strictHandler) RequestConsole(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetRenderedDeviceSpec operation middleware
-func (sh *strictHandler) GetRenderedDeviceSpec(w http.ResponseWriter, r *http.Request, name string, params GetRenderedDeviceSpecParams) {
-	var request GetRenderedDeviceSpecRequestObject
+// DeleteEnrollmentPolicy operation middleware
+func (sh *strictHandler) DeleteEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	var request DeleteEnrollmentPolicyRequestObject
 
 	request.Name = name
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRenderedDeviceSpec(ctx, request.(GetRenderedDeviceSpecRequestObject))
+		return sh.ssi.DeleteEnrollmentPolicy(ctx, request.(DeleteEnrollmentPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRenderedDeviceSpec")
+		handler = middleware(handler, "DeleteEnrollmentPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRenderedDeviceSpecResponseObject); ok {
-		if err := validResponse.VisitGetRenderedDeviceSpecResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteEnrollmentPolicyResponseObject); ok {
+		if err := validResponse.VisitDeleteEnrollmentPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5836,25 +8351,25 @@ func (sh *strictHandler) GetRenderedDeviceSpec(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// ReadDeviceStatus operation middleware
-func (sh *strictHandler) ReadDeviceStatus(w http.ResponseWriter, r *http.Request, name string) {
-	var request ReadDeviceStatusRequestObject
+// ReadEnrollmentPolicy operation middleware
+func (sh *strictHandler) ReadEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	var request ReadEnrollmentPolicyRequestObject
 
 	request.Name = name
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ReadDeviceStatus(ctx, request.(ReadDeviceStatusRequestObject))
+		return sh.ssi.ReadEnrollmentPolicy(ctx, request.(ReadEnrollmentPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReadDeviceStatus")
+		handler = middleware(handler, "ReadEnrollmentPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ReadDeviceStatusResponseObject); ok {
-		if err := validResponse.VisitReadDeviceStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReadEnrollmentPolicyResponseObject); ok {
+		if err := validResponse.VisitReadEnrollmentPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5862,13 +8377,13 @@ func (sh *strictHandler) ReadDeviceStatus(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// ReplaceDeviceStatus operation middleware
-func (sh *strictHandler) ReplaceDeviceStatus(w http.ResponseWriter, r *http.Request, name string) {
-	var request ReplaceDeviceStatusRequestObject
+// PatchEnrollmentPolicy operation middleware
+func (sh *strictHandler) PatchEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	var request PatchEnrollmentPolicyRequestObject
 
 	request.Name = name
 
-	var body ReplaceDeviceStatusJSONRequestBody
+	var body PatchEnrollmentPolicyApplicationJSONPatchPlusJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5876,18 +8391,18 @@ func (sh *strictHandler) ReplaceDeviceStatus(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ReplaceDeviceStatus(ctx, request.(ReplaceDeviceStatusRequestObject))
+		return sh.ssi.PatchEnrollmentPolicy(ctx, request.(PatchEnrollmentPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReplaceDeviceStatus")
+		handler = middleware(handler, "PatchEnrollmentPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ReplaceDeviceStatusResponseObject); ok {
-		if err := validResponse.VisitReplaceDeviceStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(PatchEnrollmentPolicyResponseObject); ok {
+		if err := validResponse.VisitPatchEnrollmentPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5895,25 +8410,32 @@ func (sh *strictHandler) ReplaceDeviceStatus(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// EnrollmentConfig operation middleware
-func (sh *strictHandler) EnrollmentConfig(w http.ResponseWriter, r *http.Request, name string) {
-	var request EnrollmentConfigRequestObject
+// ReplaceEnrollmentPolicy operation middleware
+func (sh *strictHandler) ReplaceEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	var request ReplaceEnrollmentPolicyRequestObject
 
 	request.Name = name
 
+	var body ReplaceEnrollmentPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.EnrollmentConfig(ctx, request.(EnrollmentConfigRequestObject))
+		return sh.ssi.ReplaceEnrollmentPolicy(ctx, request.(ReplaceEnrollmentPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "EnrollmentConfig")
+		handler = middleware(handler, "ReplaceEnrollmentPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(EnrollmentConfigResponseObject); ok {
-		if err := validResponse.VisitEnrollmentConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReplaceEnrollmentPolicyResponseObject); ok {
+		if err := validResponse.VisitReplaceEnrollmentPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6179,6 +8701,65 @@ func (sh *strictHandler) ReplaceEnrollmentRequestStatus(w http.ResponseWriter, r
 	}
 }
 
+// AnswerEnrollmentRequestTPMChallenge operation middleware
+func (sh *strictHandler) AnswerEnrollmentRequestTPMChallenge(w http.ResponseWriter, r *http.Request, name string) {
+	var request AnswerEnrollmentRequestTPMChallengeRequestObject
+
+	request.Name = name
+
+	var body AnswerEnrollmentRequestTPMChallengeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AnswerEnrollmentRequestTPMChallenge(ctx, request.(AnswerEnrollmentRequestTPMChallengeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AnswerEnrollmentRequestTPMChallenge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AnswerEnrollmentRequestTPMChallengeResponseObject); ok {
+		if err := validResponse.VisitAnswerEnrollmentRequestTPMChallengeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListEvents operation middleware
+func (sh *strictHandler) ListEvents(w http.ResponseWriter, r *http.Request, params ListEventsParams) {
+	var request ListEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEvents(ctx, request.(ListEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListEventsResponseObject); ok {
+		if err := validResponse.VisitListEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // DeleteFleets operation middleware
 func (sh *strictHandler) DeleteFleets(w http.ResponseWriter, r *http.Request) {
 	var request DeleteFleetsRequestObject
@@ -6486,6 +9067,39 @@ func (sh *strictHandler) ReplaceFleet(w http.ResponseWriter, r *http.Request, na
 	}
 }
 
+// DryRunFleetOwnership operation middleware
+func (sh *strictHandler) DryRunFleetOwnership(w http.ResponseWriter, r *http.Request, name string) {
+	var request DryRunFleetOwnershipRequestObject
+
+	request.Name = name
+
+	var body DryRunFleetOwnershipJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DryRunFleetOwnership(ctx, request.(DryRunFleetOwnershipRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DryRunFleetOwnership")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DryRunFleetOwnershipResponseObject); ok {
+		if err := validResponse.VisitDryRunFleetOwnershipResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ReadFleetStatus operation middleware
 func (sh *strictHandler) ReadFleetStatus(w http.ResponseWriter, r *http.Request, name string) {
 	var request ReadFleetStatusRequestObject
@@ -6942,3 +9556,229 @@ func (sh *strictHandler) ReplaceResourceSync(w http.ResponseWriter, r *http.Requ
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// DeleteServiceAccounts operation middleware
+func (sh *strictHandler) DeleteServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	var request DeleteServiceAccountsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteServiceAccounts(ctx, request.(DeleteServiceAccountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteServiceAccounts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteServiceAccountsResponseObject); ok {
+		if err := validResponse.VisitDeleteServiceAccountsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListServiceAccounts operation middleware
+func (sh *strictHandler) ListServiceAccounts(w http.ResponseWriter, r *http.Request, params ListServiceAccountsParams) {
+	var request ListServiceAccountsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListServiceAccounts(ctx, request.(ListServiceAccountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListServiceAccounts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListServiceAccountsResponseObject); ok {
+		if err := validResponse.VisitListServiceAccountsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateServiceAccount operation middleware
+func (sh *strictHandler) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	var request CreateServiceAccountRequestObject
+
+	var body CreateServiceAccountJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateServiceAccount(ctx, request.(CreateServiceAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateServiceAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateServiceAccountResponseObject); ok {
+		if err := validResponse.VisitCreateServiceAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteServiceAccount operation middleware
+func (sh *strictHandler) DeleteServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	var request DeleteServiceAccountRequestObject
+
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteServiceAccount(ctx, request.(DeleteServiceAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteServiceAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteServiceAccountResponseObject); ok {
+		if err := validResponse.VisitDeleteServiceAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReadServiceAccount operation middleware
+func (sh *strictHandler) ReadServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	var request ReadServiceAccountRequestObject
+
+	request.Name = name
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReadServiceAccount(ctx, request.(ReadServiceAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReadServiceAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReadServiceAccountResponseObject); ok {
+		if err := validResponse.VisitReadServiceAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReplaceServiceAccount operation middleware
+func (sh *strictHandler) ReplaceServiceAccount(w http.ResponseWriter, r *http.Request, name string) {
+	var request ReplaceServiceAccountRequestObject
+
+	request.Name = name
+
+	var body ReplaceServiceAccountJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaceServiceAccount(ctx, request.(ReplaceServiceAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaceServiceAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReplaceServiceAccountResponseObject); ok {
+		if err := validResponse.VisitReplaceServiceAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateServiceAccountToken operation middleware
+func (sh *strictHandler) CreateServiceAccountToken(w http.ResponseWriter, r *http.Request, name string) {
+	var request CreateServiceAccountTokenRequestObject
+
+	request.Name = name
+
+	var body CreateServiceAccountTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateServiceAccountToken(ctx, request.(CreateServiceAccountTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateServiceAccountToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateServiceAccountTokenResponseObject); ok {
+		if err := validResponse.VisitCreateServiceAccountTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteServiceAccountToken operation middleware
+func (sh *strictHandler) DeleteServiceAccountToken(w http.ResponseWriter, r *http.Request, name string, token string) {
+	var request DeleteServiceAccountTokenRequestObject
+
+	request.Name = name
+	request.Token = token
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteServiceAccountToken(ctx, request.(DeleteServiceAccountTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteServiceAccountToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteServiceAccountTokenResponseObject); ok {
+		if err := validResponse.VisitDeleteServiceAccountTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -4,14 +4,17 @@ import (
 	"context"
 	"crypto/tls"
 	"errors"
+	"fmt"
 	"io"
 	"net"
 	"sync"
+	"time"
 
 	pb "github.com/flightctl/flightctl/api/grpc/v1"
 	"github.com/flightctl/flightctl/internal/api_server/middleware"
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/service"
 	grpcAuth "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
@@ -22,6 +25,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// closedStreamTTL is how long a closed session is remembered, to turn away a
+// client that joins it late, such as a device acting on an outdated spec.
+const closedStreamTTL = 10 * time.Minute
+
 type AgentGrpcServer struct {
 	pb.UnimplementedRouterServiceServer
 	log            logrus.FieldLogger
@@ -64,13 +71,52 @@ func (s *AgentGrpcServer) Run(ctx context.Context) error {
 		server.Stop()
 	}()
 
+	go func() {
+		ticker := time.NewTicker(closedStreamTTL / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				s.expireClosedStreams(now)
+			}
+		}
+	}()
+
 	return server.Serve(listener)
 }
 
+// routerStream is the stream of one of the two clients of a session.
+type routerStream interface {
+	Send(*pb.StreamResponse) error
+	Recv() (*pb.StreamRequest, error)
+}
+
 type streamCtx struct {
 	cancel context.CancelFunc
-	stream pb.RouterService_StreamServer
+	stream routerStream
 	closed bool // one side closed the connection and we should not accept any more messages
+	// closedAt is when the session was closed, after which it is forgotten
+	closedAt time.Time
+	// joined is called when the second client connects to the session
+	joined func()
+}
+
+// closeStream remembers that the session was closed, freeing its streams.
+func (s *AgentGrpcServer) closeStream(sessionId string) {
+	s.pendingStreams.Store(sessionId, streamCtx{closed: true, closedAt: time.Now()})
+}
+
+// expireClosedStreams forgets the sessions that were closed for longer than
+// closedStreamTTL.
+func (s *AgentGrpcServer) expireClosedStreams(now time.Time) {
+	s.pendingStreams.Range(func(key, value any) bool {
+		if sctx := value.(streamCtx); sctx.closed && now.Sub(sctx.closedAt) > closedStreamTTL {
+			s.pendingStreams.Delete(key)
+		}
+		return true
+	})
 }
 
 func (s *AgentGrpcServer) Stream(stream pb.RouterService_StreamServer) error {
@@ -99,7 +145,6 @@ func (s *AgentGrpcServer) Stream(stream pb.RouterService_StreamServer) error {
 		cancel: cancel,
 		stream: stream,
 		closed: false,
-		// TODO: Add expiration fields so we can clean up old streams ver time
 	}
 
 	actual, loaded := s.pendingStreams.LoadOrStore(sessionId, sctx)
@@ -113,6 +158,9 @@ func (s *AgentGrpcServer) Stream(stream pb.RouterService_StreamServer) error {
 			s.log.Infof("client %s, attempted connection to %s which was already closed", clientName, sessionId)
 			return nil
 		}
+		if joined := actual.(streamCtx).joined; joined != nil {
+			joined()
+		}
 		err := forward(ctx, stream, otherSideStream)
 		if errors.Is(err, io.EOF) {
 			// one side closed the connection, we should not accept any more messages
@@ -130,10 +178,7 @@ func (s *AgentGrpcServer) Stream(stream pb.RouterService_StreamServer) error {
 			}
 
 			// free the other stream reference, and mark this one as closed
-			stctx := actual.(streamCtx)
-			stctx.stream = nil
-			stctx.closed = true
-			s.pendingStreams.Store(sessionId, stctx)
+			s.closeStream(sessionId)
 			return nil
 		} else {
 			actual.(streamCtx).cancel()
@@ -143,11 +188,13 @@ func (s *AgentGrpcServer) Stream(stream pb.RouterService_StreamServer) error {
 		// the map did not have a value, we are the first client, so we wait for the second
 		s.log.Infof("client %s waiting for peer %s", clientName, sessionId)
 		<-ctx.Done()
+		// the session ends with the first client, whether or not the second joined
+		s.closeStream(sessionId)
 		return nil
 	}
 }
 
-func pipe(a pb.RouterService_StreamServer, b pb.RouterService_StreamServer) error {
+func pipe(a routerStream, b routerStream) error {
 	// TODO: this is a good place to add auditing to the console
 	for {
 		msg, err := a.Recv()
@@ -170,9 +217,96 @@ func pipe(a pb.RouterService_StreamServer, b pb.RouterService_StreamServer) erro
 	}
 }
 
-func forward(ctx context.Context, a pb.RouterService_StreamServer, b pb.RouterService_StreamServer) error {
+func forward(ctx context.Context, a routerStream, b routerStream) error {
 	g, _ := errgroup.WithContext(ctx)
 	g.Go(func() error { return pipe(a, b) })
 	g.Go(func() error { return pipe(b, a) })
 	return g.Wait()
 }
+
+var _ service.SessionRouter = (*AgentGrpcServer)(nil)
+
+// OpenSession opens a session as its first client from within the service, so
+// that the service reads what the client joining the session sends.
+func (s *AgentGrpcServer) OpenSession(sessionId string) (service.RoutedSession, error) {
+	// a client joining after the session was closed is turned away
+	session := newLocalSession(func() { s.closeStream(sessionId) })
+	sctx := streamCtx{
+		cancel: session.end,
+		stream: session,
+		joined: session.join,
+	}
+	if _, loaded := s.pendingStreams.LoadOrStore(sessionId, sctx); loaded {
+		return nil, fmt.Errorf("session %s already exists", sessionId)
+	}
+	return session, nil
+}
+
+// localSession is the stream of a client of a session within the service.
+type localSession struct {
+	reader    *io.PipeReader
+	writer    *io.PipeWriter
+	joined    chan struct{}
+	joinOnce  sync.Once
+	closed    chan struct{}
+	closeOnce sync.Once
+	onClose   func()
+}
+
+func newLocalSession(onClose func()) *localSession {
+	reader, writer := io.Pipe()
+	return &localSession{
+		reader:  reader,
+		writer:  writer,
+		joined:  make(chan struct{}),
+		closed:  make(chan struct{}),
+		onClose: onClose,
+	}
+}
+
+// Send passes the payload the other client sent to the reader of the session.
+func (l *localSession) Send(msg *pb.StreamResponse) error {
+	if len(msg.GetPayload()) > 0 {
+		if _, err := l.writer.Write(msg.GetPayload()); err != nil {
+			return err
+		}
+	}
+	if msg.GetClosed() {
+		l.end()
+	}
+	return nil
+}
+
+// Recv blocks until the session is closed, as the service only reads from
+// the session.
+func (l *localSession) Recv() (*pb.StreamRequest, error) {
+	<-l.closed
+	return &pb.StreamRequest{Closed: true}, nil
+}
+
+func (l *localSession) Read(p []byte) (int, error) {
+	return l.reader.Read(p)
+}
+
+// Close closes the session, which the other client is notified of.
+func (l *localSession) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closed)
+		l.reader.Close()
+		l.onClose()
+	})
+	return nil
+}
+
+func (l *localSession) Joined() <-chan struct{} {
+	return l.joined
+}
+
+func (l *localSession) join() {
+	l.joinOnce.Do(func() { close(l.joined) })
+}
+
+// end ends the payloads the service reads from the session.
+func (l *localSession) end() {
+	l.writer.Close()
+}
@@ -0,0 +1,99 @@
+package agentserver
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStream is the stream of a device sending the given payloads.
+type fakeStream struct {
+	requests chan *pb.StreamRequest
+	mu       sync.Mutex
+	received []*pb.StreamResponse
+}
+
+func (f *fakeStream) Send(msg *pb.StreamResponse) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.received = append(f.received, msg)
+	return nil
+}
+
+func (f *fakeStream) Recv() (*pb.StreamRequest, error) {
+	msg, ok := <-f.requests
+	if !ok {
+		return nil, io.EOF
+	}
+	return msg, nil
+}
+
+func TestOpenSession(t *testing.T) {
+	require := require.New(t)
+	s := NewAgentGrpcServer(logrus.New(), nil, nil)
+
+	session, err := s.OpenSession("session-1")
+	require.NoError(err)
+	_, err = s.OpenSession("session-1")
+	require.Error(err)
+
+	// the device joins the session and sends its logs
+	device := &fakeStream{requests: make(chan *pb.StreamRequest, 3)}
+	device.requests <- &pb.StreamRequest{Payload: []byte("line 1\n")}
+	device.requests <- &pb.StreamRequest{Payload: []byte("line 2\n")}
+	device.requests <- &pb.StreamRequest{Closed: true}
+	actual, ok := s.pendingStreams.Load("session-1")
+	require.True(ok)
+	actual.(streamCtx).joined()
+	select {
+	case <-session.Joined():
+	default:
+		require.Fail("session not joined")
+	}
+
+	errs := make(chan error, 1)
+	go func() { errs <- forward(context.Background(), device, actual.(streamCtx).stream) }()
+
+	payload, err := io.ReadAll(session)
+	require.NoError(err)
+	require.Equal("line 1\nline 2\n", string(payload))
+
+	// closing the session notifies the device
+	require.NoError(session.Close())
+	require.ErrorIs(<-errs, io.EOF)
+	require.Len(device.received, 1)
+	require.True(device.received[0].Closed)
+
+	// a device joining after the session was closed is turned away
+	actual, ok = s.pendingStreams.Load("session-1")
+	require.True(ok)
+	require.True(actual.(streamCtx).closed)
+}
+
+func TestExpireClosedStreams(t *testing.T) {
+	require := require.New(t)
+	s := NewAgentGrpcServer(logrus.New(), nil, nil)
+
+	closed, err := s.OpenSession("closed")
+	require.NoError(err)
+	require.NoError(closed.Close())
+	_, err = s.OpenSession("open")
+	require.NoError(err)
+
+	// closed sessions are remembered for a while to turn away late clients
+	s.expireClosedStreams(time.Now())
+	_, ok := s.pendingStreams.Load("closed")
+	require.True(ok)
+
+	s.expireClosedStreams(time.Now().Add(closedStreamTTL + time.Minute))
+	_, ok = s.pendingStreams.Load("closed")
+	require.False(ok)
+	_, ok = s.pendingStreams.Load("open")
+	require.True(ok)
+}
@@ -15,11 +15,15 @@ import (
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/instrumentation"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	service "github.com/flightctl/flightctl/internal/service/agent"
 	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/tasks"
+	"github.com/flightctl/flightctl/pkg/queues"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 )
 
@@ -34,6 +38,7 @@ type AgentServer struct {
 	store    store.Store
 	ca       *crypto.CA
 	listener net.Listener
+	provider queues.Provider
 	metrics  *instrumentation.ApiMetrics
 }
 
@@ -44,6 +49,7 @@ func New(
 	store store.Store,
 	ca *crypto.CA,
 	listener net.Listener,
+	provider queues.Provider,
 	metrics *instrumentation.ApiMetrics,
 ) *AgentServer {
 	return &AgentServer{
@@ -52,6 +58,7 @@ func New(
 		store:    store,
 		ca:       ca,
 		listener: listener,
+		provider: provider,
 		metrics:  metrics,
 	}
 }
@@ -61,6 +68,18 @@ func oapiErrorHandler(w http.ResponseWriter, message string, statusCode int) {
 }
 
 func (s *AgentServer) Run(ctx context.Context) error {
+	s.log.Println("Initializing async jobs")
+	publisher, err := tasks.TaskQueuePublisher(s.provider)
+	if err != nil {
+		return err
+	}
+	callbackManager := tasks.NewCallbackManager(publisher, s.log)
+
+	tpmEKRoots, err := crypto.LoadCertPool(s.cfg.Service.TPMEKCACertFiles)
+	if err != nil {
+		return fmt.Errorf("failed loading TPM endorsement key CAs: %w", err)
+	}
+
 	s.log.Println("Initializing Agent-side API server")
 	swagger, err := api.GetSwagger()
 	if err != nil {
@@ -77,16 +96,25 @@ func (s *AgentServer) Run(ctx context.Context) error {
 		middleware.RequestID,
 		middleware.Logger,
 		middleware.Recoverer,
-		oapimiddleware.OapiRequestValidatorWithOptions(swagger, &oapiOpts),
 	}
+	if limit := s.cfg.RateLimit; limit != nil && limit.Agent != nil {
+		var limited prometheus.Counter
+		if s.metrics != nil {
+			limited = s.metrics.AgentRateLimited
+		}
+		rateLimiter := tlsmiddleware.NewRateLimiter(limit.Agent.RequestsPerSecond, limit.Agent.Burst, tlsmiddleware.DeviceRateLimitKey, limited)
+		middlewares = append(middlewares, rateLimiter.Middleware)
+	}
+	middlewares = append(middlewares, oapimiddleware.OapiRequestValidatorWithOptions(swagger, &oapiOpts))
 
 	if s.metrics != nil {
 		middlewares = slices.Insert(middlewares, 0, s.metrics.AgentServerMiddleware)
 	}
+	middlewares = slices.Insert(middlewares, 0, tracing.Middleware)
 	router := chi.NewRouter()
 	router.Use(middlewares...)
 
-	h := service.NewAgentServiceHandler(s.store, s.ca, s.log, s.cfg.Service.BaseAgentGrpcUrl)
+	h := service.NewAgentServiceHandler(s.store, callbackManager, s.ca, tpmEKRoots, s.log, s.cfg.Service.BaseAgentGrpcUrl)
 	server.HandlerFromMux(server.NewStrictHandler(h, nil), router)
 
 	srv := tlsmiddleware.NewHTTPServerWithTLSContext(router, s.log, s.cfg.Service.AgentEndpointAddress)
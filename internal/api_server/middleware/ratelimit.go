@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/auth/common"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+)
+
+// limiterIdleTimeout is how long the limiter of a key is kept after its bucket refilled, before it is
+// dropped to bound the memory used by keys that stopped making requests.
+const limiterIdleTimeout = 10 * time.Minute
+
+type limiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter limits requests with a token bucket per key, such as the identity that made the request.
+// Requests over the limit are rejected with 429 Too Many Requests and a Retry-After header.
+type RateLimiter struct {
+	limit   rate.Limit
+	burst   int
+	key     func(*http.Request) string
+	limited prometheus.Counter
+
+	mu       sync.Mutex
+	limiters map[string]*limiterEntry
+	lastGC   time.Time
+}
+
+// NewRateLimiter returns a rate limiter that allows requestsPerSecond per key with bursts of up to burst
+// requests. The burst defaults to requestsPerSecond, and at least 1. The limited counter is incremented
+// for every rejected request, and may be nil.
+func NewRateLimiter(requestsPerSecond float64, burst int, key func(*http.Request) string, limited prometheus.Counter) *RateLimiter {
+	if burst <= 0 {
+		burst = max(1, int(math.Ceil(requestsPerSecond)))
+	}
+	return &RateLimiter{
+		limit:    rate.Limit(requestsPerSecond),
+		burst:    burst,
+		key:      key,
+		limited:  limited,
+		limiters: map[string]*limiterEntry{},
+		lastGC:   time.Now(),
+	}
+}
+
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		now := time.Now()
+		reservation := l.limiter(l.key(r), now).ReserveN(now, 1)
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			// give the token back, the request is rejected rather than delayed
+			reservation.CancelAt(now)
+			if l.limited != nil {
+				l.limited.Inc()
+			}
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (l *RateLimiter) limiter(key string, now time.Time) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastGC) > limiterIdleTimeout {
+		for k, entry := range l.limiters {
+			// a full bucket behaves the same as a new limiter
+			if now.Sub(entry.lastSeen) > limiterIdleTimeout && entry.limiter.TokensAt(now) >= float64(l.burst) {
+				delete(l.limiters, k)
+			}
+		}
+		l.lastGC = now
+	}
+
+	entry, ok := l.limiters[key]
+	if !ok {
+		entry = &limiterEntry{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.limiters[key] = entry
+	}
+	entry.lastSeen = now
+	return entry.limiter
+}
+
+// IdentityRateLimitKey keys requests by the identity established by the auth middleware, falling back
+// to the address of the client if there is none, such as when auth is disabled.
+func IdentityRateLimitKey(r *http.Request) string {
+	return contextRateLimitKey(r.Context(), common.IdentityCtxKey, "user:", r)
+}
+
+// DeviceRateLimitKey keys requests by the common name of the client certificate of the device, falling
+// back to the address of the client if there is none. The enrollment certificate is shared by the devices
+// that enroll with it, so requests with it are keyed by the address of the client as well.
+func DeviceRateLimitKey(r *http.Request) string {
+	cn, _ := r.Context().Value(TLSCommonNameContextKey).(string)
+	if cn == crypto.ClientBootstrapCommonName || strings.HasPrefix(cn, crypto.ClientBootstrapCommonNamePrefix) {
+		return "enrollment:" + cn + "@" + clientAddress(r)
+	}
+	return contextRateLimitKey(r.Context(), TLSCommonNameContextKey, "device:", r)
+}
+
+func contextRateLimitKey(ctx context.Context, ctxKey any, prefix string, r *http.Request) string {
+	if value, ok := ctx.Value(ctxKey).(string); ok && value != "" {
+		return prefix + value
+	}
+	return "address:" + clientAddress(r)
+}
+
+func clientAddress(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,76 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/flightctl/flightctl/internal/api_server/middleware"
+	"github.com/flightctl/flightctl/internal/auth/common"
+	"github.com/flightctl/flightctl/internal/crypto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Rate limiter", func() {
+	var (
+		limited prometheus.Counter
+		handler http.Handler
+	)
+
+	BeforeEach(func() {
+		limited = prometheus.NewCounter(prometheus.CounterOpts{Name: "limited"})
+		rateLimiter := middleware.NewRateLimiter(0.5, 2, middleware.IdentityRateLimitKey, limited)
+		handler = rateLimiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+	})
+
+	serve := func(identity string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/fleets", nil)
+		r.RemoteAddr = "192.0.2.1:1234"
+		if identity != "" {
+			r = r.WithContext(context.WithValue(r.Context(), common.IdentityCtxKey, identity))
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	It("rejects requests over the burst with Retry-After", func() {
+		Expect(serve("alice").Code).To(Equal(http.StatusOK))
+		Expect(serve("alice").Code).To(Equal(http.StatusOK))
+
+		w := serve("alice")
+		Expect(w.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(w.Header().Get("Retry-After")).To(Equal("2"))
+		Expect(testutil.ToFloat64(limited)).To(Equal(1.0))
+	})
+
+	It("limits every identity separately", func() {
+		Expect(serve("alice").Code).To(Equal(http.StatusOK))
+		Expect(serve("alice").Code).To(Equal(http.StatusOK))
+		Expect(serve("alice").Code).To(Equal(http.StatusTooManyRequests))
+
+		Expect(serve("bob").Code).To(Equal(http.StatusOK))
+		Expect(serve("").Code).To(Equal(http.StatusOK))
+		Expect(testutil.ToFloat64(limited)).To(Equal(1.0))
+	})
+
+	It("keys devices by the common name of their certificate", func() {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/devices/d1/rendered", nil)
+		r = r.WithContext(context.WithValue(r.Context(), middleware.TLSCommonNameContextKey, "d1"))
+		Expect(middleware.DeviceRateLimitKey(r)).To(Equal("device:d1"))
+
+		r = httptest.NewRequest(http.MethodGet, "/api/v1/devices/d1/rendered", nil)
+		r.RemoteAddr = "192.0.2.1:1234"
+		Expect(middleware.DeviceRateLimitKey(r)).To(Equal("address:192.0.2.1"))
+
+		r = httptest.NewRequest(http.MethodPost, "/api/v1/enrollmentrequests", nil)
+		r.RemoteAddr = "192.0.2.1:1234"
+		r = r.WithContext(context.WithValue(r.Context(), middleware.TLSCommonNameContextKey, crypto.ClientBootstrapCommonName))
+		Expect(middleware.DeviceRateLimitKey(r)).To(Equal("enrollment:client-enrollment@192.0.2.1"))
+	})
+})
@@ -12,10 +12,12 @@ import (
 	api "github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/api/server"
 	tlsmiddleware "github.com/flightctl/flightctl/internal/api_server/middleware"
+	"github.com/flightctl/flightctl/internal/audit"
 	"github.com/flightctl/flightctl/internal/auth"
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/instrumentation"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/tasks"
@@ -23,6 +25,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	oapimiddleware "github.com/oapi-codegen/nethttp-middleware"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 )
 
@@ -38,6 +41,7 @@ type Server struct {
 	listener net.Listener
 	provider queues.Provider
 	metrics  *instrumentation.ApiMetrics
+	router   service.SessionRouter
 }
 
 // New returns a new instance of a flightctl server.
@@ -49,6 +53,7 @@ func New(
 	listener net.Listener,
 	provider queues.Provider,
 	metrics *instrumentation.ApiMetrics,
+	router service.SessionRouter,
 ) *Server {
 	return &Server{
 		log:      log,
@@ -58,6 +63,7 @@ func New(
 		listener: listener,
 		provider: provider,
 		metrics:  metrics,
+		router:   router,
 	}
 }
 
@@ -73,6 +79,11 @@ func (s *Server) Run(ctx context.Context) error {
 	}
 	callbackManager := tasks.NewCallbackManager(publisher, s.log)
 
+	tpmEKRoots, err := crypto.LoadCertPool(s.cfg.Service.TPMEKCACertFiles)
+	if err != nil {
+		return fmt.Errorf("failed loading TPM endorsement key CAs: %w", err)
+	}
+
 	s.log.Println("Initializing API server")
 	swagger, err := api.GetSwagger()
 	if err != nil {
@@ -85,11 +96,16 @@ func (s *Server) Run(ctx context.Context) error {
 		ErrorHandler: oapiErrorHandler,
 	}
 
-	authMiddleware, err := auth.CreateAuthMiddleware(s.cfg, s.log)
+	authMiddleware, err := auth.CreateAuthMiddleware(s.cfg, s.store.ServiceAccount(), s.log)
 	if err != nil {
 		return err
 	}
 
+	auditSink, err := audit.NewSink(s.cfg, s.store)
+	if err != nil {
+		return fmt.Errorf("failed creating audit sink: %w", err)
+	}
+
 	router := chi.NewRouter()
 
 	middlewares := [](func(http.Handler) http.Handler){
@@ -97,16 +113,30 @@ func (s *Server) Run(ctx context.Context) error {
 		middleware.Logger,
 		middleware.Recoverer,
 		authMiddleware,
-		oapimiddleware.OapiRequestValidatorWithOptions(swagger, &oapiOpts),
 	}
-
+	if limit := s.cfg.RateLimit; limit != nil && limit.Api != nil {
+		var limited prometheus.Counter
+		if s.metrics != nil {
+			limited = s.metrics.ApiRateLimited
+		}
+		// the rate limiter keys requests by the identity established by the auth middleware
+		rateLimiter := tlsmiddleware.NewRateLimiter(limit.Api.RequestsPerSecond, limit.Api.Burst, tlsmiddleware.IdentityRateLimitKey, limited)
+		middlewares = append(middlewares, rateLimiter.Middleware)
+	}
+	if auditSink != nil {
+		defer auditSink.Close()
+		// the audit middleware needs the identity established by the auth middleware
+		middlewares = append(middlewares, audit.NewAuditor(auditSink, s.store, s.log).Middleware)
+	}
+	middlewares = append(middlewares, oapimiddleware.OapiRequestValidatorWithOptions(swagger, &oapiOpts))
 	if s.metrics != nil {
 		middlewares = slices.Insert(middlewares, 0, s.metrics.ApiServerMiddleware)
 	}
+	middlewares = slices.Insert(middlewares, 0, tracing.Middleware)
 
 	router.Use(middlewares...)
 
-	h := service.NewServiceHandler(s.store, callbackManager, s.ca, s.log, s.cfg.Service.BaseAgentGrpcUrl, s.cfg.Service.BaseAgentEndpointUrl, s.cfg.Service.BaseUIUrl)
+	h := service.NewServiceHandler(s.store, callbackManager, s.ca, tpmEKRoots, s.log, s.cfg.Service.BaseAgentGrpcUrl, s.router, s.cfg.Service.BaseAgentEndpointUrl, s.cfg.Service.BaseUIUrl)
 	server.HandlerFromMux(server.NewStrictHandler(h, nil), router)
 
 	srv := tlsmiddleware.NewHTTPServer(router, s.log, s.cfg.Service.Address)
@@ -0,0 +1,236 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/auth/common"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const apiPathPrefix = "/api/v1/"
+
+var verbs = map[string]api.AuditVerb{
+	http.MethodPost:   api.AuditVerbCreate,
+	http.MethodPut:    api.AuditVerbUpdate,
+	http.MethodPatch:  api.AuditVerbPatch,
+	http.MethodDelete: api.AuditVerbDelete,
+}
+
+// collection describes a resource collection of the API, and how to get the resources in it
+// to diff them before and after a call.
+type collection struct {
+	kind string
+	get  func(ctx context.Context, st store.Store, name string) (interface{}, error)
+}
+
+var collections = map[string]collection{
+	"certificatesigningrequests": {model.CertificateSigningRequestKind, func(ctx context.Context, st store.Store, name string) (interface{}, error) {
+		return st.CertificateSigningRequest().Get(ctx, store.NullOrgId, name)
+	}},
+	"devices": {model.DeviceKind, func(ctx context.Context, st store.Store, name string) (interface{}, error) {
+		return st.Device().Get(ctx, store.NullOrgId, name)
+	}},
+	"enrollmentpolicies": {model.EnrollmentPolicyKind, func(ctx context.Context, st store.Store, name string) (interface{}, error) {
+		return st.EnrollmentPolicy().Get(ctx, store.NullOrgId, name)
+	}},
+	"enrollmentrequests": {model.EnrollmentRequestKind, func(ctx context.Context, st store.Store, name string) (interface{}, error) {
+		return st.EnrollmentRequest().Get(ctx, store.NullOrgId, name)
+	}},
+	"fleets": {model.FleetKind, func(ctx context.Context, st store.Store, name string) (interface{}, error) {
+		return st.Fleet().Get(ctx, store.NullOrgId, name)
+	}},
+	"repositories": {model.RepositoryKind, func(ctx context.Context, st store.Store, name string) (interface{}, error) {
+		// secrets are redacted from the repositories the store returns
+		return st.Repository().Get(ctx, store.NullOrgId, name)
+	}},
+	"resourcesyncs": {model.ResourceSyncKind, func(ctx context.Context, st store.Store, name string) (interface{}, error) {
+		return st.ResourceSync().Get(ctx, store.NullOrgId, name)
+	}},
+	"serviceaccounts": {model.ServiceAccountKind, func(ctx context.Context, st store.Store, name string) (interface{}, error) {
+		return st.ServiceAccount().Get(ctx, store.NullOrgId, name)
+	}},
+}
+
+// Auditor records the mutating calls to the API.
+type Auditor struct {
+	sink  Sink
+	store store.Store
+	log   logrus.FieldLogger
+}
+
+func NewAuditor(sink Sink, st store.Store, log logrus.FieldLogger) *Auditor {
+	return &Auditor{sink: sink, store: st, log: log}
+}
+
+// Middleware writes an audit record for every POST, PUT, PATCH, and DELETE request to a resource of the
+// API. It must come after the auth middleware, which establishes the identity that made the request.
+func (a *Auditor) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		verb, ok := verbs[r.Method]
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		collectionName, name, subresource := parsePath(r.URL.Path)
+		collection, ok := collections[collectionName]
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(name) == 0 && verb == api.AuditVerbCreate {
+			name = nameFromBody(r)
+		}
+
+		// the record is written after the response, when the request may be canceled
+		ctx := context.WithoutCancel(r.Context())
+		identity, _ := ctx.Value(common.IdentityCtxKey).(string)
+		record := &api.AuditRecord{
+			ApiVersion: model.AuditRecordAPI,
+			Kind:       model.AuditRecordKind,
+			Timestamp:  time.Now().UTC(),
+			RequestId:  middleware.GetReqID(ctx),
+			User:       identity,
+			Verb:       verb,
+			Resource:   api.ObjectReference{Kind: collection.kind, Name: name},
+		}
+		if len(subresource) > 0 {
+			record.Subresource = &subresource
+		}
+		// names sort by the time of the call
+		recordName := fmt.Sprintf("%016x.%s", record.Timestamp.UnixNano(), uuid.NewString()[:8])
+		record.Metadata.Name = &recordName
+
+		var before interface{}
+		if len(name) > 0 {
+			before = a.get(ctx, collection, name)
+		}
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		record.StatusCode = int32(ww.Status())
+		if record.StatusCode == 0 {
+			record.StatusCode = http.StatusOK
+		}
+		record.Outcome = api.AuditOutcomeFailure
+		if record.StatusCode >= 200 && record.StatusCode < 300 {
+			record.Outcome = api.AuditOutcomeSuccess
+		}
+
+		if record.Outcome == api.AuditOutcomeSuccess && len(name) > 0 {
+			var diff *map[string]interface{}
+			var err error
+			if verb == api.AuditVerbDelete && len(subresource) == 0 {
+				// the diff of a deletion is the deleted resource
+				diff, err = Diff(nil, before)
+			} else {
+				diff, err = Diff(before, a.get(ctx, collection, name))
+			}
+			if err != nil {
+				a.log.Errorf("failed to diff %s/%s for the audit record: %v", collection.kind, name, err)
+			}
+			record.Diff = diff
+		}
+
+		if err := a.sink.Write(ctx, record); err != nil {
+			a.log.Errorf("failed to write audit record of %s %s/%s: %v", verb, collection.kind, name, err)
+		}
+	})
+}
+
+// get returns the resource, or nil if it does not exist or cannot be read.
+func (a *Auditor) get(ctx context.Context, collection collection, name string) interface{} {
+	resource, err := collection.get(ctx, a.store, name)
+	if err != nil {
+		if !errors.Is(err, flterrors.ErrResourceNotFound) {
+			a.log.Errorf("failed to get %s/%s for the audit record: %v", collection.kind, name, err)
+		}
+		return nil
+	}
+	return resource
+}
+
+// parsePath splits an API path into the resource collection, the name of the resource, and the path below
+// the resource, so that "/api/v1/fleets/f1/templateversions/tv1" is split into "fleets", "f1", and
+// "templateversions/tv1".
+func parsePath(path string) (string, string, string) {
+	rest, ok := strings.CutPrefix(path, apiPathPrefix)
+	if !ok {
+		return "", "", ""
+	}
+	parts := strings.SplitN(strings.Trim(rest, "/"), "/", 3)
+	for len(parts) < 3 {
+		parts = append(parts, "")
+	}
+	return parts[0], parts[1], parts[2]
+}
+
+// nameFromBody returns the name in the metadata of the resource in the body of the request,
+// leaving the body to be read again by the handler.
+func nameFromBody(r *http.Request) string {
+	if r.Body == nil {
+		return ""
+	}
+	body, err := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+	var resource struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(body, &resource); err != nil {
+		return ""
+	}
+	return resource.Metadata.Name
+}
+
+// Diff returns the JSON merge patch that turns the resource before into the resource after, or nil
+// if there is no resource after or they are the same. If there is no resource before, it returns
+// the whole resource after, so the diff of a deletion is Diff(nil, before), the whole deleted resource.
+func Diff(before interface{}, after interface{}) (*map[string]interface{}, error) {
+	if after == nil {
+		return nil, nil
+	}
+	afterJSON, err := json.Marshal(after)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := afterJSON
+	if before != nil {
+		beforeJSON, err := json.Marshal(before)
+		if err != nil {
+			return nil, err
+		}
+		if patch, err = jsonpatch.CreateMergePatch(beforeJSON, afterJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	diff := map[string]interface{}{}
+	if err := json.Unmarshal(patch, &diff); err != nil {
+		return nil, err
+	}
+	if len(diff) == 0 {
+		return nil, nil
+	}
+	return &diff, nil
+}
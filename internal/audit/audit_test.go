@@ -0,0 +1,202 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/auth/common"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+type auditStore struct {
+	store.Store
+	fleets *dummyFleet
+}
+
+func (s *auditStore) Fleet() store.Fleet {
+	return s.fleets
+}
+
+type dummyFleet struct {
+	store.Fleet
+	fleet *api.Fleet
+}
+
+func (s *dummyFleet) Get(ctx context.Context, orgId uuid.UUID, name string, opts ...store.GetOption) (*api.Fleet, error) {
+	if s.fleet == nil || *s.fleet.Metadata.Name != name {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	fleet := *s.fleet
+	return &fleet, nil
+}
+
+type recordingSink struct {
+	records []*api.AuditRecord
+}
+
+func (s *recordingSink) Write(ctx context.Context, record *api.AuditRecord) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *recordingSink) Close() error {
+	return nil
+}
+
+func newTestFleet(name string, osImage string) *api.Fleet {
+	fleet := &api.Fleet{
+		ApiVersion: "v1alpha1",
+		Kind:       "Fleet",
+		Metadata:   api.ObjectMeta{Name: lo.ToPtr(name)},
+	}
+	fleet.Spec.Template.Spec.Os = &api.DeviceOSSpec{Image: osImage}
+	return fleet
+}
+
+// serveAudited serves the request through the audit middleware as the given identity, with a handler
+// that applies the change to the fleets of the store and responds with the status code.
+func serveAudited(fleets *dummyFleet, identity string, r *http.Request, status int, change func()) *recordingSink {
+	sink := &recordingSink{}
+	auditor := NewAuditor(sink, &auditStore{fleets: fleets}, log.InitLogs())
+	handler := middleware.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), common.IdentityCtxKey, identity)
+		auditor.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if change != nil {
+				change()
+			}
+			w.WriteHeader(status)
+		})).ServeHTTP(w, r.WithContext(ctx))
+	}))
+	r.Header.Set(middleware.RequestIDHeader, "req-1")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	return sink
+}
+
+func TestMiddlewareRecordsUpdateWithDiff(t *testing.T) {
+	require := require.New(t)
+	fleets := &dummyFleet{fleet: newTestFleet("f1", "quay.io/os:1")}
+
+	r := httptest.NewRequest(http.MethodPut, "/api/v1/fleets/f1", strings.NewReader("{}"))
+	sink := serveAudited(fleets, "alice", r, http.StatusOK, func() {
+		fleets.fleet = newTestFleet("f1", "quay.io/os:2")
+	})
+
+	require.Len(sink.records, 1)
+	record := sink.records[0]
+	require.Equal("alice", record.User)
+	require.Equal("req-1", record.RequestId)
+	require.Equal(api.AuditVerbUpdate, record.Verb)
+	require.Equal(api.ObjectReference{Kind: "Fleet", Name: "f1"}, record.Resource)
+	require.Nil(record.Subresource)
+	require.Equal(api.AuditOutcomeSuccess, record.Outcome)
+	require.Equal(int32(http.StatusOK), record.StatusCode)
+	require.NotNil(record.Diff)
+	diff, err := json.Marshal(record.Diff)
+	require.NoError(err)
+	require.JSONEq(`{"spec":{"template":{"spec":{"os":{"image":"quay.io/os:2"}}}}}`, string(diff))
+}
+
+func TestMiddlewareRecordsCreateWithWholeResource(t *testing.T) {
+	require := require.New(t)
+	fleets := &dummyFleet{}
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/fleets", strings.NewReader(`{"metadata":{"name":"f1"}}`))
+	sink := serveAudited(fleets, "alice", r, http.StatusCreated, func() {
+		fleets.fleet = newTestFleet("f1", "quay.io/os:1")
+	})
+
+	require.Len(sink.records, 1)
+	record := sink.records[0]
+	require.Equal(api.AuditVerbCreate, record.Verb)
+	require.Equal(api.ObjectReference{Kind: "Fleet", Name: "f1"}, record.Resource)
+	require.Equal(api.AuditOutcomeSuccess, record.Outcome)
+	require.NotNil(record.Diff)
+	require.Equal("Fleet", (*record.Diff)["kind"])
+}
+
+func TestMiddlewareRecordsFailureWithoutDiff(t *testing.T) {
+	require := require.New(t)
+	fleets := &dummyFleet{fleet: newTestFleet("f1", "quay.io/os:1")}
+
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/fleets/f1/templateversions/tv1", nil)
+	sink := serveAudited(fleets, "", r, http.StatusNotFound, nil)
+
+	require.Len(sink.records, 1)
+	record := sink.records[0]
+	require.Equal("", record.User)
+	require.Equal(api.ObjectReference{Kind: "Fleet", Name: "f1"}, record.Resource)
+	require.Equal("templateversions/tv1", *record.Subresource)
+	require.Equal(api.AuditOutcomeFailure, record.Outcome)
+	require.Equal(int32(http.StatusNotFound), record.StatusCode)
+	require.Nil(record.Diff)
+}
+
+func TestMiddlewareRecordsDeleteWithDeletedResource(t *testing.T) {
+	require := require.New(t)
+	fleets := &dummyFleet{fleet: newTestFleet("f1", "quay.io/os:1")}
+
+	r := httptest.NewRequest(http.MethodDelete, "/api/v1/fleets/f1", nil)
+	sink := serveAudited(fleets, "serviceaccount:ci", r, http.StatusOK, func() {
+		fleets.fleet = nil
+	})
+
+	require.Len(sink.records, 1)
+	require.Equal(api.AuditVerbDelete, sink.records[0].Verb)
+	require.Equal("serviceaccount:ci", sink.records[0].User)
+	require.NotNil(sink.records[0].Diff)
+	require.Equal("Fleet", (*sink.records[0].Diff)["kind"])
+	require.Equal(map[string]interface{}{"name": "f1"}, (*sink.records[0].Diff)["metadata"])
+}
+
+func TestMiddlewareIgnoresReadsAndUnknownPaths(t *testing.T) {
+	require := require.New(t)
+	fleets := &dummyFleet{fleet: newTestFleet("f1", "quay.io/os:1")}
+
+	sink := serveAudited(fleets, "alice", httptest.NewRequest(http.MethodGet, "/api/v1/fleets/f1", nil), http.StatusOK, nil)
+	require.Empty(sink.records)
+
+	sink = serveAudited(fleets, "alice", httptest.NewRequest(http.MethodPost, "/api/v1/auth/validate", nil), http.StatusOK, nil)
+	require.Empty(sink.records)
+}
+
+func TestFileSink(t *testing.T) {
+	require := require.New(t)
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	sink, err := newFileSink(path)
+	require.NoError(err)
+	for _, name := range []string{"f1", "f2"} {
+		err = sink.Write(context.Background(), &api.AuditRecord{
+			Verb:     api.AuditVerbDelete,
+			Resource: api.ObjectReference{Kind: "Fleet", Name: name},
+		})
+		require.NoError(err)
+	}
+	require.NoError(sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(err)
+	defer file.Close()
+	names := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record api.AuditRecord
+		require.NoError(json.Unmarshal(scanner.Bytes(), &record))
+		names = append(names, record.Resource.Name)
+	}
+	require.Equal([]string{"f1", "f2"}, names)
+}
@@ -0,0 +1,121 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/syslog"
+	"os"
+	"sync"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/store"
+)
+
+const (
+	// SinkDatabase writes audit records to the database, where they can be listed through the API.
+	SinkDatabase = "database"
+	// SinkFile appends audit records to a file as JSON lines.
+	SinkFile = "file"
+	// SinkSyslog sends audit records to syslog as JSON.
+	SinkSyslog = "syslog"
+	// SinkNone disables auditing.
+	SinkNone = "none"
+
+	syslogTag = "flightctl-audit"
+)
+
+// Sink is where audit records are written to.
+type Sink interface {
+	Write(ctx context.Context, record *api.AuditRecord) error
+	Close() error
+}
+
+// NewSink returns the sink configured in the audit section of the config, which defaults to the
+// database. It returns nil if auditing is disabled.
+func NewSink(cfg *config.Config, st store.Store) (Sink, error) {
+	sink := SinkDatabase
+	if cfg.Audit != nil && cfg.Audit.Sink != "" {
+		sink = cfg.Audit.Sink
+	}
+
+	switch sink {
+	case SinkDatabase:
+		return &databaseSink{store: st.AuditRecord()}, nil
+	case SinkFile:
+		if cfg.Audit.File == "" {
+			return nil, errors.New("audit.file must be set for the file audit sink")
+		}
+		return newFileSink(cfg.Audit.File)
+	case SinkSyslog:
+		writer, err := syslog.Dial(cfg.Audit.SyslogNetwork, cfg.Audit.SyslogAddress, syslog.LOG_INFO|syslog.LOG_AUTH, syslogTag)
+		if err != nil {
+			return nil, fmt.Errorf("connecting to syslog: %w", err)
+		}
+		return &syslogSink{writer: writer}, nil
+	case SinkNone:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown audit sink %q, must be one of %s, %s, %s, or %s", sink, SinkDatabase, SinkFile, SinkSyslog, SinkNone)
+	}
+}
+
+type databaseSink struct {
+	store store.AuditRecord
+}
+
+func (s *databaseSink) Write(ctx context.Context, record *api.AuditRecord) error {
+	return s.store.Create(ctx, store.NullOrgId, record)
+}
+
+func (s *databaseSink) Close() error {
+	return nil
+}
+
+type fileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func newFileSink(path string) (*fileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit file: %w", err)
+	}
+	return &fileSink{file: file}, nil
+}
+
+func (s *fileSink) Write(ctx context.Context, record *api.AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.file.Write(line)
+	return err
+}
+
+func (s *fileSink) Close() error {
+	return s.file.Close()
+}
+
+type syslogSink struct {
+	writer *syslog.Writer
+}
+
+func (s *syslogSink) Write(ctx context.Context, record *api.AuditRecord) error {
+	message, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return s.writer.Info(string(message))
+}
+
+func (s *syslogSink) Close() error {
+	return s.writer.Close()
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/flightctl/flightctl/internal/auth/authz"
 	"github.com/flightctl/flightctl/internal/auth/common"
 	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/store"
 	"github.com/sirupsen/logrus"
 )
 
@@ -24,6 +25,9 @@ const (
 
 type AuthNMiddleware interface {
 	ValidateToken(ctx context.Context, token string) (bool, error)
+	// ValidateTokenIdentity validates the token like ValidateToken, and also returns the identity
+	// a valid token belongs to, such as a user name.
+	ValidateTokenIdentity(ctx context.Context, token string) (bool, string, error)
 	GetAuthConfig() common.AuthConfig
 }
 
@@ -61,7 +65,9 @@ func getAuthToken(r *http.Request) (string, bool) {
 	return ParseAuthHeader(authHeader)
 }
 
-func CreateAuthMiddleware(cfg *config.Config, log logrus.FieldLogger) (func(http.Handler) http.Handler, error) {
+// CreateAuthMiddleware returns the middleware authenticating API requests with the configured authN provider.
+// Unless auth is disabled, the API tokens of service accounts are accepted as well.
+func CreateAuthMiddleware(cfg *config.Config, serviceAccountStore store.ServiceAccount, log logrus.FieldLogger) (func(http.Handler) http.Handler, error) {
 	value, exists := os.LookupEnv(DisableAuthEnvKey)
 	if exists && value != "" {
 		log.Warnln("Auth disabled")
@@ -80,7 +86,7 @@ func CreateAuthMiddleware(cfg *config.Config, log logrus.FieldLogger) (func(http
 			apiUrl := strings.TrimSuffix(cfg.Auth.OpenShiftApiUrl, "/")
 			log.Println(fmt.Sprintf("OpenShift auth enabled: %s", apiUrl))
 			authZ = K8sToK8sAuth{K8sAuthZ: authz.K8sAuthZ{ApiUrl: apiUrl, ClientTlsConfig: tlsConfig}}
-			authN = authn.OpenShiftAuthN{OpenShiftApiUrl: apiUrl, ClientTlsConfig: tlsConfig}
+			authN = authn.NewOpenShiftAuthN(apiUrl, tlsConfig)
 		} else if cfg.Auth.OIDCAuthority != "" {
 			oidcUrl := strings.TrimSuffix(cfg.Auth.OIDCAuthority, "/")
 			internalOidcUrl := strings.TrimSuffix(cfg.Auth.InternalOIDCAuthority, "/")
@@ -100,6 +106,9 @@ func CreateAuthMiddleware(cfg *config.Config, log logrus.FieldLogger) (func(http
 	if authZ == nil {
 		return nil, errors.New("no authZ provider defined")
 	}
+	if _, isAuthDisabled := authN.(NilAuth); !isAuthDisabled {
+		authN = ServiceAccountAuthN{AuthNMiddleware: authN, Store: serviceAccountStore}
+	}
 
 	handler := func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
@@ -112,12 +121,30 @@ func CreateAuthMiddleware(cfg *config.Config, log logrus.FieldLogger) (func(http
 				w.WriteHeader(http.StatusUnauthorized)
 				return
 			}
-			valid, err := authN.ValidateToken(r.Context(), authToken)
-			if err != nil || !valid {
-				w.WriteHeader(http.StatusUnauthorized)
-				return
+			ctx := r.Context()
+			identity := ""
+			if serviceAccountAuthN, ok := authN.(ServiceAccountAuthN); ok && IsServiceAccountToken(authToken) {
+				token, err := serviceAccountAuthN.authenticate(r.Context(), authToken)
+				if err != nil || token == nil {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if !serviceAccountTokenAllows(token, r.Method, r.URL.Path) {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				identity = ServiceAccountIdentity(token.ServiceAccountName)
+				ctx = WithServiceAccountToken(ctx, token)
+			} else {
+				valid, tokenIdentity, err := authN.ValidateTokenIdentity(r.Context(), authToken)
+				if err != nil || !valid {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				identity = tokenIdentity
 			}
-			ctx := context.WithValue(r.Context(), common.TokenCtxKey, authToken)
+			ctx = context.WithValue(ctx, common.TokenCtxKey, authToken)
+			ctx = context.WithValue(ctx, common.IdentityCtxKey, identity)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		}
 		return http.HandlerFunc(fn)
@@ -135,5 +162,10 @@ func (o K8sToK8sAuth) CheckPermission(ctx context.Context, resource string, op s
 		return false, nil
 	}
 	k8sToken := k8sTokenVal.(string)
+	if IsServiceAccountToken(k8sToken) {
+		// the scopes of service account tokens were checked by the auth middleware, and the handlers
+		// setting or granting scopes check that they are covered by the scopes of the caller
+		return true, nil
+	}
 	return o.K8sAuthZ.CheckPermission(ctx, k8sToken, resource, op)
 }
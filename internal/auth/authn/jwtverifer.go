@@ -54,19 +54,33 @@ func NewJWTAuth(oidcAuthority string, internalOIDCAuthority string, clientTlsCon
 }
 
 func (j JWTAuth) ValidateToken(ctx context.Context, token string) (bool, error) {
+	if _, err := j.parseToken(ctx, token); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ValidateTokenIdentity returns the preferred user name of a valid token, or its subject if the token has none.
+func (j JWTAuth) ValidateTokenIdentity(ctx context.Context, token string) (bool, string, error) {
+	parsed, err := j.parseToken(ctx, token)
+	if err != nil {
+		return false, "", err
+	}
+	if username, ok := parsed.PrivateClaims()["preferred_username"].(string); ok && username != "" {
+		return true, username, nil
+	}
+	return true, parsed.Subject(), nil
+}
+
+func (j JWTAuth) parseToken(ctx context.Context, token string) (jwt.Token, error) {
 	client := &http.Client{Transport: &http.Transport{
 		TLSClientConfig: j.clientTlsConfig,
 	}}
 	jwkSet, err := jwk.Fetch(ctx, j.jwksUri, jwk.WithHTTPClient(client))
 	if err != nil {
-		return false, err
-	}
-	_, err = jwt.Parse([]byte(token), jwt.WithKeySet(jwkSet), jwt.WithValidate(true))
-	if err != nil {
-		return false, err
+		return nil, err
 	}
-
-	return true, nil
+	return jwt.Parse([]byte(token), jwt.WithKeySet(jwkSet), jwt.WithValidate(true))
 }
 
 func (j JWTAuth) GetAuthConfig() common.AuthConfig {
@@ -3,6 +3,7 @@ package authn
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -12,6 +13,17 @@ import (
 type OpenShiftAuthN struct {
 	OpenShiftApiUrl string
 	ClientTlsConfig *tls.Config
+	client          *http.Client
+}
+
+func NewOpenShiftAuthN(openShiftApiUrl string, clientTlsConfig *tls.Config) OpenShiftAuthN {
+	return OpenShiftAuthN{
+		OpenShiftApiUrl: openShiftApiUrl,
+		ClientTlsConfig: clientTlsConfig,
+		client: &http.Client{Transport: &http.Transport{
+			TLSClientConfig: clientTlsConfig,
+		}},
+	}
 }
 
 type OauthServerResponse struct {
@@ -19,25 +31,51 @@ type OauthServerResponse struct {
 }
 
 func (o OpenShiftAuthN) ValidateToken(ctx context.Context, token string) (bool, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/apis/user.openshift.io/v1/users/~", o.OpenShiftApiUrl), nil)
+	res, err := o.getCurrentUser(ctx, token)
 	if err != nil {
 		return false, err
 	}
+	defer res.Body.Close()
 
-	req.Header = map[string][]string{
-		"Authorization": {"Bearer " + token},
-		"Content-Type":  {"application/json"},
+	return res.StatusCode == http.StatusOK, nil
+}
+
+// ValidateTokenIdentity returns the name of the OpenShift user a valid token belongs to.
+func (o OpenShiftAuthN) ValidateTokenIdentity(ctx context.Context, token string) (bool, string, error) {
+	res, err := o.getCurrentUser(ctx, token)
+	if err != nil {
+		return false, "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return false, "", nil
 	}
 
-	client := &http.Client{Transport: &http.Transport{
-		TLSClientConfig: o.ClientTlsConfig,
-	}}
-	res, err := client.Do(req)
+	user := userResponse{}
+	if err := json.NewDecoder(res.Body).Decode(&user); err != nil {
+		return false, "", fmt.Errorf("decoding user: %w", err)
+	}
+	return true, user.Metadata.Name, nil
+}
+
+type userResponse struct {
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+}
+
+func (o OpenShiftAuthN) getCurrentUser(ctx context.Context, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/apis/user.openshift.io/v1/users/~", o.OpenShiftApiUrl), nil)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	return res.StatusCode == http.StatusOK, nil
+	req.Header = map[string][]string{
+		"Authorization": {"Bearer " + token},
+		"Content-Type":  {"application/json"},
+	}
+
+	return o.client.Do(req)
 }
 
 func (o OpenShiftAuthN) GetAuthConfig() common.AuthConfig {
@@ -5,6 +5,8 @@ type ctxKeyAuthHeader string
 const (
 	AuthHeader  string           = "Authorization"
 	TokenCtxKey ctxKeyAuthHeader = "TokenCtxKey"
+	// IdentityCtxKey holds the identity that made the request, such as a user name.
+	IdentityCtxKey ctxKeyAuthHeader = "IdentityCtxKey"
 )
 
 type AuthConfig struct {
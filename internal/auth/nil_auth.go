@@ -12,6 +12,10 @@ func (a NilAuth) ValidateToken(ctx context.Context, token string) (bool, error)
 	return true, nil
 }
 
+func (a NilAuth) ValidateTokenIdentity(ctx context.Context, token string) (bool, string, error) {
+	return true, "", nil
+}
+
 func (a NilAuth) GetAuthConfig() common.AuthConfig {
 	return common.AuthConfig{
 		Type: "",
@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+)
+
+// ServiceAccountTokenPrefix starts the API tokens of service accounts, which tells them
+// apart from the tokens of the configured authN provider.
+const ServiceAccountTokenPrefix = "fctl_sa_"
+
+func IsServiceAccountToken(token string) bool {
+	return strings.HasPrefix(token, ServiceAccountTokenPrefix)
+}
+
+// ServiceAccountIdentity returns the identity that requests made with the tokens of the service account are attributed to.
+func ServiceAccountIdentity(serviceAccountName string) string {
+	return "serviceaccount:" + serviceAccountName
+}
+
+// NewServiceAccountToken returns a new random API token and the hash it is stored as.
+func NewServiceAccountToken() (string, string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", fmt.Errorf("generating token: %w", err)
+	}
+	token := ServiceAccountTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	return token, HashServiceAccountToken(token), nil
+}
+
+// HashServiceAccountToken returns the hash an API token is stored and looked up as. Tokens are
+// random, so a plain SHA-256 hash suffices to keep them from being recovered from the database.
+func HashServiceAccountToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// ServiceAccountAuthN validates the API tokens of service accounts against the store,
+// and passes all other tokens on to the authN provider it wraps.
+type ServiceAccountAuthN struct {
+	AuthNMiddleware
+	Store store.ServiceAccount
+}
+
+func (s ServiceAccountAuthN) ValidateToken(ctx context.Context, token string) (bool, error) {
+	if !IsServiceAccountToken(token) {
+		return s.AuthNMiddleware.ValidateToken(ctx, token)
+	}
+	serviceAccountToken, err := s.authenticate(ctx, token)
+	if err != nil {
+		return false, err
+	}
+	return serviceAccountToken != nil, nil
+}
+
+func (s ServiceAccountAuthN) ValidateTokenIdentity(ctx context.Context, token string) (bool, string, error) {
+	if !IsServiceAccountToken(token) {
+		return s.AuthNMiddleware.ValidateTokenIdentity(ctx, token)
+	}
+	serviceAccountToken, err := s.authenticate(ctx, token)
+	if err != nil || serviceAccountToken == nil {
+		return false, "", err
+	}
+	return true, ServiceAccountIdentity(serviceAccountToken.ServiceAccountName), nil
+}
+
+// authenticate returns the service account token, or nil if the token is unknown, revoked or expired.
+func (s ServiceAccountAuthN) authenticate(ctx context.Context, token string) (*model.ServiceAccountToken, error) {
+	now := time.Now().UTC()
+	serviceAccountToken, err := s.Store.UseToken(ctx, HashServiceAccountToken(token), now)
+	if errors.Is(err, flterrors.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if serviceAccountToken.ExpiresAt != nil && now.After(*serviceAccountToken.ExpiresAt) {
+		return nil, nil
+	}
+	return serviceAccountToken, nil
+}
+
+type serviceAccountTokenCtxKey struct{}
+
+// WithServiceAccountToken returns a context for a request made with the service account token.
+func WithServiceAccountToken(ctx context.Context, token *model.ServiceAccountToken) context.Context {
+	return context.WithValue(ctx, serviceAccountTokenCtxKey{}, token)
+}
+
+// CallerScopesCover returns true if the request was not made with the token of a service account, or if the
+// scopes of both the token and its service account cover every one of the requested scopes, so that a service
+// account cannot set or grant scopes beyond its own.
+func CallerScopesCover(ctx context.Context, requested []string) bool {
+	token, ok := ctx.Value(serviceAccountTokenCtxKey{}).(*model.ServiceAccountToken)
+	if !ok {
+		return true
+	}
+	return api.ServiceAccountScopesCover(token.Scopes, requested) &&
+		api.ServiceAccountScopesCover(serviceAccountScopes(token), requested)
+}
+
+func serviceAccountScopes(token *model.ServiceAccountToken) []string {
+	if token.ServiceAccount.Spec == nil {
+		return nil
+	}
+	return token.ServiceAccount.Spec.Data.Scopes
+}
+
+// serviceAccountTokenAllows returns true if the scopes of both the token and its service account allow a
+// request with the method to the API path, so that narrowing the scopes of a service account also narrows
+// the scopes of its existing tokens.
+func serviceAccountTokenAllows(token *model.ServiceAccountToken, method string, path string) bool {
+	collection := ""
+	if rest, ok := strings.CutPrefix(path, "/api/v1/"); ok {
+		collection, _, _ = strings.Cut(rest, "/")
+	}
+	return api.ServiceAccountScopesAllow(token.Scopes, collection, method) &&
+		api.ServiceAccountScopesAllow(serviceAccountScopes(token), collection, method)
+}
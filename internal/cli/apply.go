@@ -20,6 +20,9 @@ import (
 	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
 )
 
+// deviceOverridesKind can be applied but not listed, as the overrides of a device are fetched with get --overrides
+const deviceOverridesKind = "deviceoverrides"
+
 var (
 	fileExtensions  = []string{".json", ".yaml", ".yml"}
 	inputExtensions = append(fileExtensions, "stdin")
@@ -101,7 +104,7 @@ func (o *ApplyOptions) Validate(args []string) error {
 }
 
 func (o *ApplyOptions) Run(ctx context.Context, args []string) error {
-	c, err := client.NewFromConfigFile(o.ConfigFilePath)
+	c, err := client.NewFromConfigFileForContext(o.ConfigFilePath, o.Context)
 	if err != nil {
 		return fmt.Errorf("creating client: %w", err)
 	}
@@ -218,6 +221,13 @@ func applyFromReader(ctx context.Context, client *apiclient.ClientWithResponses,
 				httpResponse = response.HTTPResponse
 				message = string(response.Body)
 			}
+		case deviceOverridesKind:
+			var response *apiclient.ReplaceDeviceOverridesResponse
+			response, err = client.ReplaceDeviceOverridesWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+			if response != nil {
+				httpResponse = response.HTTPResponse
+				message = string(response.Body)
+			}
 		case EnrollmentRequestKind:
 			var response *apiclient.ReplaceEnrollmentRequestResponse
 			response, err = client.ReplaceEnrollmentRequestWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
@@ -225,6 +235,20 @@ func applyFromReader(ctx context.Context, client *apiclient.ClientWithResponses,
 				httpResponse = response.HTTPResponse
 				message = string(response.Body)
 			}
+		case EnrollmentPolicyKind:
+			var response *apiclient.ReplaceEnrollmentPolicyResponse
+			response, err = client.ReplaceEnrollmentPolicyWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+			if response != nil {
+				httpResponse = response.HTTPResponse
+				message = string(response.Body)
+			}
+		case ServiceAccountKind:
+			var response *apiclient.ReplaceServiceAccountResponse
+			response, err = client.ReplaceServiceAccountWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+			if response != nil {
+				httpResponse = response.HTTPResponse
+				message = string(response.Body)
+			}
 		case FleetKind:
 			var response *apiclient.ReplaceFleetResponse
 			response, err = client.ReplaceFleetWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
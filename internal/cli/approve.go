@@ -88,7 +88,7 @@ func (o *ApproveOptions) Validate(args []string) error {
 }
 
 func (o *ApproveOptions) Run(ctx context.Context, args []string) error {
-	c, err := client.NewFromConfigFile(o.ConfigFilePath)
+	c, err := client.NewFromConfigFileForContext(o.ConfigFilePath, o.Context)
 	if err != nil {
 		return fmt.Errorf("creating client: %w", err)
 	}
@@ -156,7 +156,7 @@ func (o *CertificateOptions) Run(ctx context.Context, args []string) error {
 		return fmt.Errorf("creating csr resource: %w", err)
 	}
 
-	c, err := client.NewFromConfigFile(o.ConfigFilePath)
+	c, err := client.NewFromConfigFileForContext(o.ConfigFilePath, o.Context)
 	if err != nil {
 		return fmt.Errorf("creating client: %w", err)
 	}
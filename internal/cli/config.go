@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type ConfigOptions struct {
+	GlobalOptions
+
+	// Service is the name of the service a context refers to
+	Service string
+	// AuthInfo is the name of the credentials a context refers to
+	AuthInfo string
+}
+
+func DefaultConfigOptions() *ConfigOptions {
+	return &ConfigOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Service:       "",
+		AuthInfo:      "",
+	}
+}
+
+func NewCmdConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config SUBCOMMAND",
+		Short: "Modify the contexts of the client config.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(newCmdConfigSubcommand("get-contexts", "List the contexts of the client config.", cobra.NoArgs, (*ConfigOptions).RunGetContexts))
+	cmd.AddCommand(newCmdConfigSubcommand("current-context", "Print the name of the current context.", cobra.NoArgs, (*ConfigOptions).RunCurrentContext))
+	cmd.AddCommand(newCmdConfigSubcommand("use-context NAME", "Set the current context.", cobra.ExactArgs(1), (*ConfigOptions).RunUseContext))
+	cmd.AddCommand(newCmdConfigSubcommand("set-context NAME", "Set the service and authentication a context refers to, creating the context if needed.", cobra.ExactArgs(1), (*ConfigOptions).RunSetContext))
+	cmd.AddCommand(newCmdConfigSubcommand("delete-context NAME", "Delete a context along with the services and authentications no other context refers to.", cobra.ExactArgs(1), (*ConfigOptions).RunDeleteContext))
+	return cmd
+}
+
+func newCmdConfigSubcommand(use string, short string, args cobra.PositionalArgs, run func(*ConfigOptions, context.Context, []string) error) *cobra.Command {
+	o := DefaultConfigOptions()
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return run(o, cmd.Context(), args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	if cmd.Name() == "set-context" {
+		cmd.Flags().StringVar(&o.Service, "service", o.Service, "The name of the service the context refers to.")
+		cmd.Flags().StringVar(&o.AuthInfo, "authentication", o.AuthInfo, "The name of the authentication the context refers to.")
+	}
+	return cmd
+}
+
+func (o *ConfigOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+}
+
+func (o *ConfigOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ConfigOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	if len(args) > 0 && len(args[0]) == 0 {
+		return fmt.Errorf("the name of the context must not be empty")
+	}
+	return nil
+}
+
+func (o *ConfigOptions) RunGetContexts(ctx context.Context, args []string) error {
+	config, err := client.LoadConfigFile(o.ConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "CURRENT\tNAME\tSERVICE\tSERVER\tAUTHENTICATION")
+	for _, namedContext := range config.Contexts {
+		current := ""
+		if namedContext.Name == config.CurrentContext {
+			current = "*"
+		}
+		server := ""
+		if service, ok := config.GetService(namedContext.Context.Service); ok {
+			server = service.Server
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", current, namedContext.Name, namedContext.Context.Service, server, namedContext.Context.AuthInfo)
+	}
+	return w.Flush()
+}
+
+func (o *ConfigOptions) RunCurrentContext(ctx context.Context, args []string) error {
+	config, err := client.LoadConfigFile(o.ConfigFilePath)
+	if err != nil {
+		return err
+	}
+	if len(config.CurrentContext) == 0 {
+		return fmt.Errorf("current context is not set")
+	}
+	fmt.Println(config.CurrentContext)
+	return nil
+}
+
+func (o *ConfigOptions) RunUseContext(ctx context.Context, args []string) error {
+	config, err := client.LoadConfigFile(o.ConfigFilePath)
+	if err != nil {
+		return err
+	}
+	if _, ok := config.GetContext(args[0]); !ok {
+		return fmt.Errorf("context %q not found", args[0])
+	}
+	config.CurrentContext = args[0]
+	if err := config.Persist(o.ConfigFilePath); err != nil {
+		return err
+	}
+	fmt.Printf("Switched to context %q.\n", args[0])
+	return nil
+}
+
+func (o *ConfigOptions) RunSetContext(ctx context.Context, args []string) error {
+	config, err := client.LoadConfigFile(o.ConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+	context, exists := config.GetContext(name)
+	if !exists {
+		if len(o.Service) == 0 {
+			return fmt.Errorf("the service of the new context %q must be specified with --service", name)
+		}
+		config.Contexts = append(config.Contexts, client.NamedContext{Name: name})
+		context, _ = config.GetContext(name)
+	}
+	if len(o.Service) > 0 {
+		if _, ok := config.GetService(o.Service); !ok {
+			return fmt.Errorf("service %q not found", o.Service)
+		}
+		context.Service = o.Service
+	}
+	if len(o.AuthInfo) > 0 {
+		if _, ok := config.GetAuthInfo(o.AuthInfo); !ok {
+			return fmt.Errorf("authentication %q not found", o.AuthInfo)
+		}
+		context.AuthInfo = o.AuthInfo
+	}
+
+	if err := config.Persist(o.ConfigFilePath); err != nil {
+		return err
+	}
+	if exists {
+		fmt.Printf("Context %q modified.\n", name)
+	} else {
+		fmt.Printf("Context %q created.\n", name)
+	}
+	return nil
+}
+
+func (o *ConfigOptions) RunDeleteContext(ctx context.Context, args []string) error {
+	config, err := client.LoadConfigFile(o.ConfigFilePath)
+	if err != nil {
+		return err
+	}
+	if err := config.DeleteContext(args[0]); err != nil {
+		return err
+	}
+	if err := config.Persist(o.ConfigFilePath); err != nil {
+		return err
+	}
+	fmt.Printf("Deleted context %q.\n", args[0])
+	return nil
+}
@@ -10,7 +10,6 @@ import (
 	"time"
 
 	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
-	"github.com/flightctl/flightctl/internal/auth/common"
 	"github.com/flightctl/flightctl/internal/client"
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/spf13/cobra"
@@ -79,7 +78,7 @@ func (o *ConsoleOptions) Validate(args []string) error {
 }
 
 func (o *ConsoleOptions) Run(ctx context.Context, args []string) error {
-	config, err := client.ParseConfigFile(o.ConfigFilePath)
+	config, err := client.ParseConfigFileForContext(o.ConfigFilePath, o.Context)
 	if err != nil {
 		return fmt.Errorf("parsing config file: %w", err)
 	}
@@ -105,7 +104,7 @@ func (o *ConsoleOptions) Run(ctx context.Context, args []string) error {
 	grpcEndpoint := console.JSON200.GRPCEndpoint
 	sessionID := console.JSON200.SessionID
 
-	err = o.connectViaGRPC(ctx, grpcEndpoint, sessionID, config.AuthInfo.Token)
+	err = o.connectViaGRPC(ctx, config, grpcEndpoint, sessionID)
 	if err == io.EOF {
 		fmt.Println("Connection closed")
 		return nil
@@ -114,18 +113,17 @@ func (o *ConsoleOptions) Run(ctx context.Context, args []string) error {
 }
 
 // TODO: Move this to a websocket call instead later, the console endpoint will redirect to a ws method
-func (o *ConsoleOptions) connectViaGRPC(ctx context.Context, grpcEndpoint, sessionID string, token string) error {
+func (o *ConsoleOptions) connectViaGRPC(ctx context.Context, config *client.Config, grpcEndpoint, sessionID string) error {
 	//grpcEndpoint = "grpcs://192.168.1.10:7444"
 	grpcEndpoint = strings.TrimRight(grpcEndpoint, "/")
 	fmt.Printf("Connecting to %s with session id %s\n", grpcEndpoint, sessionID)
-	client, err := client.NewGrpcClientFromConfigFile(o.ConfigFilePath, grpcEndpoint)
+	client, err := client.NewGRPCClientFromConfig(config, grpcEndpoint)
 	if err != nil {
 		return fmt.Errorf("creating grpc client: %w", err)
 	}
 	// add key-value pairs of metadata to context
 	ctx = metadata.AppendToOutgoingContext(ctx, consts.GrpcSessionIDKey, sessionID)
 	ctx = metadata.AppendToOutgoingContext(ctx, consts.GrpcClientNameKey, "flightctl-cli")
-	ctx = metadata.AppendToOutgoingContext(ctx, common.AuthHeader, fmt.Sprintf("Bearer %s", token))
 
 	stream, err := client.Stream(ctx)
 	if err != nil {
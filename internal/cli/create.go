@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type CreateTokenOptions struct {
+	GlobalOptions
+
+	// Name is the name of the token, unique within the service account
+	Name string
+	// Scopes restrict the token to a subset of the scopes of the service account
+	Scopes []string
+	// ExpiresIn is how long the token is accepted, or zero if it does not expire
+	ExpiresIn time.Duration
+}
+
+func DefaultCreateTokenOptions() *CreateTokenOptions {
+	return &CreateTokenOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Name:          "",
+		Scopes:        []string{},
+		ExpiresIn:     0,
+	}
+}
+
+func NewCmdCreate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create SUBCOMMAND",
+		Short: "Create credentials.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(NewCmdCreateToken())
+	return cmd
+}
+
+func NewCmdCreateToken() *cobra.Command {
+	o := DefaultCreateTokenOptions()
+	cmd := &cobra.Command{
+		Use:   "token SERVICEACCOUNT --name NAME",
+		Short: "Create an API token for a service account and print it.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context(), args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *CreateTokenOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVar(&o.Name, "name", o.Name, "The name of the token, unique within the service account.")
+	fs.StringArrayVar(&o.Scopes, "scope", o.Scopes, "A scope to grant the token, such as \"read\" or \"fleets:write\". Defaults to the scopes of the service account.")
+	fs.DurationVar(&o.ExpiresIn, "expires-in", o.ExpiresIn, "How long the token is accepted, such as \"720h\". By default the token does not expire.")
+}
+
+func (o *CreateTokenOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateTokenOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	if len(o.Name) == 0 {
+		return fmt.Errorf("the name of the token must be specified with --name")
+	}
+	if o.ExpiresIn < 0 {
+		return fmt.Errorf("--expires-in must not be negative")
+	}
+	return errors.Join(o.tokenRequest().Validate()...)
+}
+
+func (o *CreateTokenOptions) tokenRequest() api.ServiceAccountTokenRequest {
+	request := api.ServiceAccountTokenRequest{Name: o.Name}
+	if len(o.Scopes) > 0 {
+		request.Scopes = &o.Scopes
+	}
+	if o.ExpiresIn > 0 {
+		expiresAt := time.Now().Add(o.ExpiresIn).UTC()
+		request.ExpiresAt = &expiresAt
+	}
+	return request
+}
+
+func (o *CreateTokenOptions) Run(ctx context.Context, args []string) error {
+	c, err := client.NewFromConfigFileForContext(o.ConfigFilePath, o.Context)
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	serviceAccount := args[0]
+	response, err := c.CreateServiceAccountTokenWithResponse(ctx, serviceAccount, o.tokenRequest())
+	if err != nil {
+		return fmt.Errorf("creating token for %s/%s: %w", ServiceAccountKind, serviceAccount, err)
+	}
+	if response.HTTPResponse.StatusCode != http.StatusCreated {
+		return fmt.Errorf("creating token for %s/%s: %s (%s)", ServiceAccountKind, serviceAccount, string(response.Body), response.HTTPResponse.Status)
+	}
+
+	// The token is only stored hashed, so this is the only time it can be printed.
+	fmt.Println(response.JSON201.Token)
+	return nil
+}
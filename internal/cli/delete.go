@@ -15,12 +15,15 @@ type DeleteOptions struct {
 	GlobalOptions
 
 	FleetName string
+	// Token is the name of the token to revoke from a service account
+	Token string
 }
 
 func DefaultDeleteOptions() *DeleteOptions {
 	return &DeleteOptions{
 		GlobalOptions: DefaultGlobalOptions(),
 		FleetName:     "",
+		Token:         "",
 	}
 }
 
@@ -50,6 +53,7 @@ func (o *DeleteOptions) Bind(fs *pflag.FlagSet) {
 	o.GlobalOptions.Bind(fs)
 
 	fs.StringVarP(&o.FleetName, "fleetname", "f", o.FleetName, "Fleet name for accessing templateversions.")
+	fs.StringVar(&o.Token, "token", o.Token, "Revoke the token with this name from the service account instead of deleting the service account.")
 }
 
 func (o *DeleteOptions) Complete(cmd *cobra.Command, args []string) error {
@@ -65,10 +69,13 @@ func (o *DeleteOptions) Validate(args []string) error {
 		return err
 	}
 
-	kind, _, err := parseAndValidateKindName(args[0])
+	kind, name, err := parseAndValidateKindName(args[0])
 	if err != nil {
 		return err
 	}
+	if len(o.Token) > 0 && (kind != ServiceAccountKind || len(name) == 0) {
+		return fmt.Errorf("token can only be specified when deleting a specific %s", ServiceAccountKind)
+	}
 	if kind == TemplateVersionKind && len(o.FleetName) == 0 {
 		return fmt.Errorf("fleetname must be specified when deleting templateversions")
 	}
@@ -76,7 +83,7 @@ func (o *DeleteOptions) Validate(args []string) error {
 }
 
 func (o *DeleteOptions) Run(ctx context.Context, args []string) error { //nolint:gocyclo
-	c, err := client.NewFromConfigFile(o.ConfigFilePath)
+	c, err := client.NewFromConfigFileForContext(o.ConfigFilePath, o.Context)
 	if err != nil {
 		return fmt.Errorf("creating client: %w", err)
 	}
@@ -97,6 +104,16 @@ func (o *DeleteOptions) Run(ctx context.Context, args []string) error { //nolint
 		response, err = c.DeleteEnrollmentRequestWithResponse(ctx, name)
 	case kind == EnrollmentRequestKind && len(name) == 0:
 		response, err = c.DeleteEnrollmentRequestsWithResponse(ctx)
+	case kind == EnrollmentPolicyKind && len(name) > 0:
+		response, err = c.DeleteEnrollmentPolicyWithResponse(ctx, name)
+	case kind == EnrollmentPolicyKind && len(name) == 0:
+		response, err = c.DeleteEnrollmentPoliciesWithResponse(ctx)
+	case kind == ServiceAccountKind && len(name) > 0 && len(o.Token) > 0:
+		response, err = c.DeleteServiceAccountTokenWithResponse(ctx, name, o.Token)
+	case kind == ServiceAccountKind && len(name) > 0:
+		response, err = c.DeleteServiceAccountWithResponse(ctx, name)
+	case kind == ServiceAccountKind && len(name) == 0:
+		response, err = c.DeleteServiceAccountsWithResponse(ctx)
 	case kind == FleetKind && len(name) > 0:
 		response, err = c.DeleteFleetWithResponse(ctx, name)
 	case kind == FleetKind && len(name) == 0:
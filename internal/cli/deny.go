@@ -76,7 +76,7 @@ func (o *DenyOptions) Validate(args []string) error {
 }
 
 func (o *DenyOptions) Run(ctx context.Context, args []string) error {
-	c, err := client.NewFromConfigFile(o.ConfigFilePath)
+	c, err := client.NewFromConfigFileForContext(o.ConfigFilePath, o.Context)
 	if err != nil {
 		return fmt.Errorf("creating client: %w", err)
 	}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	apiclient "github.com/flightctl/flightctl/internal/api/client"
+)
+
+const (
+	deviceBundleKind = "devicebundle"
+	// deviceBundlePollInterval is how often to check whether the device uploaded
+	// the requested bundle.
+	deviceBundlePollInterval = 2 * time.Second
+	// deviceBundleTimeout is how long to wait for the device to upload the
+	// requested bundle, which it does the next time it fetches its rendered spec.
+	deviceBundleTimeout = 5 * time.Minute
+)
+
+func (o *GetOptions) validateDeviceBundle(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("%s requires a device: %s device/NAME", deviceBundleKind, deviceBundleKind)
+	}
+	kind, name, err := parseAndValidateKindName(args[1])
+	if err != nil {
+		return err
+	}
+	if kind != DeviceKind || len(name) == 0 {
+		return fmt.Errorf("%s requires a device: %s device/NAME", deviceBundleKind, deviceBundleKind)
+	}
+	if len(o.Output) == 0 {
+		return fmt.Errorf("output must be specified as the file to write the %s to", deviceBundleKind)
+	}
+	return nil
+}
+
+// getDeviceBundle requests a diagnostic bundle from the device, waits for the
+// device to upload it and writes it to the output file.
+func (o *GetOptions) getDeviceBundle(ctx context.Context, c *apiclient.ClientWithResponses, arg string) error {
+	_, name, err := parseAndValidateKindName(arg)
+	if err != nil {
+		return err
+	}
+
+	requestResponse, err := c.RequestDeviceBundleWithResponse(ctx, name)
+	if err != nil {
+		return fmt.Errorf("requesting bundle of device %s: %w", name, err)
+	}
+	if err := validateHttpResponse(requestResponse.Body, requestResponse.StatusCode(), http.StatusCreated); err != nil {
+		return fmt.Errorf("requesting bundle of device %s: %w", name, err)
+	}
+	fmt.Fprintf(os.Stderr, "Requested bundle %s, waiting for device %s to upload it...\n", requestResponse.JSON201.RequestID, name)
+
+	ctx, cancel := context.WithTimeout(ctx, deviceBundleTimeout)
+	defer cancel()
+	ticker := time.NewTicker(deviceBundlePollInterval)
+	defer ticker.Stop()
+	for {
+		response, err := c.GetDeviceBundleWithResponse(ctx, name)
+		if err != nil {
+			return fmt.Errorf("getting bundle of device %s: %w", name, err)
+		}
+		switch response.StatusCode() {
+		case http.StatusOK:
+			if err := os.WriteFile(o.Output, response.Body, 0600); err != nil {
+				return fmt.Errorf("writing bundle of device %s: %w", name, err)
+			}
+			fmt.Fprintf(os.Stderr, "Wrote bundle of device %s to %s\n", name, o.Output)
+			return nil
+		case http.StatusAccepted:
+			if response.JSON202 != nil && response.JSON202.RequestID != requestResponse.JSON201.RequestID {
+				return fmt.Errorf("getting bundle of device %s: the request was replaced by bundle request %s", name, response.JSON202.RequestID)
+			}
+		default:
+			if err := validateHttpResponse(response.Body, response.StatusCode(), http.StatusOK); err != nil {
+				return fmt.Errorf("getting bundle of device %s: %w", name, err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for device %s to upload its bundle", name)
+		case <-ticker.C:
+		}
+	}
+}
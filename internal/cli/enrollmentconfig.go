@@ -73,7 +73,7 @@ func (o *EnrollmentConfigOptions) Validate(args []string) error {
 func (o *EnrollmentConfigOptions) Run(ctx context.Context, args []string) error {
 	var pw []byte
 
-	c, err := client.NewFromConfigFile(o.ConfigFilePath)
+	c, err := client.NewFromConfigFileForContext(o.ConfigFilePath, o.Context)
 	if err != nil {
 		return fmt.Errorf("creating client: %w", err)
 	}
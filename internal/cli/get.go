@@ -43,8 +43,10 @@ type GetOptions struct {
 	Continue      string
 	FleetName     string
 	Rendered      bool
+	Overrides     bool
 	Summary       bool
 	SummaryOnly   bool
+	For           string
 }
 
 func DefaultGetOptions() *GetOptions {
@@ -58,15 +60,16 @@ func DefaultGetOptions() *GetOptions {
 		Continue:      "",
 		FleetName:     "",
 		Rendered:      false,
+		Overrides:     false,
 	}
 }
 
 func NewCmdGet() *cobra.Command {
 	o := DefaultGetOptions()
 	cmd := &cobra.Command{
-		Use:       "get (TYPE | TYPE/NAME)",
-		Short:     "Display one or many resources.",
-		Args:      cobra.ExactArgs(1),
+		Use:       "get (TYPE | TYPE/NAME | devicebundle device/NAME)",
+		Short:     "Display one or many resources, or download the diagnostic bundle of a device.",
+		Args:      cobra.RangeArgs(1, 2),
 		ValidArgs: getValidResourceKinds(),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := o.Complete(cmd, args); err != nil {
@@ -90,13 +93,15 @@ func (o *GetOptions) Bind(fs *pflag.FlagSet) {
 	fs.StringVarP(&o.LabelSelector, "selector", "l", o.LabelSelector, "Selector (label query) to filter on, as a comma-separated list of key=value.")
 	fs.StringVar(&o.FieldSelector, "field-selector", o.FieldSelector, "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2).")
 	fs.StringSliceVar(&o.StatusFilter, "status-filter", o.StatusFilter, "Filter the results by status field path using key-value pairs. Example: --status-filter=updated.status=UpToDate")
-	fs.StringVarP(&o.Output, "output", "o", o.Output, fmt.Sprintf("Output format. One of: (%s).", strings.Join(legalOutputTypes, ", ")))
+	fs.StringVarP(&o.Output, "output", "o", o.Output, fmt.Sprintf("Output format. One of: (%s). The file to write to when getting a %s.", strings.Join(legalOutputTypes, ", "), deviceBundleKind))
 	fs.Int32Var(&o.Limit, "limit", o.Limit, "The maximum number of results returned in the list response.")
 	fs.StringVar(&o.Continue, "continue", o.Continue, "Query more results starting from the value of the 'continue' field in the previous response.")
 	fs.StringVar(&o.FleetName, "fleetname", o.FleetName, "Fleet name for accessing templateversions (use only when getting templateversions).")
 	fs.BoolVar(&o.Rendered, "rendered", false, "Return the rendered device configuration that is presented to the device (use only when getting devices).")
+	fs.BoolVar(&o.Overrides, "overrides", false, "Return the overrides that are merged over the fleet template of the device (use only when getting devices).")
 	fs.BoolVarP(&o.Summary, "summary", "s", false, "Display summary information.")
 	fs.BoolVar(&o.SummaryOnly, "summary-only", false, "Display summary information only.")
+	fs.StringVar(&o.For, "for", o.For, "Only display the events or audit records of the resource given as TYPE/NAME, e.g. device/NAME (use only when getting events or audit records).")
 }
 
 func (o *GetOptions) Complete(cmd *cobra.Command, args []string) error {
@@ -104,8 +109,8 @@ func (o *GetOptions) Complete(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// The RenderedDeviceSpec can only be printed as JSON or YAML, so default to JSON if not set
-	if o.Rendered && len(o.Output) == 0 {
+	// The RenderedDeviceSpec and DeviceOverrides can only be printed as JSON or YAML, so default to JSON if not set
+	if (o.Rendered || o.Overrides) && len(o.Output) == 0 {
 		o.Output = jsonFormat
 	}
 	return nil
@@ -116,6 +121,12 @@ func (o *GetOptions) Validate(args []string) error {
 		return err
 	}
 
+	if args[0] == deviceBundleKind {
+		return o.validateDeviceBundle(args)
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("only %s accepts a second argument", deviceBundleKind)
+	}
 	kind, name, err := parseAndValidateKindName(args[0])
 	if err != nil {
 		return err
@@ -147,6 +158,24 @@ func (o *GetOptions) Validate(args []string) error {
 			}
 		}
 	}
+	if kind == EventKind && len(name) > 0 {
+		return fmt.Errorf("cannot get a single event, use --for to get the events of a resource")
+	}
+	if kind == AuditRecordKind && len(name) > 0 {
+		return fmt.Errorf("cannot get a single audit record, use --for to get the audit records of a resource")
+	}
+	if len(o.For) > 0 {
+		if kind != EventKind && kind != AuditRecordKind {
+			return fmt.Errorf("for can only be specified when fetching events or audit records")
+		}
+		forKind, forName, err := parseAndValidateKindName(o.For)
+		if err != nil {
+			return err
+		}
+		if forKind == EventKind || forKind == AuditRecordKind || len(forName) == 0 {
+			return fmt.Errorf("for must be a resource given as TYPE/NAME, e.g. device/NAME")
+		}
+	}
 	if kind == TemplateVersionKind && len(o.FleetName) == 0 {
 		return fmt.Errorf("fleetname must be specified when fetching templateversions")
 	}
@@ -161,6 +190,17 @@ func (o *GetOptions) Validate(args []string) error {
 			return fmt.Errorf("rendered output must be one of (json, yaml)")
 		}
 	}
+	if o.Overrides {
+		if o.Rendered {
+			return fmt.Errorf("overrides and rendered must not be specified together")
+		}
+		if kind != DeviceKind || len(name) == 0 {
+			return fmt.Errorf("overrides must only be specified when fetching a specific device")
+		}
+		if o.Output != jsonFormat && o.Output != yamlFormat {
+			return fmt.Errorf("overrides output must be one of (json, yaml)")
+		}
+	}
 	if o.Limit < 0 {
 		return fmt.Errorf("limit must be greater than 0")
 	}
@@ -168,11 +208,15 @@ func (o *GetOptions) Validate(args []string) error {
 }
 
 func (o *GetOptions) Run(ctx context.Context, args []string) error { //nolint:gocyclo
-	c, err := client.NewFromConfigFile(o.ConfigFilePath)
+	c, err := client.NewFromConfigFileForContext(o.ConfigFilePath, o.Context)
 	if err != nil {
 		return fmt.Errorf("creating client: %w", err)
 	}
 
+	if args[0] == deviceBundleKind {
+		return o.getDeviceBundle(ctx, c, args[1])
+	}
+
 	var response interface{}
 
 	kind, name, err := parseAndValidateKindName(args[0])
@@ -180,10 +224,12 @@ func (o *GetOptions) Run(ctx context.Context, args []string) error { //nolint:go
 		return err
 	}
 	switch {
-	case kind == DeviceKind && len(name) > 0 && !o.Rendered:
-		response, err = c.ReadDeviceWithResponse(ctx, name)
 	case kind == DeviceKind && len(name) > 0 && o.Rendered:
 		response, err = c.GetRenderedDeviceSpecWithResponse(ctx, name, &api.GetRenderedDeviceSpecParams{})
+	case kind == DeviceKind && len(name) > 0 && o.Overrides:
+		response, err = c.ReadDeviceOverridesWithResponse(ctx, name)
+	case kind == DeviceKind && len(name) > 0:
+		response, err = c.ReadDeviceWithResponse(ctx, name)
 	case kind == DeviceKind && len(name) == 0:
 		params := api.ListDevicesParams{
 			Owner:         util.StrToPtrWithNilDefault(o.Owner),
@@ -205,6 +251,26 @@ func (o *GetOptions) Run(ctx context.Context, args []string) error { //nolint:go
 			Continue:      util.StrToPtrWithNilDefault(o.Continue),
 		}
 		response, err = c.ListEnrollmentRequestsWithResponse(ctx, &params)
+	case kind == EnrollmentPolicyKind && len(name) > 0:
+		response, err = c.ReadEnrollmentPolicyWithResponse(ctx, name)
+	case kind == EnrollmentPolicyKind && len(name) == 0:
+		params := api.ListEnrollmentPoliciesParams{
+			LabelSelector: util.StrToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.StrToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.Int32ToPtrWithNilDefault(o.Limit),
+			Continue:      util.StrToPtrWithNilDefault(o.Continue),
+		}
+		response, err = c.ListEnrollmentPoliciesWithResponse(ctx, &params)
+	case kind == ServiceAccountKind && len(name) > 0:
+		response, err = c.ReadServiceAccountWithResponse(ctx, name)
+	case kind == ServiceAccountKind && len(name) == 0:
+		params := api.ListServiceAccountsParams{
+			LabelSelector: util.StrToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.StrToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.Int32ToPtrWithNilDefault(o.Limit),
+			Continue:      util.StrToPtrWithNilDefault(o.Continue),
+		}
+		response, err = c.ListServiceAccountsWithResponse(ctx, &params)
 	case kind == FleetKind && len(name) > 0:
 		response, err = c.ReadFleetWithResponse(ctx, name, nil)
 	case kind == FleetKind && len(name) == 0:
@@ -256,12 +322,43 @@ func (o *GetOptions) Run(ctx context.Context, args []string) error { //nolint:go
 			Continue:      util.StrToPtrWithNilDefault(o.Continue),
 		}
 		response, err = c.ListCertificateSigningRequestsWithResponse(ctx, &params)
+	case kind == EventKind:
+		params := api.ListEventsParams{
+			FieldSelector: util.StrToPtrWithNilDefault(o.forFieldSelector("involvedObject")),
+			Limit:         util.Int32ToPtrWithNilDefault(o.Limit),
+			Continue:      util.StrToPtrWithNilDefault(o.Continue),
+		}
+		response, err = c.ListEventsWithResponse(ctx, &params)
+	case kind == AuditRecordKind:
+		params := api.ListAuditRecordsParams{
+			FieldSelector: util.StrToPtrWithNilDefault(o.forFieldSelector("resource")),
+			Limit:         util.Int32ToPtrWithNilDefault(o.Limit),
+			Continue:      util.StrToPtrWithNilDefault(o.Continue),
+		}
+		response, err = c.ListAuditRecordsWithResponse(ctx, &params)
 	default:
 		return fmt.Errorf("unsupported resource kind: %s", kind)
 	}
 	return o.processReponse(response, err, kind, name)
 }
 
+// forFieldSelector returns the field selector of the events or audit records, restricted
+// to those whose object reference in the field refers to the resource given with --for.
+func (o *GetOptions) forFieldSelector(field string) string {
+	if len(o.For) == 0 {
+		return o.FieldSelector
+	}
+	forKind, forName, _ := parseAndValidateKindName(o.For)
+	selectors := []string{
+		fmt.Sprintf("%s.kind=%s", field, apiKinds[forKind]),
+		fmt.Sprintf("%s.name=%s", field, forName),
+	}
+	if len(o.FieldSelector) > 0 {
+		selectors = append(selectors, o.FieldSelector)
+	}
+	return strings.Join(selectors, ",")
+}
+
 func (o *GetOptions) processReponse(response interface{}, err error, kind string, name string) error {
 	errorPrefix := fmt.Sprintf("reading %s/%s", kind, name)
 	if len(name) == 0 {
@@ -339,6 +436,14 @@ func (o *GetOptions) printTable(response interface{}, kind string, name string)
 		o.printEnrollmentRequestsTable(w, response.(*apiclient.ListEnrollmentRequestsResponse).JSON200.Items...)
 	case kind == EnrollmentRequestKind && len(name) > 0:
 		o.printEnrollmentRequestsTable(w, *(response.(*apiclient.ReadEnrollmentRequestResponse).JSON200))
+	case kind == EnrollmentPolicyKind && len(name) == 0:
+		o.printEnrollmentPoliciesTable(w, response.(*apiclient.ListEnrollmentPoliciesResponse).JSON200.Items...)
+	case kind == EnrollmentPolicyKind && len(name) > 0:
+		o.printEnrollmentPoliciesTable(w, *(response.(*apiclient.ReadEnrollmentPolicyResponse).JSON200))
+	case kind == ServiceAccountKind && len(name) == 0:
+		o.printServiceAccountsTable(w, response.(*apiclient.ListServiceAccountsResponse).JSON200.Items...)
+	case kind == ServiceAccountKind && len(name) > 0:
+		o.printServiceAccountsTable(w, *(response.(*apiclient.ReadServiceAccountResponse).JSON200))
 	case kind == FleetKind && len(name) == 0:
 		o.printFleetsTable(w, response.(*apiclient.ListFleetsResponse).JSON200.Items...)
 	case kind == FleetKind && len(name) > 0:
@@ -359,6 +464,10 @@ func (o *GetOptions) printTable(response interface{}, kind string, name string)
 		o.printCSRTable(w, response.(*apiclient.ListCertificateSigningRequestsResponse).JSON200.Items...)
 	case kind == CertificateSigningRequestKind && len(name) > 0:
 		o.printCSRTable(w, *(response.(*apiclient.ReadCertificateSigningRequestResponse).JSON200))
+	case kind == EventKind:
+		o.printEventsTable(w, response.(*apiclient.ListEventsResponse).JSON200.Items...)
+	case kind == AuditRecordKind:
+		o.printAuditRecordsTable(w, response.(*apiclient.ListAuditRecordsResponse).JSON200.Items...)
 	default:
 		return fmt.Errorf("unknown resource type %s", kind)
 	}
@@ -442,6 +551,60 @@ func (o *GetOptions) printEnrollmentRequestsTable(w *tabwriter.Writer, ers ...ap
 	}
 }
 
+func (o *GetOptions) printEnrollmentPoliciesTable(w *tabwriter.Writer, eps ...api.EnrollmentPolicy) {
+	fmt.Fprintln(w, "NAME\tSELECTOR\tVALID UNTIL\tAPPROVED\tLABELS")
+	for _, e := range eps {
+		selector := "<none>"
+		if e.Spec.Selector != nil {
+			selector = strings.Join(util.LabelMapToArray(e.Spec.Selector.MatchLabels), ",")
+		}
+		validUntil := "<none>"
+		if e.Spec.ValidUntil != nil {
+			validUntil = e.Spec.ValidUntil.Format(time.RFC3339)
+		}
+		approved := "0"
+		if e.Status != nil {
+			approved = fmt.Sprintf("%d", e.Status.ApprovedCount)
+		}
+		if e.Spec.MaxApprovals != nil {
+			approved = fmt.Sprintf("%s/%d", approved, *e.Spec.MaxApprovals)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			*e.Metadata.Name,
+			selector,
+			validUntil,
+			approved,
+			strings.Join(util.LabelMapToArray(e.Spec.Labels), ","),
+		)
+	}
+}
+
+func (o *GetOptions) printServiceAccountsTable(w *tabwriter.Writer, sas ...api.ServiceAccount) {
+	fmt.Fprintln(w, "NAME\tSCOPES\tTOKENS\tLAST USED")
+	for _, sa := range sas {
+		tokens := []string{}
+		var lastUsed *time.Time
+		if sa.Status != nil {
+			for _, token := range sa.Status.Tokens {
+				tokens = append(tokens, token.Name)
+				if token.LastUsedAt != nil && (lastUsed == nil || token.LastUsedAt.After(*lastUsed)) {
+					lastUsed = token.LastUsedAt
+				}
+			}
+		}
+		lastUsedStr := NoneString
+		if lastUsed != nil {
+			lastUsedStr = humanize.Time(*lastUsed)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			*sa.Metadata.Name,
+			strings.Join(sa.Spec.Scopes, ","),
+			util.DefaultString(strings.Join(tokens, ","), NoneString),
+			lastUsedStr,
+		)
+	}
+}
+
 func (o *GetOptions) printFleetsTable(w *tabwriter.Writer, fleets ...api.Fleet) {
 	fmt.Fprintln(w, "NAME\tOWNER\tSELECTOR\tVALID\tDEVICES")
 	for i := range fleets {
@@ -567,3 +730,38 @@ func (o *GetOptions) printCSRTable(w *tabwriter.Writer, csrs ...api.CertificateS
 		)
 	}
 }
+
+func (o *GetOptions) printEventsTable(w *tabwriter.Writer, events ...api.Event) {
+	fmt.Fprintln(w, "LAST SEEN\tTYPE\tREASON\tOBJECT\tCOUNT\tMESSAGE")
+
+	for _, event := range events {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n",
+			humanize.Time(event.Timestamp),
+			event.Type,
+			event.Reason,
+			fmt.Sprintf("%s/%s", strings.ToLower(event.InvolvedObject.Kind), event.InvolvedObject.Name),
+			event.Count,
+			event.Message,
+		)
+	}
+}
+
+func (o *GetOptions) printAuditRecordsTable(w *tabwriter.Writer, records ...api.AuditRecord) {
+	fmt.Fprintln(w, "TIME\tUSER\tVERB\tRESOURCE\tOUTCOME\tSTATUS\tREQUEST ID")
+
+	for _, record := range records {
+		resource := fmt.Sprintf("%s/%s", strings.ToLower(record.Resource.Kind), record.Resource.Name)
+		if record.Subresource != nil {
+			resource = fmt.Sprintf("%s/%s", resource, *record.Subresource)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\n",
+			humanize.Time(record.Timestamp),
+			util.DefaultString(record.User, NoneString),
+			record.Verb,
+			resource,
+			record.Outcome,
+			record.StatusCode,
+			record.RequestId,
+		)
+	}
+}
@@ -28,11 +28,18 @@ func DefaultGlobalOptions() GlobalOptions {
 }
 
 func (o *GlobalOptions) Bind(fs *pflag.FlagSet) {
-	fs.StringVarP(&o.Context, "context", "c", o.Context, "Read client config from 'client_<context>.yaml' instead of 'client.yaml'.")
+	fs.StringVarP(&o.Context, "context", "c", o.Context, "The name of the client config context to use instead of the current context.")
 }
 
 func (o *GlobalOptions) Complete(cmd *cobra.Command, args []string) error {
-	o.ConfigFilePath = ConfigFilePath(o.Context)
+	o.ConfigFilePath = ConfigFilePath("")
+	// Contexts used to be kept in separate 'client_<context>.yaml' files, which are still read if they exist
+	if legacyConfigFilePath := ConfigFilePath(o.Context); legacyConfigFilePath != o.ConfigFilePath {
+		if _, err := os.Stat(legacyConfigFilePath); err == nil {
+			o.ConfigFilePath = legacyConfigFilePath
+			o.Context = ""
+		}
+	}
 	return nil
 }
 
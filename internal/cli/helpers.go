@@ -16,32 +16,59 @@ const (
 const (
 	DeviceKind                    = "device"
 	EnrollmentRequestKind         = "enrollmentrequest"
+	EnrollmentPolicyKind          = "enrollmentpolicy"
 	FleetKind                     = "fleet"
 	RepositoryKind                = "repository"
 	ResourceSyncKind              = "resourcesync"
 	TemplateVersionKind           = "templateversion"
 	CertificateSigningRequestKind = "certificatesigningrequest"
+	EventKind                     = "event"
+	ServiceAccountKind            = "serviceaccount"
+	AuditRecordKind               = "auditrecord"
 )
 
 var (
 	pluralKinds = map[string]string{
 		DeviceKind:                    "devices",
 		EnrollmentRequestKind:         "enrollmentrequests",
+		EnrollmentPolicyKind:          "enrollmentpolicies",
 		FleetKind:                     "fleets",
 		RepositoryKind:                "repositories",
 		ResourceSyncKind:              "resourcesyncs",
 		TemplateVersionKind:           "templateversions",
 		CertificateSigningRequestKind: "certificatesigningrequests",
+		EventKind:                     "events",
+		ServiceAccountKind:            "serviceaccounts",
+		AuditRecordKind:               "auditrecords",
 	}
 
 	shortnameKinds = map[string]string{
 		DeviceKind:                    "dev",
 		EnrollmentRequestKind:         "er",
+		EnrollmentPolicyKind:          "ep",
 		FleetKind:                     "flt",
 		RepositoryKind:                "repo",
 		ResourceSyncKind:              "rs",
 		TemplateVersionKind:           "tv",
 		CertificateSigningRequestKind: "csr",
+		EventKind:                     "ev",
+		ServiceAccountKind:            "sa",
+		AuditRecordKind:               "audit",
+	}
+
+	// apiKinds maps the kinds to the kinds of the API resources
+	apiKinds = map[string]string{
+		DeviceKind:                    "Device",
+		EnrollmentRequestKind:         "EnrollmentRequest",
+		EnrollmentPolicyKind:          "EnrollmentPolicy",
+		FleetKind:                     "Fleet",
+		RepositoryKind:                "Repository",
+		ResourceSyncKind:              "ResourceSync",
+		TemplateVersionKind:           "TemplateVersion",
+		CertificateSigningRequestKind: "CertificateSigningRequest",
+		EventKind:                     "Event",
+		ServiceAccountKind:            "ServiceAccount",
+		AuditRecordKind:               "AuditRecord",
 	}
 )
 
@@ -2,28 +2,25 @@ package cli
 
 import (
 	"context"
-	"crypto/tls"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net"
 	"net/http"
+	"net/url"
 	"os"
 
-	"github.com/RangelReale/osincli"
 	"github.com/flightctl/flightctl/api/v1alpha1"
 	apiClient "github.com/flightctl/flightctl/internal/api/client"
 	"github.com/flightctl/flightctl/internal/client"
 	"github.com/pkg/browser"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
-	certutil "k8s.io/client-go/util/cert"
+	"golang.org/x/oauth2"
 )
 
 type LoginOptions struct {
 	GlobalOptions
 	Token              string
 	Web                bool
+	DeviceCode         bool
 	ClientId           string
 	InsecureSkipVerify bool
 	CAFile             string
@@ -35,6 +32,7 @@ func DefaultLoginOptions() *LoginOptions {
 		GlobalOptions:      DefaultGlobalOptions(),
 		Token:              "",
 		Web:                false,
+		DeviceCode:         false,
 		ClientId:           "",
 		InsecureSkipVerify: false,
 		CAFile:             "",
@@ -69,6 +67,7 @@ func (o *LoginOptions) Bind(fs *pflag.FlagSet) {
 
 	fs.StringVarP(&o.Token, "token", "t", o.Token, "Bearer token for authentication to the API server")
 	fs.BoolVarP(&o.Web, "web", "w", o.Web, "Login via browser")
+	fs.BoolVarP(&o.DeviceCode, "device-code", "", o.DeviceCode, "Login via the OAuth2 device authorization flow, entering a code on another device")
 	fs.StringVarP(&o.ClientId, "client-id", "", o.ClientId, "ClientId to be used for Oauth2 requests")
 	fs.StringVarP(&o.CAFile, "certificate-authority", "", o.CAFile, "Path to a cert file for the certificate authority")
 	fs.StringVarP(&o.AuthCAFile, "auth-certificate-authority", "", o.AuthCAFile, "Path to a cert file for the auth certificate authority")
@@ -86,156 +85,63 @@ func (o *LoginOptions) Validate(args []string) error {
 	if err := o.GlobalOptions.Validate(args); err != nil {
 		return err
 	}
-	return nil
-}
-
-type OauthServerResponse struct {
-	TokenEndpoint string `json:"token_endpoint"`
-	AuthEndpoint  string `json:"authorization_endpoint"`
-}
-
-func (o *LoginOptions) getAuthClientTransport() (*http.Transport, error) {
-	authTransport := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: o.InsecureSkipVerify, //nolint:gosec
-		},
+	if o.Web && o.DeviceCode {
+		return fmt.Errorf("--web and --device-code cannot be used together")
 	}
-
-	if o.AuthCAFile != "" {
-		caData, err := os.ReadFile(o.AuthCAFile)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read Auth CA file: %w", err)
-		}
-		caPool, err := certutil.NewPoolFromBytes(caData)
-		if err != nil {
-			return nil, fmt.Errorf("failed parsing Auth CA certs: %w", err)
-		}
-
-		authTransport.TLSClientConfig.RootCAs = caPool
+	if o.Token != "" && (o.Web || o.DeviceCode) {
+		return fmt.Errorf("--token cannot be used together with --web or --device-code")
 	}
-
-	return authTransport, nil
+	return nil
 }
 
-func (o *LoginOptions) getOauthConfig(oauthConfigUrl string) (OauthServerResponse, error) {
-	oauthResponse := OauthServerResponse{}
-	req, err := http.NewRequest(http.MethodGet, oauthConfigUrl, nil)
-	if err != nil {
-		return oauthResponse, fmt.Errorf("failed to create http request: %w", err)
-	}
-
-	transport, err := o.getAuthClientTransport()
+// oauthLogin logs in at the OAuth2 provider described by the metadata at the given URL, using the device
+// authorization flow if requested and the browser otherwise.
+func (o *LoginOptions) oauthLogin(ctx context.Context, metadataUrl string, clientId string, scopes []string) (client.AuthInfo, error) {
+	login, err := o.newOAuthLogin(ctx, metadataUrl, clientId, scopes)
 	if err != nil {
-		return oauthResponse, err
+		return client.AuthInfo{}, err
 	}
 
-	httpClient := http.Client{
-		Transport: transport,
-	}
-
-	res, err := httpClient.Do(req)
-	if err != nil {
-		return oauthResponse, fmt.Errorf("failed to fetch oidc config: %w", err)
+	var token *oauth2.Token
+	if o.DeviceCode {
+		token, err = login.DeviceCodeLogin(ctx, func(verificationUri string, userCode string) {
+			fmt.Printf("To login, visit %s and enter the code %s\n", verificationUri, userCode)
+		})
+	} else {
+		token, err = login.WebLogin(ctx, func(loginUrl string) error {
+			fmt.Printf("Opening login URL in default browser: %s\n", loginUrl)
+			if err := browser.OpenURL(loginUrl); err != nil {
+				return fmt.Errorf("failed to open URL in default browser: %w", err)
+			}
+			return nil
+		})
 	}
-
-	defer res.Body.Close()
-	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
-		return oauthResponse, fmt.Errorf("failed to read oidc config: %w", err)
-	}
-	if err := json.Unmarshal(bodyBytes, &oauthResponse); err != nil {
-		return oauthResponse, fmt.Errorf("failed to parse oidc config: %w", err)
+		return client.AuthInfo{}, err
 	}
-	return oauthResponse, nil
+	return login.AuthInfo(token), nil
 }
 
-func (o *LoginOptions) getOauth2Token(oauthConfigUrl string, clientId string, scope string) (string, error) {
-	token := ""
-
-	oauthResponse, err := o.getOauthConfig(oauthConfigUrl)
-	if err != nil {
-		return token, err
+func (o *LoginOptions) newOAuthLogin(ctx context.Context, metadataUrl string, clientId string, scopes []string) (*client.OAuthLogin, error) {
+	login := &client.OAuthLogin{
+		ClientID:           clientId,
+		Scopes:             scopes,
+		InsecureSkipVerify: o.InsecureSkipVerify,
 	}
-
-	// find free port
-	listener, err := net.Listen("tcp", "")
-	if err != nil {
-		return token, fmt.Errorf("failed to open listener: %w", err)
+	if o.ClientId != "" {
+		login.ClientID = o.ClientId
 	}
-
-	port := listener.Addr().(*net.TCPAddr).Port
-	done := make(chan error, 1)
-	mux := http.NewServeMux()
-	callback := fmt.Sprintf("http://127.0.0.1:%d/callback", port)
-
-	var authorizeRequest *osincli.AuthorizeRequest
-	var client *osincli.Client
-
-	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
-		areqdata, err := authorizeRequest.HandleRequest(r)
-		if err != nil {
-			_, _ = w.Write([]byte(fmt.Sprintf("ERROR: %s\n", err)))
-			done <- err
-			return
-		}
-
-		treq := client.NewAccessRequest(osincli.AUTHORIZATION_CODE, areqdata)
-		// exchange the authorize token for the access token
-		ad, err := treq.GetToken()
-		if err != nil {
-			_, err = w.Write([]byte(fmt.Sprintf("ERROR: %s\n", err)))
-			if err != nil {
-				fmt.Println("failed to write response %w", err)
-			}
-			done <- err
-			return
-		}
-		_, err = w.Write([]byte("Login successful. You can close this window and return to CLI."))
-		if err != nil {
-			fmt.Println("failed to write response %w", err)
-		}
-		token = ad.AccessToken
-		done <- nil
-	})
-
-	go func() {
-		err = http.Serve(listener, mux) // #nosec G114
+	if o.AuthCAFile != "" {
+		caData, err := os.ReadFile(o.AuthCAFile)
 		if err != nil {
-			fmt.Println("failed to start local http server %w", err)
+			return nil, fmt.Errorf("failed to read Auth CA file: %w", err)
 		}
-	}()
-
-	config := &osincli.ClientConfig{
-		ClientId:           clientId,
-		AuthorizeUrl:       oauthResponse.AuthEndpoint,
-		TokenUrl:           oauthResponse.TokenEndpoint,
-		RedirectUrl:        callback,
-		ErrorsInStatusCode: true,
-		Scope:              scope,
-	}
-
-	client, err = osincli.NewClient(config)
-
-	transport, err := o.getAuthClientTransport()
-	if err != nil {
-		return token, err
+		login.CertificateAuthorityData = caData
 	}
-	client.Transport = transport
-	if err != nil {
-		return token, fmt.Errorf("failed to create oauth2 client: %w", err)
-	}
-
-	authorizeRequest = client.NewAuthorizeRequest(osincli.CODE)
-
-	loginUrl := authorizeRequest.GetAuthorizeUrl().String()
-	fmt.Printf("Opening login URL in default browser: %s\n", loginUrl)
-	err = browser.OpenURL(loginUrl)
-	if err != nil {
-		return token, fmt.Errorf("failed to open URL in default browser: %w", err)
+	if err := login.Discover(ctx, metadataUrl); err != nil {
+		return nil, err
 	}
-
-	err = <-done
-	return token, err
+	return login, nil
 }
 
 func (o *LoginOptions) Run(ctx context.Context, args []string) error {
@@ -271,11 +177,7 @@ func (o *LoginOptions) Run(ctx context.Context, args []string) error {
 
 	if respCode == http.StatusTeapot {
 		fmt.Println("Auth is disabled")
-		err = config.Persist(o.ConfigFilePath)
-		if err != nil {
-			return fmt.Errorf("persisting client config: %w", err)
-		}
-		return nil
+		return o.persistContext(config)
 	}
 
 	if respCode != http.StatusOK {
@@ -283,62 +185,47 @@ func (o *LoginOptions) Run(ctx context.Context, args []string) error {
 		return nil
 	}
 
-	token := ""
+	authInfo := client.AuthInfo{Token: o.Token}
 
 	if resp.JSON200.AuthType == "OIDC" {
-		if o.Web {
-			clientId := "flightctl"
-			if o.ClientId != "" {
-				clientId = o.ClientId
-			}
-			token, err = o.getOauth2Token(fmt.Sprintf("%s/.well-known/openid-configuration", resp.JSON200.AuthURL), clientId, "openid")
+		if o.Web || o.DeviceCode {
+			authInfo, err = o.oauthLogin(ctx, fmt.Sprintf("%s/.well-known/openid-configuration", resp.JSON200.AuthURL), "flightctl", []string{"openid"})
 			if err != nil {
 				return err
 			}
 		} else if o.Token == "" {
 			fmt.Printf("You must obtain an API token or use \"flightctl login %s --web\" to login via your browser\n", config.Service.Server)
+			fmt.Printf("On hosts without a browser, use \"flightctl login %s --device-code\" to login via another device\n", config.Service.Server)
 			return nil
-		} else {
-			token = o.Token
 		}
 	} else if resp.JSON200.AuthType == "OpenShift" {
 		oauthConfigUrl := fmt.Sprintf("%s/.well-known/oauth-authorization-server", resp.JSON200.AuthURL)
-		if o.Web {
-			clientId := "openshift-cli-client"
-			if o.ClientId != "" {
-				clientId = o.ClientId
-			}
-			token, err = o.getOauth2Token(oauthConfigUrl, clientId, "")
+		if o.Web || o.DeviceCode {
+			authInfo, err = o.oauthLogin(ctx, oauthConfigUrl, "openshift-cli-client", nil)
 			if err != nil {
 				return err
 			}
 		} else if o.Token == "" {
-			oauthConfig, err := o.getOauthConfig(oauthConfigUrl)
+			login, err := o.newOAuthLogin(ctx, oauthConfigUrl, "", nil)
 			if err != nil {
 				return fmt.Errorf("could not get oauth config: %w", err)
 			}
 
-			fmt.Printf("You must obtain an API token by visiting %s/request\n", oauthConfig.TokenEndpoint)
+			fmt.Printf("You must obtain an API token by visiting %s/request\n", login.Metadata.TokenEndpoint)
 			fmt.Printf("Then login via \"flightctl login %s --token=<token>\"\n", config.Service.Server)
 			fmt.Printf("Alternatively, use \"flightctl login %s --web\" to login via your browser\n", config.Service.Server)
 			return fmt.Errorf("no token provided")
-		} else {
-			token = o.Token
-		}
-	} else {
-		if o.Token != "" {
-			token = o.Token
-		} else {
-			fmt.Printf("Unknown auth provider. You can try logging in using \"flightctl login %s --token=<token>\"\n", config.Service.Server)
-			return fmt.Errorf("unknown auth provider")
 		}
+	} else if o.Token == "" {
+		fmt.Printf("Unknown auth provider. You can try logging in using \"flightctl login %s --token=<token>\"\n", config.Service.Server)
+		return fmt.Errorf("unknown auth provider")
 	}
 	c, err = client.NewFromConfig(config)
 	if err != nil {
 		return fmt.Errorf("creating client: %w", err)
 	}
 
-	headerVal := "Bearer " + token
+	headerVal := "Bearer " + authInfo.Token
 	res, err := c.AuthValidateWithResponse(ctx, &v1alpha1.AuthValidateParams{Authentication: &headerVal})
 	if err != nil {
 		return fmt.Errorf("validating token: %w", err)
@@ -358,11 +245,41 @@ func (o *LoginOptions) Run(ctx context.Context, args []string) error {
 		return fmt.Errorf("unexpected status: %v", res.StatusCode())
 	}
 
-	config.AuthInfo.Token = token
-	err = config.Persist(o.ConfigFilePath)
+	config.AuthInfo = authInfo
+	if err := o.persistContext(config); err != nil {
+		return err
+	}
+	fmt.Println("Login successful.")
+	return nil
+}
+
+// persistContext adds the service and credentials of the login to the client config as a context, named
+// after the --context flag or the server, and makes it the current context. Other contexts are kept.
+func (o *LoginOptions) persistContext(config *client.Config) error {
+	clientConfig, err := client.LoadConfigFile(o.ConfigFilePath)
 	if err != nil {
+		return fmt.Errorf("reading client config: %w", err)
+	}
+
+	name := o.Context
+	if len(name) == 0 {
+		name = contextNameForServer(config.Service.Server)
+	}
+	clientConfig.SetContext(name, config.Service, config.AuthInfo)
+	clientConfig.CurrentContext = name
+
+	if err := clientConfig.Persist(o.ConfigFilePath); err != nil {
 		return fmt.Errorf("persisting client config: %w", err)
 	}
-	fmt.Println("Login successful.")
+	fmt.Printf("Switched to context %q.\n", name)
 	return nil
 }
+
+// contextNameForServer returns the host and port of the server URL, or the URL if it cannot be parsed.
+func contextNameForServer(server string) string {
+	u, err := url.Parse(server)
+	if err != nil || len(u.Host) == 0 {
+		return server
+	}
+	return u.Host
+}
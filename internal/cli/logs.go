@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type LogsOptions struct {
+	GlobalOptions
+
+	App    string
+	Unit   string
+	Since  string
+	Tail   int
+	Follow bool
+}
+
+func DefaultLogsOptions() *LogsOptions {
+	return &LogsOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Tail:          -1,
+	}
+}
+
+func NewCmdLogs() *cobra.Command {
+	o := DefaultLogsOptions()
+	cmd := &cobra.Command{
+		Use:   "logs device/NAME",
+		Short: "Print the logs of a device or of one of its applications or systemd units.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context(), args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *LogsOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVar(&o.App, "app", o.App, "Print the logs of the containers of the application with this name.")
+	fs.StringVar(&o.Unit, "unit", o.Unit, "Print the journal entries of the systemd unit with this name.")
+	fs.StringVar(&o.Since, "since", o.Since, "Only print logs newer than a relative duration like 10m or 2h, or than an RFC 3339 timestamp.")
+	fs.IntVar(&o.Tail, "tail", o.Tail, "Number of most recent lines to print. Prints all lines if negative.")
+	fs.BoolVarP(&o.Follow, "follow", "f", o.Follow, "Keep printing new logs.")
+}
+
+func (o *LogsOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *LogsOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	kind, name, err := parseAndValidateKindName(args[0])
+	if err != nil {
+		return err
+	}
+	if kind != DeviceKind {
+		return fmt.Errorf("only devices have logs")
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("device name is required")
+	}
+	if len(o.App) > 0 && len(o.Unit) > 0 {
+		return fmt.Errorf("cannot specify both --app and --unit")
+	}
+	return nil
+}
+
+func (o *LogsOptions) Run(ctx context.Context, args []string) error {
+	c, err := client.NewFromConfigFileForContext(o.ConfigFilePath, o.Context)
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	_, name, err := parseAndValidateKindName(args[0])
+	if err != nil {
+		return err
+	}
+
+	params := &api.GetDeviceLogsParams{}
+	if len(o.App) > 0 {
+		params.App = &o.App
+	}
+	if len(o.Unit) > 0 {
+		params.Unit = &o.Unit
+	}
+	if len(o.Since) > 0 {
+		params.Since = &o.Since
+	}
+	if o.Tail >= 0 {
+		params.Tail = &o.Tail
+	}
+	if o.Follow {
+		params.Follow = &o.Follow
+	}
+
+	response, err := c.GetDeviceLogs(ctx, name, params)
+	if err != nil {
+		return fmt.Errorf("getting logs of device %s: %w", name, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		var responseError api.Error
+		body, _ := io.ReadAll(response.Body)
+		_ = json.Unmarshal(body, &responseError)
+		return fmt.Errorf("getting logs of device %s: %s (%s)", name, responseError.Message, response.Status)
+	}
+
+	// the device sends the logs as they are read, so copy them as they arrive
+	if _, err := io.Copy(os.Stdout, response.Body); err != nil {
+		return fmt.Errorf("reading logs of device %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type PauseOptions struct {
+	GlobalOptions
+
+	// Resume removes the pause label instead of setting it
+	Resume bool
+}
+
+func DefaultPauseOptions() *PauseOptions {
+	return &PauseOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Resume:        false,
+	}
+}
+
+func NewCmdPause() *cobra.Command {
+	o := DefaultPauseOptions()
+	return newCmdPauseOrResume(o, "pause", "Pause fleet management of a device, or of all the devices of a fleet.")
+}
+
+func NewCmdResume() *cobra.Command {
+	o := DefaultPauseOptions()
+	o.Resume = true
+	return newCmdPauseOrResume(o, "resume", "Resume fleet management of a device, or of all the devices of a fleet.")
+}
+
+func newCmdPauseOrResume(o *PauseOptions, use string, short string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " (device/NAME | fleet/NAME)",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context(), args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *PauseOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+}
+
+func (o *PauseOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *PauseOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	kind, name, err := parseAndValidateKindName(args[0])
+	if err != nil {
+		return err
+	}
+
+	if kind != DeviceKind && kind != FleetKind {
+		return fmt.Errorf("kind must be either %s or %s", DeviceKind, FleetKind)
+	}
+
+	if len(name) == 0 {
+		return fmt.Errorf("specify a specific %s", kind)
+	}
+
+	return nil
+}
+
+func (o *PauseOptions) Run(ctx context.Context, args []string) error {
+	c, err := client.NewFromConfigFileForContext(o.ConfigFilePath, o.Context)
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	kind, name, err := parseAndValidateKindName(args[0])
+	if err != nil {
+		return err
+	}
+	action := "pausing"
+	if o.Resume {
+		action = "resuming"
+	}
+	errorPrefix := fmt.Sprintf("%s %s/%s", action, kind, name)
+
+	var labels *map[string]string
+	switch kind {
+	case DeviceKind:
+		response, err := c.ReadDeviceWithResponse(ctx, name)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errorPrefix, err)
+		}
+		if response.JSON200 == nil {
+			return fmt.Errorf("%s: %s", errorPrefix, response.Status())
+		}
+		labels = response.JSON200.Metadata.Labels
+	case FleetKind:
+		response, err := c.ReadFleetWithResponse(ctx, name, nil)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errorPrefix, err)
+		}
+		if response.JSON200 == nil {
+			return fmt.Errorf("%s: %s", errorPrefix, response.Status())
+		}
+		labels = response.JSON200.Metadata.Labels
+	}
+
+	patch := pauseLabelPatch(labels, o.Resume)
+	if len(patch) == 0 {
+		// already in the requested state
+		return nil
+	}
+
+	var status string
+	var statusCode int
+	switch kind {
+	case DeviceKind:
+		response, err := c.PatchDeviceWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx, name, patch)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errorPrefix, err)
+		}
+		status, statusCode = response.Status(), response.StatusCode()
+	case FleetKind:
+		response, err := c.PatchFleetWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx, name, patch)
+		if err != nil {
+			return fmt.Errorf("%s: %w", errorPrefix, err)
+		}
+		status, statusCode = response.Status(), response.StatusCode()
+	}
+	if statusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", errorPrefix, status)
+	}
+
+	return nil
+}
+
+// pauseLabelPatch returns the JSON patch setting or removing the pause label,
+// or an empty patch if the labels are already as requested.
+func pauseLabelPatch(labels *map[string]string, resume bool) api.PatchRequest {
+	paused := api.IsFleetControllerPaused(labels)
+	// "/" in label keys is escaped as "~1" in JSON pointers
+	path := "/metadata/labels/" + strings.ReplaceAll(api.FleetControllerLabel, "/", "~1")
+
+	switch {
+	case resume && paused:
+		return api.PatchRequest{{Op: api.Remove, Path: path}}
+	case !resume && !paused && labels == nil:
+		var value interface{} = map[string]string{api.FleetControllerLabel: api.FleetControllerPaused}
+		return api.PatchRequest{{Op: api.Add, Path: "/metadata/labels", Value: &value}}
+	case !resume && !paused:
+		var value interface{} = api.FleetControllerPaused
+		return api.PatchRequest{{Op: api.Add, Path: path, Value: &value}}
+	default:
+		return api.PatchRequest{}
+	}
+}
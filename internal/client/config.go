@@ -3,6 +3,7 @@ package client
 import (
 	"bytes"
 	"context"
+	gocrypto "crypto"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
@@ -31,16 +33,39 @@ const (
 	TestRootDirEnvKey = "FLIGHTCTL_TEST_ROOT_DIR"
 )
 
+const (
+	// DefaultContextName is the name of the context that a config without contexts is migrated to.
+	DefaultContextName = "default"
+)
+
 // Config holds the information needed to connect to a FlightCtl API server
 type Config struct {
 	Service  Service  `json:"service"`
 	AuthInfo AuthInfo `json:"authentication"`
 
+	// Services are the named services that contexts refer to.
+	// +optional
+	Services []NamedService `json:"services,omitempty"`
+	// AuthInfos are the named credentials that contexts refer to.
+	// +optional
+	AuthInfos []NamedAuthInfo `json:"authentications,omitempty"`
+	// Contexts are the named pairs of a service and the credentials to access it.
+	// If there are contexts, Service and AuthInfo are set from the selected context.
+	// +optional
+	Contexts []NamedContext `json:"contexts,omitempty"`
+	// CurrentContext is the name of the context used unless another one is selected.
+	// +optional
+	CurrentContext string `json:"current-context,omitempty"`
+
 	// baseDir is used to resolve relative paths
 	// If baseDir is empty, the current working directory is used.
 	baseDir string `json:"-"`
 	// TestRootDir is the root directory for test files.
 	testRootDir string `json:"-"`
+	// filename and contextName are the config file and the context the config was read from,
+	// which refreshed tokens are written back to.
+	filename    string `json:"-"`
+	contextName string `json:"-"`
 }
 
 // Service contains information how to connect to and authenticate the FlightCtl API server.
@@ -72,9 +97,40 @@ type AuthInfo struct {
 	// ClientKeyData contains PEM-encoded data from a client key file for TLS. Overrides ClientKey.
 	// +optional
 	ClientKeyData []byte `json:"client-key-data,omitempty" datapolicy:"security-key"`
+	// ClientKeySigner signs with a client key that cannot be read, such as a key
+	// held in a TPM. Overrides ClientKey and ClientKeyData.
+	// +optional
+	ClientKeySigner gocrypto.Signer `json:"-"`
 	// Bearer token for authentication
 	// +optional
 	Token string `json:"token,omitempty"`
+	// AuthProvider is the OAuth2 provider that issued the bearer token, used to refresh it when it expires.
+	// +optional
+	AuthProvider *AuthProvider `json:"auth-provider,omitempty"`
+}
+
+// NamedService is a service that contexts can refer to by its name.
+type NamedService struct {
+	Name    string  `json:"name"`
+	Service Service `json:"service"`
+}
+
+// NamedAuthInfo are credentials that contexts can refer to by their name.
+type NamedAuthInfo struct {
+	Name     string   `json:"name"`
+	AuthInfo AuthInfo `json:"authentication"`
+}
+
+// NamedContext is a context that can be selected by its name.
+type NamedContext struct {
+	Name    string  `json:"name"`
+	Context Context `json:"context"`
+}
+
+// Context refers to a service and the credentials to access it by their names.
+type Context struct {
+	Service  string `json:"service"`
+	AuthInfo string `json:"authentication,omitempty"`
 }
 
 func (c *Config) Equal(c2 *Config) bool {
@@ -107,6 +163,7 @@ func (a *AuthInfo) Equal(a2 *AuthInfo) bool {
 		return false
 	}
 	return a.ClientCertificate == a2.ClientCertificate && a.ClientKey == a2.ClientKey &&
+		a.ClientKeySigner == a2.ClientKeySigner &&
 		bytes.Equal(a.ClientCertificateData, a2.ClientCertificateData) &&
 		bytes.Equal(a.ClientKeyData, a2.ClientKeyData)
 }
@@ -115,12 +172,23 @@ func (c *Config) DeepCopy() *Config {
 	if c == nil {
 		return nil
 	}
-	return &Config{
-		Service:     *c.Service.DeepCopy(),
-		AuthInfo:    *c.AuthInfo.DeepCopy(),
-		baseDir:     c.baseDir,
-		testRootDir: c.testRootDir,
+	c2 := &Config{
+		Service:        *c.Service.DeepCopy(),
+		AuthInfo:       *c.AuthInfo.DeepCopy(),
+		Contexts:       slices.Clone(c.Contexts),
+		CurrentContext: c.CurrentContext,
+		baseDir:        c.baseDir,
+		testRootDir:    c.testRootDir,
+		filename:       c.filename,
+		contextName:    c.contextName,
+	}
+	for _, s := range c.Services {
+		c2.Services = append(c2.Services, NamedService{Name: s.Name, Service: *s.Service.DeepCopy()})
 	}
+	for _, a := range c.AuthInfos {
+		c2.AuthInfos = append(c2.AuthInfos, NamedAuthInfo{Name: a.Name, AuthInfo: *a.AuthInfo.DeepCopy()})
+	}
+	return c2
 }
 
 func (s *Service) DeepCopy() *Service {
@@ -139,12 +207,13 @@ func (a *AuthInfo) DeepCopy() *AuthInfo {
 	a2 := *a
 	a2.ClientCertificateData = bytes.Clone(a.ClientCertificateData)
 	a2.ClientKeyData = bytes.Clone(a.ClientKeyData)
+	a2.AuthProvider = a.AuthProvider.DeepCopy()
 	return &a2
 }
 
 func (c *Config) HasCredentials() bool {
 	return (len(c.AuthInfo.ClientCertificate) > 0 || len(c.AuthInfo.ClientCertificateData) > 0) &&
-		(len(c.AuthInfo.ClientKey) > 0 || len(c.AuthInfo.ClientKeyData) > 0)
+		(len(c.AuthInfo.ClientKey) > 0 || len(c.AuthInfo.ClientKeyData) > 0 || c.AuthInfo.ClientKeySigner != nil)
 }
 
 func (c *Config) GetClientKeyPath() string {
@@ -170,16 +239,22 @@ func NewDefault() *Config {
 }
 
 // NewFromConfig returns a new FlightCtl API client from the given config.
+// Bearer tokens of the config are refreshed in place before they expire.
 func NewFromConfig(config *Config) (*client.ClientWithResponses, error) {
 
 	httpClient, err := NewHTTPClientFromConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("NewFromConfig: creating HTTP client %w", err)
 	}
+	refresher := &tokenRefresher{config: config}
 	ref := client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
 		req.Header.Set(middleware.RequestIDHeader, reqid.GetReqID())
-		if config.AuthInfo.Token != "" {
-			req.Header.Set(common.AuthHeader, fmt.Sprintf("Bearer %s", config.AuthInfo.Token))
+		token, err := refresher.token(ctx)
+		if err != nil {
+			return err
+		}
+		if token != "" {
+			req.Header.Set(common.AuthHeader, fmt.Sprintf("Bearer %s", token))
 		}
 		return nil
 	})
@@ -218,7 +293,7 @@ func NewHTTPClientFromConfig(config *Config) (*http.Client, error) {
 	}
 
 	if len(config.AuthInfo.ClientCertificateData) > 0 {
-		clientCert, err := tls.X509KeyPair(config.AuthInfo.ClientCertificateData, config.AuthInfo.ClientKeyData)
+		clientCert, err := clientCertificate(config.AuthInfo)
 		if err != nil {
 			return nil, fmt.Errorf("NewHTTPClientFromConfig: parsing client cert and key: %w", err)
 		}
@@ -235,6 +310,8 @@ func NewHTTPClientFromConfig(config *Config) (*http.Client, error) {
 
 // NewGRPCClientFromConfig returns a new gRPC Client from the given config.
 func NewGRPCClientFromConfig(config *Config, grpcEndpoint string) (grpc_v1.RouterServiceClient, error) {
+	// the refresher updates the token of the given config, like the one of NewFromConfig
+	tokenCredentials := &tokenCredentials{refresher: &tokenRefresher{config: config}}
 	config = config.DeepCopy()
 	if err := config.Flatten(); err != nil {
 		return nil, err
@@ -261,7 +338,7 @@ func NewGRPCClientFromConfig(config *Config, grpcEndpoint string) (grpc_v1.Route
 	tlsConfig.ServerName = tlsServerName
 
 	if len(config.AuthInfo.ClientCertificateData) > 0 {
-		clientCert, err := tls.X509KeyPair(config.AuthInfo.ClientCertificateData, config.AuthInfo.ClientKeyData)
+		clientCert, err := clientCertificate(config.AuthInfo)
 		if err != nil {
 			return nil, fmt.Errorf("NewHTTPClientFromConfig: parsing client cert and key: %w", err)
 		}
@@ -271,7 +348,10 @@ func NewGRPCClientFromConfig(config *Config, grpcEndpoint string) (grpc_v1.Route
 	grpcEndpoint = strings.TrimPrefix(grpcEndpoint, "grpcs://")
 	grpcEndpoint = strings.TrimPrefix(grpcEndpoint, "grpc://")
 
-	client, err := grpc.NewClient(grpcEndpoint, grpc.WithTransportCredentials(credentials.NewTLS(&tlsConfig)))
+	client, err := grpc.NewClient(grpcEndpoint,
+		grpc.WithTransportCredentials(credentials.NewTLS(&tlsConfig)),
+		grpc.WithPerRPCCredentials(tokenCredentials),
+	)
 
 	if err != nil {
 		return nil, fmt.Errorf("NewGRPCClientFromConfig: creating gRPC client: %w", err)
@@ -282,12 +362,55 @@ func NewGRPCClientFromConfig(config *Config, grpcEndpoint string) (grpc_v1.Route
 	return router, nil
 }
 
+// clientCertificate returns the TLS client certificate of the given flattened
+// auth info, signing with its ClientKeySigner if set.
+func clientCertificate(authInfo AuthInfo) (tls.Certificate, error) {
+	if authInfo.ClientKeySigner == nil {
+		return tls.X509KeyPair(authInfo.ClientCertificateData, authInfo.ClientKeyData)
+	}
+	certs, err := certutil.ParseCertsPEM(authInfo.ClientCertificateData)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	clientCert := tls.Certificate{PrivateKey: authInfo.ClientKeySigner, Leaf: certs[0]}
+	for _, cert := range certs {
+		clientCert.Certificate = append(clientCert.Certificate, cert.Raw)
+	}
+	return clientCert, nil
+}
+
 // DefaultFlightctlClientConfigPath returns the default path to the FlightCtl client config file.
 func DefaultFlightctlClientConfigPath() string {
 	return filepath.Join(homedir.HomeDir(), ".config", "flightctl", "client.yaml")
 }
 
+// LoadConfigFile reads the config file without selecting a context or validating it, so that it can be
+// modified and persisted again. A config file that does not exist yet is read as an empty config, and the
+// service and credentials of a config without contexts are moved to a context named DefaultContextName.
+func LoadConfigFile(filename string) (*Config, error) {
+	config := NewDefault()
+	contents, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading config: %w", err)
+	}
+	if err := yaml.Unmarshal(contents, config); err != nil {
+		return nil, fmt.Errorf("decoding config: %w", err)
+	}
+	config.SetBaseDir(filepath.Dir(filename))
+	// Keep the service and credentials of a config without contexts when contexts are added
+	config.migrateToContexts()
+	return config, nil
+}
+
 func ParseConfigFile(filename string) (*Config, error) {
+	return ParseConfigFileForContext(filename, "")
+}
+
+// ParseConfigFileForContext reads the config file using the given context, or the current context if it is empty.
+func ParseConfigFileForContext(filename string, context string) (*Config, error) {
 	contents, err := os.ReadFile(filename)
 	if err != nil {
 		return nil, fmt.Errorf("reading config: %w", err)
@@ -297,6 +420,11 @@ func ParseConfigFile(filename string) (*Config, error) {
 		return nil, fmt.Errorf("decoding config: %w", err)
 	}
 	config.SetBaseDir(filepath.Dir(filename))
+	config.filename = filename
+	config.contextName = context
+	if err := config.UseContext(context); err != nil {
+		return nil, err
+	}
 	if err := config.Validate(); err != nil {
 		return nil, err
 	}
@@ -305,7 +433,12 @@ func ParseConfigFile(filename string) (*Config, error) {
 
 // NewFromConfigFile returns a new FlightCtl API client using the config read from the given file.
 func NewFromConfigFile(filename string) (*client.ClientWithResponses, error) {
-	config, err := ParseConfigFile(filename)
+	return NewFromConfigFileForContext(filename, "")
+}
+
+// NewFromConfigFileForContext returns a new FlightCtl API client using the given context of the config read from the given file.
+func NewFromConfigFileForContext(filename string, context string) (*client.ClientWithResponses, error) {
+	config, err := ParseConfigFileForContext(filename, context)
 	if err != nil {
 		return nil, err
 	}
@@ -365,6 +498,18 @@ func (c *Config) Persist(filename string) error {
 	if err != nil {
 		return fmt.Errorf("encoding config: %w", err)
 	}
+	if len(c.Contexts) > 0 {
+		// The service and credentials are set from the selected context when the config is read
+		var fields map[string]interface{}
+		if err := yaml.Unmarshal(contents, &fields); err != nil {
+			return fmt.Errorf("encoding config: %w", err)
+		}
+		delete(fields, "service")
+		delete(fields, "authentication")
+		if contents, err = yaml.Marshal(fields); err != nil {
+			return fmt.Errorf("encoding config: %w", err)
+		}
+	}
 	directory := filename[:strings.LastIndex(filename, "/")]
 	if err := os.MkdirAll(directory, 0700); err != nil {
 		return fmt.Errorf("writing config: %w", err)
@@ -427,7 +572,7 @@ func validateAuthInfo(authInfo AuthInfo, baseDir string, testRootDir string) []e
 			validationErrors = append(validationErrors, fmt.Errorf("client-key-data and client-key are both specified; client-key-data will override"))
 		}
 		// Make sure a key is specified
-		if len(authInfo.ClientKey) == 0 && len(authInfo.ClientKeyData) == 0 {
+		if len(authInfo.ClientKey) == 0 && len(authInfo.ClientKeyData) == 0 && authInfo.ClientKeySigner == nil {
 			validationErrors = append(validationErrors, fmt.Errorf("client-key-data or client-key must be specified to use the clientCert authentication method"))
 		}
 
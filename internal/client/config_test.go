@@ -156,3 +156,66 @@ func TestClientConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigContexts(t *testing.T) {
+	require := require.New(t)
+	configFile := filepath.Join(t.TempDir(), "client.yaml")
+
+	// a config without contexts is migrated to the default context when contexts are added
+	legacy := NewDefault()
+	legacy.Service = Service{Server: "https://legacy:3443"}
+	legacy.AuthInfo = AuthInfo{Token: "legacy-token"}
+	require.NoError(legacy.Persist(configFile))
+
+	config, err := LoadConfigFile(configFile)
+	require.NoError(err)
+	require.Equal(DefaultContextName, config.CurrentContext)
+	config.SetContext("staging", Service{Server: "https://staging:3443"}, AuthInfo{Token: "staging-token"})
+	config.SetContext("production", Service{Server: "https://production:3443"}, AuthInfo{Token: "production-token"})
+	config.CurrentContext = "staging"
+	require.NoError(config.Persist(configFile))
+
+	tests := []struct {
+		name       string
+		context    string
+		serverWant string
+		tokenWant  string
+	}{
+		{name: "current context", context: "", serverWant: "https://staging:3443", tokenWant: "staging-token"},
+		{name: "selected context", context: "production", serverWant: "https://production:3443", tokenWant: "production-token"},
+		{name: "migrated context", context: DefaultContextName, serverWant: "https://legacy:3443", tokenWant: "legacy-token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := ParseConfigFileForContext(configFile, tt.context)
+			require.NoError(err)
+			require.Equal(tt.serverWant, parsed.Service.Server)
+			require.Equal(tt.tokenWant, parsed.AuthInfo.Token)
+		})
+	}
+
+	_, err = ParseConfigFileForContext(configFile, "missing")
+	require.ErrorContains(err, "not found")
+
+	// deleting a context deletes its service and credentials, unless another context refers to them
+	config, err = LoadConfigFile(configFile)
+	require.NoError(err)
+	config.Contexts = append(config.Contexts, NamedContext{Name: "production-admin", Context: Context{Service: "production", AuthInfo: "staging"}})
+	require.NoError(config.DeleteContext("production"))
+	require.NoError(config.DeleteContext("staging"))
+	require.Len(config.Contexts, 2)
+	require.Equal([]string{DefaultContextName, "production"}, []string{config.Services[0].Name, config.Services[1].Name})
+	require.Equal([]string{DefaultContextName, "staging"}, []string{config.AuthInfos[0].Name, config.AuthInfos[1].Name})
+	require.Empty(config.CurrentContext)
+	require.Error(config.DeleteContext("staging"))
+}
+
+func TestConfigWithoutContexts(t *testing.T) {
+	require := require.New(t)
+
+	config := Config{Service: Service{Server: "https://localhost:3443"}}
+	require.NoError(config.UseContext(""))
+	require.NoError(config.UseContext(DefaultContextName))
+	require.Equal("https://localhost:3443", config.Service.Server)
+	require.ErrorContains(config.UseContext("staging"), "not found")
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"fmt"
+	"slices"
+)
+
+// UseContext sets the service and credentials of the config from the named context, or from the current
+// context if name is empty. Configs without contexts are used as they are, for which the name must either
+// be empty or DefaultContextName.
+func (c *Config) UseContext(name string) error {
+	if len(name) == 0 {
+		name = c.CurrentContext
+	}
+	if len(c.Contexts) == 0 && (len(name) == 0 || name == DefaultContextName) {
+		return nil
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("no current context is set, select one with \"flightctl config use-context\"")
+	}
+
+	context, ok := c.GetContext(name)
+	if !ok {
+		return fmt.Errorf("context %q not found", name)
+	}
+	service, ok := c.GetService(context.Service)
+	if !ok {
+		return fmt.Errorf("service %q of context %q not found", context.Service, name)
+	}
+	c.Service = *service.DeepCopy()
+	c.AuthInfo = AuthInfo{}
+	if len(context.AuthInfo) > 0 {
+		authInfo, ok := c.GetAuthInfo(context.AuthInfo)
+		if !ok {
+			return fmt.Errorf("authentication %q of context %q not found", context.AuthInfo, name)
+		}
+		c.AuthInfo = *authInfo.DeepCopy()
+	}
+	return nil
+}
+
+func (c *Config) GetContext(name string) (*Context, bool) {
+	i := slices.IndexFunc(c.Contexts, func(n NamedContext) bool { return n.Name == name })
+	if i < 0 {
+		return nil, false
+	}
+	return &c.Contexts[i].Context, true
+}
+
+func (c *Config) GetService(name string) (*Service, bool) {
+	i := slices.IndexFunc(c.Services, func(n NamedService) bool { return n.Name == name })
+	if i < 0 {
+		return nil, false
+	}
+	return &c.Services[i].Service, true
+}
+
+func (c *Config) GetAuthInfo(name string) (*AuthInfo, bool) {
+	i := slices.IndexFunc(c.AuthInfos, func(n NamedAuthInfo) bool { return n.Name == name })
+	if i < 0 {
+		return nil, false
+	}
+	return &c.AuthInfos[i].AuthInfo, true
+}
+
+// SetContext adds or replaces the named context, and the service and credentials of the same name it refers to.
+func (c *Config) SetContext(name string, service Service, authInfo AuthInfo) {
+	c.SetService(name, service)
+	c.SetAuthInfo(name, authInfo)
+	context := Context{Service: name, AuthInfo: name}
+	if existing, ok := c.GetContext(name); ok {
+		*existing = context
+	} else {
+		c.Contexts = append(c.Contexts, NamedContext{Name: name, Context: context})
+	}
+}
+
+func (c *Config) SetService(name string, service Service) {
+	if existing, ok := c.GetService(name); ok {
+		*existing = service
+		return
+	}
+	c.Services = append(c.Services, NamedService{Name: name, Service: service})
+}
+
+func (c *Config) SetAuthInfo(name string, authInfo AuthInfo) {
+	if existing, ok := c.GetAuthInfo(name); ok {
+		*existing = authInfo
+		return
+	}
+	c.AuthInfos = append(c.AuthInfos, NamedAuthInfo{Name: name, AuthInfo: authInfo})
+}
+
+// DeleteContext deletes the named context along with the services and credentials no other context refers to.
+// If it is the current context, no context is current anymore.
+func (c *Config) DeleteContext(name string) error {
+	context, ok := c.GetContext(name)
+	if !ok {
+		return fmt.Errorf("context %q not found", name)
+	}
+	service, authInfo := context.Service, context.AuthInfo
+
+	c.Contexts = slices.DeleteFunc(c.Contexts, func(n NamedContext) bool { return n.Name == name })
+	if !slices.ContainsFunc(c.Contexts, func(n NamedContext) bool { return n.Context.Service == service }) {
+		c.Services = slices.DeleteFunc(c.Services, func(n NamedService) bool { return n.Name == service })
+	}
+	if !slices.ContainsFunc(c.Contexts, func(n NamedContext) bool { return n.Context.AuthInfo == authInfo }) {
+		c.AuthInfos = slices.DeleteFunc(c.AuthInfos, func(n NamedAuthInfo) bool { return n.Name == authInfo })
+	}
+	if c.CurrentContext == name {
+		c.CurrentContext = ""
+	}
+	return nil
+}
+
+// migrateToContexts moves the service and credentials of a config without contexts to the default context.
+func (c *Config) migrateToContexts() {
+	if len(c.Contexts) > 0 || len(c.Service.Server) == 0 {
+		return
+	}
+	c.Services = append(c.Services, NamedService{Name: DefaultContextName, Service: c.Service})
+	c.AuthInfos = append(c.AuthInfos, NamedAuthInfo{Name: DefaultContextName, AuthInfo: c.AuthInfo})
+	c.Contexts = append(c.Contexts, NamedContext{Name: DefaultContextName, Context: Context{Service: DefaultContextName, AuthInfo: DefaultContextName}})
+	if len(c.CurrentContext) == 0 {
+		c.CurrentContext = DefaultContextName
+	}
+	c.Service = Service{}
+	c.AuthInfo = AuthInfo{}
+}
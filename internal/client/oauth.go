@@ -0,0 +1,356 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/auth/common"
+	"golang.org/x/oauth2"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+// tokenExpiryDelta is how long before it expires a bearer token is refreshed, so that it does not expire in flight.
+const tokenExpiryDelta = 30 * time.Second
+
+// AuthProvider holds what is needed to refresh the bearer token of an AuthInfo at the OAuth2 provider that issued it.
+type AuthProvider struct {
+	// TokenURL is the token endpoint of the provider.
+	TokenURL string `json:"token-url"`
+	// ClientID is the OAuth2 client the token was issued to.
+	ClientID string `json:"client-id"`
+	// RefreshToken is exchanged for a new bearer token when the bearer token expires.
+	// +optional
+	RefreshToken string `json:"refresh-token,omitempty"`
+	// Expiry is when the bearer token expires. Bearer tokens without expiry are never refreshed.
+	// +optional
+	Expiry *time.Time `json:"expiry,omitempty"`
+	// CertificateAuthorityData contains PEM-encoded certificate authority certificates of the provider.
+	// +optional
+	CertificateAuthorityData []byte `json:"certificate-authority-data,omitempty"`
+	InsecureSkipVerify       bool   `json:"insecureSkipVerify,omitempty"`
+}
+
+func (p *AuthProvider) DeepCopy() *AuthProvider {
+	if p == nil {
+		return nil
+	}
+	p2 := *p
+	if p.Expiry != nil {
+		expiry := *p.Expiry
+		p2.Expiry = &expiry
+	}
+	p2.CertificateAuthorityData = bytes.Clone(p.CertificateAuthorityData)
+	return &p2
+}
+
+// needsRefresh returns true if the bearer token is about to expire and can be refreshed.
+func (p *AuthProvider) needsRefresh() bool {
+	return p != nil && len(p.RefreshToken) > 0 && p.Expiry != nil && time.Until(*p.Expiry) < tokenExpiryDelta
+}
+
+func (p *AuthProvider) oauth2Config() *oauth2.Config {
+	return &oauth2.Config{
+		ClientID: p.ClientID,
+		Endpoint: oauth2.Endpoint{TokenURL: p.TokenURL, AuthStyle: oauth2.AuthStyleInParams},
+	}
+}
+
+// refresh exchanges the refresh token for a new token.
+func (p *AuthProvider) refresh(ctx context.Context) (*oauth2.Token, error) {
+	httpClient, err := newAuthHTTPClient(p.CertificateAuthorityData, p.InsecureSkipVerify)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+	return p.oauth2Config().TokenSource(ctx, &oauth2.Token{RefreshToken: p.RefreshToken}).Token()
+}
+
+// tokenRefresher returns the bearer token of a config, refreshing it at its auth provider before it expires.
+// Refreshed tokens are written back to the config file the config was read from, if any.
+type tokenRefresher struct {
+	mu     sync.Mutex
+	config *Config
+}
+
+func (r *tokenRefresher) token(ctx context.Context) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	authInfo := &r.config.AuthInfo
+	if !authInfo.AuthProvider.needsRefresh() {
+		return authInfo.Token, nil
+	}
+	token, err := authInfo.AuthProvider.refresh(ctx)
+	if err != nil {
+		return "", fmt.Errorf("refreshing token, log in again with \"flightctl login\": %w", err)
+	}
+	authInfo.Token = token.AccessToken
+	authInfo.AuthProvider.RefreshToken = token.RefreshToken
+	authInfo.AuthProvider.Expiry = tokenExpiry(token)
+
+	if len(r.config.filename) > 0 {
+		if err := r.config.persistToken(); err != nil {
+			return "", fmt.Errorf("persisting refreshed token: %w", err)
+		}
+	}
+	return authInfo.Token, nil
+}
+
+// tokenCredentials sends the bearer token of a config with every gRPC call, refreshing it before it expires.
+type tokenCredentials struct {
+	refresher *tokenRefresher
+}
+
+func (c *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token, err := c.refresher.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return nil, nil
+	}
+	// gRPC metadata keys are lower case
+	return map[string]string{strings.ToLower(common.AuthHeader): fmt.Sprintf("Bearer %s", token)}, nil
+}
+
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// persistToken writes the bearer token and auth provider of the config back to the credentials of the context
+// in the config file it was read from. Other credentials and settings in the file are kept as they are.
+func (c *Config) persistToken() error {
+	config, err := LoadConfigFile(c.filename)
+	if err != nil {
+		return err
+	}
+	name := c.contextName
+	if len(name) == 0 {
+		name = config.CurrentContext
+	}
+	context, ok := config.GetContext(name)
+	if !ok {
+		return fmt.Errorf("context %q not found", name)
+	}
+	authInfo, ok := config.GetAuthInfo(context.AuthInfo)
+	if !ok {
+		return fmt.Errorf("authentication %q of context %q not found", context.AuthInfo, name)
+	}
+	authInfo.Token = c.AuthInfo.Token
+	authInfo.AuthProvider = c.AuthInfo.AuthProvider.DeepCopy()
+	return config.Persist(c.filename)
+}
+
+func tokenExpiry(token *oauth2.Token) *time.Time {
+	if token.Expiry.IsZero() {
+		return nil
+	}
+	expiry := token.Expiry.UTC()
+	return &expiry
+}
+
+func newAuthHTTPClient(caData []byte, insecureSkipVerify bool) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify, //nolint:gosec
+	}
+	if len(caData) > 0 {
+		caPool, err := certutil.NewPoolFromBytes(caData)
+		if err != nil {
+			return nil, fmt.Errorf("parsing auth CA certs: %w", err)
+		}
+		tlsConfig.RootCAs = caPool
+	}
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}, nil
+}
+
+// OAuthServerMetadata is the part of an OpenID Connect discovery document or of OAuth2 authorization server
+// metadata that is needed to log in.
+type OAuthServerMetadata struct {
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	// DeviceAuthorizationEndpoint is only set by providers supporting the device authorization flow.
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint,omitempty"`
+}
+
+// OAuthLogin obtains tokens from an OAuth2 provider on behalf of a user.
+type OAuthLogin struct {
+	Metadata OAuthServerMetadata
+	ClientID string
+	Scopes   []string
+	// CertificateAuthorityData contains PEM-encoded certificate authority certificates of the provider.
+	CertificateAuthorityData []byte
+	InsecureSkipVerify       bool
+}
+
+// Discover fetches the server metadata of the provider from the given discovery URL.
+func (l *OAuthLogin) Discover(ctx context.Context, metadataURL string) error {
+	httpClient, err := newAuthHTTPClient(l.CertificateAuthorityData, l.InsecureSkipVerify)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create http request: %w", err)
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch oauth server metadata: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch oauth server metadata: %s", res.Status)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read oauth server metadata: %w", err)
+	}
+	if err := json.Unmarshal(body, &l.Metadata); err != nil {
+		return fmt.Errorf("failed to parse oauth server metadata: %w", err)
+	}
+	return nil
+}
+
+func (l *OAuthLogin) oauth2Config(redirectURL string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID: l.ClientID,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:       l.Metadata.AuthorizationEndpoint,
+			DeviceAuthURL: l.Metadata.DeviceAuthorizationEndpoint,
+			TokenURL:      l.Metadata.TokenEndpoint,
+			AuthStyle:     oauth2.AuthStyleInParams,
+		},
+		RedirectURL: redirectURL,
+		Scopes:      l.Scopes,
+	}
+}
+
+func (l *OAuthLogin) withHTTPClient(ctx context.Context) (context.Context, error) {
+	httpClient, err := newAuthHTTPClient(l.CertificateAuthorityData, l.InsecureSkipVerify)
+	if err != nil {
+		return nil, err
+	}
+	return context.WithValue(ctx, oauth2.HTTPClient, httpClient), nil
+}
+
+// DeviceCodeLogin runs the OAuth2 device authorization flow, which needs no browser on the host running it.
+// prompt is called with the URL to visit and the code to enter there, and the token is returned once the
+// user approved the login.
+func (l *OAuthLogin) DeviceCodeLogin(ctx context.Context, prompt func(verificationURI string, userCode string)) (*oauth2.Token, error) {
+	if len(l.Metadata.DeviceAuthorizationEndpoint) == 0 {
+		return nil, fmt.Errorf("the authentication provider does not support the device authorization flow")
+	}
+	ctx, err := l.withHTTPClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	config := l.oauth2Config("")
+	response, err := config.DeviceAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start device authorization: %w", err)
+	}
+	verificationURI := response.VerificationURIComplete
+	if len(verificationURI) == 0 {
+		verificationURI = response.VerificationURI
+	}
+	prompt(verificationURI, response.UserCode)
+
+	token, err := config.DeviceAccessToken(ctx, response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+	return token, nil
+}
+
+// WebLogin runs the OAuth2 authorization code flow with PKCE, redirecting to a listener on localhost.
+// openURL is called with the URL to log in at, typically opening it in a browser.
+func (l *OAuthLogin) WebLogin(ctx context.Context, openURL func(string) error) (*oauth2.Token, error) {
+	ctx, err := l.withHTTPClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open listener: %w", err)
+	}
+	config := l.oauth2Config(fmt.Sprintf("http://127.0.0.1:%d/callback", listener.Addr().(*net.TCPAddr).Port))
+	state := oauth2.GenerateVerifier()
+	verifier := oauth2.GenerateVerifier()
+
+	type result struct {
+		token *oauth2.Token
+		err   error
+	}
+	done := make(chan result, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		token, err := exchangeAuthorizationCode(ctx, config, r, state, verifier)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Login failed: %v", err), http.StatusBadRequest)
+		} else {
+			_, _ = w.Write([]byte("Login successful. You can close this window and return to CLI."))
+		}
+		select {
+		case done <- result{token: token, err: err}:
+		default:
+		}
+	})
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer func() {
+		_ = server.Shutdown(context.Background())
+	}()
+
+	if err := openURL(config.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier))); err != nil {
+		return nil, err
+	}
+	select {
+	case r := <-done:
+		return r.token, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func exchangeAuthorizationCode(ctx context.Context, config *oauth2.Config, r *http.Request, state string, verifier string) (*oauth2.Token, error) {
+	query := r.URL.Query()
+	if e := query.Get("error"); len(e) > 0 {
+		return nil, fmt.Errorf("authorization failed: %s %s", e, query.Get("error_description"))
+	}
+	if query.Get("state") != state {
+		return nil, fmt.Errorf("authorization response has an unexpected state")
+	}
+	token, err := config.Exchange(ctx, query.Get("code"), oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+	return token, nil
+}
+
+// AuthInfo returns the credentials for the token. Tokens that expire and come with a refresh token
+// are refreshed at the provider when they expire.
+func (l *OAuthLogin) AuthInfo(token *oauth2.Token) AuthInfo {
+	authInfo := AuthInfo{Token: token.AccessToken}
+	if len(token.RefreshToken) > 0 {
+		authInfo.AuthProvider = &AuthProvider{
+			TokenURL:                 l.Metadata.TokenEndpoint,
+			ClientID:                 l.ClientID,
+			RefreshToken:             token.RefreshToken,
+			Expiry:                   tokenExpiry(token),
+			CertificateAuthorityData: bytes.Clone(l.CertificateAuthorityData),
+			InsecureSkipVerify:       l.InsecureSkipVerify,
+		}
+	}
+	return authInfo
+}
@@ -0,0 +1,278 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOIDCProvider is an OIDC provider issuing tokens for the authorization code flow with PKCE,
+// the device authorization flow and refresh tokens.
+type fakeOIDCProvider struct {
+	*httptest.Server
+
+	mu             sync.Mutex
+	issued         int
+	challenges     map[string]string
+	refreshTokens  map[string]bool
+	deviceApproved bool
+}
+
+func newFakeOIDCProvider(t *testing.T) *fakeOIDCProvider {
+	p := &fakeOIDCProvider{challenges: map[string]string{}, refreshTokens: map[string]bool{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"issuer":                        p.URL,
+			"authorization_endpoint":        p.URL + "/authorize",
+			"token_endpoint":                p.URL + "/token",
+			"device_authorization_endpoint": p.URL + "/device",
+		})
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("client_id") != "flightctl" || query.Get("code_challenge_method") != "S256" {
+			http.Error(w, "invalid authorization request", http.StatusBadRequest)
+			return
+		}
+		p.mu.Lock()
+		code := fmt.Sprintf("code-%d", len(p.challenges))
+		p.challenges[code] = query.Get("code_challenge")
+		p.mu.Unlock()
+		http.Redirect(w, r, fmt.Sprintf("%s?code=%s&state=%s", query.Get("redirect_uri"), code, query.Get("state")), http.StatusFound)
+	})
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"device_code":      "device-code",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": p.URL + "/activate",
+			"expires_in":       60,
+			"interval":         1,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		switch r.PostForm.Get("grant_type") {
+		case "authorization_code":
+			challenge, ok := p.challenges[r.PostForm.Get("code")]
+			verifier := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+			if !ok || challenge != base64.RawURLEncoding.EncodeToString(verifier[:]) {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+				return
+			}
+			delete(p.challenges, r.PostForm.Get("code"))
+		case "urn:ietf:params:oauth:grant-type:device_code":
+			if !p.deviceApproved {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "authorization_pending"})
+				return
+			}
+		case "refresh_token":
+			if !p.refreshTokens[r.PostForm.Get("refresh_token")] {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+				return
+			}
+			delete(p.refreshTokens, r.PostForm.Get("refresh_token"))
+		default:
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+			return
+		}
+		writeJSON(w, http.StatusOK, p.issueToken())
+	})
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+	return p
+}
+
+// issueToken must be called with the lock held.
+func (p *fakeOIDCProvider) issueToken() map[string]interface{} {
+	p.issued++
+	refreshToken := fmt.Sprintf("refresh-%d", p.issued)
+	p.refreshTokens[refreshToken] = true
+	return map[string]interface{}{
+		"access_token":  fmt.Sprintf("access-%d", p.issued),
+		"token_type":    "Bearer",
+		"refresh_token": refreshToken,
+		"expires_in":    3600,
+	}
+}
+
+func (p *fakeOIDCProvider) issuedTokens() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.issued
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newDiscoveredLogin(t *testing.T, provider *fakeOIDCProvider) *OAuthLogin {
+	login := &OAuthLogin{ClientID: "flightctl", Scopes: []string{"openid"}}
+	require.NoError(t, login.Discover(context.Background(), provider.URL+"/.well-known/openid-configuration"))
+	require.Equal(t, provider.URL+"/token", login.Metadata.TokenEndpoint)
+	return login
+}
+
+func TestWebLogin(t *testing.T) {
+	require := require.New(t)
+	provider := newFakeOIDCProvider(t)
+	login := newDiscoveredLogin(t, provider)
+
+	// the "browser" follows the redirect of the provider to the listener of the CLI
+	token, err := login.WebLogin(context.Background(), func(loginUrl string) error {
+		res, err := http.Get(loginUrl) //nolint:gosec
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("unexpected status: %s", res.Status)
+		}
+		return nil
+	})
+	require.NoError(err)
+	require.Equal("access-1", token.AccessToken)
+
+	authInfo := login.AuthInfo(token)
+	require.Equal("access-1", authInfo.Token)
+	require.NotNil(authInfo.AuthProvider)
+	require.Equal(provider.URL+"/token", authInfo.AuthProvider.TokenURL)
+	require.Equal("flightctl", authInfo.AuthProvider.ClientID)
+	require.Equal("refresh-1", authInfo.AuthProvider.RefreshToken)
+	require.NotNil(authInfo.AuthProvider.Expiry)
+	require.WithinDuration(time.Now().Add(time.Hour), *authInfo.AuthProvider.Expiry, time.Minute)
+}
+
+func TestDeviceCodeLogin(t *testing.T) {
+	require := require.New(t)
+	provider := newFakeOIDCProvider(t)
+	login := newDiscoveredLogin(t, provider)
+
+	token, err := login.DeviceCodeLogin(context.Background(), func(verificationUri string, userCode string) {
+		require.Equal(provider.URL+"/activate", verificationUri)
+		require.Equal("ABCD-EFGH", userCode)
+		provider.mu.Lock()
+		provider.deviceApproved = true
+		provider.mu.Unlock()
+	})
+	require.NoError(err)
+	require.Equal("access-1", token.AccessToken)
+	require.Equal("refresh-1", token.RefreshToken)
+
+	login.Metadata.DeviceAuthorizationEndpoint = ""
+	_, err = login.DeviceCodeLogin(context.Background(), func(string, string) {})
+	require.ErrorContains(err, "does not support the device authorization flow")
+}
+
+func TestTokenRefresh(t *testing.T) {
+	require := require.New(t)
+	provider := newFakeOIDCProvider(t)
+
+	var mu sync.Mutex
+	var authHeaders []string
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	defer apiServer.Close()
+
+	provider.mu.Lock()
+	provider.refreshTokens["refresh-0"] = true
+	provider.mu.Unlock()
+	expired := time.Now().Add(-time.Minute)
+	filename := filepath.Join(t.TempDir(), "client.yaml")
+	config := NewDefault()
+	config.SetContext("test", Service{Server: apiServer.URL}, AuthInfo{
+		Token: "access-0",
+		AuthProvider: &AuthProvider{
+			TokenURL:     provider.URL + "/token",
+			ClientID:     "flightctl",
+			RefreshToken: "refresh-0",
+			Expiry:       &expired,
+		},
+	})
+	config.CurrentContext = "test"
+	require.NoError(config.Persist(filename))
+
+	c, err := NewFromConfigFile(filename)
+	require.NoError(err)
+	_, err = c.AuthConfigWithResponse(context.Background())
+	require.NoError(err)
+	// the token is still valid, so it is not refreshed again
+	_, err = c.AuthConfigWithResponse(context.Background())
+	require.NoError(err)
+	require.Equal([]string{"Bearer access-1", "Bearer access-1"}, authHeaders)
+	require.Equal(1, provider.issuedTokens())
+
+	// the refreshed token is written back to the config file
+	persisted, err := LoadConfigFile(filename)
+	require.NoError(err)
+	authInfo, ok := persisted.GetAuthInfo("test")
+	require.True(ok)
+	require.Equal("access-1", authInfo.Token)
+	require.Equal("refresh-1", authInfo.AuthProvider.RefreshToken)
+	require.True(authInfo.AuthProvider.Expiry.After(time.Now()))
+
+	// a refresh token that was already used is rejected
+	config.SetContext("test", Service{Server: apiServer.URL}, AuthInfo{
+		Token:        "access-0",
+		AuthProvider: &AuthProvider{TokenURL: provider.URL + "/token", ClientID: "flightctl", RefreshToken: "refresh-0", Expiry: &expired},
+	})
+	require.NoError(config.Persist(filename))
+	c, err = NewFromConfigFile(filename)
+	require.NoError(err)
+	_, err = c.AuthConfigWithResponse(context.Background())
+	require.ErrorContains(err, "log in again")
+}
+
+func TestTokenCredentials(t *testing.T) {
+	require := require.New(t)
+	provider := newFakeOIDCProvider(t)
+
+	provider.mu.Lock()
+	provider.refreshTokens["refresh-0"] = true
+	provider.mu.Unlock()
+	expired := time.Now().Add(-time.Minute)
+	config := NewDefault()
+	config.AuthInfo = AuthInfo{
+		Token: "access-0",
+		AuthProvider: &AuthProvider{
+			TokenURL:     provider.URL + "/token",
+			ClientID:     "flightctl",
+			RefreshToken: "refresh-0",
+			Expiry:       &expired,
+		},
+	}
+
+	credentials := &tokenCredentials{refresher: &tokenRefresher{config: config}}
+	md, err := credentials.GetRequestMetadata(context.Background())
+	require.NoError(err)
+	require.Equal(map[string]string{"authorization": "Bearer access-1"}, md)
+	require.Equal("access-1", config.AuthInfo.Token)
+	require.True(credentials.RequireTransportSecurity())
+
+	// configs without a token send none
+	credentials = &tokenCredentials{refresher: &tokenRefresher{config: NewDefault()}}
+	md, err = credentials.GetRequestMetadata(context.Background())
+	require.NoError(err)
+	require.Empty(md)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/util"
 	"sigs.k8s.io/yaml"
@@ -12,6 +13,11 @@ import (
 
 const (
 	appName = "flightctl"
+
+	// DefaultEventRetentionPeriod is how long events are kept after they last occurred by default.
+	DefaultEventRetentionPeriod = util.Duration(7 * 24 * time.Hour)
+	// DefaultAuditRetentionPeriod is how long audit records are kept in the database by default.
+	DefaultAuditRetentionPeriod = util.Duration(90 * 24 * time.Hour)
 )
 
 type Config struct {
@@ -20,6 +26,10 @@ type Config struct {
 	Queue      *queueConfig      `json:"queue,omitempty"`
 	Auth       *authConfig       `json:"auth,omitempty"`
 	Prometheus *prometheusConfig `json:"prometheus,omitempty"`
+	Encryption *encryptionConfig `json:"encryption,omitempty"`
+	Tracing    *tracingConfig    `json:"tracing,omitempty"`
+	Audit      *auditConfig      `json:"audit,omitempty"`
+	RateLimit  *rateLimitConfig  `json:"rateLimit,omitempty"`
 }
 
 type dbConfig struct {
@@ -32,20 +42,23 @@ type dbConfig struct {
 }
 
 type svcConfig struct {
-	Address              string   `json:"address,omitempty"`
-	AgentEndpointAddress string   `json:"agentEndpointAddress,omitempty"`
-	AgentGrpcAddress     string   `json:"agentGrpcAddress,omitempty"`
-	CertStore            string   `json:"cert,omitempty"`
-	BaseUrl              string   `json:"baseUrl,omitempty"`
-	BaseAgentEndpointUrl string   `json:"baseAgentEndpointUrl,omitempty"`
-	BaseAgentGrpcUrl     string   `json:"baseAgentGrpcUrl,omitempty"`
-	BaseUIUrl            string   `json:"baseUIUrl,omitempty"`
-	CaCertFile           string   `json:"caCertFile,omitempty"`
-	CaKeyFile            string   `json:"caKeyFile,omitempty"`
-	SrvCertFile          string   `json:"srvCertFile,omitempty"`
-	SrvKeyFile           string   `json:"srvKeyFile,omitempty"`
-	AltNames             []string `json:"altNames,omitempty"`
-	LogLevel             string   `json:"logLevel,omitempty"`
+	Address                   string        `json:"address,omitempty"`
+	AgentEndpointAddress      string        `json:"agentEndpointAddress,omitempty"`
+	AgentGrpcAddress          string        `json:"agentGrpcAddress,omitempty"`
+	CertStore                 string        `json:"cert,omitempty"`
+	BaseUrl                   string        `json:"baseUrl,omitempty"`
+	BaseAgentEndpointUrl      string        `json:"baseAgentEndpointUrl,omitempty"`
+	BaseAgentGrpcUrl          string        `json:"baseAgentGrpcUrl,omitempty"`
+	BaseUIUrl                 string        `json:"baseUIUrl,omitempty"`
+	CaCertFile                string        `json:"caCertFile,omitempty"`
+	CaKeyFile                 string        `json:"caKeyFile,omitempty"`
+	SrvCertFile               string        `json:"srvCertFile,omitempty"`
+	SrvKeyFile                string        `json:"srvKeyFile,omitempty"`
+	AltNames                  []string      `json:"altNames,omitempty"`
+	LogLevel                  string        `json:"logLevel,omitempty"`
+	DeviceDisconnectedTimeout util.Duration `json:"deviceDisconnectedTimeout,omitempty"`
+	TPMEKCACertFiles          []string      `json:"tpmEkCaCertFiles,omitempty"`
+	EventRetentionPeriod      util.Duration `json:"eventRetentionPeriod,omitempty"`
 }
 
 type queueConfig struct {
@@ -66,6 +79,44 @@ type prometheusConfig struct {
 	ApiLatencyBins []float64 `json:"apiLatencyBins,omitempty"`
 }
 
+type tracingConfig struct {
+	Endpoint   string            `json:"endpoint,omitempty"`
+	CaCertFile string            `json:"caCertFile,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+}
+
+type auditConfig struct {
+	// Sink is where audit records are written to: "database", "file", "syslog", or "none".
+	Sink          string `json:"sink,omitempty"`
+	File          string `json:"file,omitempty"`
+	SyslogNetwork string `json:"syslogNetwork,omitempty"`
+	SyslogAddress string `json:"syslogAddress,omitempty"`
+	// RetentionPeriod is how long audit records are kept in the database.
+	RetentionPeriod util.Duration `json:"retentionPeriod,omitempty"`
+}
+
+type rateLimitConfig struct {
+	// Api limits the requests to the user API per identity, or per client address if auth is disabled.
+	Api *rateLimit `json:"api,omitempty"`
+	// Agent limits the requests to the agent API per device certificate.
+	Agent *rateLimit `json:"agent,omitempty"`
+}
+
+// rateLimit configures a token bucket that refills at RequestsPerSecond and holds up to Burst requests.
+type rateLimit struct {
+	RequestsPerSecond float64 `json:"requestsPerSecond,omitempty"`
+	Burst             int     `json:"burst,omitempty"`
+}
+
+type encryptionConfig struct {
+	Keys []encryptionKey `json:"keys,omitempty"`
+}
+
+type encryptionKey struct {
+	ID  string `json:"id,omitempty"`
+	Key string `json:"key,omitempty"`
+}
+
 func ConfigDir() string {
 	return filepath.Join(util.MustString(os.UserHomeDir), "."+appName)
 }
@@ -93,14 +144,16 @@ func NewDefault() *Config {
 			Password: "adminpass",
 		},
 		Service: &svcConfig{
-			Address:              ":3443",
-			AgentEndpointAddress: ":7443",
-			AgentGrpcAddress:     ":7444",
-			CertStore:            CertificateDir(),
-			BaseUrl:              "https://localhost:3443",
-			BaseAgentEndpointUrl: "https://localhost:7443",
-			BaseAgentGrpcUrl:     "grpcs://localhost:7444",
-			LogLevel:             "info",
+			Address:                   ":3443",
+			AgentEndpointAddress:      ":7443",
+			AgentGrpcAddress:          ":7444",
+			CertStore:                 CertificateDir(),
+			BaseUrl:                   "https://localhost:3443",
+			BaseAgentEndpointUrl:      "https://localhost:7443",
+			BaseAgentGrpcUrl:          "grpcs://localhost:7444",
+			LogLevel:                  "info",
+			DeviceDisconnectedTimeout: util.Duration(5 * time.Minute),
+			EventRetentionPeriod:      DefaultEventRetentionPeriod,
 		},
 		Queue: &queueConfig{
 			AmqpURL: "amqp://localhost:5672",
@@ -110,6 +163,10 @@ func NewDefault() *Config {
 			SloMax:         4.0,
 			ApiLatencyBins: []float64{1e-7, 1e-6, 1e-5, 1e-4, 1e-3, 1e-2, 1e-1, 1e0},
 		},
+		Audit: &auditConfig{
+			Sink:            "database",
+			RetentionPeriod: DefaultAuditRetentionPeriod,
+		},
 	}
 	return c
 }
@@ -161,10 +218,39 @@ func Save(cfg *Config, cfgFile string) error {
 }
 
 func Validate(cfg *Config) error {
+	if cfg.RateLimit != nil {
+		for name, limit := range map[string]*rateLimit{"api": cfg.RateLimit.Api, "agent": cfg.RateLimit.Agent} {
+			if limit == nil {
+				continue
+			}
+			if limit.RequestsPerSecond <= 0 {
+				return fmt.Errorf("rateLimit.%s.requestsPerSecond must be greater than 0", name)
+			}
+			if limit.Burst < 0 {
+				return fmt.Errorf("rateLimit.%s.burst must not be negative", name)
+			}
+		}
+	}
 	return nil
 }
 
 func (cfg *Config) String() string {
+	if cfg.Encryption != nil {
+		redacted := *cfg
+		redacted.Encryption = &encryptionConfig{}
+		for _, key := range cfg.Encryption.Keys {
+			redacted.Encryption.Keys = append(redacted.Encryption.Keys, encryptionKey{ID: key.ID, Key: "*****"})
+		}
+		cfg = &redacted
+	}
+	if cfg.Tracing != nil && len(cfg.Tracing.Headers) > 0 {
+		redacted := *cfg
+		redacted.Tracing = &tracingConfig{Endpoint: cfg.Tracing.Endpoint, CaCertFile: cfg.Tracing.CaCertFile, Headers: map[string]string{}}
+		for name := range cfg.Tracing.Headers {
+			redacted.Tracing.Headers[name] = "*****"
+		}
+		cfg = &redacted
+	}
 	contents, err := json.Marshal(cfg)
 	if err != nil {
 		return "<error>"
@@ -0,0 +1,134 @@
+package crypto
+
+import (
+	"crypto"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	deviceSecretVersion byte = 1
+	deviceSecretInfo         = "flightctl device secret v1"
+)
+
+// EncryptDeviceSecret encrypts a secret so that only the holder of the private
+// key matching the given device public key can read it. It uses ECIES: a key
+// agreement between an ephemeral key and the device key, from which an
+// AES-256-GCM key is derived with HKDF-SHA256. The result is the version, the
+// ephemeral public key and the sealed secret.
+func EncryptDeviceSecret(publicKey crypto.PublicKey, plaintext []byte) ([]byte, error) {
+	recipient, err := deviceECDHPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	ephemeral, err := recipient.Curve().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generating ephemeral key: %w", err)
+	}
+	sharedSecret, err := ephemeral.ECDH(recipient)
+	if err != nil {
+		return nil, fmt.Errorf("computing shared secret: %w", err)
+	}
+	aead, err := deviceSecretAEAD(sharedSecret, ephemeral.PublicKey().Bytes(), recipient.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := seal(aead, plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("encrypting secret: %w", err)
+	}
+
+	ciphertext := make([]byte, 0, 1+len(ephemeral.PublicKey().Bytes())+len(sealed))
+	ciphertext = append(ciphertext, deviceSecretVersion)
+	ciphertext = append(ciphertext, ephemeral.PublicKey().Bytes()...)
+	return append(ciphertext, sealed...), nil
+}
+
+// ECDHKey is a private key that computes ECDH shared secrets, such as an
+// *ecdh.PrivateKey or a key that never leaves a TPM.
+type ECDHKey interface {
+	Public() crypto.PublicKey
+	ECDH(remote *ecdh.PublicKey) ([]byte, error)
+}
+
+// DecryptDeviceSecret decrypts a secret produced by EncryptDeviceSecret using
+// the private key of the device, which is either an *ecdsa.PrivateKey or an
+// ECDHKey.
+func DecryptDeviceSecret(privateKey crypto.PrivateKey, ciphertext []byte) ([]byte, error) {
+	var recipient ECDHKey
+	switch key := privateKey.(type) {
+	case *ecdsa.PrivateKey:
+		ecdhKey, err := key.ECDH()
+		if err != nil {
+			return nil, fmt.Errorf("converting private key: %w", err)
+		}
+		recipient = ecdhKey
+	case ECDHKey:
+		recipient = key
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", privateKey)
+	}
+	recipientPublicKey, err := deviceECDHPublicKey(recipient.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) == 0 || ciphertext[0] != deviceSecretVersion {
+		return nil, errors.New("unsupported device secret version")
+	}
+	// the ephemeral public key is an uncompressed point on the curve of the device key
+	pointSize := len(recipientPublicKey.Bytes())
+	if len(ciphertext) < 1+pointSize {
+		return nil, errors.New("device secret too short")
+	}
+	ephemeral, err := recipientPublicKey.Curve().NewPublicKey(ciphertext[1 : 1+pointSize])
+	if err != nil {
+		return nil, fmt.Errorf("parsing ephemeral key: %w", err)
+	}
+	sharedSecret, err := recipient.ECDH(ephemeral)
+	if err != nil {
+		return nil, fmt.Errorf("computing shared secret: %w", err)
+	}
+	aead, err := deviceSecretAEAD(sharedSecret, ephemeral.Bytes(), recipientPublicKey.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := open(aead, ciphertext[1+pointSize:])
+	if err != nil {
+		return nil, fmt.Errorf("decrypting secret: %w", err)
+	}
+	return plaintext, nil
+}
+
+func deviceECDHPublicKey(publicKey crypto.PublicKey) (*ecdh.PublicKey, error) {
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return key.ECDH()
+	case ecdsa.PublicKey:
+		return key.ECDH()
+	case *ecdh.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+// deviceSecretAEAD derives the key of a device secret from the shared secret,
+// binding it to both public keys of the key agreement.
+func deviceSecretAEAD(sharedSecret, ephemeralPublicKey, recipientPublicKey []byte) (cipher.AEAD, error) {
+	info := append([]byte(deviceSecretInfo), ephemeralPublicKey...)
+	info = append(info, recipientPublicKey...)
+	key := make([]byte, encryptionKeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, sharedSecret, nil, info), key); err != nil {
+		return nil, fmt.Errorf("deriving secret key: %w", err)
+	}
+	return newAEAD(key)
+}
@@ -0,0 +1,69 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceSecretRoundTrip(t *testing.T) {
+	require := require.New(t)
+	publicKey, privateKey, err := NewKeyPair()
+	require.NoError(err)
+
+	ciphertext, err := EncryptDeviceSecret(publicKey, []byte("hunter2"))
+	require.NoError(err)
+	require.NotContains(string(ciphertext), "hunter2")
+
+	// every secret is encrypted with its own ephemeral key
+	again, err := EncryptDeviceSecret(publicKey, []byte("hunter2"))
+	require.NoError(err)
+	require.NotEqual(ciphertext, again)
+
+	plaintext, err := DecryptDeviceSecret(privateKey, ciphertext)
+	require.NoError(err)
+	require.Equal("hunter2", string(plaintext))
+
+	// public keys may also be passed by value
+	ciphertext, err = EncryptDeviceSecret(*publicKey.(*ecdsa.PublicKey), []byte{})
+	require.NoError(err)
+	plaintext, err = DecryptDeviceSecret(privateKey, ciphertext)
+	require.NoError(err)
+	require.Empty(plaintext)
+}
+
+func TestDeviceSecretWrongKey(t *testing.T) {
+	require := require.New(t)
+	publicKey, _, err := NewKeyPair()
+	require.NoError(err)
+	_, otherPrivateKey, err := NewKeyPair()
+	require.NoError(err)
+
+	ciphertext, err := EncryptDeviceSecret(publicKey, []byte("hunter2"))
+	require.NoError(err)
+	_, err = DecryptDeviceSecret(otherPrivateKey, ciphertext)
+	require.Error(err)
+}
+
+func TestDeviceSecretTampered(t *testing.T) {
+	require := require.New(t)
+	publicKey, privateKey, err := NewKeyPair()
+	require.NoError(err)
+
+	ciphertext, err := EncryptDeviceSecret(publicKey, []byte("hunter2"))
+	require.NoError(err)
+
+	tampered := append([]byte{}, ciphertext...)
+	tampered[len(tampered)-1] ^= 0xff
+	_, err = DecryptDeviceSecret(privateKey, tampered)
+	require.Error(err)
+
+	_, err = DecryptDeviceSecret(privateKey, ciphertext[:10])
+	require.Error(err)
+
+	tampered = append([]byte{}, ciphertext...)
+	tampered[0] = 2
+	_, err = DecryptDeviceSecret(privateKey, tampered)
+	require.ErrorContains(err, "unsupported device secret version")
+}
@@ -0,0 +1,178 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	encryptedValuePrefix = "enc:v1:"
+	encryptionKeySize    = 32
+)
+
+// EncryptionKey is a key-encryption key identified by a name that is stored
+// along with the values it protects, so that previous keys can still be used
+// for decryption after a rotation.
+type EncryptionKey struct {
+	ID  string
+	Key []byte
+}
+
+// SecretEncrypter implements envelope encryption of secret values: every value
+// is encrypted with a fresh data key, which is in turn encrypted with the active
+// key-encryption key.
+type SecretEncrypter struct {
+	active string
+	keys   map[string]cipher.AEAD
+}
+
+// NewSecretEncrypter returns a SecretEncrypter using the first of the given keys
+// for encryption and all of them for decryption.
+func NewSecretEncrypter(keys ...EncryptionKey) (*SecretEncrypter, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one encryption key is required")
+	}
+	e := &SecretEncrypter{
+		active: keys[0].ID,
+		keys:   make(map[string]cipher.AEAD, len(keys)),
+	}
+	for _, key := range keys {
+		if key.ID == "" || strings.Contains(key.ID, ":") {
+			return nil, fmt.Errorf("invalid encryption key id %q", key.ID)
+		}
+		if _, exists := e.keys[key.ID]; exists {
+			return nil, fmt.Errorf("duplicate encryption key id %q", key.ID)
+		}
+		if len(key.Key) != encryptionKeySize {
+			return nil, fmt.Errorf("encryption key %q must be %d bytes long", key.ID, encryptionKeySize)
+		}
+		aead, err := newAEAD(key.Key)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %q: %w", key.ID, err)
+		}
+		e.keys[key.ID] = aead
+	}
+	return e, nil
+}
+
+// NewEncryptionKey generates random key material for an EncryptionKey.
+func NewEncryptionKey() ([]byte, error) {
+	key := make([]byte, encryptionKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// IsEncrypted returns whether the value was produced by a SecretEncrypter.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedValuePrefix)
+}
+
+// Encrypt returns the value encrypted with the active key.
+func (e *SecretEncrypter) Encrypt(plaintext string) (string, error) {
+	dataKey, err := NewEncryptionKey()
+	if err != nil {
+		return "", fmt.Errorf("generating data key: %w", err)
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	wrappedKey, err := seal(e.keys[e.active], dataKey)
+	if err != nil {
+		return "", fmt.Errorf("encrypting data key: %w", err)
+	}
+	ciphertext, err := seal(dataAEAD, []byte(plaintext))
+	if err != nil {
+		return "", fmt.Errorf("encrypting value: %w", err)
+	}
+	return encryptedValuePrefix + e.active + ":" +
+		base64.RawStdEncoding.EncodeToString(wrappedKey) + ":" +
+		base64.RawStdEncoding.EncodeToString(ciphertext), nil
+}
+
+// Decrypt returns the plaintext of an encrypted value. Values that are not
+// encrypted are returned as is, so that data stored before encryption was
+// enabled remains readable.
+func (e *SecretEncrypter) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	keyID, wrappedKey, ciphertext, err := splitEncryptedValue(value)
+	if err != nil {
+		return "", err
+	}
+	keyAEAD, ok := e.keys[keyID]
+	if !ok {
+		return "", fmt.Errorf("unknown encryption key %q", keyID)
+	}
+	dataKey, err := open(keyAEAD, wrappedKey)
+	if err != nil {
+		return "", fmt.Errorf("decrypting data key: %w", err)
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(dataAEAD, ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("decrypting value: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation returns whether the value is not encrypted with the active key.
+func (e *SecretEncrypter) NeedsRotation(value string) bool {
+	if !IsEncrypted(value) {
+		return true
+	}
+	keyID, _, _, err := splitEncryptedValue(value)
+	return err != nil || keyID != e.active
+}
+
+func splitEncryptedValue(value string) (string, []byte, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(value, encryptedValuePrefix), ":")
+	if len(parts) != 3 {
+		return "", nil, nil, errors.New("malformed encrypted value")
+	}
+	wrappedKey, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("malformed encrypted value: %w", err)
+	}
+	ciphertext, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("malformed encrypted value: %w", err)
+	}
+	return parts[0], wrappedKey, ciphertext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(aead cipher.AEAD, data []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
@@ -0,0 +1,103 @@
+package crypto
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEncryptionKey(t *testing.T, id string) EncryptionKey {
+	key, err := NewEncryptionKey()
+	require.NoError(t, err)
+	return EncryptionKey{ID: id, Key: key}
+}
+
+func TestSecretEncrypterRoundTrip(t *testing.T) {
+	require := require.New(t)
+	e, err := NewSecretEncrypter(newTestEncryptionKey(t, "k1"))
+	require.NoError(err)
+
+	encrypted, err := e.Encrypt("hunter2")
+	require.NoError(err)
+	require.True(IsEncrypted(encrypted))
+	require.NotContains(encrypted, "hunter2")
+	require.False(e.NeedsRotation(encrypted))
+
+	// every value is encrypted with its own data key
+	again, err := e.Encrypt("hunter2")
+	require.NoError(err)
+	require.NotEqual(encrypted, again)
+
+	decrypted, err := e.Decrypt(encrypted)
+	require.NoError(err)
+	require.Equal("hunter2", decrypted)
+}
+
+func TestSecretEncrypterPlaintextPassthrough(t *testing.T) {
+	require := require.New(t)
+	e, err := NewSecretEncrypter(newTestEncryptionKey(t, "k1"))
+	require.NoError(err)
+
+	decrypted, err := e.Decrypt("legacy")
+	require.NoError(err)
+	require.Equal("legacy", decrypted)
+	require.True(e.NeedsRotation("legacy"))
+}
+
+func TestSecretEncrypterRotation(t *testing.T) {
+	require := require.New(t)
+	oldKey := newTestEncryptionKey(t, "old")
+	newKey := newTestEncryptionKey(t, "new")
+
+	oldEncrypter, err := NewSecretEncrypter(oldKey)
+	require.NoError(err)
+	encrypted, err := oldEncrypter.Encrypt("hunter2")
+	require.NoError(err)
+
+	rotated, err := NewSecretEncrypter(newKey, oldKey)
+	require.NoError(err)
+	require.True(rotated.NeedsRotation(encrypted))
+	decrypted, err := rotated.Decrypt(encrypted)
+	require.NoError(err)
+	require.Equal("hunter2", decrypted)
+
+	// once the old key is dropped, values it protects can no longer be read
+	newOnly, err := NewSecretEncrypter(newKey)
+	require.NoError(err)
+	_, err = newOnly.Decrypt(encrypted)
+	require.Error(err)
+}
+
+func TestSecretEncrypterTamperedValue(t *testing.T) {
+	require := require.New(t)
+	e, err := NewSecretEncrypter(newTestEncryptionKey(t, "k1"))
+	require.NoError(err)
+
+	first, err := e.Encrypt("hunter2")
+	require.NoError(err)
+	second, err := e.Encrypt("hunter3")
+	require.NoError(err)
+
+	// the data key of one value does not open the ciphertext of another
+	firstParts := strings.Split(first, ":")
+	secondParts := strings.Split(second, ":")
+	tampered := strings.Join(append(firstParts[:4], secondParts[4]), ":")
+	_, err = e.Decrypt(tampered)
+	require.Error(err)
+
+	_, err = e.Decrypt("enc:v1:k1:garbage")
+	require.Error(err)
+}
+
+func TestNewSecretEncrypterInvalidKeys(t *testing.T) {
+	require := require.New(t)
+	_, err := NewSecretEncrypter()
+	require.Error(err)
+	_, err = NewSecretEncrypter(EncryptionKey{ID: "short", Key: []byte("too short")})
+	require.Error(err)
+	_, err = NewSecretEncrypter(newTestEncryptionKey(t, "a:b"))
+	require.Error(err)
+	_, err = NewSecretEncrypter(newTestEncryptionKey(t, "dup"), newTestEncryptionKey(t, "dup"))
+	require.Error(err)
+}
@@ -211,3 +211,26 @@ func ParseKeyPEM(pemKey []byte) (crypto.PrivateKey, error) {
 	}
 	return key, nil
 }
+
+func ParsePublicKeyPEM(pemKey []byte) (crypto.PublicKey, error) {
+	block, err := GetPEMBlock(pemKey)
+	if err != nil {
+		return nil, err
+	}
+	if block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("unknown PEM public key type: %s", block.Type)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing public key: %v", err)
+	}
+	return key, nil
+}
+
+func PEMEncodePublicKey(key crypto.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
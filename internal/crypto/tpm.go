@@ -0,0 +1,248 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/go-tpm/legacy/tpm2"
+	"github.com/google/go-tpm/legacy/tpm2/credactivation"
+	"golang.org/x/crypto/hkdf"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+const (
+	tpmChallengeSecretInfo = "flightctl tpm credential challenge v1"
+	tpmChallengeSecretSize = 32
+)
+
+var oidSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+
+// TPMAttestation is the evidence a device presents that its identity key was
+// generated in a TPM: the certificate of the TPM's endorsement key (EK), and the
+// certification of the identity key by an attestation key (AK) of the TPM.
+type TPMAttestation struct {
+	EKCertificate        []byte
+	AttestationKeyPublic []byte
+	IdentityKeyPublic    []byte
+	CertifyInfo          []byte
+	CertifySignature     []byte
+}
+
+// VerifiedTPMAttestation holds what a TPMAttestation establishes once verified.
+type VerifiedTPMAttestation struct {
+	// EKPublicKey is the public key of the certified endorsement key.
+	EKPublicKey crypto.PublicKey
+	// AKName is the TPM name of the attestation key, which still needs to be
+	// bound to the endorsement key by a credential challenge.
+	AKName tpm2.Name
+}
+
+// TPMAttestationQualifyingData returns the data that the certification of an
+// identity key must include, binding it to the request it is submitted with.
+func TPMAttestationQualifyingData(csr []byte) []byte {
+	digest := sha256.Sum256(csr)
+	return digest[:]
+}
+
+// LoadCertPool loads the PEM-encoded certificates of the given files into a
+// certificate pool. It returns nil if no files are given.
+func LoadCertPool(files []string) (*x509.CertPool, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+	pool := x509.NewCertPool()
+	for _, file := range files {
+		contents, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("reading certificates: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(contents) {
+			return nil, fmt.Errorf("no certificates found in %s", file)
+		}
+	}
+	return pool, nil
+}
+
+// VerifyTPMAttestation verifies that the EK certificate chains to one of the
+// given roots, and that the AK certified a non-exportable TPM signing key with
+// the given public key and qualifying data. It does not prove that the AK and
+// the EK are in the same TPM; this is the purpose of the credential challenge.
+func VerifyTPMAttestation(attestation *TPMAttestation, ekRoots *x509.CertPool, publicKey crypto.PublicKey, qualifyingData []byte) (*VerifiedTPMAttestation, error) {
+	if ekRoots == nil {
+		return nil, errors.New("no TPM endorsement key CAs are configured")
+	}
+	ekCerts, err := certutil.ParseCertsPEM(attestation.EKCertificate)
+	if err != nil {
+		return nil, fmt.Errorf("parsing EK certificate: %w", err)
+	}
+	ekCert := ekCerts[0]
+	intermediates := x509.NewCertPool()
+	for _, cert := range ekCerts[1:] {
+		intermediates.AddCert(cert)
+	}
+	// EK certificates identify the TPM by a critical subject alternative name
+	// made of a directory name only, which is not checked here
+	ekCert.UnhandledCriticalExtensions = removeOID(ekCert.UnhandledCriticalExtensions, oidSubjectAltName)
+	if _, err := ekCert.Verify(x509.VerifyOptions{
+		Roots:         ekRoots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return nil, fmt.Errorf("verifying EK certificate: %w", err)
+	}
+
+	akPublic, err := tpm2.DecodePublic(attestation.AttestationKeyPublic)
+	if err != nil {
+		return nil, fmt.Errorf("decoding attestation key: %w", err)
+	}
+	if !hasTPMAttributes(akPublic, tpm2.FlagFixedTPM|tpm2.FlagFixedParent|tpm2.FlagSensitiveDataOrigin|tpm2.FlagRestricted|tpm2.FlagSign) ||
+		hasTPMAttributes(akPublic, tpm2.FlagDecrypt) {
+		return nil, errors.New("attestation key is not a restricted signing key generated in the TPM")
+	}
+	akKey, err := akPublic.Key()
+	if err != nil {
+		return nil, fmt.Errorf("decoding attestation key: %w", err)
+	}
+	akName, err := akPublic.Name()
+	if err != nil {
+		return nil, fmt.Errorf("computing attestation key name: %w", err)
+	}
+
+	identityPublic, err := tpm2.DecodePublic(attestation.IdentityKeyPublic)
+	if err != nil {
+		return nil, fmt.Errorf("decoding identity key: %w", err)
+	}
+	if !hasTPMAttributes(identityPublic, tpm2.FlagFixedTPM|tpm2.FlagFixedParent|tpm2.FlagSensitiveDataOrigin|tpm2.FlagSign) {
+		return nil, errors.New("identity key is not a signing key generated in the TPM")
+	}
+	identityKey, err := identityPublic.Key()
+	if err != nil {
+		return nil, fmt.Errorf("decoding identity key: %w", err)
+	}
+	if key, ok := identityKey.(interface{ Equal(crypto.PublicKey) bool }); !ok || !key.Equal(publicKey) {
+		return nil, errors.New("identity key does not match the public key of the CSR")
+	}
+
+	if err := verifyTPMSignature(akKey, attestation.CertifyInfo, attestation.CertifySignature); err != nil {
+		return nil, fmt.Errorf("verifying certification of identity key: %w", err)
+	}
+	certifyInfo, err := tpm2.DecodeAttestationData(attestation.CertifyInfo)
+	if err != nil {
+		return nil, fmt.Errorf("decoding certification of identity key: %w", err)
+	}
+	if certifyInfo.Type != tpm2.TagAttestCertify || certifyInfo.AttestedCertifyInfo == nil {
+		return nil, errors.New("certification of identity key is not a TPM2_Certify attestation")
+	}
+	if !bytes.Equal(certifyInfo.ExtraData, qualifyingData) {
+		return nil, errors.New("certification of identity key does not match the request")
+	}
+	if matches, err := certifyInfo.AttestedCertifyInfo.Name.MatchesPublic(identityPublic); err != nil || !matches {
+		return nil, errors.New("certification is not of the identity key")
+	}
+
+	return &VerifiedTPMAttestation{EKPublicKey: ekCert.PublicKey, AKName: akName}, nil
+}
+
+// MakeTPMCredentialChallenge protects a secret so that only the TPM holding
+// both the endorsement key and the attestation key can recover it with
+// TPM2_ActivateCredential. It returns the credential blob and the encrypted
+// secret, both with their TPM2B size prefix.
+func MakeTPMCredentialChallenge(verified *VerifiedTPMAttestation, secret []byte) ([]byte, []byte, error) {
+	if verified.AKName.Digest == nil {
+		return nil, nil, errors.New("attestation key name has no digest")
+	}
+	credentialBlob, encryptedSecret, err := credactivation.Generate(verified.AKName.Digest, verified.EKPublicKey, 16, secret)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generating credential challenge: %w", err)
+	}
+	return credentialBlob, encryptedSecret, nil
+}
+
+// TPMChallengeSecret derives the secret of the credential challenge of an
+// enrollment request from the CA key, so that the service can check the answer
+// without storing the secret.
+func (ca *CA) TPMChallengeSecret(enrollmentRequestName string, attestationKeyPublic []byte) ([]byte, error) {
+	caKey, err := x509.MarshalPKCS8PrivateKey(ca.Config.Key)
+	if err != nil {
+		return nil, fmt.Errorf("encoding CA key: %w", err)
+	}
+	akDigest := sha256.Sum256(attestationKeyPublic)
+	info := append([]byte(tpmChallengeSecretInfo), 0)
+	info = append(info, enrollmentRequestName...)
+	info = append(info, 0)
+	info = append(info, akDigest[:]...)
+	secret := make([]byte, tpmChallengeSecretSize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, caKey, nil, info), secret); err != nil {
+		return nil, fmt.Errorf("deriving challenge secret: %w", err)
+	}
+	return secret, nil
+}
+
+func verifyTPMSignature(key crypto.PublicKey, data []byte, encodedSignature []byte) error {
+	signature, err := tpm2.DecodeSignature(bytes.NewBuffer(encodedSignature))
+	if err != nil {
+		return fmt.Errorf("decoding signature: %w", err)
+	}
+	hashAlg := signature.Alg
+	switch {
+	case signature.ECC != nil:
+		hashAlg = signature.ECC.HashAlg
+	case signature.RSA != nil:
+		hashAlg = signature.RSA.HashAlg
+	}
+	hash, err := hashAlg.Hash()
+	if err != nil {
+		return fmt.Errorf("unsupported signature hash: %w", err)
+	}
+	h := hash.New()
+	h.Write(data)
+	digest := h.Sum(nil)
+
+	switch key := key.(type) {
+	case *ecdsa.PublicKey:
+		if signature.Alg != tpm2.AlgECDSA || signature.ECC == nil {
+			return fmt.Errorf("unexpected signature algorithm %v for an ECC key", signature.Alg)
+		}
+		if !ecdsa.Verify(key, digest, signature.ECC.R, signature.ECC.S) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		if signature.RSA == nil {
+			return fmt.Errorf("unexpected signature algorithm %v for an RSA key", signature.Alg)
+		}
+		switch signature.Alg {
+		case tpm2.AlgRSASSA:
+			return rsa.VerifyPKCS1v15(key, hash, digest, signature.RSA.Signature)
+		case tpm2.AlgRSAPSS:
+			return rsa.VerifyPSS(key, hash, digest, signature.RSA.Signature, nil)
+		default:
+			return fmt.Errorf("unexpected signature algorithm %v for an RSA key", signature.Alg)
+		}
+	default:
+		return fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+func hasTPMAttributes(public tpm2.Public, attributes tpm2.KeyProp) bool {
+	return public.Attributes&attributes == attributes
+}
+
+func removeOID(oids []asn1.ObjectIdentifier, oid asn1.ObjectIdentifier) []asn1.ObjectIdentifier {
+	result := oids[:0]
+	for _, o := range oids {
+		if !o.Equal(oid) {
+			result = append(result, o)
+		}
+	}
+	return result
+}
@@ -23,6 +23,7 @@ var (
 	ErrTemplateVersionIsNil   = errors.New("spec.templateVersion not set")
 	ErrInvalidTemplateVersion = errors.New("device's templateVersion is not valid")
 	ErrNoRenderedVersion      = errors.New("no rendered version for device")
+	ErrOverridesWithoutFleet  = errors.New("overrides can only be set on devices owned by a fleet")
 
 	// csr
 	ErrInvalidPEMBlock = errors.New("not a valid PEM block")
@@ -32,4 +33,10 @@ var (
 	ErrSignature       = errors.New("signature error")
 	ErrSignCert        = errors.New("error signing certificate")
 	ErrEncodeCert      = errors.New("error encoding certificate")
+
+	// enrollment policies
+	ErrMaxApprovalsReached = errors.New("the enrollment policy reached its maximum number of approvals")
+
+	// enrollment requests
+	ErrTPMAttestationNotVerified = errors.New("the TPM attestation of the enrollment request is not verified")
 )
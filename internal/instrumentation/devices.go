@@ -0,0 +1,124 @@
+package instrumentation
+
+import (
+	"context"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// deviceCollectTimeout is how long listing the devices may take per scrape,
+	// which must finish within the write timeout of the metrics server.
+	deviceCollectTimeout = 8 * time.Second
+	// devicesPerPage is the number of devices listed at a time per scrape.
+	devicesPerPage = 1000
+)
+
+// DeviceCollector exports the latest resource usage sample each device
+// reported in its status, labelled with the device and its fleet.
+type DeviceCollector struct {
+	store store.Store
+	log   logrus.FieldLogger
+
+	cpuUtilization    *prometheus.Desc
+	memoryUtilization *prometheus.Desc
+	diskUtilization   *prometheus.Desc
+}
+
+// Make sure we conform to the prometheus Collector interface
+var _ prometheus.Collector = (*DeviceCollector)(nil)
+
+func NewDeviceCollector(st store.Store, log logrus.FieldLogger) *DeviceCollector {
+	labels := []string{"device", "fleet"}
+	return &DeviceCollector{
+		store: st,
+		log:   log,
+		cpuUtilization: prometheus.NewDesc(
+			"flightctl_device_cpu_utilization",
+			"Device CPU utilization",
+			labels, nil,
+		),
+		memoryUtilization: prometheus.NewDesc(
+			"flightctl_device_memory_utilization",
+			"Device memory utilization",
+			labels, nil,
+		),
+		diskUtilization: prometheus.NewDesc(
+			"flightctl_device_disk_utilization",
+			"Device storage utilization",
+			labels, nil,
+		),
+	}
+}
+
+func (c *DeviceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.cpuUtilization
+	ch <- c.memoryUtilization
+	ch <- c.diskUtilization
+}
+
+func (c *DeviceCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), deviceCollectTimeout)
+	defer cancel()
+
+	listParams := store.ListParams{Limit: devicesPerPage}
+	for {
+		devices, err := c.store.Device().List(ctx, store.NullOrgId, listParams)
+		if err != nil {
+			c.log.Errorf("could not list devices for metrics: %v", err)
+			return
+		}
+
+		for i := range devices.Items {
+			c.collectDevice(ch, &devices.Items[i])
+		}
+
+		if devices.Metadata.Continue == nil {
+			return
+		}
+		cont, err := store.ParseContinueString(devices.Metadata.Continue)
+		if err != nil {
+			c.log.Errorf("could not parse continuation for paging: %v", err)
+			return
+		}
+		listParams.Continue = cont
+	}
+}
+
+func (c *DeviceCollector) collectDevice(ch chan<- prometheus.Metric, device *api.Device) {
+	if device.Status == nil || device.Status.Resources.Usage == nil {
+		return
+	}
+	usage := device.Status.Resources.Usage
+
+	fleet := ""
+	if ownerType, ownerName, err := util.GetResourceOwner(device.Metadata.Owner); err == nil && ownerType == model.FleetKind {
+		fleet = ownerName
+	}
+	labels := []string{*device.Metadata.Name, fleet}
+
+	c.collectLatest(ch, c.cpuUtilization, usage.Cpu, labels)
+	c.collectLatest(ch, c.memoryUtilization, usage.Memory, labels)
+	c.collectLatest(ch, c.diskUtilization, usage.Disk, labels)
+}
+
+// collectLatest exports the latest of the samples as a ratio, at the time it
+// was collected on the device.
+func (c *DeviceCollector) collectLatest(ch chan<- prometheus.Metric, desc *prometheus.Desc, samples []api.ResourceUsageSample, labels []string) {
+	if len(samples) == 0 {
+		return
+	}
+	latest := samples[len(samples)-1]
+	metric, err := prometheus.NewConstMetric(desc, prometheus.GaugeValue, float64(latest.UsedPercent)/100, labels...)
+	if err != nil {
+		c.log.Errorf("could not create metric %s: %v", desc, err)
+		return
+	}
+	ch <- prometheus.NewMetricWithTimestamp(latest.Timestamp, metric)
+}
@@ -0,0 +1,75 @@
+package instrumentation
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStore struct {
+	store.Store
+	devices []api.Device
+}
+
+func (s *fakeStore) Device() store.Device {
+	return &fakeDeviceStore{devices: s.devices}
+}
+
+type fakeDeviceStore struct {
+	store.Device
+	devices []api.Device
+}
+
+func (s *fakeDeviceStore) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*api.DeviceList, error) {
+	return &api.DeviceList{Items: s.devices}, nil
+}
+
+func TestDeviceCollector(t *testing.T) {
+	require := require.New(t)
+	now := time.UnixMilli(1714564800000)
+	usage := &api.DeviceResourceUsage{
+		Cpu: []api.ResourceUsageSample{
+			{Timestamp: now.Add(-time.Minute), UsedPercent: 10},
+			{Timestamp: now, UsedPercent: 25},
+		},
+		Memory: []api.ResourceUsageSample{{Timestamp: now, UsedPercent: 50}},
+		Disk:   []api.ResourceUsageSample{},
+	}
+	devices := []api.Device{
+		{
+			Metadata: api.ObjectMeta{Name: lo.ToPtr("owned"), Owner: lo.ToPtr("Fleet/myfleet")},
+			Status:   &api.DeviceStatus{Resources: api.DeviceResourceStatus{Usage: usage}},
+		},
+		{
+			Metadata: api.ObjectMeta{Name: lo.ToPtr("standalone")},
+			Status:   &api.DeviceStatus{Resources: api.DeviceResourceStatus{Usage: usage}},
+		},
+		{
+			// devices that did not report usage are not exported
+			Metadata: api.ObjectMeta{Name: lo.ToPtr("unreported")},
+			Status:   &api.DeviceStatus{},
+		},
+	}
+	collector := NewDeviceCollector(&fakeStore{devices: devices}, logrus.New())
+
+	expected := `
+# HELP flightctl_device_cpu_utilization Device CPU utilization
+# TYPE flightctl_device_cpu_utilization gauge
+flightctl_device_cpu_utilization{device="owned",fleet="myfleet"} 0.25 1714564800000
+flightctl_device_cpu_utilization{device="standalone",fleet=""} 0.25 1714564800000
+# HELP flightctl_device_memory_utilization Device memory utilization
+# TYPE flightctl_device_memory_utilization gauge
+flightctl_device_memory_utilization{device="owned",fleet="myfleet"} 0.5 1714564800000
+flightctl_device_memory_utilization{device="standalone",fleet=""} 0.5 1714564800000
+`
+	require.NoError(testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
@@ -24,10 +24,11 @@ const (
 )
 
 type MetricsServer struct {
-	log      logrus.FieldLogger
-	cfg      *config.Config
-	registry *prometheus.Registry
-	metrics  *ApiMetrics
+	log        logrus.FieldLogger
+	cfg        *config.Config
+	registry   *prometheus.Registry
+	metrics    *ApiMetrics
+	collectors []prometheus.Collector
 }
 
 type ApiMetrics struct {
@@ -39,6 +40,9 @@ type ApiMetrics struct {
 	ApiTraffic   prometheus.Counter
 	AgentTraffic prometheus.Counter
 
+	ApiRateLimited   prometheus.Counter
+	AgentRateLimited prometheus.Counter
+
 	SloViolations prometheus.Counter
 	ClientErrors  prometheus.Counter
 	ServerErrors  prometheus.Counter
@@ -62,6 +66,14 @@ func NewApiMetrics(cfg *config.Config) *ApiMetrics {
 			Name: "flightctl_api_requests_agent_total",
 			Help: "Number of requests to Flightctl Agent server",
 		}),
+		ApiRateLimited: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "flightctl_api_rate_limited_api_total",
+			Help: "Number of requests to Flightctl API server rejected by the rate limit",
+		}),
+		AgentRateLimited: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "flightctl_api_rate_limited_agent_total",
+			Help: "Number of requests to Flightctl Agent server rejected by the rate limit",
+		}),
 		SuccessLatency: prometheus.NewHistogram(prometheus.HistogramOpts{
 			Name:    "flightctl_api_latencies_success_seconds",
 			Help:    "Distribution of latencies of Flightctl server responses that encountered no errors",
@@ -103,12 +115,14 @@ func NewMetricsServer(
 	log logrus.FieldLogger,
 	cfg *config.Config,
 	metrics *ApiMetrics,
+	collectors ...prometheus.Collector,
 ) *MetricsServer {
 	return &MetricsServer{
-		log:      log,
-		cfg:      cfg,
-		metrics:  metrics,
-		registry: prometheus.NewRegistry(),
+		log:        log,
+		cfg:        cfg,
+		metrics:    metrics,
+		registry:   prometheus.NewRegistry(),
+		collectors: collectors,
 	}
 }
 
@@ -132,8 +146,16 @@ func (lw *loggingResponseWriter) WriteHeader(statusCode int) {
 	lw.ResponseWriter.WriteHeader(statusCode)
 }
 
+// Unwrap returns the wrapped writer, for http.ResponseController.
+func (lw *loggingResponseWriter) Unwrap() http.ResponseWriter {
+	return lw.ResponseWriter
+}
+
 func (m *MetricsServer) Run(ctx context.Context) error {
 	m.metrics.RegisterWith(m.registry)
+	for _, collector := range m.collectors {
+		m.registry.MustRegister(collector)
+	}
 
 	srv := &http.Server{
 		Addr:         m.cfg.Prometheus.Address,
@@ -243,6 +265,8 @@ func (m *ApiMetrics) RegisterWith(reg *prometheus.Registry) {
 	reg.MustRegister(m.ErrorLatency)
 	reg.MustRegister(m.ApiTraffic)
 	reg.MustRegister(m.AgentTraffic)
+	reg.MustRegister(m.ApiRateLimited)
+	reg.MustRegister(m.AgentRateLimited)
 	reg.MustRegister(m.SloViolations)
 	reg.MustRegister(m.ServerErrors)
 	reg.MustRegister(m.CpuUtilization)
@@ -0,0 +1,100 @@
+// Package otlp creates the exporters sending traces and metrics to the
+// OTLP/HTTP receiver of an OpenTelemetry collector.
+package otlp
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	tracesPath  = "/v1/traces"
+	metricsPath = "/v1/metrics"
+	// exportTimeout is how long a single export may take
+	exportTimeout = 10 * time.Second
+)
+
+// Config is where and how the telemetry is sent.
+type Config struct {
+	// Endpoint is the base URL of the OTLP/HTTP receiver of the collector,
+	// such as "https://collector:4318".
+	Endpoint string
+	// CABundle is the PEM-encoded CA bundle the collector's certificate is
+	// verified with, by default the system's CA bundle is used.
+	CABundle []byte
+	// Headers are sent with every export.
+	Headers map[string]string
+}
+
+// endpointURL returns the URL the telemetry at the path of the receiver is
+// sent to.
+func (c Config) endpointURL(path string) (string, error) {
+	u, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return "", fmt.Errorf("parsing endpoint: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("endpoint %q must be an http or https URL", c.Endpoint)
+	}
+	return strings.TrimSuffix(c.Endpoint, "/") + path, nil
+}
+
+func (c Config) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(c.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(c.CABundle) {
+			return nil, fmt.Errorf("no certificates found in CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// NewTraceExporter returns the exporter sending the spans of a tracer
+// provider to the collector.
+func NewTraceExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	endpointURL, err := cfg.endpointURL(tracesPath)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	return otlptracehttp.New(ctx,
+		otlptracehttp.WithEndpointURL(endpointURL),
+		otlptracehttp.WithTLSClientConfig(tlsConfig),
+		otlptracehttp.WithHeaders(cfg.Headers),
+		otlptracehttp.WithTimeout(exportTimeout),
+	)
+}
+
+// NewMetricExporter returns the exporter sending the metrics of a meter
+// provider to the collector.
+func NewMetricExporter(ctx context.Context, cfg Config) (sdkmetric.Exporter, error) {
+	endpointURL, err := cfg.endpointURL(metricsPath)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	return otlpmetrichttp.New(ctx,
+		otlpmetrichttp.WithEndpointURL(endpointURL),
+		otlpmetrichttp.WithTLSClientConfig(tlsConfig),
+		otlpmetrichttp.WithHeaders(cfg.Headers),
+		otlpmetrichttp.WithTimeout(exportTimeout),
+	)
+}
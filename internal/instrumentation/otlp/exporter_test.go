@@ -0,0 +1,141 @@
+package otlp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	collectormetrics "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type request struct {
+	path    string
+	headers http.Header
+	body    []byte
+}
+
+// newTestCollector returns the config for a collector that passes on the
+// requests it receives.
+func newTestCollector(t *testing.T, status int) (Config, chan request) {
+	requests := make(chan request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		requests <- request{path: r.URL.Path, headers: r.Header, body: body}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	return Config{
+		Endpoint: server.URL + "/",
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+	}, requests
+}
+
+func TestTraceExporter(t *testing.T) {
+	require := require.New(t)
+	cfg, requests := newTestCollector(t, http.StatusOK)
+	exporter, err := NewTraceExporter(context.Background(), cfg)
+	require.NoError(err)
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", "test"))),
+	)
+	defer func() { _ = tracerProvider.Shutdown(context.Background()) }()
+	tracer := tracerProvider.Tracer("test-tracer")
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	_, child := tracer.Start(ctx, "child")
+	child.SetStatus(codes.Error, "failed")
+	child.End()
+
+	req := <-requests
+	require.Equal(tracesPath, req.path)
+	require.Equal("application/x-protobuf", req.headers.Get("Content-Type"))
+	require.Equal("Bearer token", req.headers.Get("Authorization"))
+
+	body := &collectortrace.ExportTraceServiceRequest{}
+	require.NoError(proto.Unmarshal(req.body, body))
+	require.Len(body.ResourceSpans, 1)
+	require.Equal("service.name", body.ResourceSpans[0].Resource.Attributes[0].Key)
+	require.Equal("test-tracer", body.ResourceSpans[0].ScopeSpans[0].Scope.Name)
+	span := body.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	require.Equal("child", span.Name)
+	traceID := child.SpanContext().TraceID()
+	require.Equal(traceID[:], span.TraceId)
+	parentID := parent.SpanContext().SpanID()
+	require.Equal(parentID[:], span.ParentSpanId)
+	require.Equal(tracepb.Status_STATUS_CODE_ERROR, span.Status.Code)
+	require.Equal("failed", span.Status.Message)
+}
+
+func TestMetricExporter(t *testing.T) {
+	require := require.New(t)
+	cfg, requests := newTestCollector(t, http.StatusOK)
+	exporter, err := NewMetricExporter(context.Background(), cfg)
+	require.NoError(err)
+
+	now := time.Unix(1714564800, 0)
+	rm := &metricdata.ResourceMetrics{
+		Resource: resource.NewSchemaless(attribute.String("service.name", "test")),
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: "test-meter"},
+			Metrics: []metricdata.Metrics{{
+				Name: "errors",
+				Data: metricdata.Sum[int64]{
+					DataPoints:  []metricdata.DataPoint[int64]{{StartTime: now, Time: now, Value: 2}},
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+				},
+			}},
+		}},
+	}
+	require.NoError(exporter.Export(context.Background(), rm))
+
+	req := <-requests
+	require.Equal(metricsPath, req.path)
+	require.Equal("Bearer token", req.headers.Get("Authorization"))
+	body := &collectormetrics.ExportMetricsServiceRequest{}
+	require.NoError(proto.Unmarshal(req.body, body))
+	metric := body.ResourceMetrics[0].ScopeMetrics[0].Metrics[0]
+	require.Equal("errors", metric.Name)
+	require.Equal(int64(2), metric.GetSum().DataPoints[0].GetAsInt())
+	require.Equal(uint64(now.UnixNano()), metric.GetSum().DataPoints[0].TimeUnixNano)
+
+	require.NoError(exporter.Shutdown(context.Background()))
+	require.Error(exporter.Export(context.Background(), rm))
+}
+
+func TestExportError(t *testing.T) {
+	require := require.New(t)
+	cfg, requests := newTestCollector(t, http.StatusBadRequest)
+	exporter, err := NewMetricExporter(context.Background(), cfg)
+	require.NoError(err)
+
+	err = exporter.Export(context.Background(), &metricdata.ResourceMetrics{})
+	<-requests
+	require.ErrorContains(err, "400 Bad Request")
+}
+
+func TestInvalidConfig(t *testing.T) {
+	require := require.New(t)
+
+	_, err := NewTraceExporter(context.Background(), Config{Endpoint: "collector:4318"})
+	require.ErrorContains(err, "must be an http or https URL")
+	_, err = NewMetricExporter(context.Background(), Config{Endpoint: "https://collector:4318", CABundle: []byte("not a certificate")})
+	require.ErrorContains(err, "no certificates found in CA bundle")
+}
@@ -0,0 +1,102 @@
+package tracing
+
+import (
+	"errors"
+	"fmt"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const (
+	gormPluginName = "flightctl:tracing"
+	gormSpanKey    = "flightctl:tracing:span"
+)
+
+// gormPlugin traces the statements executed by gorm. The spans are children
+// of the span in the statement's context, so only the queries made with
+// db.WithContext(ctx) are part of the trace of a request.
+type gormPlugin struct{}
+
+// Make sure we conform to the gorm Plugin interface
+var _ gorm.Plugin = (*gormPlugin)(nil)
+
+func NewGormPlugin() gorm.Plugin {
+	return &gormPlugin{}
+}
+
+func (p *gormPlugin) Name() string {
+	return gormPluginName
+}
+
+func (p *gormPlugin) Initialize(db *gorm.DB) error {
+	type register func(name string, fn func(*gorm.DB)) error
+	cb := db.Callback()
+	for _, op := range []struct {
+		operation     string
+		before, after register
+	}{
+		{"create", cb.Create().Before("*").Register, cb.Create().After("*").Register},
+		{"query", cb.Query().Before("*").Register, cb.Query().After("*").Register},
+		{"update", cb.Update().Before("*").Register, cb.Update().After("*").Register},
+		{"delete", cb.Delete().Before("*").Register, cb.Delete().After("*").Register},
+		{"row", cb.Row().Before("*").Register, cb.Row().After("*").Register},
+		{"raw", cb.Raw().Before("*").Register, cb.Raw().After("*").Register},
+	} {
+		if err := op.before(fmt.Sprintf("%s:before_%s", gormPluginName, op.operation), startStatementSpan(db, op.operation)); err != nil {
+			return fmt.Errorf("registering tracing of %s statements: %w", op.operation, err)
+		}
+		if err := op.after(fmt.Sprintf("%s:after_%s", gormPluginName, op.operation), endStatementSpan); err != nil {
+			return fmt.Errorf("registering tracing of %s statements: %w", op.operation, err)
+		}
+	}
+	return nil
+}
+
+func startStatementSpan(db *gorm.DB, operation string) func(*gorm.DB) {
+	system := semconv.DBSystemSqlite
+	if db.Dialector.Name() == "postgres" {
+		system = semconv.DBSystemPostgreSQL
+	}
+	return func(tx *gorm.DB) {
+		if tx.Statement.Context == nil {
+			return
+		}
+		_, span := StartSpan(tx.Statement.Context, fmt.Sprintf("db %s", operation),
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(system, semconv.DBOperationName(operation)),
+		)
+		tx.InstanceSet(gormSpanKey, &statementSpan{span: span, operation: operation})
+	}
+}
+
+// statementSpan is the span of a statement while it is executed.
+type statementSpan struct {
+	span      trace.Span
+	operation string
+}
+
+func endStatementSpan(tx *gorm.DB) {
+	value, ok := tx.InstanceGet(gormSpanKey)
+	if !ok {
+		return
+	}
+	statement, ok := value.(*statementSpan)
+	if !ok {
+		return
+	}
+	span := statement.span
+	if tx.Statement.Table != "" {
+		span.SetName(fmt.Sprintf("db %s %s", statement.operation, tx.Statement.Table))
+		span.SetAttributes(semconv.DBCollectionName(tx.Statement.Table))
+	}
+	// the statement is parameterized, so it does not contain any values
+	span.SetAttributes(semconv.DBQueryText(tx.Statement.SQL.String()))
+
+	err := tx.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = nil
+	}
+	EndSpan(span, err)
+}
@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type testRecord struct {
+	Name string `gorm:"primaryKey"`
+}
+
+func TestGormPlugin(t *testing.T) {
+	require := require.New(t)
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(err)
+	require.NoError(db.Use(NewGormPlugin()))
+	require.NoError(db.AutoMigrate(&testRecord{}))
+	recorder := newTestRecorder(t)
+
+	ctx, parent := StartSpan(context.Background(), "request")
+	require.NoError(db.WithContext(ctx).Create(&testRecord{Name: "foo"}).Error)
+	require.ErrorIs(db.WithContext(ctx).Where("name = ?", "bar").First(&testRecord{}).Error, gorm.ErrRecordNotFound)
+	require.Error(db.WithContext(ctx).Exec("SELECT * FROM missing").Error)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(spans, 4)
+	for _, span := range spans[:3] {
+		require.Equal(parent.SpanContext().SpanID(), span.Parent().SpanID())
+		require.Contains(span.Attributes(), semconv.DBSystemSqlite)
+	}
+
+	require.Equal("db create test_records", spans[0].Name())
+	require.Contains(spans[0].Attributes(), semconv.DBCollectionName("test_records"))
+	require.Equal(codes.Unset, spans[0].Status().Code)
+
+	// not finding a record is not a failure of the query
+	require.Equal("db query test_records", spans[1].Name())
+	require.Contains(spans[1].Attributes(), semconv.DBQueryText("SELECT * FROM `test_records` WHERE name = ? ORDER BY `test_records`.`name` LIMIT 1"))
+	require.Equal(codes.Unset, spans[1].Status().Code)
+
+	require.Equal("db raw", spans[2].Name())
+	require.Equal(codes.Error, spans[2].Status().Code)
+}
@@ -0,0 +1,141 @@
+// Package tracing traces the requests of the service across the API servers,
+// the store and the task queue, and exports the spans to an OpenTelemetry
+// collector.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/instrumentation/otlp"
+	"github.com/flightctl/flightctl/pkg/version"
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// instrumentationName is the name of the tracer of the service
+	instrumentationName = "github.com/flightctl/flightctl"
+	// shutdownTimeout is how long flushing the remaining spans may take
+	shutdownTimeout = 5 * time.Second
+)
+
+// Init installs the propagator carrying the trace context across the service
+// and, if tracing is configured, the provider exporting the spans to the
+// collector. The returned function flushes the remaining spans and stops the
+// export.
+func Init(cfg *config.Config, serviceName string, log logrus.FieldLogger) (func(), error) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	if cfg.Tracing == nil || cfg.Tracing.Endpoint == "" {
+		return func() {}, nil
+	}
+
+	var caBundle []byte
+	if cfg.Tracing.CaCertFile != "" {
+		var err error
+		if caBundle, err = os.ReadFile(cfg.Tracing.CaCertFile); err != nil {
+			return nil, fmt.Errorf("reading tracing CA bundle: %w", err)
+		}
+	}
+	exporter, err := otlp.NewTraceExporter(context.Background(), otlp.Config{
+		Endpoint: cfg.Tracing.Endpoint,
+		CABundle: caBundle,
+		Headers:  cfg.Tracing.Headers,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating trace exporter: %w", err)
+	}
+
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		log.Warnf("Tracing: %v", err)
+	}))
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(version.Get().String()),
+		)),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	log.Infof("Exporting traces to %s", cfg.Tracing.Endpoint)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := tracerProvider.Shutdown(ctx); err != nil {
+			log.Warnf("Flushing traces: %v", err)
+		}
+	}, nil
+}
+
+// StartSpan starts a span of the service, which is a child of the span in the
+// context if any.
+func StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// EndSpan ends the span, marking it as failed if there was an error.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// statusRecorder saves the status code written by the handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Unwrap returns the wrapped writer, for http.ResponseController.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// Middleware traces the requests to an API server, continuing the trace of
+// the client if the request carries its context. The span is named after the
+// route matching the request, so that the requests to a resource are grouped
+// regardless of its name.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := StartSpan(ctx, fmt.Sprintf("HTTP %s", r.Method),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(r.Method),
+				semconv.URLPath(r.URL.Path),
+			),
+		)
+		defer span.End()
+
+		rw := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(rw, r.WithContext(ctx))
+
+		if routeCtx := chi.RouteContext(r.Context()); routeCtx != nil && routeCtx.RoutePattern() != "" {
+			span.SetName(fmt.Sprintf("HTTP %s %s", r.Method, routeCtx.RoutePattern()))
+			span.SetAttributes(semconv.HTTPRoute(routeCtx.RoutePattern()))
+		}
+		span.SetAttributes(semconv.HTTPResponseStatusCode(rw.statusCode))
+		if rw.statusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(rw.statusCode))
+		}
+	})
+}
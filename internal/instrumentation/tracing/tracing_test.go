@@ -0,0 +1,81 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTestRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { _ = tracerProvider.Shutdown(context.Background()) })
+	return recorder
+}
+
+func TestMiddleware(t *testing.T) {
+	require := require.New(t)
+	recorder := newTestRecorder(t)
+
+	var handlerSpan trace.SpanContext
+	router := chi.NewRouter()
+	router.Use(Middleware)
+	router.Get("/api/v1/devices/{name}", func(w http.ResponseWriter, r *http.Request) {
+		handlerSpan = trace.SpanContextFromContext(r.Context())
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	// the client's span, as propagated by the agent
+	clientCtx, clientSpan := StartSpan(context.Background(), "sync device spec")
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/devices/mydevice", nil)
+	otel.GetTextMapPropagator().Inject(clientCtx, propagation.HeaderCarrier(req.Header))
+	clientSpan.End()
+
+	rw := httptest.NewRecorder()
+	router.ServeHTTP(rw, req)
+	require.Equal(http.StatusInternalServerError, rw.Code)
+
+	spans := recorder.Ended()
+	require.Len(spans, 2)
+	serverSpan := spans[1]
+	require.Equal("HTTP GET /api/v1/devices/{name}", serverSpan.Name())
+	require.Equal(trace.SpanKindServer, serverSpan.SpanKind())
+	require.Equal(clientSpan.SpanContext().TraceID(), serverSpan.SpanContext().TraceID())
+	require.Equal(clientSpan.SpanContext().SpanID(), serverSpan.Parent().SpanID())
+	require.Equal(serverSpan.SpanContext(), handlerSpan)
+	require.Contains(serverSpan.Attributes(), semconv.HTTPRoute("/api/v1/devices/{name}"))
+	require.Contains(serverSpan.Attributes(), semconv.HTTPResponseStatusCode(http.StatusInternalServerError))
+	require.Equal(codes.Error, serverSpan.Status().Code)
+}
+
+func TestMiddlewareWithoutRoute(t *testing.T) {
+	require := require.New(t)
+	recorder := newTestRecorder(t)
+
+	router := chi.NewRouter()
+	router.Use(Middleware)
+	router.Get("/api/v1/devices", func(w http.ResponseWriter, r *http.Request) {})
+
+	rw := httptest.NewRecorder()
+	router.ServeHTTP(rw, httptest.NewRequest(http.MethodPost, "/unknown", nil))
+	require.Equal(http.StatusNotFound, rw.Code)
+
+	spans := recorder.Ended()
+	require.Len(spans, 1)
+	require.Equal("HTTP POST", spans[0].Name())
+	require.False(spans[0].Parent().IsValid())
+	require.Equal(codes.Unset, spans[0].Status().Code)
+}
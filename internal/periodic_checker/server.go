@@ -58,13 +58,38 @@ func (s *Server) Run() error {
 	resourceSyncThread.Start()
 	defer resourceSyncThread.Stop()
 
+	// vault secret watcher
+	vaultSecretWatcher := tasks.NewVaultSecretWatcher(callbackManager, s.store, s.log)
+	vaultSecretWatcherThread := thread.New(
+		s.log.WithField("pkg", "vault-secret-watcher"), "Vault secret watcher", 2*time.Minute, vaultSecretWatcher.Poll)
+	vaultSecretWatcherThread.Start()
+	defer vaultSecretWatcherThread.Stop()
+
 	// device disconnected
-	deviceDisconnected := tasks.NewDeviceDisconnected(s.log, s.store)
+	deviceDisconnected := tasks.NewDeviceDisconnected(s.log, s.store, time.Duration(s.cfg.Service.DeviceDisconnectedTimeout))
 	deviceDisconnectedThread := thread.New(
 		s.log.WithField("pkg", "device-disconnected"), "Device disconnected", tasks.DeviceDisconnectedPollingInterval, deviceDisconnected.Poll)
 	deviceDisconnectedThread.Start()
 	defer deviceDisconnectedThread.Stop()
 
+	// event cleanup
+	eventCleanup := tasks.NewEventCleanup(s.log, s.store, time.Duration(s.cfg.Service.EventRetentionPeriod))
+	eventCleanupThread := thread.New(
+		s.log.WithField("pkg", "event-cleanup"), "Event cleanup", tasks.RetentionCleanupPollingInterval, eventCleanup.Poll)
+	eventCleanupThread.Start()
+	defer eventCleanupThread.Stop()
+
+	// audit record cleanup
+	var auditRetention time.Duration
+	if s.cfg.Audit != nil {
+		auditRetention = time.Duration(s.cfg.Audit.RetentionPeriod)
+	}
+	auditCleanup := tasks.NewAuditCleanup(s.log, s.store, auditRetention)
+	auditCleanupThread := thread.New(
+		s.log.WithField("pkg", "audit-cleanup"), "Audit cleanup", tasks.RetentionCleanupPollingInterval, auditCleanup.Poll)
+	auditCleanupThread.Start()
+	defer auditCleanupThread.Stop()
+
 	sigShutdown := make(chan os.Signal, 1)
 
 	signal.Notify(sigShutdown, os.Interrupt, syscall.SIGHUP, syscall.SIGTERM, syscall.SIGQUIT)
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	agentServer "github.com/flightctl/flightctl/internal/api/server/agent"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/samber/lo"
+)
+
+// maxDeviceBundleSize is the largest diagnostic bundle a device may upload.
+const maxDeviceBundleSize = 32 * 1024 * 1024
+
+// (PUT /api/v1/devices/{name}/bundle/{requestID})
+func (s *AgentServiceHandler) UploadDeviceBundle(ctx context.Context, request agentServer.UploadDeviceBundleRequestObject) (agentServer.UploadDeviceBundleResponseObject, error) {
+	orgId := store.NullOrgId
+
+	if err := ValidateDeviceAccessFromContext(ctx, request.Name, s.log); err != nil {
+		return agentServer.UploadDeviceBundle401JSONResponse{
+			Message: err.Error(),
+		}, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(request.Body, maxDeviceBundleSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxDeviceBundleSize {
+		return agentServer.UploadDeviceBundle413JSONResponse{
+			Message: fmt.Sprintf("bundle exceeds the maximum size of %d bytes", maxDeviceBundleSize),
+		}, nil
+	}
+
+	err = s.store.DeviceBundle().Upload(ctx, orgId, request.Name, request.RequestID, data)
+	switch {
+	case err == nil:
+	case errors.Is(err, flterrors.ErrResourceNotFound):
+		return agentServer.UploadDeviceBundle404JSONResponse{
+			Message: fmt.Sprintf("no pending bundle request %s for device %s", request.RequestID, request.Name),
+		}, nil
+	default:
+		return nil, err
+	}
+
+	// the request was served, unless another one replaced it in the meantime
+	device, err := s.store.Device().Get(ctx, orgId, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if lo.FromPtr(device.Metadata.Annotations)[model.DeviceAnnotationBundle] == request.RequestID {
+		if err := s.store.Device().UpdateAnnotations(ctx, orgId, request.Name, map[string]string{}, []string{model.DeviceAnnotationBundle}); err != nil {
+			s.log.Warnf("failed to remove bundle request from device %s: %v", request.Name, err)
+		}
+	}
+	return agentServer.UploadDeviceBundle204Response{}, nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"strings"
 
@@ -11,12 +12,15 @@ import (
 	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/tasks"
 	"github.com/sirupsen/logrus"
 )
 
 type AgentServiceHandler struct {
 	store             store.Store
+	callbackManager   tasks.CallbackManager
 	ca                *crypto.CA
+	tpmEKRoots        *x509.CertPool
 	log               logrus.FieldLogger
 	agentGrpcEndpoint string
 }
@@ -54,10 +58,12 @@ func ValidateEnrollmentAccessFromContext(ctx context.Context, log logrus.FieldLo
 	return nil
 }
 
-func NewAgentServiceHandler(store store.Store, ca *crypto.CA, log logrus.FieldLogger, agentGrpcEndpoint string) *AgentServiceHandler {
+func NewAgentServiceHandler(store store.Store, callbackManager tasks.CallbackManager, ca *crypto.CA, tpmEKRoots *x509.CertPool, log logrus.FieldLogger, agentGrpcEndpoint string) *AgentServiceHandler {
 	return &AgentServiceHandler{
 		store:             store,
+		callbackManager:   callbackManager,
 		ca:                ca,
+		tpmEKRoots:        tpmEKRoots,
 		log:               log,
 		agentGrpcEndpoint: agentGrpcEndpoint,
 	}
@@ -107,7 +113,23 @@ func (s *AgentServiceHandler) CreateEnrollmentRequest(ctx context.Context, reque
 	serverRequest := server.CreateEnrollmentRequestRequestObject{
 		Body: request.Body,
 	}
-	return common.CreateEnrollmentRequest(ctx, s.store, serverRequest)
+	return common.CreateEnrollmentRequest(ctx, s.store, s.ca, s.tpmEKRoots, s.callbackManager.DeviceUpdatedCallback, s.log, serverRequest)
+}
+
+// (POST /api/v1/enrollmentrequests/{name}/tpmchallenge)
+func (s *AgentServiceHandler) AnswerEnrollmentRequestTPMChallenge(ctx context.Context, request agentServer.AnswerEnrollmentRequestTPMChallengeRequestObject) (agentServer.AnswerEnrollmentRequestTPMChallengeResponseObject, error) {
+
+	if err := ValidateEnrollmentAccessFromContext(ctx, s.log); err != nil {
+		return agentServer.AnswerEnrollmentRequestTPMChallenge401JSONResponse{
+			Message: err.Error(),
+		}, err
+	}
+
+	serverRequest := server.AnswerEnrollmentRequestTPMChallengeRequestObject{
+		Name: request.Name,
+		Body: request.Body,
+	}
+	return common.AnswerEnrollmentRequestTPMChallenge(ctx, s.store, s.ca, s.callbackManager.DeviceUpdatedCallback, s.log, serverRequest)
 }
 
 // (GET /api/v1/enrollmentrequests/{name})
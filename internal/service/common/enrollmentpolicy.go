@@ -0,0 +1,155 @@
+package common
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/api_server/middleware"
+	fccrypto "github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// EnrollmentPolicyApprover returns the approver recorded in the approval of
+// the enrollment requests approved by the named policy.
+func EnrollmentPolicyApprover(name string) string {
+	return "EnrollmentPolicy/" + name
+}
+
+// ApproveWithEnrollmentPolicies approves a pending enrollment request with the
+// first enrollment policy, in name order, that matches it, and creates its
+// device. It returns whether the request was approved. Requests with a TPM
+// attestation that was not verified yet are left pending, and so are requests
+// whose approval fails, in which case the approval is not counted against the
+// policy.
+func ApproveWithEnrollmentPolicies(ctx context.Context, st store.Store, ca *fccrypto.CA, orgId uuid.UUID, enrollmentRequest *v1alpha1.EnrollmentRequest, callback store.DeviceStoreCallback, log logrus.FieldLogger) (bool, error) {
+	if IsTPMAttestationPending(enrollmentRequest) {
+		return false, nil
+	}
+
+	policies, err := st.EnrollmentPolicy().List(ctx, orgId, store.ListParams{
+		SortBy: &store.SortField{FieldName: selector.SelectorFieldName("metadata.name"), Order: v1alpha1.Asc},
+	})
+	if err != nil {
+		return false, fmt.Errorf("listing enrollment policies: %w", err)
+	}
+	if len(policies.Items) == 0 {
+		return false, nil
+	}
+
+	csr, err := fccrypto.ParseCSR([]byte(enrollmentRequest.Spec.Csr))
+	if err != nil {
+		return false, fmt.Errorf("parsing CSR: %w", err)
+	}
+	// the common name of the enrollment certificate the request was submitted with
+	enrollmentCN, _ := ctx.Value(middleware.TLSCommonNameContextKey).(string)
+
+	now := time.Now()
+	pendingStatus := enrollmentRequest.Status
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if !EnrollmentPolicyMatches(policy, enrollmentRequest, csr.PublicKey, enrollmentCN, now) {
+			continue
+		}
+
+		approval := &v1alpha1.EnrollmentRequestApproval{
+			Approved:   true,
+			ApprovedAt: util.TimeToPtr(now),
+			ApprovedBy: util.StrToPtr(EnrollmentPolicyApprover(*policy.Metadata.Name)),
+			Labels:     lo.ToPtr(lo.Assign(lo.FromPtr(policy.Spec.Labels))),
+		}
+		if err := ApproveAndSignEnrollmentRequest(ca, enrollmentRequest, approval, v1alpha1.EnrollmentRequestApprovedReasonEnrollmentPolicy); err != nil {
+			return false, fmt.Errorf("approving enrollment request with policy %s: %w", *policy.Metadata.Name, err)
+		}
+
+		// record the approval before creating the device, so that the maximum
+		// number of approvals holds under concurrent requests
+		err := st.EnrollmentPolicy().RecordApproval(ctx, orgId, *policy.Metadata.Name, now)
+		if errors.Is(err, flterrors.ErrMaxApprovalsReached) || errors.Is(err, flterrors.ErrResourceNotFound) {
+			enrollmentRequest.Status = pendingStatus
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("recording approval of enrollment policy %s: %w", *policy.Metadata.Name, err)
+		}
+
+		if err := completeApproval(ctx, st, orgId, enrollmentRequest, callback, log); err != nil {
+			// leave the request pending, without using up an approval of the policy
+			enrollmentRequest.Status = pendingStatus
+			if revertErr := st.EnrollmentPolicy().RevertApproval(ctx, orgId, *policy.Metadata.Name); revertErr != nil {
+				err = errors.Join(err, fmt.Errorf("reverting approval of enrollment policy %s: %w", *policy.Metadata.Name, revertErr))
+			}
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// completeApproval creates the device of an approved enrollment request and
+// stores its approval, deleting the device again if the approval cannot be
+// stored.
+func completeApproval(ctx context.Context, st store.Store, orgId uuid.UUID, enrollmentRequest *v1alpha1.EnrollmentRequest, callback store.DeviceStoreCallback, log logrus.FieldLogger) error {
+	if err := CreateDeviceFromEnrollmentRequest(ctx, st, orgId, enrollmentRequest, callback, log); err != nil {
+		return fmt.Errorf("creating device from enrollment request: %w", err)
+	}
+	if _, err := st.EnrollmentRequest().UpdateStatus(ctx, orgId, enrollmentRequest); err != nil {
+		err = fmt.Errorf("updating enrollment request status: %w", err)
+		if deleteErr := st.Device().Delete(ctx, orgId, *enrollmentRequest.Metadata.Name, callback); deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("deleting device of enrollment request: %w", deleteErr))
+		}
+		return err
+	}
+	return nil
+}
+
+// EnrollmentPolicyMatches returns whether the policy approves an enrollment
+// request at the given time. A request matches if it satisfies every rule set
+// in the policy and the policy did not reach its maximum number of approvals,
+// which is checked again when the approval is recorded.
+func EnrollmentPolicyMatches(policy *v1alpha1.EnrollmentPolicy, enrollmentRequest *v1alpha1.EnrollmentRequest, publicKey crypto.PublicKey, enrollmentCN string, now time.Time) bool {
+	spec := policy.Spec
+	if spec.ValidFrom != nil && now.Before(*spec.ValidFrom) {
+		return false
+	}
+	if spec.ValidUntil != nil && now.After(*spec.ValidUntil) {
+		return false
+	}
+	if spec.MaxApprovals != nil && policy.Status != nil && policy.Status.ApprovedCount >= *spec.MaxApprovals {
+		return false
+	}
+	if spec.Selector != nil && !util.LabelsMatchLabelSelector(lo.FromPtr(enrollmentRequest.Spec.Labels), lo.FromPtr(spec.Selector.MatchLabels)) {
+		return false
+	}
+	if spec.EnrollmentCertificateCommonNames != nil && !slices.Contains(*spec.EnrollmentCertificateCommonNames, enrollmentCN) {
+		return false
+	}
+	if spec.AllowedPublicKeys != nil && !publicKeyAllowed(*spec.AllowedPublicKeys, publicKey) {
+		return false
+	}
+	return true
+}
+
+func publicKeyAllowed(allowedPublicKeys []string, publicKey crypto.PublicKey) bool {
+	key, ok := publicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok {
+		return false
+	}
+	for _, allowed := range allowedPublicKeys {
+		allowedKey, err := fccrypto.ParsePublicKeyPEM([]byte(allowed))
+		if err == nil && key.Equal(allowedKey) {
+			return true
+		}
+	}
+	return false
+}
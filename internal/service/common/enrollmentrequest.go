@@ -2,14 +2,24 @@ package common
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
+	"fmt"
+	"slices"
 
 	"github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 )
 
+const ClientCertExpiryDays = 365
+
 func ValidateAndCompleteEnrollmentRequest(enrollmentRequest *v1alpha1.EnrollmentRequest) error {
 	if enrollmentRequest.Status == nil {
 		enrollmentRequest.Status = &v1alpha1.EnrollmentRequestStatus{
@@ -20,7 +30,105 @@ func ValidateAndCompleteEnrollmentRequest(enrollmentRequest *v1alpha1.Enrollment
 	return nil
 }
 
-func CreateEnrollmentRequest(ctx context.Context, st store.Store, request server.CreateEnrollmentRequestRequestObject) (server.CreateEnrollmentRequestResponseObject, error) {
+func ApproveAndSignEnrollmentRequest(ca *crypto.CA, enrollmentRequest *v1alpha1.EnrollmentRequest, approval *v1alpha1.EnrollmentRequestApproval, reason string) error {
+	if enrollmentRequest == nil {
+		return errors.New("approveAndSignEnrollmentRequest: enrollmentRequest is nil")
+	}
+
+	if enrollmentRequest.Metadata.Name == nil {
+		return fmt.Errorf("approveAndSignEnrollmentRequest: enrollment request is missing metadata.name")
+	}
+
+	if IsTPMAttestationPending(enrollmentRequest) {
+		return flterrors.ErrTPMAttestationNotVerified
+	}
+
+	csr, err := crypto.ParseCSR([]byte(enrollmentRequest.Spec.Csr))
+	if err != nil {
+		return fmt.Errorf("approveAndSignEnrollmentRequest: error parsing CSR: %w", err)
+	}
+
+	csr.Subject.CommonName, err = crypto.CNFromDeviceFingerprint(*enrollmentRequest.Metadata.Name)
+	if err != nil {
+		return fmt.Errorf("approveAndSignEnrollmentRequest: error setting CN in CSR: %w", err)
+	}
+
+	if err := csr.CheckSignature(); err != nil {
+		return fmt.Errorf("failed to verify signature of CSR: %w", err)
+	}
+
+	expirySeconds := ClientCertExpiryDays * 24 * 60 * 60
+	certData, err := ca.IssueRequestedClientCertificate(csr, expirySeconds)
+	if err != nil {
+		return err
+	}
+	if approval.Labels == nil {
+		approval.Labels = &map[string]string{}
+	}
+	// keep the conditions and challenge of the TPM attestation, if any
+	conditions := []v1alpha1.Condition{}
+	var tpmChallenge *v1alpha1.TPMCredentialChallenge
+	if enrollmentRequest.Status != nil {
+		conditions = slices.Clone(enrollmentRequest.Status.Conditions)
+		tpmChallenge = enrollmentRequest.Status.TpmChallenge
+	}
+	enrollmentRequest.Status = &v1alpha1.EnrollmentRequestStatus{
+		Certificate:  util.StrToPtr(string(certData)),
+		Conditions:   conditions,
+		Approval:     approval,
+		TpmChallenge: tpmChallenge,
+	}
+
+	// union user-provided labels with agent-provided labels
+	if enrollmentRequest.Spec.Labels != nil {
+		for k, v := range *enrollmentRequest.Spec.Labels {
+			// don't override user-provided labels
+			if _, ok := (*enrollmentRequest.Status.Approval.Labels)[k]; !ok {
+				(*enrollmentRequest.Status.Approval.Labels)[k] = v
+			}
+		}
+	}
+
+	condition := v1alpha1.Condition{
+		Type:    v1alpha1.EnrollmentRequestApproved,
+		Status:  v1alpha1.ConditionStatusTrue,
+		Reason:  reason,
+		Message: "Approved by " + *approval.ApprovedBy,
+	}
+	v1alpha1.SetStatusCondition(&enrollmentRequest.Status.Conditions, condition)
+	return nil
+}
+
+func CreateDeviceFromEnrollmentRequest(ctx context.Context, st store.Store, orgId uuid.UUID, enrollmentRequest *v1alpha1.EnrollmentRequest, callback store.DeviceStoreCallback, log logrus.FieldLogger) error {
+	apiResource := &v1alpha1.Device{
+		Metadata: v1alpha1.ObjectMeta{
+			Name: enrollmentRequest.Metadata.Name,
+		},
+	}
+	if errs := apiResource.Validate(); len(errs) > 0 {
+		return fmt.Errorf("failed validating new device: %w", errors.Join(errs...))
+	}
+	if enrollmentRequest.Status.Approval != nil {
+		apiResource.Metadata.Labels = enrollmentRequest.Status.Approval.Labels
+	}
+	if _, err := st.Device().Create(ctx, orgId, apiResource, callback); err != nil {
+		return err
+	}
+
+	approvedBy := "unknown"
+	if enrollmentRequest.Status.Approval != nil && enrollmentRequest.Status.Approval.ApprovedBy != nil {
+		approvedBy = *enrollmentRequest.Status.Approval.ApprovedBy
+	}
+	// the event is informational, so failing to record it doesn't fail the enrollment
+	event := v1alpha1.NewEvent(model.DeviceKind, *apiResource.Metadata.Name, v1alpha1.EventTypeNormal,
+		v1alpha1.EventReasonEnrollmentRequestApproved, fmt.Sprintf("Enrollment request approved by %s", approvedBy))
+	if err := st.Event().Emit(ctx, orgId, event); err != nil {
+		log.Warnf("failed recording %s event of %s %s/%s: %v", event.Reason, event.InvolvedObject.Kind, orgId, event.InvolvedObject.Name, err)
+	}
+	return nil
+}
+
+func CreateEnrollmentRequest(ctx context.Context, st store.Store, ca *crypto.CA, tpmEKRoots *x509.CertPool, callback store.DeviceStoreCallback, log logrus.FieldLogger, request server.CreateEnrollmentRequestRequestObject) (server.CreateEnrollmentRequestResponseObject, error) {
 	orgId := store.NullOrgId
 
 	// don't set fields that are managed by the service
@@ -41,15 +149,32 @@ func CreateEnrollmentRequest(ctx context.Context, st store.Store, request server
 		return nil, err
 	}
 
+	if request.Body.Spec.TpmAttestation != nil {
+		err := ChallengeTPMAttestation(ca, tpmEKRoots, request.Body)
+		switch {
+		case err == nil:
+		case errors.Is(err, flterrors.ErrTPMAttestationNotVerified):
+			return server.CreateEnrollmentRequest400JSONResponse{Message: err.Error()}, nil
+		default:
+			return nil, err
+		}
+	}
+
 	result, err := st.EnrollmentRequest().Create(ctx, orgId, request.Body)
 	switch err {
 	case nil:
-		return server.CreateEnrollmentRequest201JSONResponse(*result), nil
 	case flterrors.ErrResourceIsNil, flterrors.ErrIllegalResourceVersionFormat:
 		return server.CreateEnrollmentRequest400JSONResponse{Message: err.Error()}, nil
 	default:
 		return nil, err
 	}
+
+	// approve the new request right away if an enrollment policy allows it,
+	// leaving it pending for manual approval if that fails
+	if _, err := ApproveWithEnrollmentPolicies(ctx, st, ca, orgId, result, callback, log); err != nil {
+		log.Errorf("failed to approve enrollment request %s with enrollment policies: %v", *result.Metadata.Name, err)
+	}
+	return server.CreateEnrollmentRequest201JSONResponse(*result), nil
 }
 
 func ReadEnrollmentRequest(ctx context.Context, st store.Store, request server.ReadEnrollmentRequestRequestObject) (server.ReadEnrollmentRequestResponseObject, error) {
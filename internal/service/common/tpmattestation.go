@@ -0,0 +1,127 @@
+package common
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// TPMCredentialChallengePendingReason is the reason of the TPMVerified
+	// condition until the device answers the credential challenge.
+	TPMCredentialChallengePendingReason = "CredentialChallengePending"
+	// TPMCredentialActivatedReason is the reason of the TPMVerified condition
+	// once the device answered the credential challenge.
+	TPMCredentialActivatedReason = "CredentialActivated"
+)
+
+// IsTPMAttestationPending returns whether the enrollment request comes with a
+// TPM attestation that was not verified yet, in which case it must not be
+// approved.
+func IsTPMAttestationPending(enrollmentRequest *v1alpha1.EnrollmentRequest) bool {
+	if enrollmentRequest.Spec.TpmAttestation == nil {
+		return false
+	}
+	return enrollmentRequest.Status == nil || !v1alpha1.IsStatusConditionTrue(enrollmentRequest.Status.Conditions, v1alpha1.EnrollmentRequestTPMVerified)
+}
+
+// ChallengeTPMAttestation verifies the TPM attestation of a new enrollment
+// request and sets the credential challenge the device must answer to prove
+// that its attestation key is in the TPM of the endorsement key. It returns an
+// error wrapping flterrors.ErrTPMAttestationNotVerified if the attestation is
+// invalid.
+func ChallengeTPMAttestation(ca *crypto.CA, ekRoots *x509.CertPool, enrollmentRequest *v1alpha1.EnrollmentRequest) error {
+	attestation := enrollmentRequest.Spec.TpmAttestation
+	csr, err := crypto.ParseCSR([]byte(enrollmentRequest.Spec.Csr))
+	if err != nil {
+		return fmt.Errorf("%w: parsing CSR: %w", flterrors.ErrTPMAttestationNotVerified, err)
+	}
+	verified, err := crypto.VerifyTPMAttestation(&crypto.TPMAttestation{
+		EKCertificate:        []byte(attestation.EkCertificate),
+		AttestationKeyPublic: attestation.AttestationKeyPublic,
+		IdentityKeyPublic:    attestation.IdentityKeyPublic,
+		CertifyInfo:          attestation.CertifyInfo,
+		CertifySignature:     attestation.CertifySignature,
+	}, ekRoots, csr.PublicKey, crypto.TPMAttestationQualifyingData([]byte(enrollmentRequest.Spec.Csr)))
+	if err != nil {
+		return fmt.Errorf("%w: %w", flterrors.ErrTPMAttestationNotVerified, err)
+	}
+
+	secret, err := ca.TPMChallengeSecret(*enrollmentRequest.Metadata.Name, attestation.AttestationKeyPublic)
+	if err != nil {
+		return err
+	}
+	credentialBlob, encryptedSecret, err := crypto.MakeTPMCredentialChallenge(verified, secret)
+	if err != nil {
+		return err
+	}
+	enrollmentRequest.Status.TpmChallenge = &v1alpha1.TPMCredentialChallenge{
+		CredentialBlob:  credentialBlob,
+		EncryptedSecret: encryptedSecret,
+	}
+	v1alpha1.SetStatusCondition(&enrollmentRequest.Status.Conditions, v1alpha1.Condition{
+		Type:    v1alpha1.EnrollmentRequestTPMVerified,
+		Status:  v1alpha1.ConditionStatusFalse,
+		Reason:  TPMCredentialChallengePendingReason,
+		Message: "Waiting for the device to answer the TPM credential challenge",
+	})
+	return nil
+}
+
+func AnswerEnrollmentRequestTPMChallenge(ctx context.Context, st store.Store, ca *crypto.CA, callback store.DeviceStoreCallback, log logrus.FieldLogger, request server.AnswerEnrollmentRequestTPMChallengeRequestObject) (server.AnswerEnrollmentRequestTPMChallengeResponseObject, error) {
+	orgId := store.NullOrgId
+
+	enrollmentRequest, err := st.EnrollmentRequest().Get(ctx, orgId, request.Name)
+	switch err {
+	case nil:
+	case flterrors.ErrResourceNotFound:
+		return server.AnswerEnrollmentRequestTPMChallenge404JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+
+	if enrollmentRequest.Spec.TpmAttestation == nil || enrollmentRequest.Status == nil || enrollmentRequest.Status.TpmChallenge == nil {
+		return server.AnswerEnrollmentRequestTPMChallenge400JSONResponse{Message: "enrollment request has no TPM credential challenge"}, nil
+	}
+	if !IsTPMAttestationPending(enrollmentRequest) {
+		return server.AnswerEnrollmentRequestTPMChallenge200JSONResponse(*enrollmentRequest), nil
+	}
+
+	secret, err := ca.TPMChallengeSecret(request.Name, enrollmentRequest.Spec.TpmAttestation.AttestationKeyPublic)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(secret, request.Body.Secret) {
+		return server.AnswerEnrollmentRequestTPMChallenge400JSONResponse{Message: "incorrect answer to the TPM credential challenge"}, nil
+	}
+
+	v1alpha1.SetStatusCondition(&enrollmentRequest.Status.Conditions, v1alpha1.Condition{
+		Type:    v1alpha1.EnrollmentRequestTPMVerified,
+		Status:  v1alpha1.ConditionStatusTrue,
+		Reason:  TPMCredentialActivatedReason,
+		Message: "The attestation key and the endorsement key are in the same TPM",
+	})
+	result, err := st.EnrollmentRequest().UpdateStatus(ctx, orgId, enrollmentRequest)
+	switch err {
+	case nil:
+	case flterrors.ErrResourceNotFound:
+		return server.AnswerEnrollmentRequestTPMChallenge404JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+
+	// the request can now be approved by an enrollment policy, and is left
+	// pending for manual approval if that fails
+	if _, err := ApproveWithEnrollmentPolicies(ctx, st, ca, orgId, result, callback, log); err != nil {
+		log.Errorf("failed to approve enrollment request %s with enrollment policies: %v", request.Name, err)
+	}
+	return server.AnswerEnrollmentRequestTPMChallenge200JSONResponse(*result), nil
+}
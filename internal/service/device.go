@@ -269,6 +269,45 @@ func (h *ServiceHandler) GetRenderedDeviceSpec(ctx context.Context, request serv
 	return common.GetRenderedDeviceSpec(ctx, h.store, request, h.consoleGrpcEndpoint)
 }
 
+// (GET /api/v1/devices/{name}/overrides)
+func (h *ServiceHandler) ReadDeviceOverrides(ctx context.Context, request server.ReadDeviceOverridesRequestObject) (server.ReadDeviceOverridesResponseObject, error) {
+	orgId := store.NullOrgId
+
+	result, err := h.store.Device().GetOverrides(ctx, orgId, request.Name)
+	switch err {
+	case nil:
+		return server.ReadDeviceOverrides200JSONResponse(*result), nil
+	case flterrors.ErrResourceNotFound:
+		return server.ReadDeviceOverrides404JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (PUT /api/v1/devices/{name}/overrides)
+func (h *ServiceHandler) ReplaceDeviceOverrides(ctx context.Context, request server.ReplaceDeviceOverridesRequestObject) (server.ReplaceDeviceOverridesResponseObject, error) {
+	orgId := store.NullOrgId
+
+	if errs := request.Body.Validate(); len(errs) > 0 {
+		return server.ReplaceDeviceOverrides400JSONResponse{Message: errors.Join(errs...).Error()}, nil
+	}
+	if request.Name != *request.Body.Metadata.Name {
+		return server.ReplaceDeviceOverrides400JSONResponse{Message: "resource name specified in metadata does not match name in path"}, nil
+	}
+
+	result, err := h.store.Device().UpdateOverrides(ctx, orgId, request.Name, request.Body.Spec, h.callbackManager.DeviceUpdatedCallback)
+	switch err {
+	case nil:
+		return server.ReplaceDeviceOverrides200JSONResponse(*result), nil
+	case flterrors.ErrResourceNotFound:
+		return server.ReplaceDeviceOverrides404JSONResponse{}, nil
+	case flterrors.ErrOverridesWithoutFleet:
+		return server.ReplaceDeviceOverrides400JSONResponse{Message: err.Error()}, nil
+	default:
+		return nil, err
+	}
+}
+
 // (PATCH /api/v1/devices/{name})
 // Only metadata.labels and spec can be patched. If we try to patch other fields, HTTP 400 Bad Request is returned.
 func (h *ServiceHandler) PatchDevice(ctx context.Context, request server.PatchDeviceRequestObject) (server.PatchDeviceResponseObject, error) {
@@ -311,7 +350,7 @@ func (h *ServiceHandler) PatchDevice(ctx context.Context, request server.PatchDe
 	common.NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
 
-	var updateCallback func(before *model.Device, after *model.Device)
+	var updateCallback func(ctx context.Context, before *model.Device, after *model.Device)
 
 	if h.callbackManager != nil {
 		updateCallback = h.callbackManager.DeviceUpdatedCallback
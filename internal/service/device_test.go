@@ -8,6 +8,7 @@ import (
 	"github.com/flightctl/flightctl/internal/api/server"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/tasks"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
@@ -31,7 +32,7 @@ type DummyDevice struct {
 
 type dummyPublisher struct{}
 
-func (d *dummyPublisher) Publish(_ []byte) error {
+func (d *dummyPublisher) Publish(_ context.Context, _ []byte) error {
 	return nil
 }
 
@@ -58,6 +59,23 @@ func (s *DummyDevice) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, devic
 	return device, false, nil
 }
 
+func (s *DummyDevice) GetOverrides(ctx context.Context, orgId uuid.UUID, name string) (*v1alpha1.DeviceOverrides, error) {
+	if name != *s.DeviceVal.Metadata.Name {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	return &v1alpha1.DeviceOverrides{Metadata: v1alpha1.ObjectMeta{Name: &name}}, nil
+}
+
+func (s *DummyDevice) UpdateOverrides(ctx context.Context, orgId uuid.UUID, name string, overrides v1alpha1.DeviceOverridesSpec, callback store.DeviceStoreCallback) (*v1alpha1.DeviceOverrides, error) {
+	if name != *s.DeviceVal.Metadata.Name {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	if s.DeviceVal.Metadata.Owner == nil && overrides != (v1alpha1.DeviceOverridesSpec{}) {
+		return nil, flterrors.ErrOverridesWithoutFleet
+	}
+	return &v1alpha1.DeviceOverrides{Metadata: v1alpha1.ObjectMeta{Name: &name}, Spec: overrides}, nil
+}
+
 func verifyDevicePatchFailed(require *require.Assertions, resp server.PatchDeviceResponseObject) {
 	_, ok := resp.(server.PatchDevice400JSONResponse)
 	require.True(ok)
@@ -235,3 +253,86 @@ func TestDeviceNonExistingResource(t *testing.T) {
 	require.NoError(err)
 	require.Equal(server.PatchDevice404JSONResponse{}, resp)
 }
+
+func testReplaceDeviceOverrides(require *require.Assertions, name string, overrides v1alpha1.DeviceOverrides) server.ReplaceDeviceOverridesResponseObject {
+	return testReplaceDeviceOverridesOfOwner(require, util.SetResourceOwner(model.FleetKind, "fleet"), name, overrides)
+}
+
+func testReplaceDeviceOverridesOfOwner(require *require.Assertions, owner *string, name string, overrides v1alpha1.DeviceOverrides) server.ReplaceDeviceOverridesResponseObject {
+	serviceHandler := ServiceHandler{
+		store: &DeviceStore{DeviceVal: v1alpha1.Device{
+			Metadata: v1alpha1.ObjectMeta{Name: util.StrToPtr("foo"), Owner: owner},
+		}},
+		callbackManager: dummyCallbackManager(),
+	}
+	resp, err := serviceHandler.ReplaceDeviceOverrides(context.Background(), server.ReplaceDeviceOverridesRequestObject{
+		Name: name,
+		Body: &overrides,
+	})
+	require.NoError(err)
+	return resp
+}
+
+func TestReplaceDeviceOverrides(t *testing.T) {
+	require := require.New(t)
+	overrides := v1alpha1.DeviceOverrides{
+		Metadata: v1alpha1.ObjectMeta{Name: util.StrToPtr("foo")},
+		Spec: v1alpha1.DeviceOverridesSpec{
+			ApplicationEnvVars: &map[string]map[string]string{"app": {"KEY": "value"}},
+		},
+	}
+	resp := testReplaceDeviceOverrides(require, "foo", overrides)
+	require.Equal(server.ReplaceDeviceOverrides200JSONResponse(overrides), resp)
+}
+
+func TestReplaceDeviceOverridesUnnamedHook(t *testing.T) {
+	require := require.New(t)
+	overrides := v1alpha1.DeviceOverrides{
+		Metadata: v1alpha1.ObjectMeta{Name: util.StrToPtr("foo")},
+		Spec: v1alpha1.DeviceOverridesSpec{
+			Hooks: &v1alpha1.DeviceHooksSpec{
+				AfterUpdating: &[]v1alpha1.DeviceUpdateHookSpec{{Path: util.StrToPtr("/etc/app")}},
+			},
+		},
+	}
+	resp := testReplaceDeviceOverrides(require, "foo", overrides)
+	_, ok := resp.(server.ReplaceDeviceOverrides400JSONResponse)
+	require.True(ok)
+}
+
+func TestReplaceDeviceOverridesNameMismatch(t *testing.T) {
+	require := require.New(t)
+	overrides := v1alpha1.DeviceOverrides{
+		Metadata: v1alpha1.ObjectMeta{Name: util.StrToPtr("bar")},
+	}
+	resp := testReplaceDeviceOverrides(require, "foo", overrides)
+	_, ok := resp.(server.ReplaceDeviceOverrides400JSONResponse)
+	require.True(ok)
+}
+
+func TestReplaceDeviceOverridesDeviceWithoutFleet(t *testing.T) {
+	require := require.New(t)
+	overrides := v1alpha1.DeviceOverrides{
+		Metadata: v1alpha1.ObjectMeta{Name: util.StrToPtr("foo")},
+		Spec: v1alpha1.DeviceOverridesSpec{
+			ApplicationEnvVars: &map[string]map[string]string{"app": {"KEY": "value"}},
+		},
+	}
+	resp := testReplaceDeviceOverridesOfOwner(require, nil, "foo", overrides)
+	_, ok := resp.(server.ReplaceDeviceOverrides400JSONResponse)
+	require.True(ok)
+
+	// the overrides of a device that left its fleet can be cleared
+	overrides.Spec = v1alpha1.DeviceOverridesSpec{}
+	resp = testReplaceDeviceOverridesOfOwner(require, nil, "foo", overrides)
+	require.Equal(server.ReplaceDeviceOverrides200JSONResponse(overrides), resp)
+}
+
+func TestReplaceDeviceOverridesNonExistingDevice(t *testing.T) {
+	require := require.New(t)
+	overrides := v1alpha1.DeviceOverrides{
+		Metadata: v1alpha1.ObjectMeta{Name: util.StrToPtr("bar")},
+	}
+	resp := testReplaceDeviceOverrides(require, "bar", overrides)
+	require.Equal(server.ReplaceDeviceOverrides404JSONResponse{}, resp)
+}
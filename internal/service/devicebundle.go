@@ -0,0 +1,63 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+)
+
+// (POST /api/v1/devices/{name}/bundle)
+func (h *ServiceHandler) RequestDeviceBundle(ctx context.Context, request server.RequestDeviceBundleRequestObject) (server.RequestDeviceBundleResponseObject, error) {
+	orgId := store.NullOrgId
+
+	// make sure the device exists
+	_, err := h.store.Device().Get(ctx, orgId, request.Name)
+	if err != nil {
+		switch {
+		case errors.Is(err, flterrors.ErrResourceNotFound):
+			return server.RequestDeviceBundle404JSONResponse{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	bundle, err := h.store.DeviceBundle().Request(ctx, orgId, request.Name, uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	// the device gathers the bundle when it finds the request in its rendered spec
+	annotations := map[string]string{model.DeviceAnnotationBundle: bundle.RequestID}
+	if err := h.store.Device().UpdateAnnotations(ctx, orgId, request.Name, annotations, []string{}); err != nil {
+		return nil, err
+	}
+	return server.RequestDeviceBundle201JSONResponse(bundle.ToApiStatus()), nil
+}
+
+// (GET /api/v1/devices/{name}/bundle)
+func (h *ServiceHandler) GetDeviceBundle(ctx context.Context, request server.GetDeviceBundleRequestObject) (server.GetDeviceBundleResponseObject, error) {
+	orgId := store.NullOrgId
+
+	bundle, err := h.store.DeviceBundle().Get(ctx, orgId, request.Name)
+	switch {
+	case err == nil:
+	case errors.Is(err, flterrors.ErrResourceNotFound):
+		return server.GetDeviceBundle404JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+
+	if bundle.UploadedAt == nil {
+		return server.GetDeviceBundle202JSONResponse(bundle.ToApiStatus()), nil
+	}
+	return server.GetDeviceBundle200ApplicationgzipResponse{
+		Body:          bytes.NewReader(bundle.Data),
+		ContentLength: int64(len(bundle.Data)),
+	}, nil
+}
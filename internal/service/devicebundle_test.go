@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+type BundleDeviceStore struct {
+	LogsDeviceStore
+	bundle *DummyDeviceBundle
+}
+
+func (s *BundleDeviceStore) DeviceBundle() store.DeviceBundle {
+	return s.bundle
+}
+
+type DummyDeviceBundle struct {
+	store.DeviceBundle
+	bundle *model.DeviceBundle
+}
+
+func (s *DummyDeviceBundle) Request(ctx context.Context, orgId uuid.UUID, deviceName string, requestID string) (*model.DeviceBundle, error) {
+	s.bundle = &model.DeviceBundle{DeviceName: deviceName, RequestID: requestID, RequestedAt: time.Now()}
+	return s.bundle, nil
+}
+
+func (s *DummyDeviceBundle) Get(ctx context.Context, orgId uuid.UUID, deviceName string) (*model.DeviceBundle, error) {
+	if s.bundle == nil || s.bundle.DeviceName != deviceName {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	return s.bundle, nil
+}
+
+func TestDeviceBundle(t *testing.T) {
+	require := require.New(t)
+	device := &v1alpha1.Device{
+		Metadata: v1alpha1.ObjectMeta{Name: lo.ToPtr("foo")},
+	}
+	bundleStore := &DummyDeviceBundle{}
+	h := ServiceHandler{
+		store: &BundleDeviceStore{LogsDeviceStore: LogsDeviceStore{DeviceVal: device}, bundle: bundleStore},
+		log:   logrus.New(),
+	}
+	ctx := context.Background()
+
+	resp, err := h.RequestDeviceBundle(ctx, server.RequestDeviceBundleRequestObject{Name: "bar"})
+	require.NoError(err)
+	require.IsType(server.RequestDeviceBundle404JSONResponse{}, resp)
+
+	getResp, err := h.GetDeviceBundle(ctx, server.GetDeviceBundleRequestObject{Name: "foo"})
+	require.NoError(err)
+	require.IsType(server.GetDeviceBundle404JSONResponse{}, getResp)
+
+	// the device is requested to upload a bundle
+	resp, err = h.RequestDeviceBundle(ctx, server.RequestDeviceBundleRequestObject{Name: "foo"})
+	require.NoError(err)
+	status := v1alpha1.DeviceBundleStatus(resp.(server.RequestDeviceBundle201JSONResponse))
+	require.NotEmpty(status.RequestID)
+	require.Nil(status.UploadedAt)
+	require.Equal(status.RequestID, (*device.Metadata.Annotations)[model.DeviceAnnotationBundle])
+
+	getResp, err = h.GetDeviceBundle(ctx, server.GetDeviceBundleRequestObject{Name: "foo"})
+	require.NoError(err)
+	require.IsType(server.GetDeviceBundle202JSONResponse{}, getResp)
+
+	// the device uploaded the bundle
+	bundleStore.bundle.UploadedAt = lo.ToPtr(time.Now())
+	bundleStore.bundle.Data = []byte("bundle")
+	getResp, err = h.GetDeviceBundle(ctx, server.GetDeviceBundleRequestObject{Name: "foo"})
+	require.NoError(err)
+	bundle, ok := getResp.(server.GetDeviceBundle200ApplicationgzipResponse)
+	require.True(ok)
+	require.Equal(int64(6), bundle.ContentLength)
+	data, err := io.ReadAll(bundle.Body)
+	require.NoError(err)
+	require.Equal("bundle", string(data))
+}
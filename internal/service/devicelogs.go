@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// deviceLogsJoinTimeout is how long to wait for a device to join a logs
+// session, which it does the next time it fetches its rendered spec.
+const deviceLogsJoinTimeout = 3 * time.Minute
+
+// SessionRouter opens sessions of the gRPC router from within the service.
+type SessionRouter interface {
+	// OpenSession opens the session with the given ID as its first client.
+	OpenSession(sessionID string) (RoutedSession, error)
+}
+
+// RoutedSession is a session of the gRPC router opened by the service, which
+// reads what the device joining the session sends.
+type RoutedSession interface {
+	io.ReadCloser
+	// Joined is closed once the device joined the session.
+	Joined() <-chan struct{}
+}
+
+func (h *ServiceHandler) GetDeviceLogs(ctx context.Context, request server.GetDeviceLogsRequestObject) (server.GetDeviceLogsResponseObject, error) {
+	orgId := store.NullOrgId
+	params := request.Params
+
+	if params.Unit != nil && params.App != nil {
+		return server.GetDeviceLogs400JSONResponse{Message: "unit and app are mutually exclusive"}, nil
+	}
+	since, err := parseLogsSince(params.Since, time.Now())
+	if err != nil {
+		return server.GetDeviceLogs400JSONResponse{Message: err.Error()}, nil
+	}
+	if h.sessionRouter == nil {
+		return server.GetDeviceLogs503JSONResponse{Message: "device logs are not available"}, nil
+	}
+
+	// make sure the device exists
+	_, err = h.store.Device().Get(ctx, orgId, request.Name)
+	if err != nil {
+		switch {
+		case errors.Is(err, flterrors.ErrResourceNotFound):
+			return server.GetDeviceLogs404JSONResponse{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	sessionId := uuid.New().String()
+	logsRequest := api.DeviceLogsRequest{
+		SessionID: sessionId,
+		Unit:      params.Unit,
+		App:       params.App,
+		Since:     since,
+		Tail:      params.Tail,
+		Follow:    params.Follow,
+	}
+	annotation, err := json.Marshal(logsRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// open the session before the device learns about it, so that the device
+	// always joins as the second client
+	session, err := h.sessionRouter.OpenSession(sessionId)
+	if err != nil {
+		return nil, err
+	}
+	annotations := map[string]string{model.DeviceAnnotationLogs: string(annotation)}
+	if err := h.store.Device().UpdateAnnotations(ctx, orgId, request.Name, annotations, []string{}); err != nil {
+		session.Close()
+		return nil, err
+	}
+
+	return &deviceLogsResponse{
+		ctx:        ctx,
+		log:        h.log,
+		session:    session,
+		deviceName: request.Name,
+		sessionId:  sessionId,
+		store:      h.store,
+	}, nil
+}
+
+// parseLogsSince parses a relative duration like 10m or an RFC 3339 timestamp.
+func parseLogsSince(since *string, now time.Time) (*time.Time, error) {
+	if since == nil {
+		return nil, nil
+	}
+	if duration, err := time.ParseDuration(*since); err == nil {
+		if duration < 0 {
+			return nil, fmt.Errorf("since must not be a negative duration: %s", *since)
+		}
+		return util.TimeToPtr(now.Add(-duration)), nil
+	}
+	t, err := time.Parse(time.RFC3339, *since)
+	if err != nil {
+		return nil, fmt.Errorf("since must be a duration like 10m or an RFC 3339 timestamp: %s", *since)
+	}
+	return &t, nil
+}
+
+// deviceLogsResponse streams the logs the device sends through the session.
+type deviceLogsResponse struct {
+	ctx        context.Context
+	log        logrus.FieldLogger
+	session    RoutedSession
+	deviceName string
+	sessionId  string
+	store      store.Store
+}
+
+func (r *deviceLogsResponse) VisitGetDeviceLogsResponse(w http.ResponseWriter) error {
+	defer r.removeAnnotation()
+	// closing the session makes the device stop sending logs
+	defer r.session.Close()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-r.ctx.Done():
+			r.session.Close()
+		case <-done:
+		}
+	}()
+
+	// logs are streamed for longer than the timeouts of the server
+	rc := http.NewResponseController(w)
+	_ = rc.SetReadDeadline(time.Time{})
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	select {
+	case <-r.session.Joined():
+	case <-time.After(deviceLogsJoinTimeout):
+		return server.GetDeviceLogs504JSONResponse{Message: "timed out waiting for the device to send its logs"}.VisitGetDeviceLogsResponse(w)
+	case <-r.ctx.Done():
+		return nil
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	buffer := make([]byte, 4096)
+	for {
+		n, err := r.session.Read(buffer)
+		if n > 0 {
+			if _, err := w.Write(buffer[:n]); err != nil {
+				r.log.Warnf("writing logs of device %s: %v", r.deviceName, err)
+				return nil
+			}
+			_ = rc.Flush()
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrClosedPipe) {
+			return nil
+		}
+		if err != nil {
+			r.log.Warnf("reading logs of device %s: %v", r.deviceName, err)
+			return nil
+		}
+	}
+}
+
+// removeAnnotation removes the logs request from the device, unless another
+// request replaced it in the meantime.
+func (r *deviceLogsResponse) removeAnnotation() {
+	ctx := context.Background()
+	device, err := r.store.Device().Get(ctx, store.NullOrgId, r.deviceName)
+	if err != nil {
+		r.log.Warnf("removing logs request from device %s: %v", r.deviceName, err)
+		return
+	}
+	var logsRequest api.DeviceLogsRequest
+	annotation := util.DefaultIfNotInMap(lo.FromPtr(device.Metadata.Annotations), model.DeviceAnnotationLogs, "")
+	if json.Unmarshal([]byte(annotation), &logsRequest) != nil || logsRequest.SessionID != r.sessionId {
+		return
+	}
+	if err := r.store.Device().UpdateAnnotations(ctx, store.NullOrgId, r.deviceName, map[string]string{}, []string{model.DeviceAnnotationLogs}); err != nil {
+		r.log.Warnf("removing logs request from device %s: %v", r.deviceName, err)
+	}
+}
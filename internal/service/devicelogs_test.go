@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+type LogsDeviceStore struct {
+	store.Store
+	DeviceVal *v1alpha1.Device
+}
+
+func (s *LogsDeviceStore) Device() store.Device {
+	return &LogsDummyDevice{DummyDevice: DummyDevice{DeviceVal: *s.DeviceVal}, deviceVal: s.DeviceVal}
+}
+
+type LogsDummyDevice struct {
+	DummyDevice
+	deviceVal *v1alpha1.Device
+}
+
+func (s *LogsDummyDevice) UpdateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) error {
+	existing := lo.FromPtr(s.deviceVal.Metadata.Annotations)
+	merged := util.MergeLabels(existing, annotations)
+	for _, key := range deleteKeys {
+		delete(merged, key)
+	}
+	s.deviceVal.Metadata.Annotations = &merged
+	return nil
+}
+
+type fakeSessionRouter struct {
+	sessions map[string]*fakeRoutedSession
+}
+
+func (r *fakeSessionRouter) OpenSession(sessionID string) (RoutedSession, error) {
+	session := &fakeRoutedSession{Reader: strings.NewReader("line 1\nline 2\n"), joined: make(chan struct{})}
+	close(session.joined)
+	r.sessions[sessionID] = session
+	return session, nil
+}
+
+type fakeRoutedSession struct {
+	io.Reader
+	joined chan struct{}
+	closed bool
+}
+
+func (s *fakeRoutedSession) Joined() <-chan struct{} {
+	return s.joined
+}
+
+func (s *fakeRoutedSession) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestParseLogsSince(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		since   *string
+		want    *time.Time
+		wantErr bool
+	}{
+		{name: "unset"},
+		{name: "duration", since: lo.ToPtr("90m"), want: lo.ToPtr(now.Add(-90 * time.Minute))},
+		{name: "timestamp", since: lo.ToPtr("2024-04-30T08:00:00Z"), want: lo.ToPtr(time.Date(2024, 4, 30, 8, 0, 0, 0, time.UTC))},
+		{name: "negative duration", since: lo.ToPtr("-10m"), wantErr: true},
+		{name: "invalid", since: lo.ToPtr("yesterday"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			got, err := parseLogsSince(tt.since, now)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestGetDeviceLogs(t *testing.T) {
+	require := require.New(t)
+	device := &v1alpha1.Device{
+		Metadata: v1alpha1.ObjectMeta{Name: lo.ToPtr("foo")},
+	}
+	router := &fakeSessionRouter{sessions: map[string]*fakeRoutedSession{}}
+	h := ServiceHandler{
+		store:         &LogsDeviceStore{DeviceVal: device},
+		log:           logrus.New(),
+		sessionRouter: router,
+	}
+	ctx := context.Background()
+
+	resp, err := h.GetDeviceLogs(ctx, server.GetDeviceLogsRequestObject{
+		Name:   "foo",
+		Params: v1alpha1.GetDeviceLogsParams{Unit: lo.ToPtr("crio.service"), App: lo.ToPtr("app")},
+	})
+	require.NoError(err)
+	require.IsType(server.GetDeviceLogs400JSONResponse{}, resp)
+
+	resp, err = h.GetDeviceLogs(ctx, server.GetDeviceLogsRequestObject{Name: "bar"})
+	require.NoError(err)
+	require.IsType(server.GetDeviceLogs404JSONResponse{}, resp)
+
+	resp, err = h.GetDeviceLogs(ctx, server.GetDeviceLogsRequestObject{
+		Name:   "foo",
+		Params: v1alpha1.GetDeviceLogsParams{Unit: lo.ToPtr("crio.service"), Tail: lo.ToPtr(10), Follow: lo.ToPtr(true)},
+	})
+	require.NoError(err)
+
+	// the device is requested to send the logs to the session
+	var logsRequest v1alpha1.DeviceLogsRequest
+	require.NoError(json.Unmarshal([]byte((*device.Metadata.Annotations)[model.DeviceAnnotationLogs]), &logsRequest))
+	require.Equal("crio.service", *logsRequest.Unit)
+	require.Equal(10, *logsRequest.Tail)
+	require.True(*logsRequest.Follow)
+	session, ok := router.sessions[logsRequest.SessionID]
+	require.True(ok)
+
+	recorder := httptest.NewRecorder()
+	require.NoError(resp.VisitGetDeviceLogsResponse(recorder))
+	require.Equal(http.StatusOK, recorder.Code)
+	require.Equal("line 1\nline 2\n", recorder.Body.String())
+	require.True(session.closed)
+	require.NotContains(*device.Metadata.Annotations, model.DeviceAnnotationLogs)
+}
+
+func TestGetDeviceLogsUnavailable(t *testing.T) {
+	h := ServiceHandler{log: logrus.New()}
+	resp, err := h.GetDeviceLogs(context.Background(), server.GetDeviceLogsRequestObject{Name: "foo"})
+	require.NoError(t, err)
+	require.IsType(t, server.GetDeviceLogs503JSONResponse{}, resp)
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/go-openapi/swag"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// (POST /api/v1/enrollmentpolicies)
+func (h *ServiceHandler) CreateEnrollmentPolicy(ctx context.Context, request server.CreateEnrollmentPolicyRequestObject) (server.CreateEnrollmentPolicyResponseObject, error) {
+	orgId := store.NullOrgId
+
+	// don't set fields that are managed by the service
+	request.Body.Status = nil
+	common.NilOutManagedObjectMetaProperties(&request.Body.Metadata)
+
+	if errs := request.Body.Validate(); len(errs) > 0 {
+		return server.CreateEnrollmentPolicy400JSONResponse{Message: errors.Join(errs...).Error()}, nil
+	}
+
+	result, err := h.store.EnrollmentPolicy().Create(ctx, orgId, request.Body)
+	switch err {
+	case nil:
+		return server.CreateEnrollmentPolicy201JSONResponse(*result), nil
+	case flterrors.ErrResourceIsNil:
+		return server.CreateEnrollmentPolicy400JSONResponse{Message: err.Error()}, nil
+	case flterrors.ErrDuplicateName:
+		return server.CreateEnrollmentPolicy400JSONResponse{Message: err.Error()}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (GET /api/v1/enrollmentpolicies)
+func (h *ServiceHandler) ListEnrollmentPolicies(ctx context.Context, request server.ListEnrollmentPoliciesRequestObject) (server.ListEnrollmentPoliciesResponseObject, error) {
+	orgId := store.NullOrgId
+	labelSelector := ""
+	if request.Params.LabelSelector != nil {
+		labelSelector = *request.Params.LabelSelector
+	}
+
+	labelMap, err := labels.ConvertSelectorToLabelsMap(labelSelector)
+	if err != nil {
+		return server.ListEnrollmentPolicies400JSONResponse{Message: err.Error()}, nil
+	}
+
+	cont, err := store.ParseContinueString(request.Params.Continue)
+	if err != nil {
+		return server.ListEnrollmentPolicies400JSONResponse{Message: fmt.Sprintf("failed to parse continue parameter: %v", err)}, nil
+	}
+
+	var fieldSelector fields.Selector
+	if request.Params.FieldSelector != nil {
+		if fieldSelector, err = fields.ParseSelector(*request.Params.FieldSelector); err != nil {
+			return server.ListEnrollmentPolicies400JSONResponse{Message: fmt.Sprintf("failed to parse field selector: %v", err)}, nil
+		}
+	}
+
+	var sortField *store.SortField
+	if request.Params.SortBy != nil {
+		sortField = &store.SortField{
+			FieldName: selector.SelectorFieldName(*request.Params.SortBy),
+			Order:     *request.Params.SortOrder,
+		}
+	}
+
+	listParams := store.ListParams{
+		Labels:        labelMap,
+		Limit:         int(swag.Int32Value(request.Params.Limit)),
+		Continue:      cont,
+		FieldSelector: fieldSelector,
+		SortBy:        sortField,
+	}
+	if listParams.Limit == 0 {
+		listParams.Limit = store.MaxRecordsPerListRequest
+	}
+	if listParams.Limit > store.MaxRecordsPerListRequest {
+		return server.ListEnrollmentPolicies400JSONResponse{Message: fmt.Sprintf("limit cannot exceed %d", store.MaxRecordsPerListRequest)}, nil
+	}
+
+	result, err := h.store.EnrollmentPolicy().List(ctx, orgId, listParams)
+	if err == nil {
+		return server.ListEnrollmentPolicies200JSONResponse(*result), nil
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return server.ListEnrollmentPolicies400JSONResponse{Message: se.Error()}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (DELETE /api/v1/enrollmentpolicies)
+func (h *ServiceHandler) DeleteEnrollmentPolicies(ctx context.Context, request server.DeleteEnrollmentPoliciesRequestObject) (server.DeleteEnrollmentPoliciesResponseObject, error) {
+	orgId := store.NullOrgId
+
+	err := h.store.EnrollmentPolicy().DeleteAll(ctx, orgId)
+	switch err {
+	case nil:
+		return server.DeleteEnrollmentPolicies200JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (GET /api/v1/enrollmentpolicies/{name})
+func (h *ServiceHandler) ReadEnrollmentPolicy(ctx context.Context, request server.ReadEnrollmentPolicyRequestObject) (server.ReadEnrollmentPolicyResponseObject, error) {
+	orgId := store.NullOrgId
+
+	result, err := h.store.EnrollmentPolicy().Get(ctx, orgId, request.Name)
+	switch err {
+	case nil:
+		return server.ReadEnrollmentPolicy200JSONResponse(*result), nil
+	case flterrors.ErrResourceNotFound:
+		return server.ReadEnrollmentPolicy404JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (PUT /api/v1/enrollmentpolicies/{name})
+func (h *ServiceHandler) ReplaceEnrollmentPolicy(ctx context.Context, request server.ReplaceEnrollmentPolicyRequestObject) (server.ReplaceEnrollmentPolicyResponseObject, error) {
+	orgId := store.NullOrgId
+
+	// don't overwrite fields that are managed by the service
+	request.Body.Status = nil
+	common.NilOutManagedObjectMetaProperties(&request.Body.Metadata)
+	if errs := request.Body.Validate(); len(errs) > 0 {
+		return server.ReplaceEnrollmentPolicy400JSONResponse{Message: errors.Join(errs...).Error()}, nil
+	}
+	if request.Name != *request.Body.Metadata.Name {
+		return server.ReplaceEnrollmentPolicy400JSONResponse{Message: "resource name specified in metadata does not match name in path"}, nil
+	}
+
+	result, created, err := h.store.EnrollmentPolicy().CreateOrUpdate(ctx, orgId, request.Body)
+	switch err {
+	case nil:
+		if created {
+			return server.ReplaceEnrollmentPolicy201JSONResponse(*result), nil
+		} else {
+			return server.ReplaceEnrollmentPolicy200JSONResponse(*result), nil
+		}
+	case flterrors.ErrResourceIsNil:
+		return server.ReplaceEnrollmentPolicy400JSONResponse{Message: err.Error()}, nil
+	case flterrors.ErrResourceNameIsNil:
+		return server.ReplaceEnrollmentPolicy400JSONResponse{Message: err.Error()}, nil
+	case flterrors.ErrResourceNotFound:
+		return server.ReplaceEnrollmentPolicy404JSONResponse{}, nil
+	case flterrors.ErrNoRowsUpdated, flterrors.ErrResourceVersionConflict:
+		return server.ReplaceEnrollmentPolicy409JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (DELETE /api/v1/enrollmentpolicies/{name})
+func (h *ServiceHandler) DeleteEnrollmentPolicy(ctx context.Context, request server.DeleteEnrollmentPolicyRequestObject) (server.DeleteEnrollmentPolicyResponseObject, error) {
+	orgId := store.NullOrgId
+	err := h.store.EnrollmentPolicy().Delete(ctx, orgId, request.Name)
+	switch err {
+	case nil:
+		return server.DeleteEnrollmentPolicy200JSONResponse{}, nil
+	case flterrors.ErrResourceNotFound:
+		return server.DeleteEnrollmentPolicy404JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (PATCH /api/v1/enrollmentpolicies/{name})
+// Only metadata.labels and spec can be patched. If we try to patch other fields, HTTP 400 Bad Request is returned.
+func (h *ServiceHandler) PatchEnrollmentPolicy(ctx context.Context, request server.PatchEnrollmentPolicyRequestObject) (server.PatchEnrollmentPolicyResponseObject, error) {
+	orgId := store.NullOrgId
+
+	currentObj, err := h.store.EnrollmentPolicy().Get(ctx, orgId, request.Name)
+	if err != nil {
+		switch err {
+		case flterrors.ErrResourceIsNil, flterrors.ErrResourceNameIsNil:
+			return server.PatchEnrollmentPolicy400JSONResponse{Message: err.Error()}, nil
+		case flterrors.ErrResourceNotFound:
+			return server.PatchEnrollmentPolicy404JSONResponse{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	newObj := &v1alpha1.EnrollmentPolicy{}
+	err = ApplyJSONPatch(ctx, currentObj, newObj, *request.Body, "/api/v1/enrollmentpolicies/"+request.Name)
+	if err != nil {
+		return server.PatchEnrollmentPolicy400JSONResponse{Message: err.Error()}, nil
+	}
+
+	if errs := newObj.Validate(); len(errs) > 0 {
+		return server.PatchEnrollmentPolicy400JSONResponse{Message: errors.Join(errs...).Error()}, nil
+	}
+	if newObj.Metadata.Name == nil || *currentObj.Metadata.Name != *newObj.Metadata.Name {
+		return server.PatchEnrollmentPolicy400JSONResponse{Message: "metadata.name is immutable"}, nil
+	}
+	if currentObj.ApiVersion != newObj.ApiVersion {
+		return server.PatchEnrollmentPolicy400JSONResponse{Message: "apiVersion is immutable"}, nil
+	}
+	if currentObj.Kind != newObj.Kind {
+		return server.PatchEnrollmentPolicy400JSONResponse{Message: "kind is immutable"}, nil
+	}
+	if !reflect.DeepEqual(currentObj.Status, newObj.Status) {
+		return server.PatchEnrollmentPolicy400JSONResponse{Message: "status is immutable"}, nil
+	}
+
+	common.NilOutManagedObjectMetaProperties(&newObj.Metadata)
+	newObj.Metadata.ResourceVersion = nil
+	result, err := h.store.EnrollmentPolicy().Update(ctx, orgId, newObj)
+
+	switch err {
+	case nil:
+		return server.PatchEnrollmentPolicy200JSONResponse(*result), nil
+	case flterrors.ErrResourceIsNil, flterrors.ErrResourceNameIsNil:
+		return server.PatchEnrollmentPolicy400JSONResponse{Message: err.Error()}, nil
+	case flterrors.ErrResourceNotFound:
+		return server.PatchEnrollmentPolicy404JSONResponse{}, nil
+	case flterrors.ErrNoRowsUpdated, flterrors.ErrResourceVersionConflict:
+		return server.PatchEnrollmentPolicy409JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+}
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/api/server"
+	fccrypto "github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+type EnrollmentPolicyStore struct {
+	store.Store
+	policies          *DummyEnrollmentPolicy
+	enrollmentRequest *DummyPendingEnrollmentRequest
+	devices           *DummyEnrolledDevice
+	events            *DummyEvent
+}
+
+func (s *EnrollmentPolicyStore) EnrollmentPolicy() store.EnrollmentPolicy {
+	return s.policies
+}
+
+func (s *EnrollmentPolicyStore) EnrollmentRequest() store.EnrollmentRequest {
+	return s.enrollmentRequest
+}
+
+func (s *EnrollmentPolicyStore) Device() store.Device {
+	return s.devices
+}
+
+func (s *EnrollmentPolicyStore) Event() store.Event {
+	return s.events
+}
+
+type DummyEnrollmentPolicy struct {
+	store.EnrollmentPolicy
+	PolicyVals []v1alpha1.EnrollmentPolicy
+	Approvals  map[string]int64
+}
+
+func (s *DummyEnrollmentPolicy) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*v1alpha1.EnrollmentPolicyList, error) {
+	return &v1alpha1.EnrollmentPolicyList{Items: s.PolicyVals}, nil
+}
+
+func (s *DummyEnrollmentPolicy) RecordApproval(ctx context.Context, orgId uuid.UUID, name string, approvedAt time.Time) error {
+	for _, policy := range s.PolicyVals {
+		if *policy.Metadata.Name != name {
+			continue
+		}
+		if policy.Spec.MaxApprovals != nil && s.Approvals[name] >= *policy.Spec.MaxApprovals {
+			return flterrors.ErrMaxApprovalsReached
+		}
+		s.Approvals[name]++
+		return nil
+	}
+	return flterrors.ErrResourceNotFound
+}
+
+func (s *DummyEnrollmentPolicy) RevertApproval(ctx context.Context, orgId uuid.UUID, name string) error {
+	if s.Approvals[name] > 0 {
+		s.Approvals[name]--
+	}
+	return nil
+}
+
+type DummyPendingEnrollmentRequest struct {
+	store.EnrollmentRequest
+	Updated   *v1alpha1.EnrollmentRequest
+	UpdateErr error
+}
+
+func (s *DummyPendingEnrollmentRequest) Get(ctx context.Context, orgId uuid.UUID, name string) (*v1alpha1.EnrollmentRequest, error) {
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyPendingEnrollmentRequest) Create(ctx context.Context, orgId uuid.UUID, req *v1alpha1.EnrollmentRequest) (*v1alpha1.EnrollmentRequest, error) {
+	return req, nil
+}
+
+func (s *DummyPendingEnrollmentRequest) UpdateStatus(ctx context.Context, orgId uuid.UUID, req *v1alpha1.EnrollmentRequest) (*v1alpha1.EnrollmentRequest, error) {
+	if s.UpdateErr != nil {
+		return nil, s.UpdateErr
+	}
+	s.Updated = req
+	return req, nil
+}
+
+type DummyEnrolledDevice struct {
+	store.Device
+	Created []v1alpha1.Device
+}
+
+func (s *DummyEnrolledDevice) Create(ctx context.Context, orgId uuid.UUID, device *v1alpha1.Device, callback store.DeviceStoreCallback) (*v1alpha1.Device, error) {
+	s.Created = append(s.Created, *device)
+	return device, nil
+}
+
+func (s *DummyEnrolledDevice) Delete(ctx context.Context, orgId uuid.UUID, name string, callback store.DeviceStoreCallback) error {
+	s.Created = slices.DeleteFunc(s.Created, func(device v1alpha1.Device) bool {
+		return *device.Metadata.Name == name
+	})
+	return nil
+}
+
+func newEnrollmentPolicyTestCA(t *testing.T) *fccrypto.CA {
+	testDirPath := t.TempDir()
+	ca, _, err := fccrypto.EnsureCA(filepath.Join(testDirPath, "ca.crt"), filepath.Join(testDirPath, "ca.key"), "", "ca", 1)
+	require.NoError(t, err)
+	return ca
+}
+
+func newTestEnrollmentRequest(t *testing.T, labels map[string]string) (*v1alpha1.EnrollmentRequest, crypto.PublicKey) {
+	publicKey, privateKey, err := fccrypto.NewKeyPair()
+	require.NoError(t, err)
+	csr, err := fccrypto.MakeCSR(privateKey.(crypto.Signer), "0123456789abcdef0123")
+	require.NoError(t, err)
+	return &v1alpha1.EnrollmentRequest{
+		ApiVersion: "v1alpha1",
+		Kind:       "EnrollmentRequest",
+		Metadata: v1alpha1.ObjectMeta{
+			Name: util.StrToPtr("0123456789abcdef0123"),
+		},
+		Spec: v1alpha1.EnrollmentRequestSpec{
+			Csr:    string(csr),
+			Labels: &labels,
+		},
+	}, publicKey
+}
+
+func newTestEnrollmentPolicy(name string, spec v1alpha1.EnrollmentPolicySpec) v1alpha1.EnrollmentPolicy {
+	return v1alpha1.EnrollmentPolicy{
+		ApiVersion: "v1alpha1",
+		Kind:       "EnrollmentPolicy",
+		Metadata: v1alpha1.ObjectMeta{
+			Name: util.StrToPtr(name),
+		},
+		Spec:   spec,
+		Status: &v1alpha1.EnrollmentPolicyStatus{},
+	}
+}
+
+func TestEnrollmentPolicyMatches(t *testing.T) {
+	require := require.New(t)
+	enrollmentRequest, publicKey := newTestEnrollmentRequest(t, map[string]string{"site": "berlin", "line": "1"})
+	otherPublicKey, _, err := fccrypto.NewKeyPair()
+	require.NoError(err)
+	allowedKey, err := fccrypto.PEMEncodePublicKey(publicKey)
+	require.NoError(err)
+	otherKey, err := fccrypto.PEMEncodePublicKey(otherPublicKey)
+	require.NoError(err)
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		spec     v1alpha1.EnrollmentPolicySpec
+		approved int64
+		matches  bool
+	}{
+		{name: "no rules", spec: v1alpha1.EnrollmentPolicySpec{}, matches: true},
+		{name: "matching labels", spec: v1alpha1.EnrollmentPolicySpec{
+			Selector: &v1alpha1.LabelSelector{MatchLabels: &map[string]string{"site": "berlin"}},
+		}, matches: true},
+		{name: "other labels", spec: v1alpha1.EnrollmentPolicySpec{
+			Selector: &v1alpha1.LabelSelector{MatchLabels: &map[string]string{"site": "paris"}},
+		}, matches: false},
+		{name: "allowed public key", spec: v1alpha1.EnrollmentPolicySpec{
+			AllowedPublicKeys: &[]string{string(otherKey), string(allowedKey)},
+		}, matches: true},
+		{name: "public key not allowed", spec: v1alpha1.EnrollmentPolicySpec{
+			AllowedPublicKeys: &[]string{string(otherKey)},
+		}, matches: false},
+		{name: "matching enrollment certificate", spec: v1alpha1.EnrollmentPolicySpec{
+			EnrollmentCertificateCommonNames: &[]string{"client-enrollment-factory-berlin"},
+		}, matches: true},
+		{name: "other enrollment certificate", spec: v1alpha1.EnrollmentPolicySpec{
+			EnrollmentCertificateCommonNames: &[]string{"client-enrollment-factory-paris"},
+		}, matches: false},
+		{name: "within time window", spec: v1alpha1.EnrollmentPolicySpec{
+			ValidFrom:  lo.ToPtr(now.Add(-time.Hour)),
+			ValidUntil: lo.ToPtr(now.Add(time.Hour)),
+		}, matches: true},
+		{name: "before time window", spec: v1alpha1.EnrollmentPolicySpec{
+			ValidFrom: lo.ToPtr(now.Add(time.Hour)),
+		}, matches: false},
+		{name: "after time window", spec: v1alpha1.EnrollmentPolicySpec{
+			ValidUntil: lo.ToPtr(now.Add(-time.Hour)),
+		}, matches: false},
+		{name: "below max approvals", spec: v1alpha1.EnrollmentPolicySpec{
+			MaxApprovals: lo.ToPtr[int64](2),
+		}, approved: 1, matches: true},
+		{name: "max approvals reached", spec: v1alpha1.EnrollmentPolicySpec{
+			MaxApprovals: lo.ToPtr[int64](2),
+		}, approved: 2, matches: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := newTestEnrollmentPolicy("policy", tt.spec)
+			policy.Status.ApprovedCount = tt.approved
+			require.Equal(tt.matches, common.EnrollmentPolicyMatches(&policy, enrollmentRequest, publicKey, "client-enrollment-factory-berlin", now))
+		})
+	}
+}
+
+func TestCreateEnrollmentRequestApprovedByPolicy(t *testing.T) {
+	require := require.New(t)
+	enrollmentRequest, _ := newTestEnrollmentRequest(t, map[string]string{"site": "berlin"})
+	st := &EnrollmentPolicyStore{
+		policies: &DummyEnrollmentPolicy{Approvals: map[string]int64{}, PolicyVals: []v1alpha1.EnrollmentPolicy{
+			newTestEnrollmentPolicy("a-paris", v1alpha1.EnrollmentPolicySpec{
+				Selector: &v1alpha1.LabelSelector{MatchLabels: &map[string]string{"site": "paris"}},
+			}),
+			newTestEnrollmentPolicy("b-berlin", v1alpha1.EnrollmentPolicySpec{
+				Selector:     &v1alpha1.LabelSelector{MatchLabels: &map[string]string{"site": "berlin"}},
+				MaxApprovals: lo.ToPtr[int64](1),
+				Labels:       &map[string]string{"fleet": "factory", "site": "berlin-1"},
+			}),
+		}},
+		enrollmentRequest: &DummyPendingEnrollmentRequest{},
+		devices:           &DummyEnrolledDevice{},
+		events:            &DummyEvent{},
+	}
+	serviceHandler := ServiceHandler{
+		store:           st,
+		ca:              newEnrollmentPolicyTestCA(t),
+		callbackManager: dummyCallbackManager(),
+	}
+
+	resp, err := serviceHandler.CreateEnrollmentRequest(context.Background(), server.CreateEnrollmentRequestRequestObject{Body: enrollmentRequest})
+	require.NoError(err)
+	created, ok := resp.(server.CreateEnrollmentRequest201JSONResponse)
+	require.True(ok)
+
+	require.NotNil(created.Status.Certificate)
+	require.True(created.Status.Approval.Approved)
+	require.Equal("EnrollmentPolicy/b-berlin", *created.Status.Approval.ApprovedBy)
+	// the labels of the policy take precedence over the labels of the agent
+	require.Equal(map[string]string{"fleet": "factory", "site": "berlin-1"}, *created.Status.Approval.Labels)
+	condition := v1alpha1.FindStatusCondition(created.Status.Conditions, v1alpha1.EnrollmentRequestApproved)
+	require.NotNil(condition)
+	require.Equal(v1alpha1.ConditionStatusTrue, condition.Status)
+	require.Equal(v1alpha1.EnrollmentRequestApprovedReasonEnrollmentPolicy, condition.Reason)
+	require.Equal("Approved by EnrollmentPolicy/b-berlin", condition.Message)
+
+	require.NotNil(st.enrollmentRequest.Updated)
+	require.Len(st.devices.Created, 1)
+	require.Equal(map[string]string{"fleet": "factory", "site": "berlin-1"}, *st.devices.Created[0].Metadata.Labels)
+	require.Equal(map[string]int64{"b-berlin": 1}, st.policies.Approvals)
+	require.Len(st.events.Emitted, 1)
+	require.Equal(v1alpha1.ObjectReference{Kind: "Device", Name: "0123456789abcdef0123"}, st.events.Emitted[0].InvolvedObject)
+	require.Equal(v1alpha1.EventReasonEnrollmentRequestApproved, st.events.Emitted[0].Reason)
+	require.Equal("Enrollment request approved by EnrollmentPolicy/b-berlin", st.events.Emitted[0].Message)
+}
+
+func TestCreateEnrollmentRequestPolicyMaxApprovalsReached(t *testing.T) {
+	require := require.New(t)
+	enrollmentRequest, _ := newTestEnrollmentRequest(t, map[string]string{"site": "berlin"})
+	policy := newTestEnrollmentPolicy("berlin", v1alpha1.EnrollmentPolicySpec{
+		MaxApprovals: lo.ToPtr[int64](1),
+	})
+	st := &EnrollmentPolicyStore{
+		// another request reached the maximum after the policies were listed
+		policies:          &DummyEnrollmentPolicy{Approvals: map[string]int64{"berlin": 1}, PolicyVals: []v1alpha1.EnrollmentPolicy{policy}},
+		enrollmentRequest: &DummyPendingEnrollmentRequest{},
+		devices:           &DummyEnrolledDevice{},
+		events:            &DummyEvent{},
+	}
+	serviceHandler := ServiceHandler{
+		store:           st,
+		ca:              newEnrollmentPolicyTestCA(t),
+		callbackManager: dummyCallbackManager(),
+	}
+
+	resp, err := serviceHandler.CreateEnrollmentRequest(context.Background(), server.CreateEnrollmentRequestRequestObject{Body: enrollmentRequest})
+	require.NoError(err)
+	created, ok := resp.(server.CreateEnrollmentRequest201JSONResponse)
+	require.True(ok)
+	require.Nil(created.Status.Approval)
+	require.Empty(created.Status.Conditions)
+	require.Nil(st.enrollmentRequest.Updated)
+	require.Empty(st.devices.Created)
+}
+
+func TestCreateEnrollmentRequestPolicyApprovalFails(t *testing.T) {
+	require := require.New(t)
+	enrollmentRequest, _ := newTestEnrollmentRequest(t, map[string]string{"site": "berlin"})
+	policy := newTestEnrollmentPolicy("berlin", v1alpha1.EnrollmentPolicySpec{
+		MaxApprovals: lo.ToPtr[int64](1),
+	})
+	st := &EnrollmentPolicyStore{
+		policies:          &DummyEnrollmentPolicy{Approvals: map[string]int64{}, PolicyVals: []v1alpha1.EnrollmentPolicy{policy}},
+		enrollmentRequest: &DummyPendingEnrollmentRequest{UpdateErr: errors.New("database unavailable")},
+		devices:           &DummyEnrolledDevice{},
+		events:            &DummyEvent{},
+	}
+	serviceHandler := ServiceHandler{
+		store:           st,
+		ca:              newEnrollmentPolicyTestCA(t),
+		callbackManager: dummyCallbackManager(),
+		log:             log.InitLogs(),
+	}
+
+	resp, err := serviceHandler.CreateEnrollmentRequest(context.Background(), server.CreateEnrollmentRequestRequestObject{Body: enrollmentRequest})
+	require.NoError(err)
+	created, ok := resp.(server.CreateEnrollmentRequest201JSONResponse)
+	require.True(ok)
+	// the request is left pending, without a device or a used up approval
+	require.Nil(created.Status.Approval)
+	require.Nil(created.Status.Certificate)
+	require.Empty(st.devices.Created)
+	require.Equal(map[string]int64{"berlin": 0}, st.policies.Approvals)
+}
@@ -8,93 +8,19 @@ import (
 
 	"github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/api/server"
-	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/go-openapi/swag"
-	"github.com/google/uuid"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
-const ClientCertExpiryDays = 365
-
-func approveAndSignEnrollmentRequest(ca *crypto.CA, enrollmentRequest *v1alpha1.EnrollmentRequest, approval *v1alpha1.EnrollmentRequestApproval) error {
-	if enrollmentRequest == nil {
-		return errors.New("approveAndSignEnrollmentRequest: enrollmentRequest is nil")
-	}
-
-	if enrollmentRequest.Metadata.Name == nil {
-		return fmt.Errorf("approveAndSignEnrollmentRequest: enrollment request is missing metadata.name")
-	}
-
-	csr, err := crypto.ParseCSR([]byte(enrollmentRequest.Spec.Csr))
-	if err != nil {
-		return fmt.Errorf("approveAndSignEnrollmentRequest: error parsing CSR: %w", err)
-	}
-
-	csr.Subject.CommonName, err = crypto.CNFromDeviceFingerprint(*enrollmentRequest.Metadata.Name)
-	if err != nil {
-		return fmt.Errorf("approveAndSignEnrollmentRequest: error setting CN in CSR: %w", err)
-	}
-
-	if err := csr.CheckSignature(); err != nil {
-		return fmt.Errorf("failed to verify signature of CSR: %w", err)
-	}
-
-	expirySeconds := ClientCertExpiryDays * 24 * 60 * 60
-	certData, err := ca.IssueRequestedClientCertificate(csr, expirySeconds)
-	if err != nil {
-		return err
-	}
-	enrollmentRequest.Status = &v1alpha1.EnrollmentRequestStatus{
-		Certificate: util.StrToPtr(string(certData)),
-		Conditions:  []v1alpha1.Condition{},
-		Approval:    approval,
-	}
-
-	// union user-provided labels with agent-provided labels
-	if enrollmentRequest.Spec.Labels != nil {
-		for k, v := range *enrollmentRequest.Spec.Labels {
-			// don't override user-provided labels
-			if _, ok := (*enrollmentRequest.Status.Approval.Labels)[k]; !ok {
-				(*enrollmentRequest.Status.Approval.Labels)[k] = v
-			}
-		}
-	}
-
-	condition := v1alpha1.Condition{
-		Type:    v1alpha1.EnrollmentRequestApproved,
-		Status:  v1alpha1.ConditionStatusTrue,
-		Reason:  "ManuallyApproved",
-		Message: "Approved by " + *approval.ApprovedBy,
-	}
-	v1alpha1.SetStatusCondition(&enrollmentRequest.Status.Conditions, condition)
-	return nil
-}
-
-func (h *ServiceHandler) createDeviceFromEnrollmentRequest(ctx context.Context, orgId uuid.UUID, enrollmentRequest *v1alpha1.EnrollmentRequest) error {
-	apiResource := &v1alpha1.Device{
-		Metadata: v1alpha1.ObjectMeta{
-			Name: enrollmentRequest.Metadata.Name,
-		},
-	}
-	if errs := apiResource.Validate(); len(errs) > 0 {
-		return fmt.Errorf("failed validating new device: %w", errors.Join(errs...))
-	}
-	if enrollmentRequest.Status.Approval != nil {
-		apiResource.Metadata.Labels = enrollmentRequest.Status.Approval.Labels
-	}
-	_, err := h.store.Device().Create(ctx, orgId, apiResource, h.callbackManager.DeviceUpdatedCallback)
-	return err
-}
-
 // (POST /api/v1/enrollmentrequests)
 func (h *ServiceHandler) CreateEnrollmentRequest(ctx context.Context, request server.CreateEnrollmentRequestRequestObject) (server.CreateEnrollmentRequestResponseObject, error) {
-	return common.CreateEnrollmentRequest(ctx, h.store, request)
+	return common.CreateEnrollmentRequest(ctx, h.store, h.ca, h.tpmEKRoots, h.callbackManager.DeviceUpdatedCallback, h.log, request)
 }
 
 // (GET /api/v1/enrollmentrequests)
@@ -273,12 +199,12 @@ func (h *ServiceHandler) ApproveEnrollmentRequest(ctx context.Context, request s
 			request.Body.ApprovedBy = util.StrToPtr("unknown")
 		}
 
-		if err := approveAndSignEnrollmentRequest(h.ca, enrollmentReq, request.Body); err != nil {
+		if err := common.ApproveAndSignEnrollmentRequest(h.ca, enrollmentReq, request.Body, v1alpha1.EnrollmentRequestApprovedReasonManual); err != nil {
 			return server.ApproveEnrollmentRequest400JSONResponse{Message: fmt.Sprintf("Error approving and signing enrollment request: %v", err.Error())}, nil
 		}
 
 		// in case of error we return 500 as it will be caused by creating device in db and not by problem with enrollment request
-		if err := h.createDeviceFromEnrollmentRequest(ctx, orgId, enrollmentReq); err != nil {
+		if err := common.CreateDeviceFromEnrollmentRequest(ctx, h.store, orgId, enrollmentReq, h.callbackManager.DeviceUpdatedCallback, h.log); err != nil {
 			return server.ApproveEnrollmentRequest500JSONResponse{Message: fmt.Sprintf("Error creating device from enrollment request: %v", err.Error())}, nil
 		}
 	}
@@ -311,3 +237,8 @@ func (h *ServiceHandler) ReplaceEnrollmentRequestStatus(ctx context.Context, req
 		return nil, err
 	}
 }
+
+// (POST /api/v1/enrollmentrequests/{name}/tpmchallenge)
+func (h *ServiceHandler) AnswerEnrollmentRequestTPMChallenge(ctx context.Context, request server.AnswerEnrollmentRequestTPMChallengeRequestObject) (server.AnswerEnrollmentRequestTPMChallengeResponseObject, error) {
+	return common.AnswerEnrollmentRequestTPMChallenge(ctx, h.store, h.ca, h.callbackManager.DeviceUpdatedCallback, h.log, request)
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/go-openapi/swag"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// (GET /api/v1/events)
+func (h *ServiceHandler) ListEvents(ctx context.Context, request server.ListEventsRequestObject) (server.ListEventsResponseObject, error) {
+	orgId := store.NullOrgId
+
+	cont, err := store.ParseContinueString(request.Params.Continue)
+	if err != nil {
+		return server.ListEvents400JSONResponse{Message: fmt.Sprintf("failed to parse continue parameter: %v", err)}, nil
+	}
+
+	var fieldSelector fields.Selector
+	if request.Params.FieldSelector != nil {
+		if fieldSelector, err = fields.ParseSelector(*request.Params.FieldSelector); err != nil {
+			return server.ListEvents400JSONResponse{Message: fmt.Sprintf("failed to parse field selector: %v", err)}, nil
+		}
+	}
+
+	var sortField *store.SortField
+	if request.Params.SortBy != nil {
+		sortField = &store.SortField{
+			FieldName: selector.SelectorFieldName(*request.Params.SortBy),
+			Order:     *request.Params.SortOrder,
+		}
+	}
+
+	listParams := store.ListParams{
+		Limit:         int(swag.Int32Value(request.Params.Limit)),
+		Continue:      cont,
+		FieldSelector: fieldSelector,
+		SortBy:        sortField,
+	}
+	if listParams.Limit == 0 {
+		listParams.Limit = store.MaxRecordsPerListRequest
+	}
+	if listParams.Limit > store.MaxRecordsPerListRequest {
+		return server.ListEvents400JSONResponse{Message: fmt.Sprintf("limit cannot exceed %d", store.MaxRecordsPerListRequest)}, nil
+	}
+
+	result, err := h.store.Event().List(ctx, orgId, listParams)
+	if err == nil {
+		return server.ListEvents200JSONResponse(*result), nil
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return server.ListEvents400JSONResponse{Message: se.Error()}, nil
+	default:
+		return nil, err
+	}
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+type EventStore struct {
+	store.Store
+	events *DummyEvent
+}
+
+func (s *EventStore) Event() store.Event {
+	return s.events
+}
+
+type DummyEvent struct {
+	store.Event
+	Emitted    []v1alpha1.Event
+	ListParams store.ListParams
+	ListErr    error
+}
+
+func (s *DummyEvent) Emit(ctx context.Context, orgId uuid.UUID, event *v1alpha1.Event) error {
+	s.Emitted = append(s.Emitted, *event)
+	return nil
+}
+
+func (s *DummyEvent) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*v1alpha1.EventList, error) {
+	s.ListParams = listParams
+	if s.ListErr != nil {
+		return nil, s.ListErr
+	}
+	return &v1alpha1.EventList{ApiVersion: "v1alpha1", Kind: "EventList", Items: s.Emitted}, nil
+}
+
+func TestListEvents(t *testing.T) {
+	require := require.New(t)
+	events := &DummyEvent{Emitted: []v1alpha1.Event{
+		*v1alpha1.NewEvent("Device", "mydevice", v1alpha1.EventTypeWarning, v1alpha1.EventReasonDeviceDisconnected, "Device is disconnected"),
+	}}
+	serviceHandler := ServiceHandler{store: &EventStore{events: events}}
+
+	resp, err := serviceHandler.ListEvents(context.Background(), server.ListEventsRequestObject{
+		Params: v1alpha1.ListEventsParams{
+			FieldSelector: lo.ToPtr("involvedObject.kind=Device,involvedObject.name=mydevice"),
+		},
+	})
+	require.NoError(err)
+	list, ok := resp.(server.ListEvents200JSONResponse)
+	require.True(ok)
+	require.Len(list.Items, 1)
+	require.Equal(v1alpha1.EventReasonDeviceDisconnected, list.Items[0].Reason)
+	require.Equal(store.MaxRecordsPerListRequest, events.ListParams.Limit)
+	require.Equal("involvedObject.kind=Device,involvedObject.name=mydevice", events.ListParams.FieldSelector.String())
+}
+
+func TestListEventsBadRequest(t *testing.T) {
+	require := require.New(t)
+	events := &DummyEvent{ListErr: selector.NewSelectorError(flterrors.ErrFieldSelectorParseFailed, errors.New("unknown field"))}
+	serviceHandler := ServiceHandler{store: &EventStore{events: events}}
+
+	resp, err := serviceHandler.ListEvents(context.Background(), server.ListEventsRequestObject{
+		Params: v1alpha1.ListEventsParams{FieldSelector: lo.ToPtr("involvedObject.uid=1")},
+	})
+	require.NoError(err)
+	_, ok := resp.(server.ListEvents400JSONResponse)
+	require.True(ok)
+
+	resp, err = serviceHandler.ListEvents(context.Background(), server.ListEventsRequestObject{
+		Params: v1alpha1.ListEventsParams{Limit: lo.ToPtr(int32(store.MaxRecordsPerListRequest + 1))},
+	})
+	require.NoError(err)
+	_, ok = resp.(server.ListEvents400JSONResponse)
+	require.True(ok)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"slices"
 
 	"github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/api/server"
@@ -15,8 +16,10 @@ import (
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/tasks"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/go-openapi/swag"
+	"github.com/samber/lo"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 )
@@ -281,7 +284,7 @@ func (h *ServiceHandler) PatchFleet(ctx context.Context, request server.PatchFle
 	common.NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
 
-	var updateCallback func(before *model.Fleet, after *model.Fleet)
+	var updateCallback func(ctx context.Context, before *model.Fleet, after *model.Fleet)
 
 	if h.callbackManager != nil {
 		updateCallback = h.callbackManager.FleetUpdatedCallback
@@ -301,3 +304,80 @@ func (h *ServiceHandler) PatchFleet(ctx context.Context, request server.PatchFle
 		return nil, err
 	}
 }
+
+// (POST /api/v1/fleets/{name}/ownershipdryrun)
+// Matches the devices against the fleets of the org as if the fleet in the request was created or
+// replaced, and returns the devices that the fleet owns or would own, or whose owner would change.
+func (h *ServiceHandler) DryRunFleetOwnership(ctx context.Context, request server.DryRunFleetOwnershipRequestObject) (server.DryRunFleetOwnershipResponseObject, error) {
+	orgId := store.NullOrgId
+
+	if errs := request.Body.Validate(); len(errs) > 0 {
+		return server.DryRunFleetOwnership400JSONResponse{Message: errors.Join(errs...).Error()}, nil
+	}
+	if request.Name != *request.Body.Metadata.Name {
+		return server.DryRunFleetOwnership400JSONResponse{Message: "resource name specified in metadata does not match name in path"}, nil
+	}
+
+	fleets, err := h.store.Fleet().List(ctx, orgId, store.ListParams{})
+	if err != nil {
+		return nil, err
+	}
+	fleetItems := []v1alpha1.Fleet{*request.Body}
+	for _, fleet := range fleets.Items {
+		if *fleet.Metadata.Name != request.Name {
+			fleetItems = append(fleetItems, fleet)
+		}
+	}
+
+	result := v1alpha1.FleetOwnershipDryRun{Items: []v1alpha1.DeviceOwnershipDryRun{}}
+	listParams := store.ListParams{Limit: store.MaxRecordsPerListRequest}
+	for {
+		devices, err := h.store.Device().List(ctx, orgId, listParams)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, device := range devices.Items {
+			currentOwner := ""
+			if device.Metadata.Owner != nil {
+				ownerType, ownerName, err := util.GetResourceOwner(device.Metadata.Owner)
+				if err != nil || ownerType != model.FleetKind {
+					// only fleets own devices according to their selectors
+					continue
+				}
+				currentOwner = ownerName
+			}
+
+			matchingFleets, ownerCandidates := tasks.MatchDeviceToFleets(lo.FromPtr(device.Metadata.Labels), fleetItems)
+			owner := currentOwner
+			switch len(ownerCandidates) {
+			case 0:
+				owner = ""
+			case 1:
+				owner = ownerCandidates[0]
+			}
+			if currentOwner != request.Name && owner == currentOwner && !slices.Contains(matchingFleets, request.Name) {
+				continue
+			}
+
+			result.Items = append(result.Items, v1alpha1.DeviceOwnershipDryRun{
+				Device:         *device.Metadata.Name,
+				CurrentOwner:   util.StrToPtrWithNilDefault(currentOwner),
+				Owner:          util.StrToPtrWithNilDefault(owner),
+				MatchingFleets: append([]string{}, matchingFleets...),
+				Overlapping:    len(ownerCandidates) > 1,
+			})
+		}
+
+		if devices.Metadata.Continue == nil {
+			break
+		}
+		cont, err := store.ParseContinueString(devices.Metadata.Continue)
+		if err != nil {
+			return nil, err
+		}
+		listParams.Continue = cont
+	}
+
+	return server.DryRunFleetOwnership200JSONResponse(result), nil
+}
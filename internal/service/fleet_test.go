@@ -8,6 +8,7 @@ import (
 	"github.com/flightctl/flightctl/internal/api/server"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -252,3 +253,126 @@ func TestFleetNonExistingResource(t *testing.T) {
 	require.NoError(err)
 	require.Equal(server.PatchFleet404JSONResponse{}, resp)
 }
+
+type FleetOwnershipStore struct {
+	store.Store
+	fleets  []v1alpha1.Fleet
+	devices []v1alpha1.Device
+}
+
+func (s *FleetOwnershipStore) Fleet() store.Fleet {
+	return &DummyFleetList{fleets: s.fleets}
+}
+
+func (s *FleetOwnershipStore) Device() store.Device {
+	return &DummyDeviceList{devices: s.devices}
+}
+
+type DummyFleetList struct {
+	store.Fleet
+	fleets []v1alpha1.Fleet
+}
+
+func (s *DummyFleetList) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams, opts ...store.ListOption) (*v1alpha1.FleetList, error) {
+	return &v1alpha1.FleetList{Items: s.fleets}, nil
+}
+
+type DummyDeviceList struct {
+	store.Device
+	devices []v1alpha1.Device
+}
+
+func (s *DummyDeviceList) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*v1alpha1.DeviceList, error) {
+	return &v1alpha1.DeviceList{Items: s.devices}, nil
+}
+
+func newOwnershipFleet(name string, matchLabels map[string]string, priority int32) v1alpha1.Fleet {
+	fleet := v1alpha1.Fleet{
+		ApiVersion: "v1alpha1",
+		Kind:       "Fleet",
+		Metadata:   v1alpha1.ObjectMeta{Name: util.StrToPtr(name)},
+	}
+	fleet.Spec.Selector = &v1alpha1.LabelSelector{MatchLabels: &matchLabels}
+	fleet.Spec.Priority = &priority
+	return fleet
+}
+
+func newOwnershipDevice(name string, labels map[string]string, owner string) v1alpha1.Device {
+	device := v1alpha1.Device{
+		ApiVersion: "v1alpha1",
+		Kind:       "Device",
+		Metadata:   v1alpha1.ObjectMeta{Name: util.StrToPtr(name), Labels: &labels},
+	}
+	if owner != "" {
+		device.Metadata.Owner = util.SetResourceOwner(model.FleetKind, owner)
+	}
+	return device
+}
+
+func TestDryRunFleetOwnership(t *testing.T) {
+	require := require.New(t)
+	serviceHandler := ServiceHandler{
+		store: &FleetOwnershipStore{
+			fleets: []v1alpha1.Fleet{
+				newOwnershipFleet("edge", map[string]string{"site": "edge"}, 0),
+				newOwnershipFleet("canary", map[string]string{"ring": "canary"}, 10),
+			},
+			devices: []v1alpha1.Device{
+				newOwnershipDevice("edge-only", map[string]string{"site": "edge"}, "edge"),
+				newOwnershipDevice("edge-canary", map[string]string{"site": "edge", "ring": "canary"}, "canary"),
+				newOwnershipDevice("edge-gpu", map[string]string{"site": "edge", "gpu": "true"}, "edge"),
+				newOwnershipDevice("other", map[string]string{"site": "core"}, ""),
+			},
+		},
+	}
+
+	// a new fleet with the priority of the canary fleet takes over the devices
+	// of the edge fleet, and overlaps with the canary fleet
+	proposed := newOwnershipFleet("gpu", map[string]string{"site": "edge"}, 10)
+	resp, err := serviceHandler.DryRunFleetOwnership(context.Background(), server.DryRunFleetOwnershipRequestObject{
+		Name: "gpu",
+		Body: &proposed,
+	})
+	require.NoError(err)
+	result, ok := resp.(server.DryRunFleetOwnership200JSONResponse)
+	require.True(ok)
+	require.Equal([]v1alpha1.DeviceOwnershipDryRun{
+		{
+			Device:         "edge-only",
+			CurrentOwner:   util.StrToPtr("edge"),
+			Owner:          util.StrToPtr("gpu"),
+			MatchingFleets: []string{"gpu", "edge"},
+			Overlapping:    false,
+		},
+		{
+			Device:         "edge-canary",
+			CurrentOwner:   util.StrToPtr("canary"),
+			Owner:          util.StrToPtr("canary"),
+			MatchingFleets: []string{"gpu", "edge", "canary"},
+			Overlapping:    true,
+		},
+		{
+			Device:         "edge-gpu",
+			CurrentOwner:   util.StrToPtr("edge"),
+			Owner:          util.StrToPtr("gpu"),
+			MatchingFleets: []string{"gpu", "edge"},
+			Overlapping:    false,
+		},
+	}, result.Items)
+}
+
+func TestDryRunFleetOwnershipNameMismatch(t *testing.T) {
+	require := require.New(t)
+	serviceHandler := ServiceHandler{
+		store: &FleetOwnershipStore{},
+	}
+
+	proposed := newOwnershipFleet("gpu", map[string]string{"site": "edge"}, 0)
+	resp, err := serviceHandler.DryRunFleetOwnership(context.Background(), server.DryRunFleetOwnershipRequestObject{
+		Name: "other",
+		Body: &proposed,
+	})
+	require.NoError(err)
+	_, ok := resp.(server.DryRunFleetOwnership400JSONResponse)
+	require.True(ok)
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"crypto/x509"
+
 	"github.com/flightctl/flightctl/internal/api/server"
 	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/store"
@@ -11,9 +13,11 @@ import (
 type ServiceHandler struct {
 	store               store.Store
 	ca                  *crypto.CA
+	tpmEKRoots          *x509.CertPool
 	log                 logrus.FieldLogger
 	callbackManager     tasks.CallbackManager
 	consoleGrpcEndpoint string
+	sessionRouter       SessionRouter
 	agentEndpoint       string
 	uiUrl               string
 }
@@ -21,13 +25,15 @@ type ServiceHandler struct {
 // Make sure we conform to servers Service interface
 var _ server.Service = (*ServiceHandler)(nil)
 
-func NewServiceHandler(store store.Store, callbackManager tasks.CallbackManager, ca *crypto.CA, log logrus.FieldLogger, consoleGrpcEndpoint string, agentEndpoint string, uiUrl string) *ServiceHandler {
+func NewServiceHandler(store store.Store, callbackManager tasks.CallbackManager, ca *crypto.CA, tpmEKRoots *x509.CertPool, log logrus.FieldLogger, consoleGrpcEndpoint string, sessionRouter SessionRouter, agentEndpoint string, uiUrl string) *ServiceHandler {
 	return &ServiceHandler{
 		store:               store,
 		ca:                  ca,
+		tpmEKRoots:          tpmEKRoots,
 		log:                 log,
 		callbackManager:     callbackManager,
 		consoleGrpcEndpoint: consoleGrpcEndpoint,
+		sessionRouter:       sessionRouter,
 		agentEndpoint:       agentEndpoint,
 		uiUrl:               uiUrl,
 	}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/auth"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/go-openapi/swag"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// errScopesNotCovered rejects service account tokens setting or granting scopes beyond their own, which would
+// let them escalate their privileges.
+const errScopesNotCovered = "service account tokens can only set or grant scopes that they are granted themselves"
+
+// (POST /api/v1/serviceaccounts)
+func (h *ServiceHandler) CreateServiceAccount(ctx context.Context, request server.CreateServiceAccountRequestObject) (server.CreateServiceAccountResponseObject, error) {
+	orgId := store.NullOrgId
+
+	// don't set fields that are managed by the service
+	request.Body.Status = nil
+	common.NilOutManagedObjectMetaProperties(&request.Body.Metadata)
+
+	if errs := request.Body.Validate(); len(errs) > 0 {
+		return server.CreateServiceAccount400JSONResponse{Message: errors.Join(errs...).Error()}, nil
+	}
+	if !auth.CallerScopesCover(ctx, request.Body.Spec.Scopes) {
+		return server.CreateServiceAccount403JSONResponse{Message: errScopesNotCovered}, nil
+	}
+
+	result, err := h.store.ServiceAccount().Create(ctx, orgId, request.Body)
+	switch err {
+	case nil:
+		return server.CreateServiceAccount201JSONResponse(*result), nil
+	case flterrors.ErrResourceIsNil:
+		return server.CreateServiceAccount400JSONResponse{Message: err.Error()}, nil
+	case flterrors.ErrDuplicateName:
+		return server.CreateServiceAccount400JSONResponse{Message: err.Error()}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (GET /api/v1/serviceaccounts)
+func (h *ServiceHandler) ListServiceAccounts(ctx context.Context, request server.ListServiceAccountsRequestObject) (server.ListServiceAccountsResponseObject, error) {
+	orgId := store.NullOrgId
+	labelSelector := ""
+	if request.Params.LabelSelector != nil {
+		labelSelector = *request.Params.LabelSelector
+	}
+
+	labelMap, err := labels.ConvertSelectorToLabelsMap(labelSelector)
+	if err != nil {
+		return server.ListServiceAccounts400JSONResponse{Message: err.Error()}, nil
+	}
+
+	cont, err := store.ParseContinueString(request.Params.Continue)
+	if err != nil {
+		return server.ListServiceAccounts400JSONResponse{Message: fmt.Sprintf("failed to parse continue parameter: %v", err)}, nil
+	}
+
+	var fieldSelector fields.Selector
+	if request.Params.FieldSelector != nil {
+		if fieldSelector, err = fields.ParseSelector(*request.Params.FieldSelector); err != nil {
+			return server.ListServiceAccounts400JSONResponse{Message: fmt.Sprintf("failed to parse field selector: %v", err)}, nil
+		}
+	}
+
+	var sortField *store.SortField
+	if request.Params.SortBy != nil {
+		sortField = &store.SortField{
+			FieldName: selector.SelectorFieldName(*request.Params.SortBy),
+			Order:     *request.Params.SortOrder,
+		}
+	}
+
+	listParams := store.ListParams{
+		Labels:        labelMap,
+		Limit:         int(swag.Int32Value(request.Params.Limit)),
+		Continue:      cont,
+		FieldSelector: fieldSelector,
+		SortBy:        sortField,
+	}
+	if listParams.Limit == 0 {
+		listParams.Limit = store.MaxRecordsPerListRequest
+	}
+	if listParams.Limit > store.MaxRecordsPerListRequest {
+		return server.ListServiceAccounts400JSONResponse{Message: fmt.Sprintf("limit cannot exceed %d", store.MaxRecordsPerListRequest)}, nil
+	}
+
+	result, err := h.store.ServiceAccount().List(ctx, orgId, listParams)
+	if err == nil {
+		return server.ListServiceAccounts200JSONResponse(*result), nil
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return server.ListServiceAccounts400JSONResponse{Message: se.Error()}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (DELETE /api/v1/serviceaccounts)
+func (h *ServiceHandler) DeleteServiceAccounts(ctx context.Context, request server.DeleteServiceAccountsRequestObject) (server.DeleteServiceAccountsResponseObject, error) {
+	orgId := store.NullOrgId
+
+	err := h.store.ServiceAccount().DeleteAll(ctx, orgId)
+	switch err {
+	case nil:
+		return server.DeleteServiceAccounts200JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (GET /api/v1/serviceaccounts/{name})
+func (h *ServiceHandler) ReadServiceAccount(ctx context.Context, request server.ReadServiceAccountRequestObject) (server.ReadServiceAccountResponseObject, error) {
+	orgId := store.NullOrgId
+
+	result, err := h.store.ServiceAccount().Get(ctx, orgId, request.Name)
+	switch err {
+	case nil:
+		return server.ReadServiceAccount200JSONResponse(*result), nil
+	case flterrors.ErrResourceNotFound:
+		return server.ReadServiceAccount404JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (PUT /api/v1/serviceaccounts/{name})
+func (h *ServiceHandler) ReplaceServiceAccount(ctx context.Context, request server.ReplaceServiceAccountRequestObject) (server.ReplaceServiceAccountResponseObject, error) {
+	orgId := store.NullOrgId
+
+	// don't overwrite fields that are managed by the service
+	request.Body.Status = nil
+	common.NilOutManagedObjectMetaProperties(&request.Body.Metadata)
+	if errs := request.Body.Validate(); len(errs) > 0 {
+		return server.ReplaceServiceAccount400JSONResponse{Message: errors.Join(errs...).Error()}, nil
+	}
+	if request.Name != *request.Body.Metadata.Name {
+		return server.ReplaceServiceAccount400JSONResponse{Message: "resource name specified in metadata does not match name in path"}, nil
+	}
+	if !auth.CallerScopesCover(ctx, request.Body.Spec.Scopes) {
+		return server.ReplaceServiceAccount403JSONResponse{Message: errScopesNotCovered}, nil
+	}
+
+	result, created, err := h.store.ServiceAccount().CreateOrUpdate(ctx, orgId, request.Body)
+	switch err {
+	case nil:
+		if created {
+			return server.ReplaceServiceAccount201JSONResponse(*result), nil
+		} else {
+			return server.ReplaceServiceAccount200JSONResponse(*result), nil
+		}
+	case flterrors.ErrResourceIsNil:
+		return server.ReplaceServiceAccount400JSONResponse{Message: err.Error()}, nil
+	case flterrors.ErrResourceNameIsNil:
+		return server.ReplaceServiceAccount400JSONResponse{Message: err.Error()}, nil
+	case flterrors.ErrResourceNotFound:
+		return server.ReplaceServiceAccount404JSONResponse{}, nil
+	case flterrors.ErrNoRowsUpdated, flterrors.ErrResourceVersionConflict:
+		return server.ReplaceServiceAccount409JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (DELETE /api/v1/serviceaccounts/{name})
+func (h *ServiceHandler) DeleteServiceAccount(ctx context.Context, request server.DeleteServiceAccountRequestObject) (server.DeleteServiceAccountResponseObject, error) {
+	orgId := store.NullOrgId
+	err := h.store.ServiceAccount().Delete(ctx, orgId, request.Name)
+	switch err {
+	case nil:
+		return server.DeleteServiceAccount200JSONResponse{}, nil
+	case flterrors.ErrResourceNotFound:
+		return server.DeleteServiceAccount404JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (POST /api/v1/serviceaccounts/{name}/tokens)
+func (h *ServiceHandler) CreateServiceAccountToken(ctx context.Context, request server.CreateServiceAccountTokenRequestObject) (server.CreateServiceAccountTokenResponseObject, error) {
+	orgId := store.NullOrgId
+
+	if errs := request.Body.Validate(); len(errs) > 0 {
+		return server.CreateServiceAccountToken400JSONResponse{Message: errors.Join(errs...).Error()}, nil
+	}
+	if request.Body.ExpiresAt != nil && !request.Body.ExpiresAt.After(time.Now()) {
+		return server.CreateServiceAccountToken400JSONResponse{Message: "expiresAt must be in the future"}, nil
+	}
+
+	serviceAccount, err := h.store.ServiceAccount().Get(ctx, orgId, request.Name)
+	switch err {
+	case nil:
+	case flterrors.ErrResourceNotFound:
+		return server.CreateServiceAccountToken404JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+	scopes := serviceAccount.Spec.Scopes
+	if request.Body.Scopes != nil {
+		scopes = *request.Body.Scopes
+		if !v1alpha1.ServiceAccountScopesCover(serviceAccount.Spec.Scopes, scopes) {
+			return server.CreateServiceAccountToken400JSONResponse{Message: fmt.Sprintf("scopes must be allowed by the scopes of the service account: %s", strings.Join(serviceAccount.Spec.Scopes, ", "))}, nil
+		}
+	}
+	if !auth.CallerScopesCover(ctx, scopes) {
+		return server.CreateServiceAccountToken403JSONResponse{Message: errScopesNotCovered}, nil
+	}
+
+	token, tokenHash, err := auth.NewServiceAccountToken()
+	if err != nil {
+		return nil, err
+	}
+	err = h.store.ServiceAccount().CreateToken(ctx, orgId, &model.ServiceAccountToken{
+		ServiceAccountName: request.Name,
+		Name:               request.Body.Name,
+		TokenHash:          tokenHash,
+		Scopes:             scopes,
+		ExpiresAt:          request.Body.ExpiresAt,
+	})
+	switch err {
+	case nil:
+		return server.CreateServiceAccountToken201JSONResponse{
+			Name:      request.Body.Name,
+			Token:     token,
+			Scopes:    scopes,
+			ExpiresAt: request.Body.ExpiresAt,
+		}, nil
+	case flterrors.ErrResourceNotFound:
+		return server.CreateServiceAccountToken404JSONResponse{}, nil
+	case flterrors.ErrDuplicateName:
+		return server.CreateServiceAccountToken409JSONResponse{Message: fmt.Sprintf("service account %s already has a token named %s", request.Name, request.Body.Name)}, nil
+	default:
+		return nil, err
+	}
+}
+
+// (DELETE /api/v1/serviceaccounts/{name}/tokens/{token})
+func (h *ServiceHandler) DeleteServiceAccountToken(ctx context.Context, request server.DeleteServiceAccountTokenRequestObject) (server.DeleteServiceAccountTokenResponseObject, error) {
+	orgId := store.NullOrgId
+
+	err := h.store.ServiceAccount().DeleteToken(ctx, orgId, request.Name, request.Token)
+	switch err {
+	case nil:
+		return server.DeleteServiceAccountToken200JSONResponse{}, nil
+	case flterrors.ErrResourceNotFound:
+		return server.DeleteServiceAccountToken404JSONResponse{}, nil
+	default:
+		return nil, err
+	}
+}
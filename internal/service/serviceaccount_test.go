@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/auth"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+type ServiceAccountStore struct {
+	store.Store
+	serviceAccounts *DummyServiceAccount
+}
+
+func (s *ServiceAccountStore) ServiceAccount() store.ServiceAccount {
+	return s.serviceAccounts
+}
+
+type DummyServiceAccount struct {
+	store.ServiceAccount
+	ServiceAccountVals []v1alpha1.ServiceAccount
+	Tokens             []model.ServiceAccountToken
+}
+
+func (s *DummyServiceAccount) Get(ctx context.Context, orgId uuid.UUID, name string) (*v1alpha1.ServiceAccount, error) {
+	for _, serviceAccount := range s.ServiceAccountVals {
+		if *serviceAccount.Metadata.Name == name {
+			return &serviceAccount, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyServiceAccount) CreateToken(ctx context.Context, orgId uuid.UUID, token *model.ServiceAccountToken) error {
+	for _, existing := range s.Tokens {
+		if existing.ServiceAccountName == token.ServiceAccountName && existing.Name == token.Name {
+			return flterrors.ErrDuplicateName
+		}
+	}
+	s.Tokens = append(s.Tokens, *token)
+	return nil
+}
+
+func (s *DummyServiceAccount) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, serviceAccount *v1alpha1.ServiceAccount) (*v1alpha1.ServiceAccount, bool, error) {
+	for i := range s.ServiceAccountVals {
+		if *s.ServiceAccountVals[i].Metadata.Name == *serviceAccount.Metadata.Name {
+			s.ServiceAccountVals[i] = *serviceAccount
+			return serviceAccount, false, nil
+		}
+	}
+	s.ServiceAccountVals = append(s.ServiceAccountVals, *serviceAccount)
+	return serviceAccount, true, nil
+}
+
+func newTestServiceAccountHandler(scopes ...string) (*ServiceHandler, *DummyServiceAccount) {
+	serviceAccounts := &DummyServiceAccount{ServiceAccountVals: []v1alpha1.ServiceAccount{{
+		Metadata: v1alpha1.ObjectMeta{Name: lo.ToPtr("ci")},
+		Spec:     v1alpha1.ServiceAccountSpec{Scopes: scopes},
+	}}}
+	return &ServiceHandler{store: &ServiceAccountStore{serviceAccounts: serviceAccounts}}, serviceAccounts
+}
+
+func TestCreateServiceAccountTokenDefaultsToServiceAccountScopes(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, serviceAccounts := newTestServiceAccountHandler("read", "fleets:write")
+
+	resp, err := serviceHandler.CreateServiceAccountToken(context.Background(), server.CreateServiceAccountTokenRequestObject{
+		Name: "ci",
+		Body: &v1alpha1.ServiceAccountTokenRequest{Name: "pipeline"},
+	})
+	require.NoError(err)
+	created, ok := resp.(server.CreateServiceAccountToken201JSONResponse)
+	require.True(ok)
+	require.True(auth.IsServiceAccountToken(created.Token))
+	require.Equal([]string{"read", "fleets:write"}, created.Scopes)
+
+	// only the hash of the token is stored
+	require.Len(serviceAccounts.Tokens, 1)
+	require.Equal(auth.HashServiceAccountToken(created.Token), serviceAccounts.Tokens[0].TokenHash)
+	require.Equal("ci", serviceAccounts.Tokens[0].ServiceAccountName)
+}
+
+func TestCreateServiceAccountTokenNarrowsScopes(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, serviceAccounts := newTestServiceAccountHandler("write")
+	expiresAt := time.Now().Add(time.Hour).UTC()
+
+	resp, err := serviceHandler.CreateServiceAccountToken(context.Background(), server.CreateServiceAccountTokenRequestObject{
+		Name: "ci",
+		Body: &v1alpha1.ServiceAccountTokenRequest{Name: "inventory", Scopes: &[]string{"devices:read"}, ExpiresAt: &expiresAt},
+	})
+	require.NoError(err)
+	created, ok := resp.(server.CreateServiceAccountToken201JSONResponse)
+	require.True(ok)
+	require.Equal([]string{"devices:read"}, created.Scopes)
+	require.Equal(&expiresAt, serviceAccounts.Tokens[0].ExpiresAt)
+}
+
+func TestCreateServiceAccountTokenRejectsScopesBeyondServiceAccount(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, serviceAccounts := newTestServiceAccountHandler("read", "fleets:write")
+
+	resp, err := serviceHandler.CreateServiceAccountToken(context.Background(), server.CreateServiceAccountTokenRequestObject{
+		Name: "ci",
+		Body: &v1alpha1.ServiceAccountTokenRequest{Name: "pipeline", Scopes: &[]string{"devices:write"}},
+	})
+	require.NoError(err)
+	_, ok := resp.(server.CreateServiceAccountToken400JSONResponse)
+	require.True(ok)
+	require.Empty(serviceAccounts.Tokens)
+}
+
+func TestCreateServiceAccountTokenRejectsPastExpiry(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, serviceAccounts := newTestServiceAccountHandler("read")
+
+	resp, err := serviceHandler.CreateServiceAccountToken(context.Background(), server.CreateServiceAccountTokenRequestObject{
+		Name: "ci",
+		Body: &v1alpha1.ServiceAccountTokenRequest{Name: "pipeline", ExpiresAt: lo.ToPtr(time.Now().Add(-time.Minute))},
+	})
+	require.NoError(err)
+	_, ok := resp.(server.CreateServiceAccountToken400JSONResponse)
+	require.True(ok)
+	require.Empty(serviceAccounts.Tokens)
+}
+
+func TestCreateServiceAccountTokenDuplicateName(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, _ := newTestServiceAccountHandler("read")
+	request := server.CreateServiceAccountTokenRequestObject{
+		Name: "ci",
+		Body: &v1alpha1.ServiceAccountTokenRequest{Name: "pipeline"},
+	}
+
+	resp, err := serviceHandler.CreateServiceAccountToken(context.Background(), request)
+	require.NoError(err)
+	_, ok := resp.(server.CreateServiceAccountToken201JSONResponse)
+	require.True(ok)
+
+	resp, err = serviceHandler.CreateServiceAccountToken(context.Background(), request)
+	require.NoError(err)
+	_, ok = resp.(server.CreateServiceAccountToken409JSONResponse)
+	require.True(ok)
+}
+
+func TestCreateServiceAccountTokenUnknownServiceAccount(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, _ := newTestServiceAccountHandler("read")
+
+	resp, err := serviceHandler.CreateServiceAccountToken(context.Background(), server.CreateServiceAccountTokenRequestObject{
+		Name: "deploy",
+		Body: &v1alpha1.ServiceAccountTokenRequest{Name: "pipeline"},
+	})
+	require.NoError(err)
+	_, ok := resp.(server.CreateServiceAccountToken404JSONResponse)
+	require.True(ok)
+}
+
+// serviceAccountCaller returns the context of a request made with a token of the "automation" service account.
+func serviceAccountCaller(tokenScopes []string, serviceAccountScopes []string) context.Context {
+	return auth.WithServiceAccountToken(context.Background(), &model.ServiceAccountToken{
+		ServiceAccountName: "automation",
+		ServiceAccount:     model.ServiceAccount{Spec: model.MakeJSONField(v1alpha1.ServiceAccountSpec{Scopes: serviceAccountScopes})},
+		Name:               "token",
+		Scopes:             tokenScopes,
+	})
+}
+
+func TestServiceAccountTokenCannotEscalateScopes(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, serviceAccounts := newTestServiceAccountHandler("serviceaccounts:write")
+	ctx := serviceAccountCaller([]string{"serviceaccounts:write"}, []string{"write"})
+
+	// raising the scopes of a service account beyond those of the caller
+	replaceResp, err := serviceHandler.ReplaceServiceAccount(ctx, server.ReplaceServiceAccountRequestObject{
+		Name: "ci",
+		Body: &v1alpha1.ServiceAccount{Metadata: v1alpha1.ObjectMeta{Name: lo.ToPtr("ci")}, Spec: v1alpha1.ServiceAccountSpec{Scopes: []string{"write"}}},
+	})
+	require.NoError(err)
+	_, ok := replaceResp.(server.ReplaceServiceAccount403JSONResponse)
+	require.True(ok)
+	require.Equal([]string{"serviceaccounts:write"}, serviceAccounts.ServiceAccountVals[0].Spec.Scopes)
+
+	// creating a service account with scopes beyond those of the caller
+	createResp, err := serviceHandler.CreateServiceAccount(ctx, server.CreateServiceAccountRequestObject{
+		Body: &v1alpha1.ServiceAccount{Metadata: v1alpha1.ObjectMeta{Name: lo.ToPtr("admin")}, Spec: v1alpha1.ServiceAccountSpec{Scopes: []string{"devices:write"}}},
+	})
+	require.NoError(err)
+	_, ok = createResp.(server.CreateServiceAccount403JSONResponse)
+	require.True(ok)
+
+	// scopes within those of the caller can be set
+	replaceResp, err = serviceHandler.ReplaceServiceAccount(ctx, server.ReplaceServiceAccountRequestObject{
+		Name: "ci",
+		Body: &v1alpha1.ServiceAccount{Metadata: v1alpha1.ObjectMeta{Name: lo.ToPtr("ci")}, Spec: v1alpha1.ServiceAccountSpec{Scopes: []string{"serviceaccounts:read"}}},
+	})
+	require.NoError(err)
+	_, ok = replaceResp.(server.ReplaceServiceAccount200JSONResponse)
+	require.True(ok)
+}
+
+func TestServiceAccountTokenCannotGrantScopesBeyondItsOwn(t *testing.T) {
+	require := require.New(t)
+	serviceHandler, serviceAccounts := newTestServiceAccountHandler("write")
+
+	// the scopes of the token of the caller limit what it grants
+	ctx := serviceAccountCaller([]string{"serviceaccounts:write"}, []string{"write"})
+	resp, err := serviceHandler.CreateServiceAccountToken(ctx, server.CreateServiceAccountTokenRequestObject{
+		Name: "ci",
+		Body: &v1alpha1.ServiceAccountTokenRequest{Name: "pipeline"},
+	})
+	require.NoError(err)
+	_, ok := resp.(server.CreateServiceAccountToken403JSONResponse)
+	require.True(ok)
+
+	// and so do the scopes of its service account
+	ctx = serviceAccountCaller([]string{"write"}, []string{"serviceaccounts:write"})
+	resp, err = serviceHandler.CreateServiceAccountToken(ctx, server.CreateServiceAccountTokenRequestObject{
+		Name: "ci",
+		Body: &v1alpha1.ServiceAccountTokenRequest{Name: "pipeline", Scopes: &[]string{"devices:read"}},
+	})
+	require.NoError(err)
+	_, ok = resp.(server.CreateServiceAccountToken403JSONResponse)
+	require.True(ok)
+	require.Empty(serviceAccounts.Tokens)
+
+	resp, err = serviceHandler.CreateServiceAccountToken(ctx, server.CreateServiceAccountTokenRequestObject{
+		Name: "ci",
+		Body: &v1alpha1.ServiceAccountTokenRequest{Name: "pipeline", Scopes: &[]string{"serviceaccounts:read"}},
+	})
+	require.NoError(err)
+	_, ok = resp.(server.CreateServiceAccountToken201JSONResponse)
+	require.True(ok)
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/api/server"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type TPMAttestationStore struct {
+	EnrollmentPolicyStore
+	enrollmentRequest *DummyChallengedEnrollmentRequest
+}
+
+func (s *TPMAttestationStore) EnrollmentRequest() store.EnrollmentRequest {
+	return s.enrollmentRequest
+}
+
+type DummyChallengedEnrollmentRequest struct {
+	DummyPendingEnrollmentRequest
+	EnrollmentRequest *v1alpha1.EnrollmentRequest
+}
+
+func (s *DummyChallengedEnrollmentRequest) Get(ctx context.Context, orgId uuid.UUID, name string) (*v1alpha1.EnrollmentRequest, error) {
+	return s.EnrollmentRequest, nil
+}
+
+func newTestChallengedEnrollmentRequest(t *testing.T) *v1alpha1.EnrollmentRequest {
+	enrollmentRequest, _ := newTestEnrollmentRequest(t, map[string]string{})
+	enrollmentRequest.Spec.TpmAttestation = &v1alpha1.TPMAttestation{AttestationKeyPublic: []byte("ak")}
+	enrollmentRequest.Status = &v1alpha1.EnrollmentRequestStatus{
+		Conditions: []v1alpha1.Condition{{
+			Type:   v1alpha1.EnrollmentRequestTPMVerified,
+			Status: v1alpha1.ConditionStatusFalse,
+			Reason: common.TPMCredentialChallengePendingReason,
+		}},
+		TpmChallenge: &v1alpha1.TPMCredentialChallenge{},
+	}
+	return enrollmentRequest
+}
+
+func TestCreateEnrollmentRequestTPMAttestationNotVerified(t *testing.T) {
+	require := require.New(t)
+	enrollmentRequest, _ := newTestEnrollmentRequest(t, map[string]string{})
+	enrollmentRequest.Spec.TpmAttestation = &v1alpha1.TPMAttestation{}
+	st := &EnrollmentPolicyStore{
+		policies:          &DummyEnrollmentPolicy{},
+		enrollmentRequest: &DummyPendingEnrollmentRequest{},
+		devices:           &DummyEnrolledDevice{},
+		events:            &DummyEvent{},
+	}
+	serviceHandler := ServiceHandler{
+		store:           st,
+		ca:              newEnrollmentPolicyTestCA(t),
+		callbackManager: dummyCallbackManager(),
+	}
+
+	// no EK roots are configured
+	resp, err := serviceHandler.CreateEnrollmentRequest(context.Background(), server.CreateEnrollmentRequestRequestObject{Body: enrollmentRequest})
+	require.NoError(err)
+	badRequest, ok := resp.(server.CreateEnrollmentRequest400JSONResponse)
+	require.True(ok)
+	require.Contains(badRequest.Message, "no TPM endorsement key CAs are configured")
+}
+
+func TestApproveEnrollmentRequestTPMAttestationPending(t *testing.T) {
+	require := require.New(t)
+	st := &TPMAttestationStore{
+		EnrollmentPolicyStore: EnrollmentPolicyStore{devices: &DummyEnrolledDevice{}},
+		enrollmentRequest:     &DummyChallengedEnrollmentRequest{EnrollmentRequest: newTestChallengedEnrollmentRequest(t)},
+	}
+	serviceHandler := ServiceHandler{
+		store:           st,
+		ca:              newEnrollmentPolicyTestCA(t),
+		callbackManager: dummyCallbackManager(),
+	}
+
+	resp, err := serviceHandler.ApproveEnrollmentRequest(context.Background(), server.ApproveEnrollmentRequestRequestObject{
+		Name: "0123456789abcdef0123",
+		Body: &v1alpha1.EnrollmentRequestApproval{Approved: true, Labels: &map[string]string{}},
+	})
+	require.NoError(err)
+	badRequest, ok := resp.(server.ApproveEnrollmentRequest400JSONResponse)
+	require.True(ok)
+	require.Contains(badRequest.Message, "the TPM attestation of the enrollment request is not verified")
+	require.Empty(st.devices.Created)
+}
+
+func TestAnswerEnrollmentRequestTPMChallenge(t *testing.T) {
+	require := require.New(t)
+	enrollmentRequest := newTestChallengedEnrollmentRequest(t)
+	st := &TPMAttestationStore{
+		EnrollmentPolicyStore: EnrollmentPolicyStore{
+			policies: &DummyEnrollmentPolicy{Approvals: map[string]int64{}, PolicyVals: []v1alpha1.EnrollmentPolicy{
+				newTestEnrollmentPolicy("all", v1alpha1.EnrollmentPolicySpec{}),
+			}},
+			devices: &DummyEnrolledDevice{},
+			events:  &DummyEvent{},
+		},
+		enrollmentRequest: &DummyChallengedEnrollmentRequest{EnrollmentRequest: enrollmentRequest},
+	}
+	ca := newEnrollmentPolicyTestCA(t)
+	serviceHandler := ServiceHandler{
+		store:           st,
+		ca:              ca,
+		callbackManager: dummyCallbackManager(),
+	}
+
+	resp, err := serviceHandler.AnswerEnrollmentRequestTPMChallenge(context.Background(), server.AnswerEnrollmentRequestTPMChallengeRequestObject{
+		Name: "0123456789abcdef0123",
+		Body: &v1alpha1.TPMCredentialChallengeResponse{Secret: []byte("wrong")},
+	})
+	require.NoError(err)
+	_, ok := resp.(server.AnswerEnrollmentRequestTPMChallenge400JSONResponse)
+	require.True(ok)
+	require.Empty(st.devices.Created)
+
+	secret, err := ca.TPMChallengeSecret("0123456789abcdef0123", []byte("ak"))
+	require.NoError(err)
+	resp, err = serviceHandler.AnswerEnrollmentRequestTPMChallenge(context.Background(), server.AnswerEnrollmentRequestTPMChallengeRequestObject{
+		Name: "0123456789abcdef0123",
+		Body: &v1alpha1.TPMCredentialChallengeResponse{Secret: secret},
+	})
+	require.NoError(err)
+	answered, ok := resp.(server.AnswerEnrollmentRequestTPMChallenge200JSONResponse)
+	require.True(ok)
+	require.True(v1alpha1.IsStatusConditionTrue(answered.Status.Conditions, v1alpha1.EnrollmentRequestTPMVerified))
+
+	// the verified request is approved by the enrollment policy
+	updated := st.enrollmentRequest.Updated
+	require.True(v1alpha1.IsStatusConditionTrue(updated.Status.Conditions, v1alpha1.EnrollmentRequestApproved))
+	require.True(v1alpha1.IsStatusConditionTrue(updated.Status.Conditions, v1alpha1.EnrollmentRequestTPMVerified))
+	require.Equal(util.StrToPtr(common.EnrollmentPolicyApprover("all")), updated.Status.Approval.ApprovedBy)
+	require.Len(st.devices.Created, 1)
+}
@@ -0,0 +1,111 @@
+package store
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type AuditRecord interface {
+	Create(ctx context.Context, orgId uuid.UUID, record *api.AuditRecord) error
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.AuditRecordList, error)
+	DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error)
+	InitialMigration() error
+}
+
+type AuditRecordStore struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+// Make sure we conform to AuditRecord interface
+var _ AuditRecord = (*AuditRecordStore)(nil)
+
+func NewAuditRecord(db *gorm.DB, log logrus.FieldLogger) AuditRecord {
+	return &AuditRecordStore{db: db, log: log}
+}
+
+func (s *AuditRecordStore) InitialMigration() error {
+	return s.db.AutoMigrate(&model.AuditRecord{})
+}
+
+// Create records the audit record. Audit records are never updated.
+func (s *AuditRecordStore) Create(ctx context.Context, orgId uuid.UUID, resource *api.AuditRecord) error {
+	if resource == nil {
+		return flterrors.ErrResourceIsNil
+	}
+	record := model.NewAuditRecordFromApiResource(resource)
+	record.OrgID = orgId
+	return ErrorFromGormError(s.db.WithContext(ctx).Create(record).Error)
+}
+
+func (s *AuditRecordStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.AuditRecordList, error) {
+	var records model.AuditRecordList
+	var nextContinue *string
+	var numRemaining *int64
+
+	if listParams.Limit < 0 {
+		return nil, flterrors.ErrLimitParamOutOfBounds
+	}
+
+	query, err := ListQuery(&records).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
+	if err != nil {
+		return nil, err
+	}
+	if listParams.SortBy == nil {
+		// audit records are named after the time of the call
+		query = query.Order("name")
+	}
+
+	if listParams.Limit > 0 {
+		// Request 1 more than the user asked for to see if we need to return "continue"
+		query = AddPaginationToQuery(query, listParams.Limit+1, listParams.Continue)
+	}
+	result := query.Find(&records)
+
+	// If we got more than the user requested, remove one record and calculate "continue"
+	if listParams.Limit > 0 && len(records) > listParams.Limit {
+		nextContinueStruct := Continue{
+			Name:    records[len(records)-1].Name,
+			Version: CurrentContinueVersion,
+		}
+		records = records[:len(records)-1]
+
+		var numRemainingVal int64
+		if listParams.Continue != nil {
+			numRemainingVal = listParams.Continue.Count - int64(listParams.Limit)
+			if numRemainingVal < 1 {
+				numRemainingVal = 1
+			}
+		} else {
+			countQuery, err := ListQuery(&records).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
+			if err != nil {
+				return nil, err
+			}
+			numRemainingVal = CountRemainingItems(countQuery, nextContinueStruct.Name)
+		}
+		nextContinueStruct.Count = numRemainingVal
+		contByte, _ := json.Marshal(nextContinueStruct)
+		contStr := b64.StdEncoding.EncodeToString(contByte)
+		nextContinue = &contStr
+		numRemaining = &numRemainingVal
+	}
+
+	apiAuditRecordList := records.ToApiResource(nextContinue, numRemaining)
+	return &apiAuditRecordList, ErrorFromGormError(result.Error)
+}
+
+// DeleteOlderThan deletes the audit records of all orgs that were recorded
+// before the cutoff, returning the number of deleted records.
+func (s *AuditRecordStore) DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := s.db.WithContext(ctx).Where("timestamp < ?", cutoff).Delete(&model.AuditRecord{})
+	return result.RowsAffected, ErrorFromGormError(result.Error)
+}
@@ -48,14 +48,14 @@ func (s *CertificateSigningRequestStore) InitialMigration() error {
 
 // Warning: this is a user-facing function and will set the Status to nil
 func (s *CertificateSigningRequestStore) Create(ctx context.Context, orgId uuid.UUID, resource *api.CertificateSigningRequest) (*api.CertificateSigningRequest, error) {
-	updatedResource, _, _, err := s.createOrUpdate(orgId, resource, ModeCreateOnly)
+	updatedResource, _, _, err := s.createOrUpdate(ctx, orgId, resource, ModeCreateOnly)
 	return updatedResource, err
 }
 
 // Warning: this is a user-facing function and will set the Status to nil
 func (s *CertificateSigningRequestStore) Update(ctx context.Context, orgId uuid.UUID, resource *api.CertificateSigningRequest) (*api.CertificateSigningRequest, error) {
 	updatedResource, _, err := retryCreateOrUpdate(func() (*api.CertificateSigningRequest, bool, bool, error) {
-		return s.createOrUpdate(orgId, resource, ModeUpdateOnly)
+		return s.createOrUpdate(ctx, orgId, resource, ModeUpdateOnly)
 	})
 	return updatedResource, err
 }
@@ -65,7 +65,7 @@ func (s *CertificateSigningRequestStore) List(ctx context.Context, orgId uuid.UU
 	var nextContinue *string
 	var numRemaining *int64
 
-	query, err := ListQuery(&certificateSigningRequests).Build(ctx, s.db, orgId, listParams)
+	query, err := ListQuery(&certificateSigningRequests).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +91,7 @@ func (s *CertificateSigningRequestStore) List(ctx context.Context, orgId uuid.UU
 				numRemainingVal = 1
 			}
 		} else {
-			countQuery, err := ListQuery(&certificateSigningRequests).Build(ctx, s.db, orgId, listParams)
+			countQuery, err := ListQuery(&certificateSigningRequests).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 			if err != nil {
 				return nil, err
 			}
@@ -110,7 +110,7 @@ func (s *CertificateSigningRequestStore) List(ctx context.Context, orgId uuid.UU
 
 func (s *CertificateSigningRequestStore) DeleteAll(ctx context.Context, orgId uuid.UUID) error {
 	condition := model.CertificateSigningRequest{}
-	result := s.db.Unscoped().Where("org_id = ?", orgId).Delete(&condition)
+	result := s.db.WithContext(ctx).Unscoped().Where("org_id = ?", orgId).Delete(&condition)
 	return ErrorFromGormError(result.Error)
 }
 
@@ -118,7 +118,7 @@ func (s *CertificateSigningRequestStore) Get(ctx context.Context, orgId uuid.UUI
 	certificateSigningRequest := model.CertificateSigningRequest{
 		Resource: model.Resource{OrgID: orgId, Name: name},
 	}
-	result := s.db.First(&certificateSigningRequest)
+	result := s.db.WithContext(ctx).First(&certificateSigningRequest)
 	if result.Error != nil {
 		return nil, ErrorFromGormError(result.Error)
 	}
@@ -126,17 +126,17 @@ func (s *CertificateSigningRequestStore) Get(ctx context.Context, orgId uuid.UUI
 	return &apiCertificateSigningRequest, nil
 }
 
-func (s *CertificateSigningRequestStore) createCertificateSigningRequest(certificateSigningRequest *model.CertificateSigningRequest) (bool, error) {
+func (s *CertificateSigningRequestStore) createCertificateSigningRequest(ctx context.Context, certificateSigningRequest *model.CertificateSigningRequest) (bool, error) {
 	certificateSigningRequest.Generation = lo.ToPtr[int64](1)
 	certificateSigningRequest.ResourceVersion = lo.ToPtr[int64](1)
-	if result := s.db.Create(certificateSigningRequest); result.Error != nil {
+	if result := s.db.WithContext(ctx).Create(certificateSigningRequest); result.Error != nil {
 		err := ErrorFromGormError(result.Error)
 		return err == flterrors.ErrDuplicateName, err
 	}
 	return false, nil
 }
 
-func (s *CertificateSigningRequestStore) updateCertificateSigningRequest(existingRecord, certificateSigningRequest *model.CertificateSigningRequest) (bool, error) {
+func (s *CertificateSigningRequestStore) updateCertificateSigningRequest(ctx context.Context, existingRecord, certificateSigningRequest *model.CertificateSigningRequest) (bool, error) {
 	updateSpec := certificateSigningRequest.Spec != nil && !reflect.DeepEqual(existingRecord.Spec, certificateSigningRequest.Spec)
 
 	// Update the generation if the spec was updated
@@ -148,7 +148,7 @@ func (s *CertificateSigningRequestStore) updateCertificateSigningRequest(existin
 	}
 	certificateSigningRequest.ResourceVersion = lo.ToPtr(lo.FromPtr(existingRecord.ResourceVersion) + 1)
 	where := model.CertificateSigningRequest{Resource: model.Resource{OrgID: certificateSigningRequest.OrgID, Name: certificateSigningRequest.Name}}
-	query := s.db.Model(where).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion))
+	query := s.db.WithContext(ctx).Model(where).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion))
 
 	result := query.Updates(&certificateSigningRequest)
 	if result.Error != nil {
@@ -161,7 +161,7 @@ func (s *CertificateSigningRequestStore) updateCertificateSigningRequest(existin
 }
 
 // Warning: this is a user-facing function and will set the Status to nil
-func (s *CertificateSigningRequestStore) createOrUpdate(orgId uuid.UUID, resource *api.CertificateSigningRequest, mode CreateOrUpdateMode) (*api.CertificateSigningRequest, bool, bool, error) {
+func (s *CertificateSigningRequestStore) createOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.CertificateSigningRequest, mode CreateOrUpdateMode) (*api.CertificateSigningRequest, bool, bool, error) {
 	if resource == nil {
 		return nil, false, false, flterrors.ErrResourceIsNil
 	}
@@ -176,7 +176,7 @@ func (s *CertificateSigningRequestStore) createOrUpdate(orgId uuid.UUID, resourc
 	certificatesigningrequest.OrgID = orgId
 	certificatesigningrequest.Status = nil
 
-	existingRecord, err := getExistingRecord[model.CertificateSigningRequest](s.db, certificatesigningrequest.Name, orgId)
+	existingRecord, err := getExistingRecord[model.CertificateSigningRequest](s.db.WithContext(ctx), certificatesigningrequest.Name, orgId)
 	if err != nil {
 		return nil, false, false, err
 	}
@@ -190,11 +190,11 @@ func (s *CertificateSigningRequestStore) createOrUpdate(orgId uuid.UUID, resourc
 	}
 
 	if !exists {
-		if retry, err := s.createCertificateSigningRequest(certificatesigningrequest); err != nil {
+		if retry, err := s.createCertificateSigningRequest(ctx, certificatesigningrequest); err != nil {
 			return nil, false, retry, err
 		}
 	} else {
-		if retry, err := s.updateCertificateSigningRequest(existingRecord, certificatesigningrequest); err != nil {
+		if retry, err := s.updateCertificateSigningRequest(ctx, existingRecord, certificatesigningrequest); err != nil {
 			return nil, false, retry, err
 		}
 	}
@@ -205,7 +205,7 @@ func (s *CertificateSigningRequestStore) createOrUpdate(orgId uuid.UUID, resourc
 
 func (s *CertificateSigningRequestStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.CertificateSigningRequest) (*api.CertificateSigningRequest, bool, error) {
 	return retryCreateOrUpdate(func() (*api.CertificateSigningRequest, bool, bool, error) {
-		return s.createOrUpdate(orgId, resource, ModeCreateOrUpdate)
+		return s.createOrUpdate(ctx, orgId, resource, ModeCreateOrUpdate)
 	})
 }
 
@@ -219,7 +219,7 @@ func (s *CertificateSigningRequestStore) UpdateStatus(ctx context.Context, orgId
 	certificateSigningRequest := model.CertificateSigningRequest{
 		Resource: model.Resource{OrgID: orgId, Name: *resource.Metadata.Name},
 	}
-	result := s.db.Model(&certificateSigningRequest).Updates(map[string]interface{}{
+	result := s.db.WithContext(ctx).Model(&certificateSigningRequest).Updates(map[string]interface{}{
 		"status":           model.MakeJSONField(resource.Status),
 		"resource_version": gorm.Expr("resource_version + 1"),
 	})
@@ -230,16 +230,16 @@ func (s *CertificateSigningRequestStore) Delete(ctx context.Context, orgId uuid.
 	condition := model.CertificateSigningRequest{
 		Resource: model.Resource{OrgID: orgId, Name: name},
 	}
-	result := s.db.Unscoped().Delete(&condition)
+	result := s.db.WithContext(ctx).Unscoped().Delete(&condition)
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return nil
 	}
 	return ErrorFromGormError(result.Error)
 }
 
-func (s *CertificateSigningRequestStore) updateConditions(orgId uuid.UUID, name string, conditions []api.Condition) (bool, error) {
+func (s *CertificateSigningRequestStore) updateConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []api.Condition) (bool, error) {
 	existingRecord := model.CertificateSigningRequest{Resource: model.Resource{OrgID: orgId, Name: name}}
-	result := s.db.First(&existingRecord)
+	result := s.db.WithContext(ctx).First(&existingRecord)
 	if result.Error != nil {
 		return false, ErrorFromGormError(result.Error)
 	}
@@ -258,7 +258,7 @@ func (s *CertificateSigningRequestStore) updateConditions(orgId uuid.UUID, name
 		return false, nil
 	}
 
-	result = s.db.Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
+	result = s.db.WithContext(ctx).Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
 		"status":           existingRecord.Status,
 		"resource_version": gorm.Expr("resource_version + 1"),
 	})
@@ -274,6 +274,6 @@ func (s *CertificateSigningRequestStore) updateConditions(orgId uuid.UUID, name
 
 func (s *CertificateSigningRequestStore) UpdateConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []api.Condition) error {
 	return retryUpdate(func() (bool, error) {
-		return s.updateConditions(orgId, name, conditions)
+		return s.updateConditions(ctx, orgId, name, conditions)
 	})
 }
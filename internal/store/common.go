@@ -74,9 +74,15 @@ func (lq *listQuery) Build(ctx context.Context, db *gorm.DB, orgId uuid.UUID, li
 	if listParams.InvertLabels != nil && *listParams.InvertLabels {
 		invertLabels = true
 	}
-	query = LabelSelectionQuery(query, listParams.Labels, invertLabels)
-	if query, err = LabelMatchExpressionsQuery(query, listParams.LabelMatchExpressions); err != nil {
-		return nil, err
+	if invertLabels {
+		if query, err = InvertedLabelSelectorQuery(query, listParams.Labels, listParams.LabelMatchExpressions); err != nil {
+			return nil, err
+		}
+	} else {
+		query = LabelSelectionQuery(query, listParams.Labels, false)
+		if query, err = LabelMatchExpressionsQuery(query, listParams.LabelMatchExpressions); err != nil {
+			return nil, err
+		}
 	}
 	if query, err = AnnotationsMatchExpressionsQuery(query, listParams.AnnotationsMatchExpressions); err != nil {
 		return nil, err
@@ -202,6 +208,49 @@ func LabelMatchExpressionsQuery(query *gorm.DB, matchExpressions api.MatchExpres
 	return matchExpressionsQuery(query, matchExpressions, "labels")
 }
 
+// InvertedLabelSelectorQuery selects the resources that don't match the
+// labels and the match expressions together, i.e. that fail at least one of
+// them.
+func InvertedLabelSelectorQuery(query *gorm.DB, labels map[string]string, matchExpressions api.MatchExpressions) (*gorm.DB, error) {
+	var conditions []string
+	var args []any
+
+	if len(labels) > 0 {
+		var params []string
+		for _, label := range util.LabelMapToArray(&labels) {
+			params = append(params, "?")
+			args = append(args, label)
+		}
+		conditions = append(conditions, fmt.Sprintf("labels @> ARRAY[%s]", strings.Join(params, ",")))
+	}
+
+	for _, me := range matchExpressions {
+		switch me.Operator {
+		case api.In:
+			condition, meArgs := matchExpressionQueryAndArgs(me, "labels")
+			conditions = append(conditions, condition)
+			args = append(args, meArgs...)
+		case api.NotIn:
+			condition, meArgs := matchExpressionQueryAndArgs(me, "labels")
+			conditions = append(conditions, fmt.Sprintf("NOT (%s)", condition))
+			args = append(args, meArgs...)
+		case api.Exists:
+			conditions = append(conditions, existsQuery("labels"))
+			args = append(args, me.Key+"=%")
+		case api.DoesNotExist:
+			conditions = append(conditions, fmt.Sprintf("NOT %s", existsQuery("labels")))
+			args = append(args, me.Key+"=%")
+		default:
+			return nil, fmt.Errorf("unexpected operator %s", me.Operator)
+		}
+	}
+
+	if len(conditions) == 0 {
+		return query, nil
+	}
+	return query.Not(fmt.Sprintf("(%s)", strings.Join(conditions, " AND ")), args...), nil
+}
+
 func AnnotationsMatchExpressionsQuery(query *gorm.DB, matchExpressions api.MatchExpressions) (*gorm.DB, error) {
 	return matchExpressionsQuery(query, matchExpressions, "annotations")
 }
@@ -32,7 +32,7 @@ func PrepareDBForUnitTests(log *logrus.Logger) (Store, *config.Config, string, *
 		log.Fatalf("initializing data store: %v", err)
 	}
 
-	store := NewStore(db, log.WithField("pkg", "store"))
+	store := NewStore(db, nil, log.WithField("pkg", "store"))
 	if err := store.InitialMigration(); err != nil {
 		log.Fatalf("running initial migration: %v", err)
 	}
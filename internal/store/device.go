@@ -2,12 +2,14 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	b64 "encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/flterrors"
@@ -29,12 +31,16 @@ type Device interface {
 	Get(ctx context.Context, orgId uuid.UUID, name string) (*api.Device, error)
 	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, device *api.Device, fieldsToUnset []string, fromAPI bool, callback DeviceStoreCallback) (*api.Device, bool, error)
 	UpdateStatus(ctx context.Context, orgId uuid.UUID, device *api.Device) (*api.Device, error)
-	UpdateSummaryStatusBatch(ctx context.Context, orgId uuid.UUID, deviceNames []string, status api.DeviceSummaryStatusType, statusInfo string) error
+	SetUnknownIfNotSeenSince(ctx context.Context, orgId uuid.UUID, lastSeenBefore time.Time, owners OwnerFilter, statusInfo string) ([]string, error)
+	ListOrgIds(ctx context.Context) ([]uuid.UUID, error)
 	DeleteAll(ctx context.Context, orgId uuid.UUID, callback DeviceStoreAllDeletedCallback) error
 	Delete(ctx context.Context, orgId uuid.UUID, name string, callback DeviceStoreCallback) error
 	UpdateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) error
-	UpdateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications string) error
+	UpdateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications string) (string, error)
 	GetRendered(ctx context.Context, orgId uuid.UUID, name string, knownRenderedVersion *string, consoleGrpcEndpoint string) (*api.RenderedDeviceSpec, error)
+	GetOverrides(ctx context.Context, orgId uuid.UUID, name string) (*api.DeviceOverrides, error)
+	ListOverrides(ctx context.Context, orgId uuid.UUID, names []string) (map[string]api.DeviceOverridesSpec, error)
+	UpdateOverrides(ctx context.Context, orgId uuid.UUID, name string, overrides api.DeviceOverridesSpec, callback DeviceStoreCallback) (*api.DeviceOverrides, error)
 	SetServiceConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []api.Condition) error
 	OverwriteRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string, repositoryNames ...string) error
 	GetRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string) (*api.RepositoryList, error)
@@ -42,22 +48,30 @@ type Device interface {
 	SetIntegrationTestCreateOrUpdateCallback(IntegrationTestCallback)
 }
 
+// OwnerFilter restricts a query to devices owned by one of Owners, or if Owners is
+// empty, to devices not owned by any of ExcludeOwners (including devices without an owner).
+type OwnerFilter struct {
+	Owners        []string
+	ExcludeOwners []string
+}
+
 type IntegrationTestCallback func()
 type DeviceStore struct {
-	db  *gorm.DB
-	log logrus.FieldLogger
+	db    *gorm.DB
+	event Event
+	log   logrus.FieldLogger
 
 	IntegrationTestCreateOrUpdateCallback IntegrationTestCallback
 }
 
-type DeviceStoreCallback func(before *model.Device, after *model.Device)
-type DeviceStoreAllDeletedCallback func(orgId uuid.UUID)
+type DeviceStoreCallback func(ctx context.Context, before *model.Device, after *model.Device)
+type DeviceStoreAllDeletedCallback func(ctx context.Context, orgId uuid.UUID)
 
 // Make sure we conform to Device interface
 var _ Device = (*DeviceStore)(nil)
 
-func NewDevice(db *gorm.DB, log logrus.FieldLogger) Device {
-	return &DeviceStore{db: db, log: log, IntegrationTestCreateOrUpdateCallback: func() {}}
+func NewDevice(db *gorm.DB, event Event, log logrus.FieldLogger) Device {
+	return &DeviceStore{db: db, event: event, log: log, IntegrationTestCreateOrUpdateCallback: func() {}}
 }
 
 func (s *DeviceStore) SetIntegrationTestCreateOrUpdateCallback(c IntegrationTestCallback) {
@@ -97,6 +111,21 @@ func (s *DeviceStore) InitialMigration() error {
 		}
 	}
 
+	// Create an index on the time the device was last seen, used to detect disconnected devices.
+	// The cast of a timestamp with a time zone is not immutable in general, but lastSeen is
+	// always serialized in RFC 3339 format, which includes the offset.
+	if !s.db.Migrator().HasIndex(&model.Device{}, "idx_device_last_seen") {
+		if s.db.Dialector.Name() == "postgres" {
+			if err := s.db.Exec(`CREATE OR REPLACE FUNCTION device_last_seen(status jsonb) RETURNS timestamptz
+				LANGUAGE sql IMMUTABLE AS $$ SELECT (status->>'lastSeen')::timestamptz $$`).Error; err != nil {
+				return err
+			}
+			if err := s.db.Exec("CREATE INDEX idx_device_last_seen ON devices USING BTREE (org_id, device_last_seen(status))").Error; err != nil {
+				return err
+			}
+		}
+	}
+
 	// Create GIN index for device status
 	if !s.db.Migrator().HasIndex(&model.Device{}, "idx_device_status") {
 		if s.db.Dialector.Name() == "postgres" {
@@ -114,13 +143,13 @@ func (s *DeviceStore) InitialMigration() error {
 }
 
 func (s *DeviceStore) Create(ctx context.Context, orgId uuid.UUID, resource *api.Device, callback DeviceStoreCallback) (*api.Device, error) {
-	updatedResource, _, _, err := s.createOrUpdate(orgId, resource, nil, true, ModeCreateOnly, callback)
+	updatedResource, _, _, err := s.createOrUpdate(ctx, orgId, resource, nil, true, ModeCreateOnly, callback)
 	return updatedResource, err
 }
 
 func (s *DeviceStore) Update(ctx context.Context, orgId uuid.UUID, resource *api.Device, fieldsToUnset []string, fromAPI bool, callback DeviceStoreCallback) (*api.Device, error) {
 	updatedResource, _, err := retryCreateOrUpdate(func() (*api.Device, bool, bool, error) {
-		return s.createOrUpdate(orgId, resource, fieldsToUnset, fromAPI, ModeUpdateOnly, callback)
+		return s.createOrUpdate(ctx, orgId, resource, fieldsToUnset, fromAPI, ModeUpdateOnly, callback)
 	})
 	return updatedResource, err
 }
@@ -134,7 +163,7 @@ func (s *DeviceStore) List(ctx context.Context, orgId uuid.UUID, listParams List
 		return nil, flterrors.ErrLimitParamOutOfBounds
 	}
 
-	query, err := ListQuery(&devices).Build(ctx, s.db, orgId, listParams)
+	query, err := ListQuery(&devices).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 	if err != nil {
 		return nil, err
 	}
@@ -160,7 +189,7 @@ func (s *DeviceStore) List(ctx context.Context, orgId uuid.UUID, listParams List
 				numRemainingVal = 1
 			}
 		} else {
-			countQuery, err := ListQuery(&devices).Build(ctx, s.db, orgId, listParams)
+			countQuery, err := ListQuery(&devices).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 			if err != nil {
 				return nil, err
 			}
@@ -178,7 +207,7 @@ func (s *DeviceStore) List(ctx context.Context, orgId uuid.UUID, listParams List
 }
 
 func (s *DeviceStore) Summary(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.DevicesSummary, error) {
-	query, err := ListQuery(&model.DeviceList{}).Build(ctx, s.db, orgId, listParams)
+	query, err := ListQuery(&model.DeviceList{}).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 	if err != nil {
 		return nil, err
 	}
@@ -209,12 +238,12 @@ func (s *DeviceStore) Summary(ctx context.Context, orgId uuid.UUID, listParams L
 
 func (s *DeviceStore) DeleteAll(ctx context.Context, orgId uuid.UUID, callback DeviceStoreAllDeletedCallback) error {
 	condition := model.Device{}
-	result := s.db.Unscoped().Where("org_id = ?", orgId).Delete(&condition)
+	result := s.db.WithContext(ctx).Unscoped().Where("org_id = ?", orgId).Delete(&condition)
 
 	if result.Error != nil {
 		return ErrorFromGormError(result.Error)
 	}
-	callback(orgId)
+	callback(ctx, orgId)
 
 	return nil
 }
@@ -223,7 +252,7 @@ func (s *DeviceStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*a
 	device := model.Device{
 		Resource: model.Resource{OrgID: orgId, Name: name},
 	}
-	result := s.db.First(&device)
+	result := s.db.WithContext(ctx).First(&device)
 	if result.Error != nil {
 		return nil, ErrorFromGormError(result.Error)
 	}
@@ -231,17 +260,17 @@ func (s *DeviceStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*a
 	return &apiDevice, nil
 }
 
-func (s *DeviceStore) createDevice(device *model.Device) (bool, error) {
+func (s *DeviceStore) createDevice(ctx context.Context, device *model.Device) (bool, error) {
 	device.Generation = lo.ToPtr[int64](1)
 	device.ResourceVersion = lo.ToPtr[int64](1)
-	if result := s.db.Create(device); result.Error != nil {
+	if result := s.db.WithContext(ctx).Create(device); result.Error != nil {
 		err := ErrorFromGormError(result.Error)
 		return err == flterrors.ErrDuplicateName, err
 	}
 	return false, nil
 }
 
-func (s *DeviceStore) updateDevice(fromAPI bool, existingRecord, device *model.Device, fieldsToUnset []string) (bool, error) {
+func (s *DeviceStore) updateDevice(ctx context.Context, fromAPI bool, existingRecord, device *model.Device, fieldsToUnset []string) (bool, error) {
 	sameSpec := api.DeviceSpecsAreEqual(device.Spec.Data, existingRecord.Spec.Data)
 
 	// Update the generation if the spec was updated
@@ -257,7 +286,7 @@ func (s *DeviceStore) updateDevice(fromAPI bool, existingRecord, device *model.D
 	}
 	device.ResourceVersion = lo.ToPtr(lo.FromPtr(existingRecord.ResourceVersion) + 1)
 	where := model.Device{Resource: model.Resource{OrgID: device.OrgID, Name: device.Name}}
-	query := s.db.Model(where).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion))
+	query := s.db.WithContext(ctx).Model(where).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion))
 
 	selectFields := []string{"spec"}
 	selectFields = append(selectFields, GetNonNilFieldsFromResource(device.Resource)...)
@@ -273,7 +302,7 @@ func (s *DeviceStore) updateDevice(fromAPI bool, existingRecord, device *model.D
 	return false, nil
 }
 
-func (s *DeviceStore) createOrUpdate(orgId uuid.UUID, resource *api.Device, fieldsToUnset []string, fromAPI bool, mode CreateOrUpdateMode, callback DeviceStoreCallback) (*api.Device, bool, bool, error) {
+func (s *DeviceStore) createOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.Device, fieldsToUnset []string, fromAPI bool, mode CreateOrUpdateMode, callback DeviceStoreCallback) (*api.Device, bool, bool, error) {
 	if resource == nil {
 		return nil, false, false, flterrors.ErrResourceIsNil
 	}
@@ -290,7 +319,7 @@ func (s *DeviceStore) createOrUpdate(orgId uuid.UUID, resource *api.Device, fiel
 	// Use the dedicated API to update annotations
 	device.Annotations = nil
 
-	existingRecord, err := getExistingRecord[model.Device](s.db, device.Name, orgId)
+	existingRecord, err := getExistingRecord[model.Device](s.db.WithContext(ctx), device.Name, orgId)
 	if err != nil {
 		return nil, false, false, err
 	}
@@ -305,16 +334,18 @@ func (s *DeviceStore) createOrUpdate(orgId uuid.UUID, resource *api.Device, fiel
 
 	s.IntegrationTestCreateOrUpdateCallback()
 	if !exists {
-		if retry, err := s.createDevice(device); err != nil {
+		if retry, err := s.createDevice(ctx, device); err != nil {
 			return nil, false, retry, err
 		}
 	} else {
-		if retry, err := s.updateDevice(fromAPI, existingRecord, device, fieldsToUnset); err != nil {
+		// Overrides are only updated through the dedicated API
+		device.Overrides = existingRecord.Overrides
+		if retry, err := s.updateDevice(ctx, fromAPI, existingRecord, device, fieldsToUnset); err != nil {
 			return nil, false, retry, err
 		}
 	}
 
-	callback(existingRecord, device)
+	callback(ctx, existingRecord, device)
 
 	updatedResource := device.ToApiResource()
 	return &updatedResource, !exists, false, nil
@@ -322,38 +353,52 @@ func (s *DeviceStore) createOrUpdate(orgId uuid.UUID, resource *api.Device, fiel
 
 func (s *DeviceStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.Device, fieldsToUnset []string, fromAPI bool, callback DeviceStoreCallback) (*api.Device, bool, error) {
 	return retryCreateOrUpdate(func() (*api.Device, bool, bool, error) {
-		return s.createOrUpdate(orgId, resource, fieldsToUnset, fromAPI, ModeCreateOrUpdate, callback)
+		return s.createOrUpdate(ctx, orgId, resource, fieldsToUnset, fromAPI, ModeCreateOrUpdate, callback)
 	})
 }
 
-func (s *DeviceStore) UpdateSummaryStatusBatch(ctx context.Context, orgId uuid.UUID, deviceNames []string, status api.DeviceSummaryStatusType, statusInfo string) error {
-	if len(deviceNames) == 0 {
-		return nil
-	}
-
-	tokens := strings.Repeat("?,", len(deviceNames))
-	// trim tailing comma
-	tokens = tokens[:len(tokens)-1]
-
+// SetUnknownIfNotSeenSince sets the summary status of all devices of the org matching the owner filter
+// that were last seen before lastSeenBefore to Unknown, and returns the names of the devices that
+// transitioned to Unknown.
+func (s *DeviceStore) SetUnknownIfNotSeenSince(ctx context.Context, orgId uuid.UUID, lastSeenBefore time.Time, owners OwnerFilter, statusInfo string) ([]string, error) {
 	// https://www.postgresql.org/docs/current/functions-json.html
 	// jsonb_set(target jsonb, path text[], new_value jsonb, create_missing boolean)
-	createMissing := "false"
-	query := fmt.Sprintf(`
-        UPDATE devices
-        SET 
-            status = jsonb_set(
-                jsonb_set(status, '{summary,status}', '"%s"', %s), 
-                '{summary,info}', '"%s"'
-            ),
-            resource_version = resource_version + 1
-        WHERE name IN (%s)`, status, createMissing, statusInfo, tokens)
-
-	args := make([]interface{}, len(deviceNames))
-	for i, name := range deviceNames {
-		args[i] = name
+	query := `
+		UPDATE devices
+		SET
+			status = jsonb_set(
+				jsonb_set(status, '{summary,status}', to_jsonb(?::text), false),
+				'{summary,info}', to_jsonb(?::text)
+			),
+			resource_version = resource_version + 1
+		WHERE org_id = ?
+			AND status->'summary'->>'status' <> ?
+			AND device_last_seen(status) < ?`
+	args := []interface{}{api.DeviceSummaryStatusUnknown, statusInfo, orgId, api.DeviceSummaryStatusUnknown, lastSeenBefore}
+
+	if len(owners.Owners) > 0 {
+		query += " AND owner = ANY(?)"
+		args = append(args, pq.StringArray(owners.Owners))
+	} else if len(owners.ExcludeOwners) > 0 {
+		query += " AND (owner IS NULL OR owner <> ALL(?))"
+		args = append(args, pq.StringArray(owners.ExcludeOwners))
+	}
+	query += " RETURNING name"
+
+	var names []string
+	if err := s.db.WithContext(ctx).Raw(query, args...).Scan(&names).Error; err != nil {
+		return nil, ErrorFromGormError(err)
 	}
+	return names, nil
+}
 
-	return s.db.WithContext(ctx).Exec(query, args...).Error
+// ListOrgIds returns the IDs of all organizations that have at least one device.
+func (s *DeviceStore) ListOrgIds(ctx context.Context) ([]uuid.UUID, error) {
+	var orgIds []uuid.UUID
+	if err := s.db.WithContext(ctx).Model(&model.Device{}).Distinct("org_id").Pluck("org_id", &orgIds).Error; err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+	return orgIds, nil
 }
 
 func (s *DeviceStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *api.Device) (*api.Device, error) {
@@ -363,20 +408,63 @@ func (s *DeviceStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resourc
 	if resource.Metadata.Name == nil {
 		return nil, flterrors.ErrResourceNameIsNil
 	}
-	device := model.Device{
-		Resource: model.Resource{OrgID: orgId, Name: *resource.Metadata.Name},
+	name := *resource.Metadata.Name
+
+	// Return the summary status that was replaced, to detect devices that reported again after being marked disconnected
+	var previousSummaryStatus []sql.NullString
+	result := s.db.WithContext(ctx).Raw(`
+		UPDATE devices AS d
+		SET status = ?, resource_version = d.resource_version + 1
+		FROM (SELECT org_id, name, status->'summary'->>'status' AS summary_status FROM devices WHERE org_id = ? AND name = ? FOR UPDATE) AS previous
+		WHERE d.org_id = previous.org_id AND d.name = previous.name
+		RETURNING previous.summary_status`, model.MakeJSONField(resource.Status), orgId, name).Scan(&previousSummaryStatus)
+	if result.Error != nil {
+		return resource, ErrorFromGormError(result.Error)
 	}
-	result := s.db.Model(&device).Updates(map[string]interface{}{
-		"status":           model.MakeJSONField(resource.Status),
-		"resource_version": gorm.Expr("resource_version + 1"),
-	})
-	return resource, ErrorFromGormError(result.Error)
+
+	if len(previousSummaryStatus) == 1 && previousSummaryStatus[0].String == string(api.DeviceSummaryStatusUnknown) {
+		err := retryUpdate(func() (bool, error) {
+			return s.clearDisconnectedCondition(ctx, orgId, name)
+		})
+		if err != nil {
+			s.log.Warnf("failed to clear disconnected condition of device %s/%s: %v", orgId, name, err)
+		}
+	}
+	return resource, nil
+}
+
+func (s *DeviceStore) clearDisconnectedCondition(ctx context.Context, orgId uuid.UUID, name string) (retry bool, err error) {
+	existingRecord := model.Device{Resource: model.Resource{OrgID: orgId, Name: name}}
+	result := s.db.WithContext(ctx).First(&existingRecord)
+	if result.Error != nil {
+		return false, ErrorFromGormError(result.Error)
+	}
+	if existingRecord.ServiceConditions == nil || existingRecord.ServiceConditions.Data.Conditions == nil ||
+		!api.IsStatusConditionTrue(*existingRecord.ServiceConditions.Data.Conditions, api.DeviceDisconnected) {
+		return false, nil
+	}
+
+	s.log.Infof("Device %s/%s reported its status again after being disconnected", orgId, name)
+	condition := api.Condition{
+		Type:    api.DeviceDisconnected,
+		Status:  api.ConditionStatusFalse,
+		Reason:  api.DeviceDisconnectedReasonReconnected,
+		Message: "The device reported its status",
+	}
+	retry, err = s.setServiceConditions(ctx, orgId, name, []api.Condition{condition})
+	if err == nil {
+		event := api.NewEvent(model.DeviceKind, name, api.EventTypeNormal, api.EventReasonDeviceReconnected, condition.Message)
+		if err := s.event.Emit(ctx, orgId, event); err != nil {
+			s.log.Warnf("failed to record reconnection of device %s/%s: %v", orgId, name, err)
+		}
+	}
+	return retry, err
 }
 
 func (s *DeviceStore) Delete(ctx context.Context, orgId uuid.UUID, name string, callback DeviceStoreCallback) error {
 	var existingRecord model.Device
 	log := log.WithReqIDFromCtx(ctx, s.log)
-	err := s.db.Transaction(func(innerTx *gorm.DB) (err error) {
+	err := s.db.WithContext(ctx).Transaction(func(innerTx *gorm.DB) (err error) {
 		existingRecord = model.Device{Resource: model.Resource{OrgID: orgId, Name: name}}
 		result := innerTx.First(&existingRecord)
 		if result.Error != nil {
@@ -403,28 +491,33 @@ func (s *DeviceStore) Delete(ctx context.Context, orgId uuid.UUID, name string,
 		return err
 	}
 
-	callback(&existingRecord, nil)
+	callback(ctx, &existingRecord, nil)
 	return nil
 }
 
-func (s *DeviceStore) updateAnnotations(orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) (bool, error) {
+func (s *DeviceStore) updateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) (bool, error) {
 	existingRecord := model.Device{Resource: model.Resource{OrgID: orgId, Name: name}}
-	result := s.db.First(&existingRecord)
+	result := s.db.WithContext(ctx).First(&existingRecord)
 	if result.Error != nil {
 		return false, ErrorFromGormError(result.Error)
 	}
 	existingAnnotations := util.LabelArrayToMap(existingRecord.Annotations)
 
 	existingConsoleAnnotation := util.DefaultIfNotInMap(existingAnnotations, model.DeviceAnnotationConsole, "")
+	existingLogsAnnotation := util.DefaultIfNotInMap(existingAnnotations, model.DeviceAnnotationLogs, "")
+	existingBundleAnnotation := util.DefaultIfNotInMap(existingAnnotations, model.DeviceAnnotationBundle, "")
 	existingAnnotations = util.MergeLabels(existingAnnotations, annotations)
 
 	for _, deleteKey := range deleteKeys {
 		delete(existingAnnotations, deleteKey)
 	}
 	newConsoleAnnotation := util.DefaultIfNotInMap(existingAnnotations, model.DeviceAnnotationConsole, "")
+	newLogsAnnotation := util.DefaultIfNotInMap(existingAnnotations, model.DeviceAnnotationLogs, "")
+	newBundleAnnotation := util.DefaultIfNotInMap(existingAnnotations, model.DeviceAnnotationBundle, "")
 
-	// Changing the console annotation requires bumping the renderedVersion annotation
-	if existingConsoleAnnotation != newConsoleAnnotation {
+	// Changing the console, logs or bundle annotation requires bumping the renderedVersion annotation
+	if existingConsoleAnnotation != newConsoleAnnotation || existingLogsAnnotation != newLogsAnnotation ||
+		existingBundleAnnotation != newBundleAnnotation {
 		nextRenderedVersion, err := getNextRenderedVersion(existingAnnotations)
 		if err != nil {
 			return false, err
@@ -435,7 +528,7 @@ func (s *DeviceStore) updateAnnotations(orgId uuid.UUID, name string, annotation
 
 	annotationsArray := util.LabelMapToArray(&existingAnnotations)
 
-	result = s.db.Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
+	result = s.db.WithContext(ctx).Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
 		"annotations":      pq.StringArray(annotationsArray),
 		"resource_version": gorm.Expr("resource_version + 1"),
 	})
@@ -452,21 +545,21 @@ func (s *DeviceStore) updateAnnotations(orgId uuid.UUID, name string, annotation
 
 func (s *DeviceStore) UpdateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) error {
 	return retryUpdate(func() (bool, error) {
-		return s.updateAnnotations(orgId, name, annotations, deleteKeys)
+		return s.updateAnnotations(ctx, orgId, name, annotations, deleteKeys)
 	})
 }
 
-func (s *DeviceStore) updateRendered(orgId uuid.UUID, name, renderedConfig, renderedApplications string) (retry bool, err error) {
+func (s *DeviceStore) updateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications string) (renderedVersion string, retry bool, err error) {
 	existingRecord := model.Device{Resource: model.Resource{OrgID: orgId, Name: name}}
-	result := s.db.First(&existingRecord)
+	result := s.db.WithContext(ctx).First(&existingRecord)
 	if result.Error != nil {
-		return false, ErrorFromGormError(result.Error)
+		return "", false, ErrorFromGormError(result.Error)
 	}
 	existingAnnotations := util.LabelArrayToMap(existingRecord.Annotations)
 
 	nextRenderedVersion, err := getNextRenderedVersion(existingAnnotations)
 	if err != nil {
-		return false, err
+		return "", false, err
 	}
 
 	existingAnnotations[model.DeviceAnnotationRenderedVersion] = nextRenderedVersion
@@ -477,7 +570,7 @@ func (s *DeviceStore) updateRendered(orgId uuid.UUID, name, renderedConfig, rend
 		renderedApplicationsJSON = "[]"
 	}
 
-	result = s.db.Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
+	result = s.db.WithContext(ctx).Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
 		"annotations":           pq.StringArray(annotationsArray),
 		"rendered_config":       &renderedConfig,
 		"rendered_applications": &renderedApplicationsJSON,
@@ -486,12 +579,12 @@ func (s *DeviceStore) updateRendered(orgId uuid.UUID, name, renderedConfig, rend
 
 	err = ErrorFromGormError(result.Error)
 	if err != nil {
-		return strings.Contains(err.Error(), "deadlock"), err
+		return "", strings.Contains(err.Error(), "deadlock"), err
 	}
 	if result.RowsAffected == 0 {
-		return true, flterrors.ErrNoRowsUpdated
+		return "", true, flterrors.ErrNoRowsUpdated
 	}
-	return false, nil
+	return nextRenderedVersion, false, nil
 }
 
 func getNextRenderedVersion(annotations map[string]string) (string, error) {
@@ -509,17 +602,20 @@ func getNextRenderedVersion(annotations map[string]string) (string, error) {
 	return strconv.FormatInt(currentRenderedVersion, 10), nil
 }
 
-func (s *DeviceStore) UpdateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications string) error {
-	return retryUpdate(func() (bool, error) {
-		return s.updateRendered(orgId, name, renderedConfig, renderedApplications)
+func (s *DeviceStore) UpdateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications string) (string, error) {
+	var renderedVersion string
+	err := retryUpdate(func() (retry bool, err error) {
+		renderedVersion, retry, err = s.updateRendered(ctx, orgId, name, renderedConfig, renderedApplications)
+		return retry, err
 	})
+	return renderedVersion, err
 }
 
 func (s *DeviceStore) GetRendered(ctx context.Context, orgId uuid.UUID, name string, knownRenderedVersion *string, consoleGrpcEndpoint string) (*api.RenderedDeviceSpec, error) {
 	device := model.Device{
 		Resource: model.Resource{OrgID: orgId, Name: name},
 	}
-	result := s.db.First(&device)
+	result := s.db.WithContext(ctx).First(&device)
 	if result.Error != nil {
 		return nil, ErrorFromGormError(result.Error)
 	}
@@ -539,9 +635,23 @@ func (s *DeviceStore) GetRendered(ctx context.Context, orgId uuid.UUID, name str
 		}
 	}
 
-	// if we have a console request we ignore the rendered version
+	var logs *api.DeviceLogsRequest
+	if val, ok := annotations[model.DeviceAnnotationLogs]; ok {
+		logs = &api.DeviceLogsRequest{}
+		if err := json.Unmarshal([]byte(val), logs); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal logs request: %w", err)
+		}
+		logs.GRPCEndpoint = consoleGrpcEndpoint
+	}
+
+	var bundle *api.DeviceBundleRequest
+	if val, ok := annotations[model.DeviceAnnotationBundle]; ok {
+		bundle = &api.DeviceBundleRequest{RequestID: val}
+	}
+
+	// if we have a console or logs request we ignore the rendered version
 	// TODO: bump the rendered version instead?
-	if console == nil && knownRenderedVersion != nil && renderedVersion == *knownRenderedVersion {
+	if console == nil && logs == nil && knownRenderedVersion != nil && renderedVersion == *knownRenderedVersion {
 		return nil, nil
 	}
 
@@ -553,15 +663,104 @@ func (s *DeviceStore) GetRendered(ctx context.Context, orgId uuid.UUID, name str
 		Resources:       device.Spec.Data.Resources,
 		Hooks:           device.Spec.Data.Hooks,
 		Console:         console,
+		Logs:            logs,
+		Bundle:          bundle,
 		Applications:    device.RenderedApplications.Data,
 	}
 
+	if val, ok := annotations[model.DeviceAnnotationOverridden]; ok {
+		renderedConfig.Overridden = &api.DeviceOverriddenItems{}
+		if err := json.Unmarshal([]byte(val), renderedConfig.Overridden); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal overridden items: %w", err)
+		}
+	}
+
 	return &renderedConfig, nil
 }
 
-func (s *DeviceStore) setServiceConditions(orgId uuid.UUID, name string, conditions []api.Condition) (retry bool, err error) {
+func (s *DeviceStore) GetOverrides(ctx context.Context, orgId uuid.UUID, name string) (*api.DeviceOverrides, error) {
+	device := model.Device{
+		Resource: model.Resource{OrgID: orgId, Name: name},
+	}
+	result := s.db.WithContext(ctx).First(&device)
+	if result.Error != nil {
+		return nil, ErrorFromGormError(result.Error)
+	}
+	overrides := device.OverridesToApiResource()
+	return &overrides, nil
+}
+
+// ListOverrides returns the overrides of the named devices by device name, leaving out the devices
+// that do not exist.
+func (s *DeviceStore) ListOverrides(ctx context.Context, orgId uuid.UUID, names []string) (map[string]api.DeviceOverridesSpec, error) {
+	overridesByName := map[string]api.DeviceOverridesSpec{}
+	if len(names) == 0 {
+		return overridesByName, nil
+	}
+	var devices []model.Device
+	result := s.db.WithContext(ctx).Select("name", "overrides").Where("org_id = ? AND name IN ?", orgId, names).Find(&devices)
+	if result.Error != nil {
+		return nil, ErrorFromGormError(result.Error)
+	}
+	for i := range devices {
+		overridesByName[devices[i].Name] = devices[i].OverridesToApiResource().Spec
+	}
+	return overridesByName, nil
+}
+
+func (s *DeviceStore) updateOverrides(ctx context.Context, orgId uuid.UUID, name string, overrides api.DeviceOverridesSpec, callback DeviceStoreCallback) (*model.Device, bool, error) {
+	existingRecord := model.Device{Resource: model.Resource{OrgID: orgId, Name: name}}
+	result := s.db.WithContext(ctx).First(&existingRecord)
+	if result.Error != nil {
+		return nil, false, ErrorFromGormError(result.Error)
+	}
+	// overrides are only merged over the template of the device's fleet, so they would never apply
+	// to other devices. They can still be cleared, in case the device left its fleet.
+	if overrides != (api.DeviceOverridesSpec{}) {
+		if ownerType, _, err := util.GetResourceOwner(existingRecord.Owner); err != nil || ownerType != model.FleetKind {
+			return nil, false, flterrors.ErrOverridesWithoutFleet
+		}
+	}
+
+	updatedRecord := existingRecord
+	updatedRecord.Overrides = model.MakeJSONField(overrides)
+	updatedRecord.ResourceVersion = lo.ToPtr(lo.FromPtr(existingRecord.ResourceVersion) + 1)
+
+	result = s.db.WithContext(ctx).Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
+		"overrides":        updatedRecord.Overrides,
+		"resource_version": gorm.Expr("resource_version + 1"),
+	})
+	err := ErrorFromGormError(result.Error)
+	if err != nil {
+		return nil, strings.Contains(err.Error(), "deadlock"), err
+	}
+	if result.RowsAffected == 0 {
+		return nil, true, flterrors.ErrNoRowsUpdated
+	}
+
+	callback(ctx, &existingRecord, &updatedRecord)
+	return &updatedRecord, false, nil
+}
+
+// UpdateOverrides replaces the overrides of the device, which must be owned by a fleet unless the
+// overrides are empty. The callback is notified so that the overrides are merged over the template
+// of the device's fleet.
+func (s *DeviceStore) UpdateOverrides(ctx context.Context, orgId uuid.UUID, name string, overrides api.DeviceOverridesSpec, callback DeviceStoreCallback) (*api.DeviceOverrides, error) {
+	var device *model.Device
+	err := retryUpdate(func() (retry bool, err error) {
+		device, retry, err = s.updateOverrides(ctx, orgId, name, overrides, callback)
+		return retry, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	updatedOverrides := device.OverridesToApiResource()
+	return &updatedOverrides, nil
+}
+
+func (s *DeviceStore) setServiceConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []api.Condition) (retry bool, err error) {
 	existingRecord := model.Device{Resource: model.Resource{OrgID: orgId, Name: name}}
-	result := s.db.First(&existingRecord)
+	result := s.db.WithContext(ctx).First(&existingRecord)
 	if result.Error != nil {
 		return false, ErrorFromGormError(result.Error)
 	}
@@ -577,7 +776,7 @@ func (s *DeviceStore) setServiceConditions(orgId uuid.UUID, name string, conditi
 		api.SetStatusCondition(existingRecord.ServiceConditions.Data.Conditions, condition)
 	}
 
-	result = s.db.Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
+	result = s.db.WithContext(ctx).Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
 		"service_conditions": existingRecord.ServiceConditions,
 		"resource_version":   gorm.Expr("resource_version + 1"),
 	})
@@ -593,7 +792,7 @@ func (s *DeviceStore) setServiceConditions(orgId uuid.UUID, name string, conditi
 
 func (s *DeviceStore) SetServiceConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []api.Condition) error {
 	return retryUpdate(func() (bool, error) {
-		return s.setServiceConditions(orgId, name, conditions)
+		return s.setServiceConditions(ctx, orgId, name, conditions)
 	})
 }
 
@@ -602,7 +801,7 @@ func (s *DeviceStore) OverwriteRepositoryRefs(ctx context.Context, orgId uuid.UU
 	for _, repoName := range repositoryNames {
 		repos = append(repos, model.Repository{Resource: model.Resource{OrgID: orgId, Name: repoName}})
 	}
-	return s.db.Transaction(func(innerTx *gorm.DB) error {
+	return s.db.WithContext(ctx).Transaction(func(innerTx *gorm.DB) error {
 		device := model.Device{Resource: model.Resource{OrgID: orgId, Name: name}}
 		if err := innerTx.Model(&device).Association("Repositories").Replace(repos); err != nil {
 			return ErrorFromGormError(err)
@@ -614,7 +813,7 @@ func (s *DeviceStore) OverwriteRepositoryRefs(ctx context.Context, orgId uuid.UU
 func (s *DeviceStore) GetRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string) (*api.RepositoryList, error) {
 	device := model.Device{Resource: model.Resource{OrgID: orgId, Name: name}}
 	var repos model.RepositoryList
-	err := s.db.Model(&device).Association("Repositories").Find(&repos)
+	err := s.db.WithContext(ctx).Model(&device).Association("Repositories").Find(&repos)
 	if err != nil {
 		return nil, ErrorFromGormError(err)
 	}
@@ -0,0 +1,78 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type DeviceBundle interface {
+	Request(ctx context.Context, orgId uuid.UUID, deviceName string, requestID string) (*model.DeviceBundle, error)
+	Upload(ctx context.Context, orgId uuid.UUID, deviceName string, requestID string, data []byte) error
+	Get(ctx context.Context, orgId uuid.UUID, deviceName string) (*model.DeviceBundle, error)
+	InitialMigration() error
+}
+
+type DeviceBundleStore struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+// Make sure we conform to DeviceBundle interface
+var _ DeviceBundle = (*DeviceBundleStore)(nil)
+
+func NewDeviceBundle(db *gorm.DB, log logrus.FieldLogger) DeviceBundle {
+	return &DeviceBundleStore{db: db, log: log}
+}
+
+func (s *DeviceBundleStore) InitialMigration() error {
+	return s.db.AutoMigrate(&model.DeviceBundle{})
+}
+
+// Request records a new bundle request for the device, replacing the bundle
+// previously requested from it.
+func (s *DeviceBundleStore) Request(ctx context.Context, orgId uuid.UUID, deviceName string, requestID string) (*model.DeviceBundle, error) {
+	bundle := model.DeviceBundle{
+		OrgID:       orgId,
+		DeviceName:  deviceName,
+		RequestID:   requestID,
+		RequestedAt: time.Now().UTC(),
+	}
+	result := s.db.WithContext(ctx).Omit("Device").Save(&bundle)
+	if result.Error != nil {
+		return nil, ErrorFromGormError(result.Error)
+	}
+	return &bundle, nil
+}
+
+// Upload stores the bundle uploaded by the device for the given request. It
+// returns ErrResourceNotFound if the request is not the device's latest one.
+func (s *DeviceBundleStore) Upload(ctx context.Context, orgId uuid.UUID, deviceName string, requestID string, data []byte) error {
+	result := s.db.WithContext(ctx).Model(&model.DeviceBundle{}).
+		Where("org_id = ? AND device_name = ? AND request_id = ?", orgId, deviceName, requestID).
+		Updates(map[string]interface{}{
+			"uploaded_at": time.Now().UTC(),
+			"data":        data,
+		})
+	if result.Error != nil {
+		return ErrorFromGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return flterrors.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (s *DeviceBundleStore) Get(ctx context.Context, orgId uuid.UUID, deviceName string) (*model.DeviceBundle, error) {
+	bundle := model.DeviceBundle{OrgID: orgId, DeviceName: deviceName}
+	result := s.db.WithContext(ctx).First(&bundle)
+	if result.Error != nil {
+		return nil, ErrorFromGormError(result.Error)
+	}
+	return &bundle, nil
+}
@@ -0,0 +1,300 @@
+package store
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type EnrollmentPolicy interface {
+	Create(ctx context.Context, orgId uuid.UUID, policy *api.EnrollmentPolicy) (*api.EnrollmentPolicy, error)
+	Update(ctx context.Context, orgId uuid.UUID, policy *api.EnrollmentPolicy) (*api.EnrollmentPolicy, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.EnrollmentPolicyList, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*api.EnrollmentPolicy, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, policy *api.EnrollmentPolicy) (*api.EnrollmentPolicy, bool, error)
+	RecordApproval(ctx context.Context, orgId uuid.UUID, name string, approvedAt time.Time) error
+	RevertApproval(ctx context.Context, orgId uuid.UUID, name string) error
+	DeleteAll(ctx context.Context, orgId uuid.UUID) error
+	Delete(ctx context.Context, orgId uuid.UUID, name string) error
+	InitialMigration() error
+}
+
+type EnrollmentPolicyStore struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+// Make sure we conform to EnrollmentPolicy interface
+var _ EnrollmentPolicy = (*EnrollmentPolicyStore)(nil)
+
+func NewEnrollmentPolicy(db *gorm.DB, log logrus.FieldLogger) EnrollmentPolicy {
+	return &EnrollmentPolicyStore{db: db, log: log}
+}
+
+func (s *EnrollmentPolicyStore) InitialMigration() error {
+	return s.db.AutoMigrate(&model.EnrollmentPolicy{})
+}
+
+func (s *EnrollmentPolicyStore) Create(ctx context.Context, orgId uuid.UUID, resource *api.EnrollmentPolicy) (*api.EnrollmentPolicy, error) {
+	updatedResource, _, _, err := s.createOrUpdate(ctx, orgId, resource, ModeCreateOnly)
+	return updatedResource, err
+}
+
+func (s *EnrollmentPolicyStore) Update(ctx context.Context, orgId uuid.UUID, resource *api.EnrollmentPolicy) (*api.EnrollmentPolicy, error) {
+	updatedResource, _, err := retryCreateOrUpdate(func() (*api.EnrollmentPolicy, bool, bool, error) {
+		return s.createOrUpdate(ctx, orgId, resource, ModeUpdateOnly)
+	})
+	return updatedResource, err
+}
+
+func (s *EnrollmentPolicyStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.EnrollmentPolicyList, error) {
+	var enrollmentPolicies model.EnrollmentPolicyList
+	var nextContinue *string
+	var numRemaining *int64
+
+	if listParams.Limit < 0 {
+		return nil, flterrors.ErrLimitParamOutOfBounds
+	}
+
+	query, err := ListQuery(&enrollmentPolicies).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if listParams.Limit > 0 {
+		// Request 1 more than the user asked for to see if we need to return "continue"
+		query = AddPaginationToQuery(query, listParams.Limit+1, listParams.Continue)
+	}
+	result := query.Find(&enrollmentPolicies)
+
+	// If we got more than the user requested, remove one record and calculate "continue"
+	if listParams.Limit > 0 && len(enrollmentPolicies) > listParams.Limit {
+		nextContinueStruct := Continue{
+			Name:    enrollmentPolicies[len(enrollmentPolicies)-1].Name,
+			Version: CurrentContinueVersion,
+		}
+		enrollmentPolicies = enrollmentPolicies[:len(enrollmentPolicies)-1]
+
+		var numRemainingVal int64
+		if listParams.Continue != nil {
+			numRemainingVal = listParams.Continue.Count - int64(listParams.Limit)
+			if numRemainingVal < 1 {
+				numRemainingVal = 1
+			}
+		} else {
+			countQuery, err := ListQuery(&enrollmentPolicies).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
+			if err != nil {
+				return nil, err
+			}
+			numRemainingVal = CountRemainingItems(countQuery, nextContinueStruct.Name)
+		}
+		nextContinueStruct.Count = numRemainingVal
+		contByte, _ := json.Marshal(nextContinueStruct)
+		contStr := b64.StdEncoding.EncodeToString(contByte)
+		nextContinue = &contStr
+		numRemaining = &numRemainingVal
+	}
+
+	apiEnrollmentPolicyList := enrollmentPolicies.ToApiResource(nextContinue, numRemaining)
+	return &apiEnrollmentPolicyList, ErrorFromGormError(result.Error)
+}
+
+func (s *EnrollmentPolicyStore) DeleteAll(ctx context.Context, orgId uuid.UUID) error {
+	condition := model.EnrollmentPolicy{}
+	result := s.db.WithContext(ctx).Unscoped().Where("org_id = ?", orgId).Delete(&condition)
+	return ErrorFromGormError(result.Error)
+}
+
+func (s *EnrollmentPolicyStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*api.EnrollmentPolicy, error) {
+	enrollmentPolicy := model.EnrollmentPolicy{
+		Resource: model.Resource{OrgID: orgId, Name: name},
+	}
+	result := s.db.WithContext(ctx).First(&enrollmentPolicy)
+	if result.Error != nil {
+		return nil, ErrorFromGormError(result.Error)
+	}
+	apiEnrollmentPolicy := enrollmentPolicy.ToApiResource()
+	return &apiEnrollmentPolicy, nil
+}
+
+func (s *EnrollmentPolicyStore) createEnrollmentPolicy(ctx context.Context, enrollmentPolicy *model.EnrollmentPolicy) (bool, error) {
+	enrollmentPolicy.Generation = lo.ToPtr[int64](1)
+	enrollmentPolicy.ResourceVersion = lo.ToPtr[int64](1)
+	if result := s.db.WithContext(ctx).Create(enrollmentPolicy); result.Error != nil {
+		err := ErrorFromGormError(result.Error)
+		return err == flterrors.ErrDuplicateName, err
+	}
+	return false, nil
+}
+
+func (s *EnrollmentPolicyStore) updateEnrollmentPolicy(ctx context.Context, existingRecord, enrollmentPolicy *model.EnrollmentPolicy) (bool, error) {
+	updateSpec := enrollmentPolicy.Spec != nil && !reflect.DeepEqual(existingRecord.Spec, enrollmentPolicy.Spec)
+
+	// Update the generation if the spec was updated
+	if updateSpec {
+		enrollmentPolicy.Generation = lo.ToPtr(lo.FromPtr(existingRecord.Generation) + 1)
+	}
+	if enrollmentPolicy.ResourceVersion != nil && lo.FromPtr(existingRecord.ResourceVersion) != lo.FromPtr(enrollmentPolicy.ResourceVersion) {
+		return false, flterrors.ErrResourceVersionConflict
+	}
+	enrollmentPolicy.ResourceVersion = lo.ToPtr(lo.FromPtr(existingRecord.ResourceVersion) + 1)
+	where := model.EnrollmentPolicy{Resource: model.Resource{OrgID: enrollmentPolicy.OrgID, Name: enrollmentPolicy.Name}}
+	query := s.db.WithContext(ctx).Model(where).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion))
+
+	result := query.Updates(&enrollmentPolicy)
+	if result.Error != nil {
+		return false, ErrorFromGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return true, flterrors.ErrNoRowsUpdated
+	}
+	return false, nil
+}
+
+func (s *EnrollmentPolicyStore) createOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.EnrollmentPolicy, mode CreateOrUpdateMode) (*api.EnrollmentPolicy, bool, bool, error) {
+	if resource == nil {
+		return nil, false, false, flterrors.ErrResourceIsNil
+	}
+	if resource.Metadata.Name == nil {
+		return nil, false, false, flterrors.ErrResourceNameIsNil
+	}
+
+	enrollmentPolicy, err := model.NewEnrollmentPolicyFromApiResource(resource)
+	if err != nil {
+		return nil, false, false, err
+	}
+	enrollmentPolicy.OrgID = orgId
+	// The status is only updated when requests are approved
+	enrollmentPolicy.Status = nil
+
+	existingRecord, err := getExistingRecord[model.EnrollmentPolicy](s.db.WithContext(ctx), enrollmentPolicy.Name, orgId)
+	if err != nil {
+		return nil, false, false, err
+	}
+	exists := existingRecord != nil
+
+	if exists && mode == ModeCreateOnly {
+		return nil, false, false, flterrors.ErrDuplicateName
+	}
+	if !exists && mode == ModeUpdateOnly {
+		return nil, false, false, flterrors.ErrResourceNotFound
+	}
+
+	if !exists {
+		enrollmentPolicy.Status = model.MakeJSONField(api.EnrollmentPolicyStatus{})
+		if retry, err := s.createEnrollmentPolicy(ctx, enrollmentPolicy); err != nil {
+			return nil, false, retry, err
+		}
+	} else {
+		if retry, err := s.updateEnrollmentPolicy(ctx, existingRecord, enrollmentPolicy); err != nil {
+			return nil, false, retry, err
+		}
+		enrollmentPolicy.Status = existingRecord.Status
+	}
+
+	updatedResource := enrollmentPolicy.ToApiResource()
+	return &updatedResource, !exists, false, nil
+}
+
+func (s *EnrollmentPolicyStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.EnrollmentPolicy) (*api.EnrollmentPolicy, bool, error) {
+	return retryCreateOrUpdate(func() (*api.EnrollmentPolicy, bool, bool, error) {
+		return s.createOrUpdate(ctx, orgId, resource, ModeCreateOrUpdate)
+	})
+}
+
+func (s *EnrollmentPolicyStore) recordApproval(ctx context.Context, orgId uuid.UUID, name string, approvedAt time.Time) (bool, error) {
+	existingRecord := model.EnrollmentPolicy{Resource: model.Resource{OrgID: orgId, Name: name}}
+	result := s.db.WithContext(ctx).First(&existingRecord)
+	if result.Error != nil {
+		return false, ErrorFromGormError(result.Error)
+	}
+	var status api.EnrollmentPolicyStatus
+	if existingRecord.Status != nil {
+		status = existingRecord.Status.Data
+	}
+	if existingRecord.Spec != nil && existingRecord.Spec.Data.MaxApprovals != nil &&
+		status.ApprovedCount >= *existingRecord.Spec.Data.MaxApprovals {
+		return false, flterrors.ErrMaxApprovalsReached
+	}
+	status.ApprovedCount++
+	status.LastApprovedAt = &approvedAt
+
+	result = s.db.WithContext(ctx).Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
+		"status":           model.MakeJSONField(status),
+		"resource_version": gorm.Expr("resource_version + 1"),
+	})
+	err := ErrorFromGormError(result.Error)
+	if err != nil {
+		return strings.Contains(err.Error(), "deadlock"), err
+	}
+	if result.RowsAffected == 0 {
+		return true, flterrors.ErrNoRowsUpdated
+	}
+	return false, nil
+}
+
+// RecordApproval counts a request approved by the policy. It fails with
+// ErrMaxApprovalsReached, without counting the request, if the policy already
+// approved its maximum number of requests.
+func (s *EnrollmentPolicyStore) RecordApproval(ctx context.Context, orgId uuid.UUID, name string, approvedAt time.Time) error {
+	return retryUpdate(func() (bool, error) {
+		return s.recordApproval(ctx, orgId, name, approvedAt)
+	})
+}
+
+func (s *EnrollmentPolicyStore) revertApproval(ctx context.Context, orgId uuid.UUID, name string) (bool, error) {
+	existingRecord := model.EnrollmentPolicy{Resource: model.Resource{OrgID: orgId, Name: name}}
+	result := s.db.WithContext(ctx).First(&existingRecord)
+	if result.Error != nil {
+		return false, ErrorFromGormError(result.Error)
+	}
+	if existingRecord.Status == nil || existingRecord.Status.Data.ApprovedCount == 0 {
+		return false, nil
+	}
+	status := existingRecord.Status.Data
+	status.ApprovedCount--
+
+	result = s.db.WithContext(ctx).Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
+		"status":           model.MakeJSONField(status),
+		"resource_version": gorm.Expr("resource_version + 1"),
+	})
+	err := ErrorFromGormError(result.Error)
+	if err != nil {
+		return strings.Contains(err.Error(), "deadlock"), err
+	}
+	if result.RowsAffected == 0 {
+		return true, flterrors.ErrNoRowsUpdated
+	}
+	return false, nil
+}
+
+// RevertApproval uncounts a request counted by RecordApproval, for an approval
+// that could not be completed.
+func (s *EnrollmentPolicyStore) RevertApproval(ctx context.Context, orgId uuid.UUID, name string) error {
+	return retryUpdate(func() (bool, error) {
+		return s.revertApproval(ctx, orgId, name)
+	})
+}
+
+func (s *EnrollmentPolicyStore) Delete(ctx context.Context, orgId uuid.UUID, name string) error {
+	condition := model.EnrollmentPolicy{
+		Resource: model.Resource{OrgID: orgId, Name: name},
+	}
+	result := s.db.WithContext(ctx).Unscoped().Delete(&condition)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	return ErrorFromGormError(result.Error)
+}
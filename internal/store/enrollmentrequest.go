@@ -52,7 +52,7 @@ func (s *EnrollmentRequestStore) Create(ctx context.Context, orgId uuid.UUID, re
 		return nil, err
 	}
 	enrollmentrequest.OrgID = orgId
-	_, err = s.createEnrollmentRequest(enrollmentrequest)
+	_, err = s.createEnrollmentRequest(ctx, enrollmentrequest)
 	return resource, err
 }
 
@@ -65,7 +65,7 @@ func (s *EnrollmentRequestStore) List(ctx context.Context, orgId uuid.UUID, list
 		return nil, flterrors.ErrLimitParamOutOfBounds
 	}
 
-	query, err := ListQuery(&enrollmentRequests).Build(ctx, s.db, orgId, listParams)
+	query, err := ListQuery(&enrollmentRequests).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +91,7 @@ func (s *EnrollmentRequestStore) List(ctx context.Context, orgId uuid.UUID, list
 				numRemainingVal = 1
 			}
 		} else {
-			countQuery, err := ListQuery(&enrollmentRequests).Build(ctx, s.db, orgId, listParams)
+			countQuery, err := ListQuery(&enrollmentRequests).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 			if err != nil {
 				return nil, err
 			}
@@ -110,7 +110,7 @@ func (s *EnrollmentRequestStore) List(ctx context.Context, orgId uuid.UUID, list
 
 func (s *EnrollmentRequestStore) DeleteAll(ctx context.Context, orgId uuid.UUID) error {
 	condition := model.EnrollmentRequest{}
-	result := s.db.Unscoped().Where("org_id = ?", orgId).Delete(&condition)
+	result := s.db.WithContext(ctx).Unscoped().Where("org_id = ?", orgId).Delete(&condition)
 	return ErrorFromGormError(result.Error)
 }
 
@@ -118,7 +118,7 @@ func (s *EnrollmentRequestStore) Get(ctx context.Context, orgId uuid.UUID, name
 	enrollmentRequest := model.EnrollmentRequest{
 		Resource: model.Resource{OrgID: orgId, Name: name},
 	}
-	result := s.db.First(&enrollmentRequest)
+	result := s.db.WithContext(ctx).First(&enrollmentRequest)
 	if result.Error != nil {
 		return nil, ErrorFromGormError(result.Error)
 	}
@@ -126,17 +126,17 @@ func (s *EnrollmentRequestStore) Get(ctx context.Context, orgId uuid.UUID, name
 	return &apiEnrollmentRequest, nil
 }
 
-func (s *EnrollmentRequestStore) createEnrollmentRequest(enrollmentRequest *model.EnrollmentRequest) (bool, error) {
+func (s *EnrollmentRequestStore) createEnrollmentRequest(ctx context.Context, enrollmentRequest *model.EnrollmentRequest) (bool, error) {
 	enrollmentRequest.Generation = lo.ToPtr[int64](1)
 	enrollmentRequest.ResourceVersion = lo.ToPtr[int64](1)
-	if result := s.db.Create(enrollmentRequest); result.Error != nil {
+	if result := s.db.WithContext(ctx).Create(enrollmentRequest); result.Error != nil {
 		err := ErrorFromGormError(result.Error)
 		return err == flterrors.ErrDuplicateName, err
 	}
 	return false, nil
 }
 
-func (s *EnrollmentRequestStore) updateEnrollmentRequest(existingRecord, enrollmentRequest *model.EnrollmentRequest) (bool, error) {
+func (s *EnrollmentRequestStore) updateEnrollmentRequest(ctx context.Context, existingRecord, enrollmentRequest *model.EnrollmentRequest) (bool, error) {
 	updateSpec := enrollmentRequest.Spec != nil && !reflect.DeepEqual(existingRecord.Spec, enrollmentRequest.Spec)
 
 	// Update the generation if the spec was updated
@@ -148,7 +148,7 @@ func (s *EnrollmentRequestStore) updateEnrollmentRequest(existingRecord, enrollm
 	}
 	enrollmentRequest.ResourceVersion = lo.ToPtr(lo.FromPtr(existingRecord.ResourceVersion) + 1)
 	where := model.EnrollmentRequest{Resource: model.Resource{OrgID: enrollmentRequest.OrgID, Name: enrollmentRequest.Name}}
-	query := s.db.Model(where).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion))
+	query := s.db.WithContext(ctx).Model(where).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion))
 
 	result := query.Updates(&enrollmentRequest)
 	if result.Error != nil {
@@ -160,7 +160,7 @@ func (s *EnrollmentRequestStore) updateEnrollmentRequest(existingRecord, enrollm
 	return false, nil
 }
 
-func (s *EnrollmentRequestStore) createOrUpdate(orgId uuid.UUID, resource *api.EnrollmentRequest) (*api.EnrollmentRequest, bool, bool, error) {
+func (s *EnrollmentRequestStore) createOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.EnrollmentRequest) (*api.EnrollmentRequest, bool, bool, error) {
 	if resource == nil {
 		return nil, false, false, flterrors.ErrResourceIsNil
 	}
@@ -175,17 +175,17 @@ func (s *EnrollmentRequestStore) createOrUpdate(orgId uuid.UUID, resource *api.E
 	enrollmentrequest.OrgID = orgId
 	enrollmentrequest.Status = nil
 
-	existingRecord, err := getExistingRecord[model.EnrollmentRequest](s.db, enrollmentrequest.Name, orgId)
+	existingRecord, err := getExistingRecord[model.EnrollmentRequest](s.db.WithContext(ctx), enrollmentrequest.Name, orgId)
 	if err != nil {
 		return nil, false, false, err
 	}
 	exists := existingRecord != nil
 	if !exists {
-		if retry, err := s.createEnrollmentRequest(enrollmentrequest); err != nil {
+		if retry, err := s.createEnrollmentRequest(ctx, enrollmentrequest); err != nil {
 			return nil, false, retry, err
 		}
 	} else {
-		if retry, err := s.updateEnrollmentRequest(existingRecord, enrollmentrequest); err != nil {
+		if retry, err := s.updateEnrollmentRequest(ctx, existingRecord, enrollmentrequest); err != nil {
 			return nil, false, retry, err
 		}
 	}
@@ -196,7 +196,7 @@ func (s *EnrollmentRequestStore) createOrUpdate(orgId uuid.UUID, resource *api.E
 
 func (s *EnrollmentRequestStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.EnrollmentRequest) (*api.EnrollmentRequest, bool, error) {
 	return retryCreateOrUpdate(func() (*api.EnrollmentRequest, bool, bool, error) {
-		return s.createOrUpdate(orgId, resource)
+		return s.createOrUpdate(ctx, orgId, resource)
 	})
 }
 
@@ -210,7 +210,7 @@ func (s *EnrollmentRequestStore) UpdateStatus(ctx context.Context, orgId uuid.UU
 	enrollmentRequest := model.EnrollmentRequest{
 		Resource: model.Resource{OrgID: orgId, Name: *resource.Metadata.Name},
 	}
-	result := s.db.Model(&enrollmentRequest).Updates(map[string]interface{}{
+	result := s.db.WithContext(ctx).Model(&enrollmentRequest).Updates(map[string]interface{}{
 		"status":           model.MakeJSONField(resource.Status),
 		"resource_version": gorm.Expr("resource_version + 1"),
 	})
@@ -221,7 +221,7 @@ func (s *EnrollmentRequestStore) Delete(ctx context.Context, orgId uuid.UUID, na
 	condition := model.EnrollmentRequest{
 		Resource: model.Resource{OrgID: orgId, Name: name},
 	}
-	result := s.db.Unscoped().Delete(&condition)
+	result := s.db.WithContext(ctx).Unscoped().Delete(&condition)
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return nil
 	}
@@ -0,0 +1,137 @@
+package store
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type Event interface {
+	Emit(ctx context.Context, orgId uuid.UUID, event *api.Event) error
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.EventList, error)
+	DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error)
+	InitialMigration() error
+}
+
+type EventStore struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+// Make sure we conform to Event interface
+var _ Event = (*EventStore)(nil)
+
+func NewEvent(db *gorm.DB, log logrus.FieldLogger) Event {
+	return &EventStore{db: db, log: log}
+}
+
+func (s *EventStore) InitialMigration() error {
+	return s.db.AutoMigrate(&model.Event{})
+}
+
+// Emit records the event. If the latest event of the involved object is the
+// same, its count and timestamp are updated instead, so that repeated
+// occurrences don't flood the history of the object.
+func (s *EventStore) Emit(ctx context.Context, orgId uuid.UUID, resource *api.Event) error {
+	if resource == nil {
+		return flterrors.ErrResourceIsNil
+	}
+	event := model.NewEventFromApiResource(resource)
+	event.OrgID = orgId
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(innerTx *gorm.DB) error {
+		var latest model.EventList
+		result := innerTx.Where("org_id = ? AND involved_object_kind = ? AND involved_object_name = ?", orgId, event.InvolvedObjectKind, event.InvolvedObjectName).
+			Order("timestamp DESC").Limit(1).Find(&latest)
+		if result.Error != nil {
+			return ErrorFromGormError(result.Error)
+		}
+		if len(latest) > 0 && latest[0].Type == event.Type && latest[0].Reason == event.Reason && latest[0].Message == event.Message {
+			result = innerTx.Model(&latest[0]).Updates(map[string]interface{}{
+				"count":     gorm.Expr("count + 1"),
+				"timestamp": event.Timestamp,
+			})
+			return ErrorFromGormError(result.Error)
+		}
+
+		// names sort by the time of the event within the involved object
+		event.Name = fmt.Sprintf("%s.%x", event.InvolvedObjectName, event.Timestamp.UnixNano())
+		event.Count = 1
+		return ErrorFromGormError(innerTx.Create(event).Error)
+	})
+}
+
+func (s *EventStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.EventList, error) {
+	var events model.EventList
+	var nextContinue *string
+	var numRemaining *int64
+
+	if listParams.Limit < 0 {
+		return nil, flterrors.ErrLimitParamOutOfBounds
+	}
+
+	query, err := ListQuery(&events).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
+	if err != nil {
+		return nil, err
+	}
+	if listParams.SortBy == nil {
+		// the events of an object are named after the time they occurred
+		query = query.Order("name")
+	}
+
+	if listParams.Limit > 0 {
+		// Request 1 more than the user asked for to see if we need to return "continue"
+		query = AddPaginationToQuery(query, listParams.Limit+1, listParams.Continue)
+	}
+	result := query.Find(&events)
+
+	// If we got more than the user requested, remove one record and calculate "continue"
+	if listParams.Limit > 0 && len(events) > listParams.Limit {
+		nextContinueStruct := Continue{
+			Name:    events[len(events)-1].Name,
+			Version: CurrentContinueVersion,
+		}
+		events = events[:len(events)-1]
+
+		var numRemainingVal int64
+		if listParams.Continue != nil {
+			numRemainingVal = listParams.Continue.Count - int64(listParams.Limit)
+			if numRemainingVal < 1 {
+				numRemainingVal = 1
+			}
+		} else {
+			countQuery, err := ListQuery(&events).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
+			if err != nil {
+				return nil, err
+			}
+			numRemainingVal = CountRemainingItems(countQuery, nextContinueStruct.Name)
+		}
+		nextContinueStruct.Count = numRemainingVal
+		contByte, _ := json.Marshal(nextContinueStruct)
+		contStr := b64.StdEncoding.EncodeToString(contByte)
+		nextContinue = &contStr
+		numRemaining = &numRemainingVal
+	}
+
+	apiEventList := events.ToApiResource(nextContinue, numRemaining)
+	return &apiEventList, ErrorFromGormError(result.Error)
+}
+
+// DeleteOlderThan deletes the events of all orgs that last occurred before
+// the cutoff, returning the number of deleted events.
+func (s *EventStore) DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := s.db.WithContext(ctx).Where("timestamp < ?", cutoff).Delete(&model.Event{})
+	return result.RowsAffected, ErrorFromGormError(result.Error)
+}
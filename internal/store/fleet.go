@@ -45,8 +45,8 @@ type FleetStore struct {
 	log logrus.FieldLogger
 }
 
-type FleetStoreCallback func(before *model.Fleet, after *model.Fleet)
-type FleetStoreAllDeletedCallback func(orgId uuid.UUID)
+type FleetStoreCallback func(ctx context.Context, before *model.Fleet, after *model.Fleet)
+type FleetStoreAllDeletedCallback func(ctx context.Context, orgId uuid.UUID)
 
 // Make sure we conform to Fleet interface
 var _ Fleet = (*FleetStore)(nil)
@@ -60,13 +60,13 @@ func (s *FleetStore) InitialMigration() error {
 }
 
 func (s *FleetStore) Create(ctx context.Context, orgId uuid.UUID, resource *api.Fleet, callback FleetStoreCallback) (*api.Fleet, error) {
-	updatedResource, _, _, err := s.createOrUpdate(orgId, resource, ModeCreateOnly, callback)
+	updatedResource, _, _, err := s.createOrUpdate(ctx, orgId, resource, ModeCreateOnly, callback)
 	return updatedResource, err
 }
 
 func (s *FleetStore) Update(ctx context.Context, orgId uuid.UUID, resource *api.Fleet, callback FleetStoreCallback) (*api.Fleet, error) {
 	updatedResource, _, err := retryCreateOrUpdate(func() (*api.Fleet, bool, bool, error) {
-		return s.createOrUpdate(orgId, resource, ModeUpdateOnly, callback)
+		return s.createOrUpdate(ctx, orgId, resource, ModeUpdateOnly, callback)
 	})
 	return updatedResource, err
 }
@@ -105,7 +105,7 @@ func (s *FleetStore) List(ctx context.Context, orgId uuid.UUID, listParams ListP
 	}
 
 	lo.ForEach(opts, func(opt ListOption, _ int) { opt(&options) })
-	query, err := ListQuery(&model.Fleet{}).Build(ctx, s.db, orgId, listParams)
+	query, err := ListQuery(&model.Fleet{}).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +132,7 @@ func (s *FleetStore) List(ctx context.Context, orgId uuid.UUID, listParams ListP
 				numRemainingVal = 1
 			}
 		} else {
-			countQuery, err := ListQuery(&model.Fleet{}).Build(ctx, s.db, orgId, listParams)
+			countQuery, err := ListQuery(&model.Fleet{}).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 			if err != nil {
 				return nil, err
 			}
@@ -171,9 +171,9 @@ func (s *FleetStore) ListIgnoreOrg() ([]model.Fleet, error) {
 
 func (s *FleetStore) DeleteAll(ctx context.Context, orgId uuid.UUID, callback FleetStoreAllDeletedCallback) error {
 	condition := model.Fleet{}
-	result := s.db.Unscoped().Where("org_id = ?", orgId).Delete(&condition)
+	result := s.db.WithContext(ctx).Unscoped().Where("org_id = ?", orgId).Delete(&condition)
 	if result.Error == nil {
-		callback(orgId)
+		callback(ctx, orgId)
 	}
 	return ErrorFromGormError(result.Error)
 }
@@ -197,7 +197,7 @@ func (s *FleetStore) Get(ctx context.Context, orgId uuid.UUID, name string, opts
 	}
 
 	var fleet fleetWithCount
-	result := s.db.Table("fleets").Where("org_id = ? and name = ?", orgId, name).
+	result := s.db.WithContext(ctx).Table("fleets").Where("org_id = ? and name = ?", orgId, name).
 		Select(fleetSelectStr(true)).
 		Scan(&fleet)
 	if result.Error != nil {
@@ -210,7 +210,7 @@ func (s *FleetStore) Get(ctx context.Context, orgId uuid.UUID, name string, opts
 		Total: fleet.DeviceCount,
 	}
 	if options.withSummary {
-		deviceQuery, err := ListQuery(&model.Device{}).Build(ctx, s.db, orgId,
+		deviceQuery, err := ListQuery(&model.Device{}).Build(ctx, s.db.WithContext(ctx), orgId,
 			ListParams{Owners: []string{*util.SetResourceOwner(model.FleetKind, name)}})
 		if err != nil {
 			return nil, err
@@ -238,21 +238,21 @@ func (s *FleetStore) Get(ctx context.Context, orgId uuid.UUID, name string, opts
 	return &apiFleet, nil
 }
 
-func (s *FleetStore) createFleet(fleet *model.Fleet) (bool, error) {
+func (s *FleetStore) createFleet(ctx context.Context, fleet *model.Fleet) (bool, error) {
 	if fleet.Spec.Data.Template.Metadata == nil {
 		fleet.Spec.Data.Template.Metadata = &api.ObjectMeta{}
 	}
 	fleet.Spec.Data.Template.Metadata.Generation = lo.ToPtr[int64](1)
 	fleet.Generation = lo.ToPtr[int64](1)
 	fleet.ResourceVersion = lo.ToPtr[int64](1)
-	if result := s.db.Create(fleet); result.Error != nil {
+	if result := s.db.WithContext(ctx).Create(fleet); result.Error != nil {
 		err := ErrorFromGormError(result.Error)
 		return err == flterrors.ErrDuplicateName, err
 	}
 	return false, nil
 }
 
-func (s *FleetStore) updateFleet(existingRecord, fleet *model.Fleet) (bool, error) {
+func (s *FleetStore) updateFleet(ctx context.Context, existingRecord, fleet *model.Fleet) (bool, error) {
 	if existingRecord.Owner != nil && *existingRecord.Owner != lo.FromPtr(fleet.Owner) {
 		return false, flterrors.ErrUpdatingResourceWithOwnerNotAllowed
 	}
@@ -277,7 +277,7 @@ func (s *FleetStore) updateFleet(existingRecord, fleet *model.Fleet) (bool, erro
 
 	fleet.ResourceVersion = lo.ToPtr(lo.FromPtr(existingRecord.ResourceVersion) + 1)
 
-	query := s.db.Model(&model.Fleet{}).Where("org_id = ? and name = ? and resource_version = ?", fleet.OrgID, fleet.Name, lo.FromPtr(existingRecord.ResourceVersion))
+	query := s.db.WithContext(ctx).Model(&model.Fleet{}).Where("org_id = ? and name = ? and resource_version = ?", fleet.OrgID, fleet.Name, lo.FromPtr(existingRecord.ResourceVersion))
 
 	selectFields := []string{"spec"}
 	selectFields = append(selectFields, GetNonNilFieldsFromResource(fleet.Resource)...)
@@ -292,7 +292,7 @@ func (s *FleetStore) updateFleet(existingRecord, fleet *model.Fleet) (bool, erro
 	return false, nil
 }
 
-func (s *FleetStore) createOrUpdate(orgId uuid.UUID, resource *api.Fleet, mode CreateOrUpdateMode, callback FleetStoreCallback) (*api.Fleet, bool, bool, error) {
+func (s *FleetStore) createOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.Fleet, mode CreateOrUpdateMode, callback FleetStoreCallback) (*api.Fleet, bool, bool, error) {
 	if resource == nil {
 		return nil, false, false, flterrors.ErrResourceIsNil
 	}
@@ -311,7 +311,7 @@ func (s *FleetStore) createOrUpdate(orgId uuid.UUID, resource *api.Fleet, mode C
 
 	fleet.Owner = resource.Metadata.Owner
 
-	existingRecord, err := getExistingRecord[model.Fleet](s.db, fleet.Name, orgId)
+	existingRecord, err := getExistingRecord[model.Fleet](s.db.WithContext(ctx), fleet.Name, orgId)
 	if err != nil {
 		return nil, false, false, err
 	}
@@ -325,16 +325,16 @@ func (s *FleetStore) createOrUpdate(orgId uuid.UUID, resource *api.Fleet, mode C
 	}
 
 	if !exists {
-		if retry, err := s.createFleet(fleet); err != nil {
+		if retry, err := s.createFleet(ctx, fleet); err != nil {
 			return nil, false, retry, err
 		}
 	} else {
-		if retry, err := s.updateFleet(existingRecord, fleet); err != nil {
+		if retry, err := s.updateFleet(ctx, existingRecord, fleet); err != nil {
 			return nil, false, retry, err
 		}
 	}
 
-	callback(existingRecord, fleet)
+	callback(ctx, existingRecord, fleet)
 
 	updatedResource := fleet.ToApiResource()
 	return &updatedResource, !exists, false, nil
@@ -342,7 +342,7 @@ func (s *FleetStore) createOrUpdate(orgId uuid.UUID, resource *api.Fleet, mode C
 
 func (s *FleetStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.Fleet, callback FleetStoreCallback) (*api.Fleet, bool, error) {
 	return retryCreateOrUpdate(func() (*api.Fleet, bool, bool, error) {
-		return s.createOrUpdate(orgId, resource, ModeCreateOrUpdate, callback)
+		return s.createOrUpdate(ctx, orgId, resource, ModeCreateOrUpdate, callback)
 	})
 }
 
@@ -359,13 +359,13 @@ func (s *FleetStore) CreateOrUpdateMultiple(ctx context.Context, orgId uuid.UUID
 }
 
 func (s *FleetStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *api.Fleet) (*api.Fleet, error) {
-	return s.updateStatus(s.db, orgId, resource)
+	return s.updateStatus(s.db.WithContext(ctx), orgId, resource)
 }
 
 func (s *FleetStore) UpdateStatusMultiple(ctx context.Context, orgId uuid.UUID, resources ...*api.Fleet) error {
 	var errs []error
 	for _, resource := range resources {
-		_, err := s.updateStatus(s.db, orgId, resource)
+		_, err := s.updateStatus(s.db.WithContext(ctx), orgId, resource)
 		errs = append(errs, err)
 	}
 	return errors.Join(lo.Uniq(errs)...)
@@ -389,7 +389,7 @@ func (s *FleetStore) updateStatus(tx *gorm.DB, orgId uuid.UUID, resource *api.Fl
 }
 
 func (s *FleetStore) UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error {
-	db := s.db
+	db := s.db.WithContext(ctx)
 	if tx != nil {
 		db = tx
 	}
@@ -404,7 +404,7 @@ func (s *FleetStore) UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uuid.UUI
 }
 
 func (s *FleetStore) UnsetOwnerByKind(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, resourceKind string) error {
-	db := s.db
+	db := s.db.WithContext(ctx)
 	if tx != nil {
 		db = tx
 	}
@@ -420,18 +420,18 @@ func (s *FleetStore) UnsetOwnerByKind(ctx context.Context, tx *gorm.DB, orgId uu
 
 func (s *FleetStore) Delete(ctx context.Context, orgId uuid.UUID, callback FleetStoreCallback, names ...string) error {
 	deleted := []model.Fleet{}
-	if err := s.db.Raw(`delete from fleets where org_id = ? and name in (?) returning *`, orgId, names).Scan(&deleted).Error; err != nil {
+	if err := s.db.WithContext(ctx).Raw(`delete from fleets where org_id = ? and name in (?) returning *`, orgId, names).Scan(&deleted).Error; err != nil {
 		return ErrorFromGormError(err)
 	}
 	for i := range deleted {
-		callback(&deleted[i], nil)
+		callback(ctx, &deleted[i], nil)
 	}
 	return nil
 }
 
-func (s *FleetStore) updateConditions(orgId uuid.UUID, name string, conditions []api.Condition) (bool, error) {
+func (s *FleetStore) updateConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []api.Condition) (bool, error) {
 	existingRecord := model.Fleet{Resource: model.Resource{OrgID: orgId, Name: name}}
-	result := s.db.First(&existingRecord)
+	result := s.db.WithContext(ctx).First(&existingRecord)
 	if result.Error != nil {
 		return false, ErrorFromGormError(result.Error)
 	}
@@ -450,7 +450,7 @@ func (s *FleetStore) updateConditions(orgId uuid.UUID, name string, conditions [
 		return false, nil
 	}
 
-	result = s.db.Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
+	result = s.db.WithContext(ctx).Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
 		"status":           existingRecord.Status,
 		"resource_version": gorm.Expr("resource_version + 1"),
 	})
@@ -466,13 +466,13 @@ func (s *FleetStore) updateConditions(orgId uuid.UUID, name string, conditions [
 
 func (s *FleetStore) UpdateConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []api.Condition) error {
 	return retryUpdate(func() (bool, error) {
-		return s.updateConditions(orgId, name, conditions)
+		return s.updateConditions(ctx, orgId, name, conditions)
 	})
 }
 
-func (s *FleetStore) updateAnnotations(orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) (bool, error) {
+func (s *FleetStore) updateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) (bool, error) {
 	existingRecord := model.Fleet{Resource: model.Resource{OrgID: orgId, Name: name}}
-	result := s.db.First(&existingRecord)
+	result := s.db.WithContext(ctx).First(&existingRecord)
 	if result.Error != nil {
 		return false, ErrorFromGormError(result.Error)
 	}
@@ -484,7 +484,7 @@ func (s *FleetStore) updateAnnotations(orgId uuid.UUID, name string, annotations
 	}
 	annotationsArray := util.LabelMapToArray(&existingAnnotations)
 
-	result = s.db.Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
+	result = s.db.WithContext(ctx).Model(existingRecord).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion)).Updates(map[string]interface{}{
 		"annotations":      pq.StringArray(annotationsArray),
 		"resource_version": gorm.Expr("resource_version + 1"),
 	})
@@ -500,7 +500,7 @@ func (s *FleetStore) updateAnnotations(orgId uuid.UUID, name string, annotations
 
 func (s *FleetStore) UpdateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) error {
 	return retryUpdate(func() (bool, error) {
-		return s.updateAnnotations(orgId, name, annotations, deleteKeys)
+		return s.updateAnnotations(ctx, orgId, name, annotations, deleteKeys)
 	})
 }
 
@@ -509,7 +509,7 @@ func (s *FleetStore) OverwriteRepositoryRefs(ctx context.Context, orgId uuid.UUI
 	for _, repoName := range repositoryNames {
 		repos = append(repos, model.Repository{Resource: model.Resource{OrgID: orgId, Name: repoName}})
 	}
-	return s.db.Transaction(func(innerTx *gorm.DB) error {
+	return s.db.WithContext(ctx).Transaction(func(innerTx *gorm.DB) error {
 		fleet := model.Fleet{Resource: model.Resource{OrgID: orgId, Name: name}}
 		if err := innerTx.Model(&fleet).Association("Repositories").Replace(repos); err != nil {
 			return ErrorFromGormError(err)
@@ -521,7 +521,7 @@ func (s *FleetStore) OverwriteRepositoryRefs(ctx context.Context, orgId uuid.UUI
 func (s *FleetStore) GetRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string) (*api.RepositoryList, error) {
 	fleet := model.Fleet{Resource: model.Resource{OrgID: orgId, Name: name}}
 	var repos model.RepositoryList
-	err := s.db.Model(&fleet).Association("Repositories").Find(&repos)
+	err := s.db.WithContext(ctx).Model(&fleet).Association("Repositories").Find(&repos)
 	if err != nil {
 		return nil, ErrorFromGormError(err)
 	}
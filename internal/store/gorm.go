@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/sirupsen/logrus"
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
@@ -62,6 +63,11 @@ func InitDB(cfg *config.Config, log *logrus.Logger) (*gorm.DB, error) {
 		return nil, err
 	}
 
+	if err = newDB.Use(tracing.NewGormPlugin()); err != nil {
+		klog.Fatalf("Failed to register tracing plugin: %v", err)
+		return nil, err
+	}
+
 	sqlDB, err := newDB.DB()
 	if err != nil {
 		klog.Fatalf("failed to configure connections: %v", err)
@@ -0,0 +1,111 @@
+package model
+
+import (
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+)
+
+var (
+	AuditRecordAPI      = "v1alpha1"
+	AuditRecordKind     = "AuditRecord"
+	AuditRecordListKind = "AuditRecordList"
+)
+
+type AuditRecord struct {
+	OrgID uuid.UUID `gorm:"type:uuid;primary_key;"`
+	Name  string    `gorm:"primary_key;" selector:"metadata.name"`
+
+	Timestamp time.Time `gorm:"index" selector:"timestamp"`
+	RequestID string    `selector:"requestId"`
+	User      string    `gorm:"index" selector:"user"`
+	Verb      string    `selector:"verb"`
+
+	// The resource the call was made to.
+	ResourceKind string `gorm:"index:audit_record_resource_idx,priority:1" selector:"resource.kind"`
+	ResourceName string `gorm:"index:audit_record_resource_idx,priority:2" selector:"resource.name"`
+	Subresource  string `selector:"subresource"`
+
+	Outcome    string `selector:"outcome"`
+	StatusCode int32
+
+	// The changes the call made to the resource, stored as opaque JSON object.
+	Diff *JSONField[map[string]interface{}] `gorm:"type:jsonb"`
+
+	CreatedAt time.Time `selector:"metadata.created_at"`
+}
+
+type AuditRecordList []AuditRecord
+
+func NewAuditRecordFromApiResource(resource *api.AuditRecord) *AuditRecord {
+	if resource == nil {
+		return &AuditRecord{}
+	}
+	record := &AuditRecord{
+		Name:         util.DefaultIfNil(resource.Metadata.Name, ""),
+		Timestamp:    resource.Timestamp,
+		RequestID:    resource.RequestId,
+		User:         resource.User,
+		Verb:         string(resource.Verb),
+		ResourceKind: resource.Resource.Kind,
+		ResourceName: resource.Resource.Name,
+		Subresource:  util.DefaultIfNil(resource.Subresource, ""),
+		Outcome:      string(resource.Outcome),
+		StatusCode:   resource.StatusCode,
+	}
+	if resource.Diff != nil {
+		record.Diff = MakeJSONField(*resource.Diff)
+	}
+	return record
+}
+
+func (a *AuditRecord) ToApiResource() api.AuditRecord {
+	if a == nil {
+		return api.AuditRecord{}
+	}
+	record := api.AuditRecord{
+		ApiVersion: AuditRecordAPI,
+		Kind:       AuditRecordKind,
+		Metadata: api.ObjectMeta{
+			Name:              util.StrToPtr(a.Name),
+			CreationTimestamp: util.TimeToPtr(a.CreatedAt.UTC()),
+		},
+		Timestamp: a.Timestamp.UTC(),
+		RequestId: a.RequestID,
+		User:      a.User,
+		Verb:      api.AuditVerb(a.Verb),
+		Resource: api.ObjectReference{
+			Kind: a.ResourceKind,
+			Name: a.ResourceName,
+		},
+		Outcome:    api.AuditOutcome(a.Outcome),
+		StatusCode: a.StatusCode,
+	}
+	if len(a.Subresource) > 0 {
+		record.Subresource = util.StrToPtr(a.Subresource)
+	}
+	if a.Diff != nil {
+		record.Diff = &a.Diff.Data
+	}
+	return record
+}
+
+func (al AuditRecordList) ToApiResource(cont *string, numRemaining *int64) api.AuditRecordList {
+	auditRecordList := make([]api.AuditRecord, len(al))
+	for i, record := range al {
+		auditRecordList[i] = record.ToApiResource()
+	}
+	ret := api.AuditRecordList{
+		ApiVersion: AuditRecordAPI,
+		Kind:       AuditRecordListKind,
+		Items:      auditRecordList,
+		Metadata:   api.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret
+}
@@ -6,18 +6,24 @@ import (
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/samber/lo"
 )
 
 var (
-	DeviceAPI      = "v1alpha1"
-	DeviceKind     = "Device"
-	DeviceListKind = "DeviceList"
-
-	DeviceAnnotationTemplateVersion = "fleet-controller/templateVersion"
-	DeviceAnnotationRenderedVersion = "device-controller/renderedVersion"
-	DeviceAnnotationConsole         = "device-controller/console"
+	DeviceAPI           = "v1alpha1"
+	DeviceKind          = "Device"
+	DeviceListKind      = "DeviceList"
+	DeviceOverridesKind = "DeviceOverrides"
+
+	DeviceAnnotationTemplateVersion        = "fleet-controller/templateVersion"
+	DeviceAnnotationRenderedVersion        = "device-controller/renderedVersion"
+	DeviceAnnotationConsole                = "device-controller/console"
+	DeviceAnnotationLogs                   = "device-controller/logs"
+	DeviceAnnotationBundle                 = "device-controller/bundle"
+	DeviceAnnotationRenderedSecretVersions = "device-controller/renderedSecretVersions"
+	DeviceAnnotationOverridden             = "fleet-controller/overridden"
 )
 
 type Device struct {
@@ -32,6 +38,9 @@ type Device struct {
 	// Conditions set by the service, as opposed to the agent.
 	ServiceConditions *JSONField[ServiceConditions]
 
+	// The overrides merged over the template of the device's fleet, exposed in a separate endpoint.
+	Overrides *JSONField[api.DeviceOverridesSpec] `gorm:"type:jsonb"`
+
 	// The rendered ignition config, exposed in a separate endpoint.
 	RenderedConfig *string
 
@@ -42,12 +51,50 @@ type Device struct {
 	Repositories []Repository `gorm:"many2many:device_repos;constraint:OnDelete:CASCADE;"`
 }
 
+// deviceSelectors maps the selectors of device status fields to their JSONB
+// fields cast to the type of their values, so that they can be compared to
+// plain values instead of JSON.
+var deviceSelectors = map[selector.SelectorFieldName]selector.SelectorFieldName{
+	"status.systemInfo.architecture":         "status.systemInfo.architecture::string",
+	"status.systemInfo.operatingSystem":      "status.systemInfo.operatingSystem::string",
+	"status.systemInfo.hostname":             "status.systemInfo.hostname::string",
+	"status.systemInfo.kernelVersion":        "status.systemInfo.kernelVersion::string",
+	"status.systemInfo.agentVersion":         "status.systemInfo.agentVersion::string",
+	"status.systemInfo.cpu.model":            "status.systemInfo.cpu.model::string",
+	"status.systemInfo.cpu.count":            "status.systemInfo.cpu.count::integer",
+	"status.systemInfo.memory.totalBytes":    "status.systemInfo.memory.totalBytes::bigInt",
+	"status.systemInfo.product.vendor":       "status.systemInfo.product.vendor::string",
+	"status.systemInfo.product.name":         "status.systemInfo.product.name::string",
+	"status.systemInfo.product.serialNumber": "status.systemInfo.product.serialNumber::string",
+	"status.systemInfo.product.uuid":         "status.systemInfo.product.uuid::string",
+	"status.systemInfo.bootc.bootedImage":    "status.systemInfo.bootc.bootedImage::string",
+}
+
 type ServiceConditions struct {
 	Conditions *[]api.Condition `json:"conditions,omitempty"`
 }
 
 type DeviceList []Device
 
+func (d Device) ResolveCustomSelector(field selector.SelectorFieldName) []selector.SelectorFieldName {
+	if ref, ok := deviceSelectors[field]; ok {
+		return []selector.SelectorFieldName{ref}
+	}
+	return nil
+}
+
+func (d Device) ListCustomSelectors() []selector.SelectorFieldName {
+	return lo.Keys(deviceSelectors)
+}
+
+func (dl DeviceList) ResolveCustomSelector(field selector.SelectorFieldName) []selector.SelectorFieldName {
+	return Device{}.ResolveCustomSelector(field)
+}
+
+func (dl DeviceList) ListCustomSelectors() []selector.SelectorFieldName {
+	return Device{}.ListCustomSelectors()
+}
+
 func (d Device) String() string {
 	val, _ := json.Marshal(d)
 	return string(val)
@@ -139,6 +186,23 @@ func (d *Device) ToApiResource() api.Device {
 	}
 }
 
+// OverridesToApiResource returns the overrides of the device, which are empty
+// if none were set.
+func (d *Device) OverridesToApiResource() api.DeviceOverrides {
+	spec := api.DeviceOverridesSpec{}
+	if d.Overrides != nil {
+		spec = d.Overrides.Data
+	}
+	return api.DeviceOverrides{
+		ApiVersion: DeviceAPI,
+		Kind:       DeviceOverridesKind,
+		Metadata: api.ObjectMeta{
+			Name: util.StrToPtr(d.Name),
+		},
+		Spec: spec,
+	}
+}
+
 func (dl DeviceList) ToApiResource(cont *string, numRemaining *int64) api.DeviceList {
 	if dl == nil {
 		return api.DeviceList{
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceInventorySelectors(t *testing.T) {
+	fs, err := selector.NewFieldSelector(&DeviceList{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		selector  string
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			selector:  "status.systemInfo.hostname=edge-1",
+			wantQuery: "status -> 'systemInfo' ->> 'hostname' = ?",
+			wantArgs:  []any{"edge-1"},
+		},
+		{
+			selector:  "status.systemInfo.cpu.count=4",
+			wantQuery: "CAST(status -> 'systemInfo' -> 'cpu' ->> 'count' AS integer) = ?",
+			wantArgs:  []any{4},
+		},
+		{
+			selector:  "status.systemInfo.memory.totalBytes=8589934592",
+			wantQuery: "CAST(status -> 'systemInfo' -> 'memory' ->> 'totalBytes' AS bigint) = ?",
+			wantArgs:  []any{int64(8589934592)},
+		},
+		{
+			// other status fields are still compared as JSON
+			selector:  `status.summary.status="Online"`,
+			wantQuery: "status -> 'summary' -> 'status' = ?",
+			wantArgs:  []any{`"Online"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			require := require.New(t)
+			query, args, err := fs.ParseFromString(context.Background(), tt.selector)
+			require.NoError(err)
+			require.Equal(tt.wantQuery, query)
+			require.Equal(tt.wantArgs, args)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/google/uuid"
+)
+
+// DeviceBundle is the diagnostic bundle last requested from a device.
+type DeviceBundle struct {
+	OrgID      uuid.UUID `gorm:"type:uuid;primary_key;"`
+	DeviceName string    `gorm:"primary_key;"`
+	Device     Device    `gorm:"foreignkey:OrgID,DeviceName;constraint:OnDelete:CASCADE;"`
+
+	// The ID of the request, under which the device uploads the bundle.
+	RequestID   string
+	RequestedAt time.Time
+
+	// The uploaded bundle, unset until the device uploads it.
+	UploadedAt *time.Time
+	Data       []byte
+}
+
+// ToApiStatus returns the status of the bundle, without its contents.
+func (b *DeviceBundle) ToApiStatus() api.DeviceBundleStatus {
+	status := api.DeviceBundleStatus{
+		RequestID:   b.RequestID,
+		RequestedAt: b.RequestedAt,
+		UploadedAt:  b.UploadedAt,
+	}
+	if b.UploadedAt != nil {
+		size := int64(len(b.Data))
+		status.Size = &size
+	}
+	return status
+}
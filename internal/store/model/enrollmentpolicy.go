@@ -0,0 +1,123 @@
+package model
+
+import (
+	"encoding/json"
+	"strconv"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+var (
+	EnrollmentPolicyAPI      = "v1alpha1"
+	EnrollmentPolicyKind     = "EnrollmentPolicy"
+	EnrollmentPolicyListKind = "EnrollmentPolicyList"
+)
+
+type EnrollmentPolicy struct {
+	Resource
+
+	// The rules of the enrollment policy, stored as opaque JSON object.
+	Spec *JSONField[api.EnrollmentPolicySpec] `gorm:"type:jsonb"`
+
+	// The approvals made by the enrollment policy, stored as opaque JSON object.
+	Status *JSONField[api.EnrollmentPolicyStatus] `gorm:"type:jsonb" selector:"status"`
+}
+
+type EnrollmentPolicyList []EnrollmentPolicy
+
+func (e EnrollmentPolicy) String() string {
+	val, _ := json.Marshal(e)
+	return string(val)
+}
+
+func NewEnrollmentPolicyFromApiResource(resource *api.EnrollmentPolicy) (*EnrollmentPolicy, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &EnrollmentPolicy{}, nil
+	}
+
+	status := api.EnrollmentPolicyStatus{}
+	if resource.Status != nil {
+		status = *resource.Status
+	}
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &EnrollmentPolicy{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Labels:          util.LabelMapToArray(resource.Metadata.Labels),
+			Annotations:     util.LabelMapToArray(resource.Metadata.Annotations),
+			ResourceVersion: resourceVersion,
+		},
+		Spec:   MakeJSONField(resource.Spec),
+		Status: MakeJSONField(status),
+	}, nil
+}
+
+func (e *EnrollmentPolicy) ToApiResource() api.EnrollmentPolicy {
+	if e == nil {
+		return api.EnrollmentPolicy{}
+	}
+
+	var spec api.EnrollmentPolicySpec
+	if e.Spec != nil {
+		spec = e.Spec.Data
+	}
+
+	status := api.EnrollmentPolicyStatus{}
+	if e.Status != nil {
+		status = e.Status.Data
+	}
+
+	metadataLabels := util.LabelArrayToMap(e.Resource.Labels)
+	metadataAnnotations := util.LabelArrayToMap(e.Resource.Annotations)
+
+	return api.EnrollmentPolicy{
+		ApiVersion: EnrollmentPolicyAPI,
+		Kind:       EnrollmentPolicyKind,
+		Metadata: api.ObjectMeta{
+			Name:              util.StrToPtr(e.Name),
+			CreationTimestamp: util.TimeToPtr(e.CreatedAt.UTC()),
+			Labels:            &metadataLabels,
+			Annotations:       &metadataAnnotations,
+			Generation:        e.Generation,
+			ResourceVersion:   lo.Ternary(e.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(e.ResourceVersion), 10)), nil),
+		},
+		Spec:   spec,
+		Status: &status,
+	}
+}
+
+func (el EnrollmentPolicyList) ToApiResource(cont *string, numRemaining *int64) api.EnrollmentPolicyList {
+	if el == nil {
+		return api.EnrollmentPolicyList{
+			ApiVersion: EnrollmentPolicyAPI,
+			Kind:       EnrollmentPolicyListKind,
+			Items:      []api.EnrollmentPolicy{},
+		}
+	}
+
+	enrollmentPolicyList := make([]api.EnrollmentPolicy, len(el))
+	for i, enrollmentPolicy := range el {
+		enrollmentPolicyList[i] = enrollmentPolicy.ToApiResource()
+	}
+	ret := api.EnrollmentPolicyList{
+		ApiVersion: EnrollmentPolicyAPI,
+		Kind:       EnrollmentPolicyListKind,
+		Items:      enrollmentPolicyList,
+		Metadata:   api.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+)
+
+var (
+	EventAPI      = "v1alpha1"
+	EventKind     = "Event"
+	EventListKind = "EventList"
+)
+
+type Event struct {
+	OrgID uuid.UUID `gorm:"type:uuid;primary_key;index:event_involved_object_idx,priority:1"`
+	Name  string    `gorm:"primary_key;" selector:"metadata.name"`
+
+	// The resource the event is about.
+	InvolvedObjectKind string `gorm:"index:event_involved_object_idx,priority:2" selector:"involvedObject.kind"`
+	InvolvedObjectName string `gorm:"index:event_involved_object_idx,priority:3" selector:"involvedObject.name"`
+
+	Type    string `selector:"type"`
+	Reason  string `selector:"reason"`
+	Message string
+
+	// The number of consecutive occurrences of the event, and the time of the
+	// last one. The time of the first one is the creation time.
+	Count     int64
+	Timestamp time.Time `gorm:"index" selector:"timestamp"`
+	CreatedAt time.Time `selector:"metadata.created_at"`
+}
+
+type EventList []Event
+
+func NewEventFromApiResource(resource *api.Event) *Event {
+	if resource == nil {
+		return &Event{}
+	}
+	return &Event{
+		Name:               util.DefaultIfNil(resource.Metadata.Name, ""),
+		InvolvedObjectKind: resource.InvolvedObject.Kind,
+		InvolvedObjectName: resource.InvolvedObject.Name,
+		Type:               string(resource.Type),
+		Reason:             string(resource.Reason),
+		Message:            resource.Message,
+		Count:              resource.Count,
+		Timestamp:          resource.Timestamp,
+	}
+}
+
+func (e *Event) ToApiResource() api.Event {
+	if e == nil {
+		return api.Event{}
+	}
+	return api.Event{
+		ApiVersion: EventAPI,
+		Kind:       EventKind,
+		Metadata: api.ObjectMeta{
+			Name:              util.StrToPtr(e.Name),
+			CreationTimestamp: util.TimeToPtr(e.CreatedAt.UTC()),
+		},
+		InvolvedObject: api.ObjectReference{
+			Kind: e.InvolvedObjectKind,
+			Name: e.InvolvedObjectName,
+		},
+		Type:      api.EventType(e.Type),
+		Reason:    api.EventReason(e.Reason),
+		Message:   e.Message,
+		Count:     e.Count,
+		Timestamp: e.Timestamp.UTC(),
+	}
+}
+
+func (el EventList) ToApiResource(cont *string, numRemaining *int64) api.EventList {
+	eventList := make([]api.Event, len(el))
+	for i, event := range el {
+		eventList[i] = event.ToApiResource()
+	}
+	ret := api.EventList{
+		ApiVersion: EventAPI,
+		Kind:       EventListKind,
+		Items:      eventList,
+		Metadata:   api.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret
+}
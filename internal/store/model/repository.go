@@ -35,6 +35,11 @@ type Repository struct {
 type RepositoryList []Repository
 
 func (d Repository) String() string {
+	if d.Spec != nil {
+		// Never print credentials, whether they are encrypted or not
+		d.Spec = MakeJSONField(d.Spec.Data)
+		_ = d.TransformSecrets(func(string, string) (string, error) { return RedactedValue, nil })
+	}
 	val, _ := json.Marshal(d)
 	return string(val)
 }
@@ -67,10 +72,84 @@ func NewRepositoryFromApiResource(resource *api.Repository) (*Repository, error)
 	}, nil
 }
 
+// RedactedValue replaces the credentials of a repository in API responses.
+const RedactedValue = "*****"
+
 func hideValue(value *string) {
 	if value != nil {
-		*value = *util.StrToPtr("*****")
+		*value = *util.StrToPtr(RedactedValue)
+	}
+}
+
+// TransformSecrets replaces every credential held in the spec (passwords,
+// tokens, private keys and passphrases) with the result of fn, which gets the
+// path of the field within the spec and its current value.
+func (f *Repository) TransformSecrets(fn func(field, value string) (string, error)) error {
+	if f == nil || f.Spec == nil {
+		return nil
+	}
+	spec := &f.Spec.Data
+	if _, err := spec.GetGenericRepoSpec(); err == nil {
+		return nil
+	}
+	if httpSpec, err := spec.GetHttpRepoSpec(); err == nil {
+		fields := map[string]*string{
+			"httpConfig.password": httpSpec.HttpConfig.Password,
+			"httpConfig.token":    httpSpec.HttpConfig.Token,
+			"httpConfig.tls.key":  httpSpec.HttpConfig.TlsKey,
+		}
+		if err := transformValues(fields, fn); err != nil {
+			return err
+		}
+		return spec.FromHttpRepoSpec(httpSpec)
+	}
+	if sshSpec, err := spec.GetSshRepoSpec(); err == nil {
+		fields := map[string]*string{
+			"sshConfig.sshPrivateKey":        sshSpec.SshConfig.SshPrivateKey,
+			"sshConfig.privateKeyPassphrase": sshSpec.SshConfig.PrivateKeyPassphrase,
+		}
+		if err := transformValues(fields, fn); err != nil {
+			return err
+		}
+		return spec.FromSshRepoSpec(sshSpec)
+	}
+	if ociSpec, err := spec.GetOciRepoSpec(); err == nil {
+		fields := map[string]*string{
+			"ociConfig.password": ociSpec.OciConfig.Password,
+			"ociConfig.token":    ociSpec.OciConfig.Token,
+		}
+		if err := transformValues(fields, fn); err != nil {
+			return err
+		}
+		return spec.FromOciRepoSpec(ociSpec)
 	}
+	if vaultSpec, err := spec.GetVaultRepoSpec(); err == nil {
+		fields := map[string]*string{
+			"vaultConfig.token": vaultSpec.VaultConfig.Token,
+		}
+		if vaultSpec.VaultConfig.AppRole != nil {
+			fields["vaultConfig.appRole.secretId"] = &vaultSpec.VaultConfig.AppRole.SecretId
+		}
+		if err := transformValues(fields, fn); err != nil {
+			return err
+		}
+		return spec.FromVaultRepoSpec(vaultSpec)
+	}
+	return nil
+}
+
+func transformValues(fields map[string]*string, fn func(field, value string) (string, error)) error {
+	for field, value := range fields {
+		if value == nil {
+			continue
+		}
+		transformed, err := fn(field, *value)
+		if err != nil {
+			return err
+		}
+		*value = transformed
+	}
+	return nil
 }
 
 func (f *Repository) ToApiResource() (api.Repository, error) {
@@ -93,6 +172,7 @@ func (f *Repository) ToApiResource() (api.Repository, error) {
 		gitHttpSpec, err := spec.GetHttpRepoSpec()
 		if err == nil {
 			hideValue(gitHttpSpec.HttpConfig.Password)
+			hideValue(gitHttpSpec.HttpConfig.Token)
 			hideValue(gitHttpSpec.HttpConfig.TlsKey)
 			hideValue(gitHttpSpec.HttpConfig.TlsCrt)
 			if err := spec.FromHttpRepoSpec(gitHttpSpec); err != nil {
@@ -107,6 +187,20 @@ func (f *Repository) ToApiResource() (api.Repository, error) {
 				if err := spec.FromSshRepoSpec(gitSshRepoSpec); err != nil {
 					return api.Repository{}, err
 				}
+			} else if ociRepoSpec, err := spec.GetOciRepoSpec(); err == nil {
+				hideValue(ociRepoSpec.OciConfig.Password)
+				hideValue(ociRepoSpec.OciConfig.Token)
+				if err := spec.FromOciRepoSpec(ociRepoSpec); err != nil {
+					return api.Repository{}, err
+				}
+			} else if vaultRepoSpec, err := spec.GetVaultRepoSpec(); err == nil {
+				hideValue(vaultRepoSpec.VaultConfig.Token)
+				if vaultRepoSpec.VaultConfig.AppRole != nil {
+					hideValue(&vaultRepoSpec.VaultConfig.AppRole.SecretId)
+				}
+				if err := spec.FromVaultRepoSpec(vaultRepoSpec); err != nil {
+					return api.Repository{}, err
+				}
 			}
 		}
 	}
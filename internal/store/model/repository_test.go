@@ -0,0 +1,114 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSshRepository(t *testing.T) *Repository {
+	spec := api.RepositorySpec{}
+	err := spec.FromSshRepoSpec(api.SshRepoSpec{
+		Url:  "git@example.com:repo.git",
+		Type: "git",
+		SshConfig: api.SshConfig{
+			SshPrivateKey:        util.StrToPtr("private-key"),
+			PrivateKeyPassphrase: util.StrToPtr("secret-passphrase"),
+		},
+	})
+	require.NoError(t, err)
+	return &Repository{
+		Resource: Resource{Name: "repo"},
+		Spec:     MakeJSONField(spec),
+	}
+}
+
+func TestRepositoryTransformSecrets(t *testing.T) {
+	require := require.New(t)
+	repo := newTestSshRepository(t)
+
+	fields := []string{}
+	err := repo.TransformSecrets(func(field, value string) (string, error) {
+		fields = append(fields, field)
+		return strings.ToUpper(value), nil
+	})
+	require.NoError(err)
+	require.ElementsMatch([]string{"sshConfig.sshPrivateKey", "sshConfig.privateKeyPassphrase"}, fields)
+
+	sshSpec, err := repo.Spec.Data.GetSshRepoSpec()
+	require.NoError(err)
+	require.Equal("PRIVATE-KEY", *sshSpec.SshConfig.SshPrivateKey)
+	require.Equal("SECRET-PASSPHRASE", *sshSpec.SshConfig.PrivateKeyPassphrase)
+	require.Equal("git@example.com:repo.git", sshSpec.Url)
+}
+
+func TestRepositoryStringHidesSecrets(t *testing.T) {
+	require := require.New(t)
+	repo := newTestSshRepository(t)
+
+	str := repo.String()
+	require.NotContains(str, "private-key")
+	require.NotContains(str, "secret-passphrase")
+	require.Contains(str, RedactedValue)
+
+	// the repository itself is left untouched
+	sshSpec, err := repo.Spec.Data.GetSshRepoSpec()
+	require.NoError(err)
+	require.Equal("private-key", *sshSpec.SshConfig.SshPrivateKey)
+}
+
+func TestRepositoryToApiResourceHidesSecrets(t *testing.T) {
+	require := require.New(t)
+	spec := api.RepositorySpec{}
+	err := spec.FromHttpRepoSpec(api.HttpRepoSpec{
+		Url:  "https://example.com/repo.git",
+		Type: "http",
+		HttpConfig: api.HttpConfig{
+			Password: util.StrToPtr("password"),
+			Token:    util.StrToPtr("token"),
+			TlsKey:   util.StrToPtr("key"),
+		},
+	})
+	require.NoError(err)
+	repo := &Repository{Resource: Resource{Name: "repo"}, Spec: MakeJSONField(spec)}
+
+	apiRepo, err := repo.ToApiResource()
+	require.NoError(err)
+	httpSpec, err := apiRepo.Spec.GetHttpRepoSpec()
+	require.NoError(err)
+	require.Equal(RedactedValue, *httpSpec.HttpConfig.Password)
+	require.Equal(RedactedValue, *httpSpec.HttpConfig.Token)
+	require.Equal(RedactedValue, *httpSpec.HttpConfig.TlsKey)
+}
+
+func TestRepositoryVaultSecrets(t *testing.T) {
+	require := require.New(t)
+	spec := api.RepositorySpec{}
+	err := spec.FromVaultRepoSpec(api.VaultRepoSpec{
+		Url:  "https://vault.example.com:8200",
+		Type: api.Vault,
+		VaultConfig: api.VaultConfig{
+			AppRole: &api.VaultAppRoleAuth{RoleId: "role", SecretId: "secret-id"},
+		},
+	})
+	require.NoError(err)
+	repo := &Repository{Resource: Resource{Name: "vault"}, Spec: MakeJSONField(spec)}
+
+	fields := []string{}
+	err = repo.TransformSecrets(func(field, value string) (string, error) {
+		fields = append(fields, field)
+		return value, nil
+	})
+	require.NoError(err)
+	require.Equal([]string{"vaultConfig.appRole.secretId"}, fields)
+
+	apiRepo, err := repo.ToApiResource()
+	require.NoError(err)
+	vaultSpec, err := apiRepo.Spec.GetVaultRepoSpec()
+	require.NoError(err)
+	require.Equal("role", vaultSpec.VaultConfig.AppRole.RoleId)
+	require.Equal(RedactedValue, vaultSpec.VaultConfig.AppRole.SecretId)
+}
@@ -0,0 +1,147 @@
+package model
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+)
+
+var (
+	ServiceAccountAPI      = "v1alpha1"
+	ServiceAccountKind     = "ServiceAccount"
+	ServiceAccountListKind = "ServiceAccountList"
+)
+
+type ServiceAccount struct {
+	Resource
+
+	// The scopes the tokens of the service account may be granted, stored as opaque JSON object.
+	Spec *JSONField[api.ServiceAccountSpec] `gorm:"type:jsonb"`
+}
+
+type ServiceAccountList []ServiceAccount
+
+// ServiceAccountToken is an API token of a service account. Only the hash of the
+// token is stored, so that it cannot be retrieved again after it was created.
+type ServiceAccountToken struct {
+	OrgID              uuid.UUID      `gorm:"type:uuid;primary_key;"`
+	ServiceAccountName string         `gorm:"primary_key;"`
+	ServiceAccount     ServiceAccount `gorm:"foreignkey:OrgID,ServiceAccountName;constraint:OnDelete:CASCADE;"`
+	Name               string         `gorm:"primary_key;"`
+
+	// The hex-encoded SHA-256 hash of the token, by which tokens are looked up.
+	TokenHash string `gorm:"uniqueIndex"`
+
+	Scopes     pq.StringArray `gorm:"type:text[]"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+func (s ServiceAccount) String() string {
+	val, _ := json.Marshal(s)
+	return string(val)
+}
+
+func NewServiceAccountFromApiResource(resource *api.ServiceAccount) (*ServiceAccount, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &ServiceAccount{}, nil
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &ServiceAccount{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Labels:          util.LabelMapToArray(resource.Metadata.Labels),
+			Annotations:     util.LabelMapToArray(resource.Metadata.Annotations),
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+// ToApiResource returns the service account with the given tokens in its status.
+func (s *ServiceAccount) ToApiResource(tokens ...ServiceAccountToken) api.ServiceAccount {
+	if s == nil {
+		return api.ServiceAccount{}
+	}
+
+	var spec api.ServiceAccountSpec
+	if s.Spec != nil {
+		spec = s.Spec.Data
+	}
+
+	status := api.ServiceAccountStatus{Tokens: make([]api.ServiceAccountTokenStatus, len(tokens))}
+	for i, token := range tokens {
+		status.Tokens[i] = token.ToApiStatus()
+	}
+
+	metadataLabels := util.LabelArrayToMap(s.Resource.Labels)
+	metadataAnnotations := util.LabelArrayToMap(s.Resource.Annotations)
+
+	return api.ServiceAccount{
+		ApiVersion: ServiceAccountAPI,
+		Kind:       ServiceAccountKind,
+		Metadata: api.ObjectMeta{
+			Name:              util.StrToPtr(s.Name),
+			CreationTimestamp: util.TimeToPtr(s.CreatedAt.UTC()),
+			Labels:            &metadataLabels,
+			Annotations:       &metadataAnnotations,
+			Generation:        s.Generation,
+			ResourceVersion:   lo.Ternary(s.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(s.ResourceVersion), 10)), nil),
+		},
+		Spec:   spec,
+		Status: &status,
+	}
+}
+
+// ToApiResource returns the service accounts, each with its tokens in its status.
+func (sl ServiceAccountList) ToApiResource(tokens map[string][]ServiceAccountToken, cont *string, numRemaining *int64) api.ServiceAccountList {
+	serviceAccountList := make([]api.ServiceAccount, len(sl))
+	for i, serviceAccount := range sl {
+		serviceAccountList[i] = serviceAccount.ToApiResource(tokens[serviceAccount.Name]...)
+	}
+	ret := api.ServiceAccountList{
+		ApiVersion: ServiceAccountAPI,
+		Kind:       ServiceAccountListKind,
+		Items:      serviceAccountList,
+		Metadata:   api.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret
+}
+
+// ToApiStatus returns the description of the token, without the token itself.
+func (t *ServiceAccountToken) ToApiStatus() api.ServiceAccountTokenStatus {
+	return api.ServiceAccountTokenStatus{
+		Name:       t.Name,
+		Scopes:     []string(t.Scopes),
+		CreatedAt:  t.CreatedAt.UTC(),
+		ExpiresAt:  utcTimePtr(t.ExpiresAt),
+		LastUsedAt: utcTimePtr(t.LastUsedAt),
+	}
+}
+
+func utcTimePtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return lo.ToPtr(t.UTC())
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	b64 "encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"reflect"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/google/uuid"
@@ -26,24 +29,74 @@ type Repository interface {
 	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, repository *api.Repository, callback RepositoryStoreCallback) (*api.Repository, bool, error)
 	Delete(ctx context.Context, orgId uuid.UUID, name string, callback RepositoryStoreCallback) error
 	UpdateStatusIgnoreOrg(repository *model.Repository) error
+	RotateSecrets(ctx context.Context) (int, error)
 	GetFleetRefs(ctx context.Context, orgId uuid.UUID, name string) (*api.FleetList, error)
 	GetDeviceRefs(ctx context.Context, orgId uuid.UUID, name string) (*api.DeviceList, error)
 	InitialMigration() error
 }
 
 type RepositoryStore struct {
-	db  *gorm.DB
-	log logrus.FieldLogger
+	db        *gorm.DB
+	encrypter *crypto.SecretEncrypter
+	log       logrus.FieldLogger
 }
 
-type RepositoryStoreCallback func(*model.Repository)
-type RepositoryStoreAllDeletedCallback func(uuid.UUID)
+type RepositoryStoreCallback func(context.Context, *model.Repository)
+type RepositoryStoreAllDeletedCallback func(context.Context, uuid.UUID)
 
 // Make sure we conform to Repository interface
 var _ Repository = (*RepositoryStore)(nil)
 
-func NewRepository(db *gorm.DB, log logrus.FieldLogger) Repository {
-	return &RepositoryStore{db: db, log: log}
+// NewRepository returns a Repository store. If encrypter is not nil, the
+// credentials of repositories are encrypted before they are written to the
+// database and decrypted when read back.
+func NewRepository(db *gorm.DB, encrypter *crypto.SecretEncrypter, log logrus.FieldLogger) Repository {
+	return &RepositoryStore{db: db, encrypter: encrypter, log: log}
+}
+
+func (s *RepositoryStore) encryptSecrets(repository *model.Repository) error {
+	if s.encrypter == nil {
+		return nil
+	}
+	return repository.TransformSecrets(func(_, value string) (string, error) {
+		if crypto.IsEncrypted(value) {
+			return value, nil
+		}
+		return s.encrypter.Encrypt(value)
+	})
+}
+
+func (s *RepositoryStore) decryptSecrets(repository *model.Repository) error {
+	err := repository.TransformSecrets(func(_, value string) (string, error) {
+		if !crypto.IsEncrypted(value) {
+			return value, nil
+		}
+		if s.encrypter == nil {
+			return "", errors.New("no encryption keys configured")
+		}
+		return s.encrypter.Decrypt(value)
+	})
+	if err != nil {
+		return fmt.Errorf("decrypting credentials of repository %s: %w", repository.Name, err)
+	}
+	return nil
+}
+
+// keepRedactedSecrets restores the credentials of the existing record that
+// were sent back in their redacted form, e.g. by a client that updates a
+// repository it got from the API.
+func keepRedactedSecrets(existingRecord, repository *model.Repository) error {
+	existing := map[string]string{}
+	_ = existingRecord.TransformSecrets(func(field, value string) (string, error) {
+		existing[field] = value
+		return value, nil
+	})
+	return repository.TransformSecrets(func(field, value string) (string, error) {
+		if existingValue, ok := existing[field]; ok && value == model.RedactedValue {
+			return existingValue, nil
+		}
+		return value, nil
+	})
 }
 
 func (s *RepositoryStore) InitialMigration() error {
@@ -57,7 +110,7 @@ func (s *RepositoryStore) Create(ctx context.Context, orgId uuid.UUID, resource
 
 func (s *RepositoryStore) Update(ctx context.Context, orgId uuid.UUID, resource *api.Repository, callback RepositoryStoreCallback) (*api.Repository, error) {
 	updatedResource, _, err := retryCreateOrUpdate(func() (*api.Repository, bool, bool, error) {
-		return s.createOrUpdate(orgId, resource, ModeUpdateOnly, callback)
+		return s.createOrUpdate(ctx, orgId, resource, ModeUpdateOnly, callback)
 	})
 	return updatedResource, err
 }
@@ -71,7 +124,7 @@ func (s *RepositoryStore) List(ctx context.Context, orgId uuid.UUID, listParams
 		return nil, flterrors.ErrLimitParamOutOfBounds
 	}
 
-	query, err := ListQuery(&repositories).Build(ctx, s.db, orgId, listParams)
+	query, err := ListQuery(&repositories).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +150,7 @@ func (s *RepositoryStore) List(ctx context.Context, orgId uuid.UUID, listParams
 				numRemainingVal = 1
 			}
 		} else {
-			countQuery, err := ListQuery(&repositories).Build(ctx, s.db, orgId, listParams)
+			countQuery, err := ListQuery(&repositories).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 			if err != nil {
 				return nil, err
 			}
@@ -128,14 +181,24 @@ func (s *RepositoryStore) ListIgnoreOrg() ([]model.Repository, error) {
 	if result.Error != nil {
 		return nil, ErrorFromGormError(result.Error)
 	}
-	return repositories, nil
+	// a repository whose credentials cannot be decrypted is skipped rather than failing the list
+	// for every org
+	decrypted := make([]model.Repository, 0, len(repositories))
+	for i := range repositories {
+		if err := s.decryptSecrets(&repositories[i]); err != nil {
+			s.log.Errorf("failed to decrypt the credentials of repository %s/%s, skipping: %v", repositories[i].OrgID, repositories[i].Name, err)
+			continue
+		}
+		decrypted = append(decrypted, repositories[i])
+	}
+	return decrypted, nil
 }
 
 func (s *RepositoryStore) DeleteAll(ctx context.Context, orgId uuid.UUID, callback RepositoryStoreAllDeletedCallback) error {
 	condition := model.Repository{}
-	result := s.db.Unscoped().Where("spec IS NOT NULL AND org_id = ?", orgId).Delete(&condition)
+	result := s.db.WithContext(ctx).Unscoped().Where("spec IS NOT NULL AND org_id = ?", orgId).Delete(&condition)
 	if result.Error == nil {
-		callback(orgId)
+		callback(ctx, orgId)
 	}
 	return ErrorFromGormError(result.Error)
 }
@@ -153,24 +216,37 @@ func (s *RepositoryStore) GetInternal(ctx context.Context, orgId uuid.UUID, name
 	repository := model.Repository{
 		Resource: model.Resource{OrgID: orgId, Name: name},
 	}
-	result := s.db.Where("spec IS NOT NULL").First(&repository)
+	result := s.db.WithContext(ctx).Where("spec IS NOT NULL").First(&repository)
 	if result.Error != nil {
 		return nil, ErrorFromGormError(result.Error)
 	}
+	if err := s.decryptSecrets(&repository); err != nil {
+		return nil, err
+	}
 	return &repository, nil
 }
 
-func (s *RepositoryStore) createRepository(repository *model.Repository) (bool, error) {
+func (s *RepositoryStore) createRepository(ctx context.Context, repository *model.Repository) (bool, error) {
 	repository.Generation = lo.ToPtr[int64](1)
 	repository.ResourceVersion = lo.ToPtr[int64](1)
-	if result := s.db.Create(repository); result.Error != nil {
+	if err := s.encryptSecrets(repository); err != nil {
+		return false, err
+	}
+	if result := s.db.WithContext(ctx).Create(repository); result.Error != nil {
 		err := ErrorFromGormError(result.Error)
 		return err == flterrors.ErrDuplicateName, err
 	}
 	return false, nil
 }
 
-func (s *RepositoryStore) updateRepository(existingRecord, repository *model.Repository) (bool, error) {
+func (s *RepositoryStore) updateRepository(ctx context.Context, existingRecord, repository *model.Repository) (bool, error) {
+	// Compare the specs in cleartext, as every encryption of a value differs
+	if err := s.decryptSecrets(existingRecord); err != nil {
+		return false, err
+	}
+	if err := keepRedactedSecrets(existingRecord, repository); err != nil {
+		return false, err
+	}
 	updateSpec := repository.Spec != nil && !reflect.DeepEqual(existingRecord.Spec, repository.Spec)
 
 	// Update the generation if the spec was updated
@@ -181,8 +257,11 @@ func (s *RepositoryStore) updateRepository(existingRecord, repository *model.Rep
 		return false, flterrors.ErrResourceVersionConflict
 	}
 	repository.ResourceVersion = lo.ToPtr(lo.FromPtr(existingRecord.ResourceVersion) + 1)
+	if err := s.encryptSecrets(repository); err != nil {
+		return false, err
+	}
 	where := model.Repository{Resource: model.Resource{OrgID: repository.OrgID, Name: repository.Name}}
-	query := s.db.Model(where).Where("(resource_version is null or resource_version = ?)", lo.FromPtr(existingRecord.ResourceVersion))
+	query := s.db.WithContext(ctx).Model(where).Where("(resource_version is null or resource_version = ?)", lo.FromPtr(existingRecord.ResourceVersion))
 
 	result := query.Updates(&repository)
 	if result.Error != nil {
@@ -194,7 +273,7 @@ func (s *RepositoryStore) updateRepository(existingRecord, repository *model.Rep
 	return false, nil
 }
 
-func (s *RepositoryStore) createOrUpdate(orgId uuid.UUID, resource *api.Repository, mode CreateOrUpdateMode, callback RepositoryStoreCallback) (*api.Repository, bool, bool, error) {
+func (s *RepositoryStore) createOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.Repository, mode CreateOrUpdateMode, callback RepositoryStoreCallback) (*api.Repository, bool, bool, error) {
 	if resource == nil {
 		return nil, false, false, flterrors.ErrResourceIsNil
 	}
@@ -209,7 +288,7 @@ func (s *RepositoryStore) createOrUpdate(orgId uuid.UUID, resource *api.Reposito
 	repository.OrgID = orgId
 	repository.Status = nil
 
-	existingRecord, err := getExistingRecord[model.Repository](s.db, repository.Name, orgId)
+	existingRecord, err := getExistingRecord[model.Repository](s.db.WithContext(ctx), repository.Name, orgId)
 	if err != nil {
 		return nil, false, false, err
 	}
@@ -223,15 +302,15 @@ func (s *RepositoryStore) createOrUpdate(orgId uuid.UUID, resource *api.Reposito
 	}
 
 	if !exists {
-		if retry, err := s.createRepository(repository); err != nil {
+		if retry, err := s.createRepository(ctx, repository); err != nil {
 			return nil, false, retry, err
 		}
 	} else {
-		if retry, err := s.updateRepository(existingRecord, repository); err != nil {
+		if retry, err := s.updateRepository(ctx, existingRecord, repository); err != nil {
 			return nil, false, retry, err
 		}
 	}
-	callback(repository)
+	callback(ctx, repository)
 
 	updatedResource, err := repository.ToApiResource()
 	return &updatedResource, !exists || existingRecord.Spec == nil, false, err
@@ -239,7 +318,7 @@ func (s *RepositoryStore) createOrUpdate(orgId uuid.UUID, resource *api.Reposito
 
 func (s *RepositoryStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.Repository, callback RepositoryStoreCallback) (*api.Repository, bool, error) {
 	return retryCreateOrUpdate(func() (*api.Repository, bool, bool, error) {
-		return s.createOrUpdate(orgId, resource, ModeCreateOrUpdate, callback)
+		return s.createOrUpdate(ctx, orgId, resource, ModeCreateOrUpdate, callback)
 	})
 }
 
@@ -253,13 +332,61 @@ func (s *RepositoryStore) UpdateStatusIgnoreOrg(resource *model.Repository) erro
 	return ErrorFromGormError(result.Error)
 }
 
+// RotateSecrets re-encrypts with the active key the credentials of all
+// repositories, regardless of ownership, that are stored in cleartext or with
+// a previous key. It returns the number of repositories that were updated.
+func (s *RepositoryStore) RotateSecrets(ctx context.Context) (int, error) {
+	if s.encrypter == nil {
+		return 0, errors.New("no encryption keys configured")
+	}
+	var repositories model.RepositoryList
+	if err := s.db.WithContext(ctx).Where("spec IS NOT NULL").Find(&repositories).Error; err != nil {
+		return 0, ErrorFromGormError(err)
+	}
+
+	rotated := 0
+	for i := range repositories {
+		repository := &repositories[i]
+		needsRotation := false
+		_ = repository.TransformSecrets(func(_, value string) (string, error) {
+			needsRotation = needsRotation || s.encrypter.NeedsRotation(value)
+			return value, nil
+		})
+		if !needsRotation {
+			continue
+		}
+		if err := s.decryptSecrets(repository); err != nil {
+			return rotated, err
+		}
+		if err := s.encryptSecrets(repository); err != nil {
+			return rotated, err
+		}
+
+		// Neither the generation nor the resource version change, as the spec is the same
+		where := model.Repository{Resource: model.Resource{OrgID: repository.OrgID, Name: repository.Name}}
+		result := s.db.WithContext(ctx).Model(&where).
+			Where("(resource_version is null or resource_version = ?)", lo.FromPtr(repository.ResourceVersion)).
+			Update("spec", repository.Spec)
+		if result.Error != nil {
+			return rotated, ErrorFromGormError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			// The repository was updated concurrently, and therefore re-encrypted
+			s.log.Warnf("repository %s/%s changed while rotating its credentials, skipping", repository.OrgID, repository.Name)
+			continue
+		}
+		rotated++
+	}
+	return rotated, nil
+}
+
 func (s *RepositoryStore) Delete(ctx context.Context, orgId uuid.UUID, name string, callback RepositoryStoreCallback) error {
 	var existingRecords []*model.Repository
-	if err := s.db.Raw(`delete from repositories where org_id = ? and name = ? and spec is not null returning *`, orgId, name).Scan(&existingRecords).Error; err != nil {
+	if err := s.db.WithContext(ctx).Raw(`delete from repositories where org_id = ? and name = ? and spec is not null returning *`, orgId, name).Scan(&existingRecords).Error; err != nil {
 		return ErrorFromGormError(err)
 	}
 	for i := range existingRecords {
-		callback(existingRecords[i])
+		callback(ctx, existingRecords[i])
 	}
 	return nil
 }
@@ -267,7 +394,7 @@ func (s *RepositoryStore) Delete(ctx context.Context, orgId uuid.UUID, name stri
 func (s *RepositoryStore) GetFleetRefs(ctx context.Context, orgId uuid.UUID, name string) (*api.FleetList, error) {
 	repository := model.Repository{Resource: model.Resource{OrgID: orgId, Name: name}}
 	var fleets model.FleetList
-	err := s.db.Model(&repository).Association("Fleets").Find(&fleets)
+	err := s.db.WithContext(ctx).Model(&repository).Association("Fleets").Find(&fleets)
 	if err != nil {
 		return nil, ErrorFromGormError(err)
 	}
@@ -278,7 +405,7 @@ func (s *RepositoryStore) GetFleetRefs(ctx context.Context, orgId uuid.UUID, nam
 func (s *RepositoryStore) GetDeviceRefs(ctx context.Context, orgId uuid.UUID, name string) (*api.DeviceList, error) {
 	repository := model.Repository{Resource: model.Resource{OrgID: orgId, Name: name}}
 	var devices model.DeviceList
-	err := s.db.Model(&repository).Association("Devices").Find(&devices)
+	err := s.db.WithContext(ctx).Model(&repository).Association("Devices").Find(&devices)
 	if err != nil {
 		return nil, ErrorFromGormError(err)
 	}
@@ -50,13 +50,13 @@ func (s *ResourceSyncStore) InitialMigration() error {
 }
 
 func (s *ResourceSyncStore) Create(ctx context.Context, orgId uuid.UUID, resource *api.ResourceSync) (*api.ResourceSync, error) {
-	updatedResource, _, _, err := s.createOrUpdate(orgId, resource, ModeCreateOnly)
+	updatedResource, _, _, err := s.createOrUpdate(ctx, orgId, resource, ModeCreateOnly)
 	return updatedResource, err
 }
 
 func (s *ResourceSyncStore) Update(ctx context.Context, orgId uuid.UUID, resource *api.ResourceSync) (*api.ResourceSync, error) {
 	updatedResource, _, err := retryCreateOrUpdate(func() (*api.ResourceSync, bool, bool, error) {
-		return s.createOrUpdate(orgId, resource, ModeUpdateOnly)
+		return s.createOrUpdate(ctx, orgId, resource, ModeUpdateOnly)
 	})
 	return updatedResource, err
 }
@@ -70,7 +70,7 @@ func (s *ResourceSyncStore) List(ctx context.Context, orgId uuid.UUID, listParam
 		return nil, flterrors.ErrLimitParamOutOfBounds
 	}
 
-	query, err := ListQuery(&resourceSyncs).Build(ctx, s.db, orgId, listParams)
+	query, err := ListQuery(&resourceSyncs).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +96,7 @@ func (s *ResourceSyncStore) List(ctx context.Context, orgId uuid.UUID, listParam
 				numRemainingVal = 1
 			}
 		} else {
-			countQuery, err := ListQuery(&resourceSyncs).Build(ctx, s.db, orgId, listParams)
+			countQuery, err := ListQuery(&resourceSyncs).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 			if err != nil {
 				return nil, err
 			}
@@ -114,7 +114,7 @@ func (s *ResourceSyncStore) List(ctx context.Context, orgId uuid.UUID, listParam
 }
 
 func (s *ResourceSyncStore) DeleteAll(ctx context.Context, orgId uuid.UUID, callback removeAllResourceSyncOwnerCallback) error {
-	return s.db.Transaction(func(tx *gorm.DB) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Unscoped().Where("org_id = ?", orgId).Delete(&model.ResourceSync{}).Error; err != nil {
 			return ErrorFromGormError(err)
 		}
@@ -126,7 +126,7 @@ func (s *ResourceSyncStore) Get(ctx context.Context, orgId uuid.UUID, name strin
 	resourcesync := model.ResourceSync{
 		Resource: model.Resource{OrgID: orgId, Name: name},
 	}
-	result := s.db.First(&resourcesync)
+	result := s.db.WithContext(ctx).First(&resourcesync)
 	if result.Error != nil {
 		return nil, ErrorFromGormError(result.Error)
 	}
@@ -134,17 +134,17 @@ func (s *ResourceSyncStore) Get(ctx context.Context, orgId uuid.UUID, name strin
 	return &apiResourceSync, nil
 }
 
-func (s *ResourceSyncStore) createResourceSync(resourceSync *model.ResourceSync) (bool, error) {
+func (s *ResourceSyncStore) createResourceSync(ctx context.Context, resourceSync *model.ResourceSync) (bool, error) {
 	resourceSync.Generation = lo.ToPtr[int64](1)
 	resourceSync.ResourceVersion = lo.ToPtr[int64](1)
-	if result := s.db.Create(resourceSync); result.Error != nil {
+	if result := s.db.WithContext(ctx).Create(resourceSync); result.Error != nil {
 		err := ErrorFromGormError(result.Error)
 		return err == flterrors.ErrDuplicateName, err
 	}
 	return false, nil
 }
 
-func (s *ResourceSyncStore) updateResourceSync(existingRecord, resourceSync *model.ResourceSync) (bool, error) {
+func (s *ResourceSyncStore) updateResourceSync(ctx context.Context, existingRecord, resourceSync *model.ResourceSync) (bool, error) {
 	updateSpec := resourceSync.Spec != nil && !reflect.DeepEqual(existingRecord.Spec, resourceSync.Spec)
 
 	// Update the generation if the spec was updated
@@ -156,7 +156,7 @@ func (s *ResourceSyncStore) updateResourceSync(existingRecord, resourceSync *mod
 	}
 	resourceSync.ResourceVersion = lo.ToPtr(lo.FromPtr(existingRecord.ResourceVersion) + 1)
 	where := model.ResourceSync{Resource: model.Resource{OrgID: resourceSync.OrgID, Name: resourceSync.Name}}
-	query := s.db.Model(where).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion))
+	query := s.db.WithContext(ctx).Model(where).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion))
 
 	result := query.Updates(&resourceSync)
 	if result.Error != nil {
@@ -168,7 +168,7 @@ func (s *ResourceSyncStore) updateResourceSync(existingRecord, resourceSync *mod
 	return false, nil
 }
 
-func (s *ResourceSyncStore) createOrUpdate(orgId uuid.UUID, resource *api.ResourceSync, mode CreateOrUpdateMode) (*api.ResourceSync, bool, bool, error) {
+func (s *ResourceSyncStore) createOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.ResourceSync, mode CreateOrUpdateMode) (*api.ResourceSync, bool, bool, error) {
 	if resource == nil {
 		return nil, false, false, flterrors.ErrResourceIsNil
 	}
@@ -183,7 +183,7 @@ func (s *ResourceSyncStore) createOrUpdate(orgId uuid.UUID, resource *api.Resour
 	resourceSync.OrgID = orgId
 	resourceSync.Status = nil
 
-	existingRecord, err := getExistingRecord[model.ResourceSync](s.db, resourceSync.Name, orgId)
+	existingRecord, err := getExistingRecord[model.ResourceSync](s.db.WithContext(ctx), resourceSync.Name, orgId)
 	if err != nil {
 		return nil, false, false, err
 	}
@@ -197,11 +197,11 @@ func (s *ResourceSyncStore) createOrUpdate(orgId uuid.UUID, resource *api.Resour
 	}
 
 	if !exists {
-		if retry, err := s.createResourceSync(resourceSync); err != nil {
+		if retry, err := s.createResourceSync(ctx, resourceSync); err != nil {
 			return nil, false, retry, err
 		}
 	} else {
-		if retry, err := s.updateResourceSync(existingRecord, resourceSync); err != nil {
+		if retry, err := s.updateResourceSync(ctx, existingRecord, resourceSync); err != nil {
 			return nil, false, retry, err
 		}
 	}
@@ -212,7 +212,7 @@ func (s *ResourceSyncStore) createOrUpdate(orgId uuid.UUID, resource *api.Resour
 
 func (s *ResourceSyncStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.ResourceSync) (*api.ResourceSync, bool, error) {
 	return retryCreateOrUpdate(func() (*api.ResourceSync, bool, bool, error) {
-		return s.createOrUpdate(orgId, resource, ModeCreateOrUpdate)
+		return s.createOrUpdate(ctx, orgId, resource, ModeCreateOrUpdate)
 	})
 }
 
@@ -229,7 +229,7 @@ func (s *ResourceSyncStore) UpdateStatusIgnoreOrg(resource *model.ResourceSync)
 
 func (s *ResourceSyncStore) Delete(ctx context.Context, orgId uuid.UUID, name string, callback removeOwnerCallback) error {
 	existingRecord := model.ResourceSync{Resource: model.Resource{OrgID: orgId, Name: name}}
-	err := s.db.Transaction(func(innerTx *gorm.DB) (err error) {
+	err := s.db.WithContext(ctx).Transaction(func(innerTx *gorm.DB) (err error) {
 		result := innerTx.First(&existingRecord)
 		if result.Error != nil {
 			return ErrorFromGormError(result.Error)
@@ -93,7 +93,8 @@ func (sr *selectorFieldResolver) ResolveNames(field SelectorFieldName) ([]string
 }
 
 // ResolveFields resolves a selector field name to its corresponding schema field(s).
-// It supports resolving JSONB fields and custom field resolutions if a fieldResolver is present.
+// It supports resolving JSONB fields and custom field resolutions if a fieldResolver is present,
+// which take precedence over the fields of the schema.
 // It returns a slice of resolved SelectorField or an error if the field cannot be resolved.
 func (sr *selectorFieldResolver) ResolveFields(field SelectorFieldName) ([]*SelectorField, error) {
 	resolve := func(fn SelectorFieldName) (*SelectorField, error) {
@@ -162,15 +163,8 @@ func (sr *selectorFieldResolver) ResolveFields(field SelectorFieldName) ([]*Sele
 		return nil, nil
 	}
 
-	resolvedField, err := resolve(field)
-	if err != nil {
-		return nil, sr.newUnsupportedFieldError(field, err)
-	}
-
-	if resolvedField != nil {
-		return []*SelectorField{resolvedField}, nil
-	}
-
+	// Custom selectors take precedence, so that models can resolve paths within
+	// JSONB fields to values of a specific type.
 	if sr.fieldResolver != nil {
 		refs := sr.fieldResolver.ResolveCustomSelector(field)
 		if len(refs) > 0 {
@@ -190,6 +184,15 @@ func (sr *selectorFieldResolver) ResolveFields(field SelectorFieldName) ([]*Sele
 		}
 	}
 
+	resolvedField, err := resolve(field)
+	if err != nil {
+		return nil, sr.newUnsupportedFieldError(field, err)
+	}
+
+	if resolvedField != nil {
+		return []*SelectorField{resolvedField}, nil
+	}
+
 	return nil, sr.newUnsupportedFieldError(field, nil)
 }
 
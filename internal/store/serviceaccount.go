@@ -0,0 +1,301 @@
+package store
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// tokenLastUsedResolution is how often the last use of a token is recorded at most,
+// so that tokens used by every request do not cause a write per request.
+const tokenLastUsedResolution = time.Minute
+
+type ServiceAccount interface {
+	Create(ctx context.Context, orgId uuid.UUID, serviceAccount *api.ServiceAccount) (*api.ServiceAccount, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.ServiceAccountList, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*api.ServiceAccount, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, serviceAccount *api.ServiceAccount) (*api.ServiceAccount, bool, error)
+	DeleteAll(ctx context.Context, orgId uuid.UUID) error
+	Delete(ctx context.Context, orgId uuid.UUID, name string) error
+	CreateToken(ctx context.Context, orgId uuid.UUID, token *model.ServiceAccountToken) error
+	DeleteToken(ctx context.Context, orgId uuid.UUID, serviceAccountName string, name string) error
+	UseToken(ctx context.Context, tokenHash string, usedAt time.Time) (*model.ServiceAccountToken, error)
+	InitialMigration() error
+}
+
+type ServiceAccountStore struct {
+	db  *gorm.DB
+	log logrus.FieldLogger
+}
+
+// Make sure we conform to ServiceAccount interface
+var _ ServiceAccount = (*ServiceAccountStore)(nil)
+
+func NewServiceAccount(db *gorm.DB, log logrus.FieldLogger) ServiceAccount {
+	return &ServiceAccountStore{db: db, log: log}
+}
+
+func (s *ServiceAccountStore) InitialMigration() error {
+	return s.db.AutoMigrate(&model.ServiceAccount{}, &model.ServiceAccountToken{})
+}
+
+func (s *ServiceAccountStore) Create(ctx context.Context, orgId uuid.UUID, resource *api.ServiceAccount) (*api.ServiceAccount, error) {
+	updatedResource, _, _, err := s.createOrUpdate(ctx, orgId, resource, ModeCreateOnly)
+	return updatedResource, err
+}
+
+func (s *ServiceAccountStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.ServiceAccountList, error) {
+	var serviceAccounts model.ServiceAccountList
+	var nextContinue *string
+	var numRemaining *int64
+
+	if listParams.Limit < 0 {
+		return nil, flterrors.ErrLimitParamOutOfBounds
+	}
+
+	query, err := ListQuery(&serviceAccounts).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
+	if err != nil {
+		return nil, err
+	}
+
+	if listParams.Limit > 0 {
+		// Request 1 more than the user asked for to see if we need to return "continue"
+		query = AddPaginationToQuery(query, listParams.Limit+1, listParams.Continue)
+	}
+	result := query.Find(&serviceAccounts)
+	if result.Error != nil {
+		return nil, ErrorFromGormError(result.Error)
+	}
+
+	// If we got more than the user requested, remove one record and calculate "continue"
+	if listParams.Limit > 0 && len(serviceAccounts) > listParams.Limit {
+		nextContinueStruct := Continue{
+			Name:    serviceAccounts[len(serviceAccounts)-1].Name,
+			Version: CurrentContinueVersion,
+		}
+		serviceAccounts = serviceAccounts[:len(serviceAccounts)-1]
+
+		var numRemainingVal int64
+		if listParams.Continue != nil {
+			numRemainingVal = listParams.Continue.Count - int64(listParams.Limit)
+			if numRemainingVal < 1 {
+				numRemainingVal = 1
+			}
+		} else {
+			countQuery, err := ListQuery(&serviceAccounts).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
+			if err != nil {
+				return nil, err
+			}
+			numRemainingVal = CountRemainingItems(countQuery, nextContinueStruct.Name)
+		}
+		nextContinueStruct.Count = numRemainingVal
+		contByte, _ := json.Marshal(nextContinueStruct)
+		contStr := b64.StdEncoding.EncodeToString(contByte)
+		nextContinue = &contStr
+		numRemaining = &numRemainingVal
+	}
+
+	names := lo.Map(serviceAccounts, func(serviceAccount model.ServiceAccount, _ int) string { return serviceAccount.Name })
+	tokens, err := s.listTokens(ctx, orgId, names...)
+	if err != nil {
+		return nil, err
+	}
+
+	apiServiceAccountList := serviceAccounts.ToApiResource(tokens, nextContinue, numRemaining)
+	return &apiServiceAccountList, nil
+}
+
+// listTokens returns the tokens of the named service accounts by the name of their service account.
+func (s *ServiceAccountStore) listTokens(ctx context.Context, orgId uuid.UUID, serviceAccountNames ...string) (map[string][]model.ServiceAccountToken, error) {
+	tokensByServiceAccount := map[string][]model.ServiceAccountToken{}
+	if len(serviceAccountNames) == 0 {
+		return tokensByServiceAccount, nil
+	}
+	var tokens []model.ServiceAccountToken
+	result := s.db.WithContext(ctx).Where("org_id = ? AND service_account_name IN ?", orgId, serviceAccountNames).Order("name").Find(&tokens)
+	if result.Error != nil {
+		return nil, ErrorFromGormError(result.Error)
+	}
+	for _, token := range tokens {
+		tokensByServiceAccount[token.ServiceAccountName] = append(tokensByServiceAccount[token.ServiceAccountName], token)
+	}
+	return tokensByServiceAccount, nil
+}
+
+func (s *ServiceAccountStore) DeleteAll(ctx context.Context, orgId uuid.UUID) error {
+	condition := model.ServiceAccount{}
+	result := s.db.WithContext(ctx).Unscoped().Where("org_id = ?", orgId).Delete(&condition)
+	return ErrorFromGormError(result.Error)
+}
+
+func (s *ServiceAccountStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*api.ServiceAccount, error) {
+	serviceAccount := model.ServiceAccount{
+		Resource: model.Resource{OrgID: orgId, Name: name},
+	}
+	result := s.db.WithContext(ctx).First(&serviceAccount)
+	if result.Error != nil {
+		return nil, ErrorFromGormError(result.Error)
+	}
+	tokens, err := s.listTokens(ctx, orgId, name)
+	if err != nil {
+		return nil, err
+	}
+	apiServiceAccount := serviceAccount.ToApiResource(tokens[name]...)
+	return &apiServiceAccount, nil
+}
+
+func (s *ServiceAccountStore) createServiceAccount(ctx context.Context, serviceAccount *model.ServiceAccount) (bool, error) {
+	serviceAccount.Generation = lo.ToPtr[int64](1)
+	serviceAccount.ResourceVersion = lo.ToPtr[int64](1)
+	if result := s.db.WithContext(ctx).Create(serviceAccount); result.Error != nil {
+		err := ErrorFromGormError(result.Error)
+		return err == flterrors.ErrDuplicateName, err
+	}
+	return false, nil
+}
+
+func (s *ServiceAccountStore) updateServiceAccount(ctx context.Context, existingRecord, serviceAccount *model.ServiceAccount) (bool, error) {
+	updateSpec := serviceAccount.Spec != nil && !reflect.DeepEqual(existingRecord.Spec, serviceAccount.Spec)
+
+	// Update the generation if the spec was updated
+	if updateSpec {
+		serviceAccount.Generation = lo.ToPtr(lo.FromPtr(existingRecord.Generation) + 1)
+	}
+	if serviceAccount.ResourceVersion != nil && lo.FromPtr(existingRecord.ResourceVersion) != lo.FromPtr(serviceAccount.ResourceVersion) {
+		return false, flterrors.ErrResourceVersionConflict
+	}
+	serviceAccount.ResourceVersion = lo.ToPtr(lo.FromPtr(existingRecord.ResourceVersion) + 1)
+	where := model.ServiceAccount{Resource: model.Resource{OrgID: serviceAccount.OrgID, Name: serviceAccount.Name}}
+	query := s.db.WithContext(ctx).Model(where).Where("resource_version = ?", lo.FromPtr(existingRecord.ResourceVersion))
+
+	result := query.Updates(&serviceAccount)
+	if result.Error != nil {
+		return false, ErrorFromGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return true, flterrors.ErrNoRowsUpdated
+	}
+	return false, nil
+}
+
+func (s *ServiceAccountStore) createOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.ServiceAccount, mode CreateOrUpdateMode) (*api.ServiceAccount, bool, bool, error) {
+	if resource == nil {
+		return nil, false, false, flterrors.ErrResourceIsNil
+	}
+	if resource.Metadata.Name == nil {
+		return nil, false, false, flterrors.ErrResourceNameIsNil
+	}
+
+	serviceAccount, err := model.NewServiceAccountFromApiResource(resource)
+	if err != nil {
+		return nil, false, false, err
+	}
+	serviceAccount.OrgID = orgId
+
+	existingRecord, err := getExistingRecord[model.ServiceAccount](s.db.WithContext(ctx), serviceAccount.Name, orgId)
+	if err != nil {
+		return nil, false, false, err
+	}
+	exists := existingRecord != nil
+
+	if exists && mode == ModeCreateOnly {
+		return nil, false, false, flterrors.ErrDuplicateName
+	}
+	if !exists && mode == ModeUpdateOnly {
+		return nil, false, false, flterrors.ErrResourceNotFound
+	}
+
+	if !exists {
+		if retry, err := s.createServiceAccount(ctx, serviceAccount); err != nil {
+			return nil, false, retry, err
+		}
+	} else {
+		if retry, err := s.updateServiceAccount(ctx, existingRecord, serviceAccount); err != nil {
+			return nil, false, retry, err
+		}
+		serviceAccount.CreatedAt = existingRecord.CreatedAt
+	}
+
+	tokens, err := s.listTokens(ctx, orgId, serviceAccount.Name)
+	if err != nil {
+		return nil, false, false, err
+	}
+	updatedResource := serviceAccount.ToApiResource(tokens[serviceAccount.Name]...)
+	return &updatedResource, !exists, false, nil
+}
+
+func (s *ServiceAccountStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.ServiceAccount) (*api.ServiceAccount, bool, error) {
+	return retryCreateOrUpdate(func() (*api.ServiceAccount, bool, bool, error) {
+		return s.createOrUpdate(ctx, orgId, resource, ModeCreateOrUpdate)
+	})
+}
+
+// Delete deletes the service account along with its tokens.
+func (s *ServiceAccountStore) Delete(ctx context.Context, orgId uuid.UUID, name string) error {
+	condition := model.ServiceAccount{
+		Resource: model.Resource{OrgID: orgId, Name: name},
+	}
+	result := s.db.WithContext(ctx).Unscoped().Delete(&condition)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	return ErrorFromGormError(result.Error)
+}
+
+// CreateToken adds the token to its service account. It fails with ErrResourceNotFound if the
+// service account does not exist, and with ErrDuplicateName if it already has a token of that name.
+func (s *ServiceAccountStore) CreateToken(ctx context.Context, orgId uuid.UUID, token *model.ServiceAccountToken) error {
+	token.OrgID = orgId
+	return s.db.WithContext(ctx).Transaction(func(innerTx *gorm.DB) error {
+		serviceAccount := model.ServiceAccount{Resource: model.Resource{OrgID: orgId, Name: token.ServiceAccountName}}
+		if err := innerTx.First(&serviceAccount).Error; err != nil {
+			return ErrorFromGormError(err)
+		}
+		return ErrorFromGormError(innerTx.Omit("ServiceAccount").Create(token).Error)
+	})
+}
+
+// DeleteToken revokes the token of the service account.
+func (s *ServiceAccountStore) DeleteToken(ctx context.Context, orgId uuid.UUID, serviceAccountName string, name string) error {
+	result := s.db.WithContext(ctx).Where("org_id = ? AND service_account_name = ? AND name = ?", orgId, serviceAccountName, name).Delete(&model.ServiceAccountToken{})
+	if result.Error != nil {
+		return ErrorFromGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return flterrors.ErrResourceNotFound
+	}
+	return nil
+}
+
+// UseToken returns the token with the given hash along with its service account, and records
+// that it was used. It fails with ErrResourceNotFound if there is no such token.
+func (s *ServiceAccountStore) UseToken(ctx context.Context, tokenHash string, usedAt time.Time) (*model.ServiceAccountToken, error) {
+	var token model.ServiceAccountToken
+	result := s.db.WithContext(ctx).Preload("ServiceAccount").Where("token_hash = ?", tokenHash).First(&token)
+	if result.Error != nil {
+		return nil, ErrorFromGormError(result.Error)
+	}
+	if token.LastUsedAt != nil && usedAt.Sub(*token.LastUsedAt) < tokenLastUsedResolution {
+		return &token, nil
+	}
+
+	result = s.db.WithContext(ctx).Model(&model.ServiceAccountToken{}).
+		Where("org_id = ? AND service_account_name = ? AND name = ?", token.OrgID, token.ServiceAccountName, token.Name).
+		Update("last_used_at", usedAt)
+	if result.Error != nil {
+		return nil, ErrorFromGormError(result.Error)
+	}
+	token.LastUsedAt = &usedAt
+	return &token, nil
+}
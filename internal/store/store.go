@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
@@ -21,41 +23,75 @@ var (
 
 type Store interface {
 	Device() Device
+	DeviceBundle() DeviceBundle
 	EnrollmentRequest() EnrollmentRequest
+	EnrollmentPolicy() EnrollmentPolicy
 	CertificateSigningRequest() CertificateSigningRequest
 	Fleet() Fleet
 	TemplateVersion() TemplateVersion
 	Repository() Repository
 	ResourceSync() ResourceSync
+	Event() Event
+	ServiceAccount() ServiceAccount
+	AuditRecord() AuditRecord
 	InitialMigration() error
 	Close() error
 }
 
 type DataStore struct {
 	device                    Device
+	deviceBundle              DeviceBundle
 	enrollmentRequest         EnrollmentRequest
+	enrollmentPolicy          EnrollmentPolicy
 	certificateSigningRequest CertificateSigningRequest
 	fleet                     Fleet
 	templateVersion           TemplateVersion
 	repository                Repository
 	resourceSync              ResourceSync
+	event                     Event
+	serviceAccount            ServiceAccount
+	auditRecord               AuditRecord
 
 	db *gorm.DB
 }
 
-func NewStore(db *gorm.DB, log logrus.FieldLogger) Store {
+func NewStore(db *gorm.DB, encrypter *crypto.SecretEncrypter, log logrus.FieldLogger) Store {
+	event := NewEvent(db, log)
 	return &DataStore{
-		device:                    NewDevice(db, log),
+		device:                    NewDevice(db, event, log),
+		deviceBundle:              NewDeviceBundle(db, log),
 		enrollmentRequest:         NewEnrollmentRequest(db, log),
+		enrollmentPolicy:          NewEnrollmentPolicy(db, log),
 		certificateSigningRequest: NewCertificateSigningRequest(db, log),
 		fleet:                     NewFleet(db, log),
 		templateVersion:           NewTemplateVersion(db, log),
-		repository:                NewRepository(db, log),
+		repository:                NewRepository(db, encrypter, log),
 		resourceSync:              NewResourceSync(db, log),
+		event:                     event,
+		serviceAccount:            NewServiceAccount(db, log),
+		auditRecord:               NewAuditRecord(db, log),
 		db:                        db,
 	}
 }
 
+// NewSecretEncrypter returns the encrypter for secrets at rest built from the
+// configured keys, the first of which is the active one. It returns nil if no
+// keys are configured, in which case secrets are stored in cleartext.
+func NewSecretEncrypter(cfg *config.Config) (*crypto.SecretEncrypter, error) {
+	if cfg.Encryption == nil || len(cfg.Encryption.Keys) == 0 {
+		return nil, nil
+	}
+	keys := make([]crypto.EncryptionKey, 0, len(cfg.Encryption.Keys))
+	for _, key := range cfg.Encryption.Keys {
+		decoded, err := b64.StdEncoding.DecodeString(key.Key)
+		if err != nil {
+			return nil, fmt.Errorf("decoding encryption key %q: %w", key.ID, err)
+		}
+		keys = append(keys, crypto.EncryptionKey{ID: key.ID, Key: decoded})
+	}
+	return crypto.NewSecretEncrypter(keys...)
+}
+
 func (s *DataStore) Repository() Repository {
 	return s.repository
 }
@@ -64,10 +100,18 @@ func (s *DataStore) Device() Device {
 	return s.device
 }
 
+func (s *DataStore) DeviceBundle() DeviceBundle {
+	return s.deviceBundle
+}
+
 func (s *DataStore) EnrollmentRequest() EnrollmentRequest {
 	return s.enrollmentRequest
 }
 
+func (s *DataStore) EnrollmentPolicy() EnrollmentPolicy {
+	return s.enrollmentPolicy
+}
+
 func (s *DataStore) CertificateSigningRequest() CertificateSigningRequest {
 	return s.certificateSigningRequest
 }
@@ -84,13 +128,31 @@ func (s *DataStore) ResourceSync() ResourceSync {
 	return s.resourceSync
 }
 
+func (s *DataStore) Event() Event {
+	return s.event
+}
+
+func (s *DataStore) ServiceAccount() ServiceAccount {
+	return s.serviceAccount
+}
+
+func (s *DataStore) AuditRecord() AuditRecord {
+	return s.auditRecord
+}
+
 func (s *DataStore) InitialMigration() error {
 	if err := s.Device().InitialMigration(); err != nil {
 		return err
 	}
+	if err := s.DeviceBundle().InitialMigration(); err != nil {
+		return err
+	}
 	if err := s.EnrollmentRequest().InitialMigration(); err != nil {
 		return err
 	}
+	if err := s.EnrollmentPolicy().InitialMigration(); err != nil {
+		return err
+	}
 	if err := s.CertificateSigningRequest().InitialMigration(); err != nil {
 		return err
 	}
@@ -106,6 +168,15 @@ func (s *DataStore) InitialMigration() error {
 	if err := s.ResourceSync().InitialMigration(); err != nil {
 		return err
 	}
+	if err := s.Event().InitialMigration(); err != nil {
+		return err
+	}
+	if err := s.ServiceAccount().InitialMigration(); err != nil {
+		return err
+	}
+	if err := s.AuditRecord().InitialMigration(); err != nil {
+		return err
+	}
 	return s.customizeMigration()
 }
 
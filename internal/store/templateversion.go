@@ -32,7 +32,7 @@ type TemplateVersionStore struct {
 	log logrus.FieldLogger
 }
 
-type TemplateVersionStoreCallback func(tv *model.TemplateVersion)
+type TemplateVersionStoreCallback func(ctx context.Context, tv *model.TemplateVersion)
 
 // Make sure we conform to TemplateVersion interface
 var _ TemplateVersion = (*TemplateVersionStore)(nil)
@@ -61,14 +61,14 @@ func (s *TemplateVersionStore) Create(ctx context.Context, orgId uuid.UUID, reso
 	api.SetStatusCondition(&status.Conditions, api.Condition{Type: api.TemplateVersionValid, Status: api.ConditionStatusUnknown})
 	templateVersion.Status = model.MakeJSONField(status)
 	fleet := model.Fleet{Resource: model.Resource{OrgID: orgId, Name: resource.Spec.Fleet}}
-	if err = s.db.First(&fleet).Error; err != nil {
+	if err = s.db.WithContext(ctx).First(&fleet).Error; err != nil {
 		return nil, ErrorFromGormError(err)
 	}
 
-	if err = s.db.Create(templateVersion).Error; err != nil {
+	if err = s.db.WithContext(ctx).Create(templateVersion).Error; err != nil {
 		return nil, ErrorFromGormError(err)
 	}
-	callback(templateVersion)
+	callback(ctx, templateVersion)
 	return lo.ToPtr(templateVersion.ToApiResource()), err
 }
 
@@ -81,7 +81,7 @@ func (s *TemplateVersionStore) List(ctx context.Context, orgId uuid.UUID, listPa
 		return nil, flterrors.ErrLimitParamOutOfBounds
 	}
 
-	query, err := ListQuery(&templateVersions).Build(ctx, s.db, orgId, listParams)
+	query, err := ListQuery(&templateVersions).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +107,7 @@ func (s *TemplateVersionStore) List(ctx context.Context, orgId uuid.UUID, listPa
 				numRemainingVal = 1
 			}
 		} else {
-			countQuery, err := ListQuery(&templateVersions).Build(ctx, s.db, orgId, listParams)
+			countQuery, err := ListQuery(&templateVersions).Build(ctx, s.db.WithContext(ctx), orgId, listParams)
 			if err != nil {
 				return nil, err
 			}
@@ -126,7 +126,7 @@ func (s *TemplateVersionStore) List(ctx context.Context, orgId uuid.UUID, listPa
 
 func (s *TemplateVersionStore) GetNewestValid(ctx context.Context, orgId uuid.UUID, fleet string) (*api.TemplateVersion, error) {
 	var templateVersion model.TemplateVersion
-	result := s.db.Model(&templateVersion).Where("org_id = ? AND fleet_name = ? AND valid = ?", orgId, fleet, true).Order("created_at DESC").First(&templateVersion)
+	result := s.db.WithContext(ctx).Model(&templateVersion).Where("org_id = ? AND fleet_name = ? AND valid = ?", orgId, fleet, true).Order("created_at DESC").First(&templateVersion)
 	if result.Error != nil {
 		return nil, ErrorFromGormError(result.Error)
 	}
@@ -136,7 +136,7 @@ func (s *TemplateVersionStore) GetNewestValid(ctx context.Context, orgId uuid.UU
 
 func (s *TemplateVersionStore) DeleteAll(ctx context.Context, orgId uuid.UUID, fleet *string) error {
 	condition := model.TemplateVersion{}
-	unscoped := s.db.Unscoped()
+	unscoped := s.db.WithContext(ctx).Unscoped()
 	var whereQuery *gorm.DB
 	if fleet != nil {
 		whereQuery = unscoped.Where("org_id = ? AND fleet_name = ?", orgId, *fleet)
@@ -154,7 +154,7 @@ func (s *TemplateVersionStore) Get(ctx context.Context, orgId uuid.UUID, fleet s
 		FleetName: fleet,
 		Name:      name,
 	}
-	result := s.db.First(&templateVersion)
+	result := s.db.WithContext(ctx).First(&templateVersion)
 	if result.Error != nil {
 		return nil, ErrorFromGormError(result.Error)
 	}
@@ -168,7 +168,7 @@ func (s *TemplateVersionStore) Delete(ctx context.Context, orgId uuid.UUID, flee
 		FleetName: fleet,
 		Name:      name,
 	}
-	result := s.db.Unscoped().Delete(&condition)
+	result := s.db.WithContext(ctx).Unscoped().Delete(&condition)
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return nil
 	}
@@ -197,13 +197,13 @@ func (s *TemplateVersionStore) UpdateStatus(ctx context.Context, orgId uuid.UUID
 		updates["valid"] = valid
 	}
 
-	result := s.db.Model(&templateVersion).Updates(updates)
+	result := s.db.WithContext(ctx).Model(&templateVersion).Updates(updates)
 	if result.Error != nil {
 		return ErrorFromGormError(result.Error)
 	}
 
 	if valid != nil && *valid {
-		callback(&templateVersion)
+		callback(ctx, &templateVersion)
 	}
 	return nil
 }
@@ -1,16 +1,20 @@
 package tasks
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/queues"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -34,16 +38,16 @@ const (
 )
 
 type CallbackManager interface {
-	FleetUpdatedCallback(before *model.Fleet, after *model.Fleet)
-	RepositoryUpdatedCallback(repository *model.Repository)
-	AllRepositoriesDeletedCallback(orgId uuid.UUID)
-	AllFleetsDeletedCallback(orgId uuid.UUID)
-	AllDevicesDeletedCallback(orgId uuid.UUID)
-	DeviceUpdatedCallback(before *model.Device, after *model.Device)
-	TemplateVersionCreatedCallback(templateVersion *model.TemplateVersion)
-	TemplateVersionValidatedCallback(templateVersion *model.TemplateVersion)
-	FleetSourceUpdated(orgId uuid.UUID, name string)
-	DeviceSourceUpdated(orgId uuid.UUID, name string)
+	FleetUpdatedCallback(ctx context.Context, before *model.Fleet, after *model.Fleet)
+	RepositoryUpdatedCallback(ctx context.Context, repository *model.Repository)
+	AllRepositoriesDeletedCallback(ctx context.Context, orgId uuid.UUID)
+	AllFleetsDeletedCallback(ctx context.Context, orgId uuid.UUID)
+	AllDevicesDeletedCallback(ctx context.Context, orgId uuid.UUID)
+	DeviceUpdatedCallback(ctx context.Context, before *model.Device, after *model.Device)
+	TemplateVersionCreatedCallback(ctx context.Context, templateVersion *model.TemplateVersion)
+	TemplateVersionValidatedCallback(ctx context.Context, templateVersion *model.TemplateVersion)
+	FleetSourceUpdated(ctx context.Context, orgId uuid.UUID, name string)
+	DeviceSourceUpdated(ctx context.Context, orgId uuid.UUID, name string)
 }
 
 type callbackManager struct {
@@ -66,22 +70,34 @@ func NewCallbackManager(publisher queues.Publisher, log logrus.FieldLogger) Call
 	}
 }
 
-func (t *callbackManager) submitTask(taskName string, resource ResourceReference, op string) {
+func (t *callbackManager) submitTask(ctx context.Context, taskName string, resource ResourceReference, op string) {
 	resource.TaskName = taskName
 	resource.Op = op
+
+	// the change was already stored, so the task must be published even if the
+	// request that made it is canceled
+	ctx, span := tracing.StartSpan(context.WithoutCancel(ctx), fmt.Sprintf("publish %s", taskName),
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(resource.spanAttributes()...),
+	)
+	var err error
+	defer func() { tracing.EndSpan(span, err) }()
+
 	b, err := json.Marshal(&resource)
 	if err != nil {
 		t.log.WithError(err).Error("failed to marshal payload")
 		return
 	}
-	if err = t.publisher.Publish(b); err != nil {
+	if err = t.publisher.Publish(ctx, b); err != nil {
 		t.log.WithError(err).Error("failed to publish resource")
 	}
 }
 
-func (t *callbackManager) FleetUpdatedCallback(before *model.Fleet, after *model.Fleet) {
+func (t *callbackManager) FleetUpdatedCallback(ctx context.Context, before *model.Fleet, after *model.Fleet) {
 	var templateUpdated bool
 	var selectorUpdated bool
+	var priorityUpdated bool
+	var pausedUpdated bool
 	var fleet *model.Fleet
 
 	if before == nil && after == nil {
@@ -102,52 +118,69 @@ func (t *callbackManager) FleetUpdatedCallback(before *model.Fleet, after *model
 		fleet = after
 		templateUpdated = !reflect.DeepEqual(before.Spec.Data.Template.Spec, after.Spec.Data.Template.Spec)
 		selectorUpdated = !reflect.DeepEqual(before.Spec.Data.Selector, after.Spec.Data.Selector)
+		priorityUpdated = lo.FromPtr(before.Spec.Data.Priority) != lo.FromPtr(after.Spec.Data.Priority)
+		pausedUpdated = isFleetControllerPaused(before.Labels) != isFleetControllerPaused(after.Labels)
 	}
 
 	ref := ResourceReference{OrgID: fleet.OrgID, Kind: model.FleetKind, Name: fleet.Name}
 	if templateUpdated {
 		// If the template was updated, start rolling out the new spec
-		t.submitTask(FleetValidateTask, ref, FleetValidateOpUpdate)
+		t.submitTask(ctx, FleetValidateTask, ref, FleetValidateOpUpdate)
 	}
-	if selectorUpdated {
+	if selectorUpdated || priorityUpdated {
 		op := FleetSelectorMatchOpUpdate
 		if fleet.Status != nil && fleet.Status.Data.Conditions != nil && api.IsStatusConditionTrue(fleet.Status.Data.Conditions, api.FleetOverlappingSelectors) {
 			op = FleetSelectorMatchOpUpdateOverlap
 		}
-		t.submitTask(FleetSelectorMatchTask, ref, op)
+		// A new priority may hand devices the fleet owns over to another
+		// fleet, so the devices of the whole org are reevaluated
+		if priorityUpdated {
+			op = FleetSelectorMatchOpUpdateOverlap
+		}
+		t.submitTask(ctx, FleetSelectorMatchTask, ref, op)
 	}
+	if pausedUpdated {
+		// Pausing the fleet is recorded on its devices, and resuming it rolls out the template
+		// versions that were held back
+		t.submitTask(ctx, FleetRolloutTask, ref, FleetRolloutOpUpdate)
+	}
+}
+
+func isFleetControllerPaused(labels []string) bool {
+	return api.IsFleetControllerPaused(lo.ToPtr(util.LabelArrayToMap(labels)))
 }
 
-func (t *callbackManager) FleetSourceUpdated(orgId uuid.UUID, name string) {
+func (t *callbackManager) FleetSourceUpdated(ctx context.Context, orgId uuid.UUID, name string) {
 	ref := ResourceReference{OrgID: orgId, Kind: model.FleetKind, Name: name}
-	t.submitTask(FleetValidateTask, ref, FleetValidateOpUpdate)
+	t.submitTask(ctx, FleetValidateTask, ref, FleetValidateOpUpdate)
 }
 
-func (t *callbackManager) RepositoryUpdatedCallback(repository *model.Repository) {
+func (t *callbackManager) RepositoryUpdatedCallback(ctx context.Context, repository *model.Repository) {
 	resourceRef := ResourceReference{
 		OrgID: repository.OrgID,
 		Kind:  model.RepositoryKind,
 		Name:  repository.Name,
 	}
-	t.submitTask(RepositoryUpdatesTask, resourceRef, RepositoryUpdateOpUpdate)
+	t.submitTask(ctx, RepositoryUpdatesTask, resourceRef, RepositoryUpdateOpUpdate)
 }
 
-func (t *callbackManager) AllRepositoriesDeletedCallback(orgId uuid.UUID) {
-	t.submitTask(RepositoryUpdatesTask, ResourceReference{OrgID: orgId, Kind: model.RepositoryKind}, RepositoryUpdateOpDeleteAll)
+func (t *callbackManager) AllRepositoriesDeletedCallback(ctx context.Context, orgId uuid.UUID) {
+	t.submitTask(ctx, RepositoryUpdatesTask, ResourceReference{OrgID: orgId, Kind: model.RepositoryKind}, RepositoryUpdateOpDeleteAll)
 }
 
-func (t *callbackManager) AllFleetsDeletedCallback(orgId uuid.UUID) {
-	t.submitTask(FleetSelectorMatchTask, ResourceReference{OrgID: orgId, Kind: model.FleetKind}, FleetSelectorMatchOpDeleteAll)
+func (t *callbackManager) AllFleetsDeletedCallback(ctx context.Context, orgId uuid.UUID) {
+	t.submitTask(ctx, FleetSelectorMatchTask, ResourceReference{OrgID: orgId, Kind: model.FleetKind}, FleetSelectorMatchOpDeleteAll)
 }
 
-func (t *callbackManager) AllDevicesDeletedCallback(orgId uuid.UUID) {
-	t.submitTask(FleetSelectorMatchTask, ResourceReference{OrgID: orgId, Kind: model.DeviceKind}, FleetSelectorMatchOpDeleteAll)
+func (t *callbackManager) AllDevicesDeletedCallback(ctx context.Context, orgId uuid.UUID) {
+	t.submitTask(ctx, FleetSelectorMatchTask, ResourceReference{OrgID: orgId, Kind: model.DeviceKind}, FleetSelectorMatchOpDeleteAll)
 }
 
-func (t *callbackManager) DeviceUpdatedCallback(before *model.Device, after *model.Device) {
+func (t *callbackManager) DeviceUpdatedCallback(ctx context.Context, before *model.Device, after *model.Device) {
 	var labelsUpdated bool
 	var ownerUpdated bool
 	var specUpdated bool
+	var overridesUpdated bool
 	var device *model.Device
 
 	if before == nil && after == nil {
@@ -173,13 +206,14 @@ func (t *callbackManager) DeviceUpdatedCallback(before *model.Device, after *mod
 		labelsUpdated = !reflect.DeepEqual(before.Labels, after.Labels)
 		ownerUpdated = util.DefaultIfNil(before.Owner, "") != util.DefaultIfNil(after.Owner, "")
 		specUpdated = !reflect.DeepEqual(before.Spec, after.Spec)
+		overridesUpdated = !reflect.DeepEqual(before.Overrides, after.Overrides)
 	}
 
 	ref := ResourceReference{OrgID: device.OrgID, Kind: model.DeviceKind, Name: device.Name}
-	if ownerUpdated || labelsUpdated {
+	if ownerUpdated || labelsUpdated || overridesUpdated {
 		// If the device's owner was updated, or if labels were updating that might affect parametrers,
-		// check if we need to update its spec according to its new fleet
-		t.submitTask(FleetRolloutTask, ref, FleetRolloutOpUpdate)
+		// or if its overrides changed, check if we need to update its spec according to its fleet
+		t.submitTask(ctx, FleetRolloutTask, ref, FleetRolloutOpUpdate)
 	}
 	if labelsUpdated {
 		// Check if the new labels cause the device to move to a different fleet
@@ -188,33 +222,33 @@ func (t *callbackManager) DeviceUpdatedCallback(before *model.Device, after *mod
 		if api.IsStatusConditionTrue(device.Status.Data.Conditions, api.DeviceMultipleOwners) {
 			op = FleetSelectorMatchOpUpdateOverlap
 		}
-		t.submitTask(FleetSelectorMatchTask, ref, op)
+		t.submitTask(ctx, FleetSelectorMatchTask, ref, op)
 	}
 	if specUpdated {
-		t.submitTask(DeviceRenderTask, ref, DeviceRenderOpUpdate)
+		t.submitTask(ctx, DeviceRenderTask, ref, DeviceRenderOpUpdate)
 	}
 }
 
-func (t *callbackManager) DeviceSourceUpdated(orgId uuid.UUID, name string) {
+func (t *callbackManager) DeviceSourceUpdated(ctx context.Context, orgId uuid.UUID, name string) {
 	ref := ResourceReference{OrgID: orgId, Kind: model.DeviceKind, Name: name}
-	t.submitTask(DeviceRenderTask, ref, DeviceRenderOpUpdate)
+	t.submitTask(ctx, DeviceRenderTask, ref, DeviceRenderOpUpdate)
 }
 
-func (t *callbackManager) TemplateVersionCreatedCallback(templateVersion *model.TemplateVersion) {
+func (t *callbackManager) TemplateVersionCreatedCallback(ctx context.Context, templateVersion *model.TemplateVersion) {
 	resourceRef := ResourceReference{
 		OrgID: templateVersion.OrgID,
 		Kind:  model.TemplateVersionKind,
 		Name:  templateVersion.Name,
 		Owner: *util.SetResourceOwner(model.FleetKind, templateVersion.FleetName),
 	}
-	t.submitTask(TemplateVersionPopulateTask, resourceRef, TemplateVersionPopulateOpCreated)
+	t.submitTask(ctx, TemplateVersionPopulateTask, resourceRef, TemplateVersionPopulateOpCreated)
 }
 
-func (t *callbackManager) TemplateVersionValidatedCallback(templateVersion *model.TemplateVersion) {
+func (t *callbackManager) TemplateVersionValidatedCallback(ctx context.Context, templateVersion *model.TemplateVersion) {
 	resourceRef := ResourceReference{
 		OrgID: templateVersion.OrgID,
 		Kind:  model.FleetKind,
 		Name:  templateVersion.FleetName,
 	}
-	t.submitTask(FleetRolloutTask, resourceRef, FleetRolloutOpUpdate)
+	t.submitTask(ctx, FleetRolloutTask, resourceRef, FleetRolloutOpUpdate)
 }
@@ -1,27 +1,39 @@
 package tasks
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/store/model"
 	flightlog "github.com/flightctl/flightctl/pkg/log"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 type MockPublisher struct {
 	publishedResources []ResourceReference
+	publishedContexts  []context.Context
 }
 
-func (m *MockPublisher) Publish(payload []byte) error {
+func (m *MockPublisher) Publish(ctx context.Context, payload []byte) error {
 	var resource ResourceReference
 	err := json.Unmarshal(payload, &resource)
 	if err != nil {
 		return err
 	}
 	m.publishedResources = append(m.publishedResources, resource)
+	m.publishedContexts = append(m.publishedContexts, ctx)
 	return nil
 }
 
@@ -35,6 +47,59 @@ var (
 	orgId            uuid.UUID
 )
 
+var _ = Describe("Task tracing", func() {
+	var recorder *tracetest.SpanRecorder
+
+	BeforeEach(func() {
+		mockPublisher = &MockPublisher{}
+		callbacksManager = NewCallbackManager(mockPublisher, flightlog.InitLogs())
+		orgId = uuid.New()
+
+		recorder = tracetest.NewSpanRecorder()
+		tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		otel.SetTracerProvider(tracerProvider)
+		DeferCleanup(func() {
+			_ = tracerProvider.Shutdown(context.Background())
+			otel.SetTracerProvider(noop.NewTracerProvider())
+		})
+	})
+
+	It("continues the trace of the update when processing the task", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		ctx, requestSpan := tracing.StartSpan(ctx, "HTTP PUT /api/v1/fleets/{name}")
+		callbacksManager.FleetSourceUpdated(ctx, orgId, "fleet")
+		requestSpan.End()
+		cancel()
+
+		Expect(mockPublisher.publishedContexts).To(HaveLen(1))
+		publishCtx := mockPublisher.publishedContexts[0]
+		Expect(publishCtx.Err()).ToNot(HaveOccurred())
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		publishSpan := spans[0]
+		Expect(publishSpan.Name()).To(Equal("publish " + FleetValidateTask))
+		Expect(publishSpan.SpanKind()).To(Equal(trace.SpanKindProducer))
+		Expect(publishSpan.Parent().SpanID()).To(Equal(requestSpan.SpanContext().SpanID()))
+		Expect(trace.SpanContextFromContext(publishCtx)).To(Equal(publishSpan.SpanContext()))
+
+		// the task name is unknown to the dispatcher, so processing it fails
+		payload, err := json.Marshal(ResourceReference{TaskName: "unknown", OrgID: orgId})
+		Expect(err).ToNot(HaveOccurred())
+		err = dispatchTasks(nil, callbacksManager, nil)(publishCtx, payload, flightlog.InitLogs())
+		Expect(err).To(HaveOccurred())
+
+		spans = recorder.Ended()
+		Expect(spans).To(HaveLen(3))
+		processSpan := spans[2]
+		Expect(processSpan.Name()).To(Equal("process unknown"))
+		Expect(processSpan.SpanKind()).To(Equal(trace.SpanKindConsumer))
+		Expect(processSpan.SpanContext().TraceID()).To(Equal(requestSpan.SpanContext().TraceID()))
+		Expect(processSpan.Parent().SpanID()).To(Equal(publishSpan.SpanContext().SpanID()))
+		Expect(processSpan.Status().Code).To(Equal(codes.Error))
+	})
+})
+
 var _ = Describe("FleetUpdatedCallback", func() {
 	BeforeEach(func() {
 		mockPublisher = &MockPublisher{}
@@ -44,7 +109,7 @@ var _ = Describe("FleetUpdatedCallback", func() {
 
 	When("both before and after are nil", func() {
 		It("does nothing", func() {
-			callbacksManager.FleetUpdatedCallback(nil, nil)
+			callbacksManager.FleetUpdatedCallback(context.Background(), nil, nil)
 			Expect(mockPublisher.publishedResources).To(BeEmpty())
 		})
 	})
@@ -52,7 +117,7 @@ var _ = Describe("FleetUpdatedCallback", func() {
 	When("before is nil and after is not nil", func() {
 		It("submits FleetValidateTask and FleetSelectorMatchTask", func() {
 			after := CreateTestingFleet(orgId, "after", "image1", &map[string]string{"labelKey": "selector"})
-			callbacksManager.FleetUpdatedCallback(nil, after)
+			callbacksManager.FleetUpdatedCallback(context.Background(), nil, after)
 
 			Expect(mockPublisher.publishedResources).To(HaveLen(2))
 
@@ -73,7 +138,7 @@ var _ = Describe("FleetUpdatedCallback", func() {
 	When("before is not nil and after is nil", func() {
 		It("submits FleetSelectorMatchTask", func() {
 			before := CreateTestingFleet(orgId, "before", "image1", &map[string]string{"labelKey": "selector"})
-			callbacksManager.FleetUpdatedCallback(before, nil)
+			callbacksManager.FleetUpdatedCallback(context.Background(), before, nil)
 
 			Expect(mockPublisher.publishedResources).To(HaveLen(1))
 
@@ -89,7 +154,7 @@ var _ = Describe("FleetUpdatedCallback", func() {
 		It("submits FleetValidateTask and FleetSelectorMatchTask", func() {
 			before := CreateTestingFleet(orgId, "before", "image1", &map[string]string{"labelKey": "selector1"})
 			after := CreateTestingFleet(orgId, "after", "image2", &map[string]string{"labelKey": "selector2"})
-			callbacksManager.FleetUpdatedCallback(before, after)
+			callbacksManager.FleetUpdatedCallback(context.Background(), before, after)
 
 			Expect(mockPublisher.publishedResources).To(HaveLen(2))
 
@@ -111,7 +176,7 @@ var _ = Describe("FleetUpdatedCallback", func() {
 		It("submits FleetSelectorMatchTask", func() {
 			before := CreateTestingFleet(orgId, "before", "image1", &map[string]string{"labelKey": "selector1"})
 			after := CreateTestingFleet(orgId, "after", "image1", &map[string]string{"labelKey": "selector2"})
-			callbacksManager.FleetUpdatedCallback(before, after)
+			callbacksManager.FleetUpdatedCallback(context.Background(), before, after)
 
 			Expect(mockPublisher.publishedResources).To(HaveLen(1))
 
@@ -122,6 +187,40 @@ var _ = Describe("FleetUpdatedCallback", func() {
 			Expect(publishedResource.Op).To(Equal(FleetSelectorMatchOpUpdate))
 		})
 	})
+
+	When("priority is updated", func() {
+		It("submits an org-wide FleetSelectorMatchTask", func() {
+			before := CreateTestingFleet(orgId, "before", "image1", &map[string]string{"labelKey": "selector1"})
+			after := CreateTestingFleet(orgId, "after", "image1", &map[string]string{"labelKey": "selector1"})
+			after.Spec.Data.Priority = lo.ToPtr[int32](10)
+			callbacksManager.FleetUpdatedCallback(context.Background(), before, after)
+
+			Expect(mockPublisher.publishedResources).To(HaveLen(1))
+
+			publishedResource := mockPublisher.publishedResources[0]
+			Expect(publishedResource.OrgID).To(Equal(orgId))
+			Expect(publishedResource.Kind).To(Equal(model.FleetKind))
+			Expect(publishedResource.TaskName).To(Equal(FleetSelectorMatchTask))
+			Expect(publishedResource.Op).To(Equal(FleetSelectorMatchOpUpdateOverlap))
+		})
+	})
+
+	When("the fleet is paused", func() {
+		It("submits FleetRolloutTask", func() {
+			before := CreateTestingFleet(orgId, "before", "image1", &map[string]string{"labelKey": "selector1"})
+			after := CreateTestingFleet(orgId, "after", "image1", &map[string]string{"labelKey": "selector1"})
+			after.Labels = append(after.Labels, fmt.Sprintf("%s=%s", api.FleetControllerLabel, api.FleetControllerPaused))
+			callbacksManager.FleetUpdatedCallback(context.Background(), before, after)
+
+			Expect(mockPublisher.publishedResources).To(HaveLen(1))
+
+			publishedResource := mockPublisher.publishedResources[0]
+			Expect(publishedResource.OrgID).To(Equal(orgId))
+			Expect(publishedResource.Kind).To(Equal(model.FleetKind))
+			Expect(publishedResource.TaskName).To(Equal(FleetRolloutTask))
+			Expect(publishedResource.Op).To(Equal(FleetRolloutOpUpdate))
+		})
+	})
 })
 
 var _ = Describe("DeviceUpdatedCallback", func() {
@@ -133,7 +232,7 @@ var _ = Describe("DeviceUpdatedCallback", func() {
 
 	When("both before and after are nil", func() {
 		It("does nothing", func() {
-			callbacksManager.DeviceUpdatedCallback(nil, nil)
+			callbacksManager.DeviceUpdatedCallback(context.Background(), nil, nil)
 			Expect(mockPublisher.publishedResources).To(BeEmpty())
 		})
 	})
@@ -141,7 +240,7 @@ var _ = Describe("DeviceUpdatedCallback", func() {
 	When("before is nil and after is not nil", func() {
 		It("submits FleetRolloutTask, FleetSelectorMatchTask and DeviceRenderTask", func() {
 			after := CreateTestingDevice(orgId, "after", &map[string]string{"labelKey": "label1"}, "os1")
-			callbacksManager.DeviceUpdatedCallback(nil, after)
+			callbacksManager.DeviceUpdatedCallback(context.Background(), nil, after)
 
 			Expect(mockPublisher.publishedResources).To(HaveLen(3))
 
@@ -168,7 +267,7 @@ var _ = Describe("DeviceUpdatedCallback", func() {
 	When("before is not nil and after is nil", func() {
 		It("submits FleetRolloutTask and FleetSelectorMatchTask", func() {
 			before := CreateTestingDevice(orgId, "before", &map[string]string{"labelKey": "label1"}, "os1")
-			callbacksManager.DeviceUpdatedCallback(before, nil)
+			callbacksManager.DeviceUpdatedCallback(context.Background(), before, nil)
 
 			Expect(mockPublisher.publishedResources).To(HaveLen(2))
 
@@ -190,7 +289,7 @@ var _ = Describe("DeviceUpdatedCallback", func() {
 		It("submits FleetRolloutTask, FleetSelectorMatchTask and DeviceRenderTask", func() {
 			before := CreateTestingDevice(orgId, "before", &map[string]string{"labelKey": "label1"}, "os1")
 			after := CreateTestingDevice(orgId, "after", &map[string]string{"labelKey": "label2"}, "os2")
-			callbacksManager.DeviceUpdatedCallback(before, after)
+			callbacksManager.DeviceUpdatedCallback(context.Background(), before, after)
 
 			Expect(mockPublisher.publishedResources).To(HaveLen(3))
 
@@ -218,7 +317,7 @@ var _ = Describe("DeviceUpdatedCallback", func() {
 		It("submits FleetRolloutTask, FleetSelectorMatchTask and DeviceRenderTask", func() {
 			before := CreateTestingDevice(orgId, "before", &map[string]string{"labelKey": "label1"}, "os1")
 			after := CreateTestingDevice(orgId, "after", &map[string]string{"labelKey": "label2"}, "os2")
-			callbacksManager.DeviceUpdatedCallback(before, after)
+			callbacksManager.DeviceUpdatedCallback(context.Background(), before, after)
 
 			Expect(mockPublisher.publishedResources).To(HaveLen(3))
 
@@ -241,6 +340,25 @@ var _ = Describe("DeviceUpdatedCallback", func() {
 			Expect(publishedResource.Op).To(Equal(DeviceRenderOpUpdate))
 		})
 	})
+
+	When("overrides are updated", func() {
+		It("submits FleetRolloutTask", func() {
+			before := CreateTestingDevice(orgId, "device", &map[string]string{"labelKey": "label1"}, "os1")
+			after := *before
+			after.Overrides = model.MakeJSONField(api.DeviceOverridesSpec{
+				ApplicationEnvVars: &map[string]map[string]string{"app": {"key": "value"}},
+			})
+			callbacksManager.DeviceUpdatedCallback(context.Background(), before, &after)
+
+			Expect(mockPublisher.publishedResources).To(HaveLen(1))
+
+			publishedResource := mockPublisher.publishedResources[0]
+			Expect(publishedResource.OrgID).To(Equal(orgId))
+			Expect(publishedResource.Kind).To(Equal(model.DeviceKind))
+			Expect(publishedResource.TaskName).To(Equal(FleetRolloutTask))
+			Expect(publishedResource.Op).To(Equal(FleetRolloutOpUpdate))
+		})
+	})
 })
 
 var _ = Describe("FleetSourceUpdated", func() {
@@ -251,7 +369,7 @@ var _ = Describe("FleetSourceUpdated", func() {
 	})
 
 	It("submits FleetValidateTask", func() {
-		callbacksManager.FleetSourceUpdated(orgId, "name")
+		callbacksManager.FleetSourceUpdated(context.Background(), orgId, "name")
 
 		Expect(mockPublisher.publishedResources).To(HaveLen(1))
 
@@ -271,7 +389,7 @@ var _ = Describe("DeviceSourceUpdated", func() {
 	})
 
 	It("submits DeviceRenderTask", func() {
-		callbacksManager.DeviceSourceUpdated(orgId, "name")
+		callbacksManager.DeviceSourceUpdated(context.Background(), orgId, "name")
 
 		Expect(mockPublisher.publishedResources).To(HaveLen(1))
 
@@ -292,7 +410,7 @@ var _ = Describe("RepositoryUpdatedCallback", func() {
 
 	It("submits RepositoryUpdatesTask", func() {
 		repository := CreateTestingRepository(orgId, "name", "url")
-		callbacksManager.RepositoryUpdatedCallback(repository)
+		callbacksManager.RepositoryUpdatedCallback(context.Background(), repository)
 
 		Expect(mockPublisher.publishedResources).To(HaveLen(1))
 
@@ -312,7 +430,7 @@ var _ = Describe("AllRepositoriesDeletedCallback", func() {
 	})
 
 	It("submits RepositoryUpdatesTask", func() {
-		callbacksManager.AllRepositoriesDeletedCallback(orgId)
+		callbacksManager.AllRepositoriesDeletedCallback(context.Background(), orgId)
 
 		Expect(mockPublisher.publishedResources).To(HaveLen(1))
 
@@ -332,7 +450,7 @@ var _ = Describe("AllFleetsDeletedCallback", func() {
 	})
 
 	It("submits FleetSelectorMatchTask", func() {
-		callbacksManager.AllFleetsDeletedCallback(orgId)
+		callbacksManager.AllFleetsDeletedCallback(context.Background(), orgId)
 
 		Expect(mockPublisher.publishedResources).To(HaveLen(1))
 
@@ -352,7 +470,7 @@ var _ = Describe("AllDevicesDeletedCallback", func() {
 	})
 
 	It("submits FleetSelectorMatchTask", func() {
-		callbacksManager.AllDevicesDeletedCallback(orgId)
+		callbacksManager.AllDevicesDeletedCallback(context.Background(), orgId)
 
 		Expect(mockPublisher.publishedResources).To(HaveLen(1))
 
@@ -373,7 +491,7 @@ var _ = Describe("TemplateVersionCreatedCallback", func() {
 
 	It("submits TemplateVersionPopulateTask", func() {
 		templateVersion := CreateTestingTemplateVersion(orgId, "name", "template")
-		callbacksManager.TemplateVersionCreatedCallback(templateVersion)
+		callbacksManager.TemplateVersionCreatedCallback(context.Background(), templateVersion)
 
 		Expect(mockPublisher.publishedResources).To(HaveLen(1))
 
@@ -394,7 +512,7 @@ var _ = Describe("TemplateVersionValidatedCallback", func() {
 
 	It("submits FleetRolloutTask", func() {
 		templateVersion := CreateTestingTemplateVersion(orgId, "name", "template")
-		callbacksManager.TemplateVersionValidatedCallback(templateVersion)
+		callbacksManager.TemplateVersionValidatedCallback(context.Background(), templateVersion)
 
 		Expect(mockPublisher.publishedResources).To(HaveLen(1))
 
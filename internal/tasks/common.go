@@ -1,12 +1,16 @@
 package tasks
 
 import (
+	"context"
 	"errors"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const ItemsPerPage = 1000
@@ -32,6 +36,17 @@ type ResourceReference struct {
 	Owner    string
 }
 
+// spanAttributes describes the task in the spans publishing and processing it.
+func (r *ResourceReference) spanAttributes() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("flightctl.task.name", r.TaskName),
+		attribute.String("flightctl.task.op", r.Op),
+		attribute.String("flightctl.resource.kind", r.Kind),
+		attribute.String("flightctl.resource.name", r.Name),
+		attribute.String("flightctl.org_id", r.OrgID.String()),
+	}
+}
+
 var (
 	ErrUnknownConfigName      = errors.New("failed to find configuration item name")
 	ErrUnknownApplicationType = errors.New("unknown application type")
@@ -53,3 +68,12 @@ func getOwnerFleet(device *api.Device) (string, bool, error) {
 
 	return ownerName, true, nil
 }
+
+// emitEvent records an event in the history of a resource. Events are
+// informational, so failing to record one is logged rather than failing the
+// task.
+func emitEvent(ctx context.Context, eventStore store.Event, orgId uuid.UUID, event *api.Event, log logrus.FieldLogger) {
+	if err := eventStore.Emit(ctx, orgId, event); err != nil {
+		log.Warnf("failed recording %s event of %s %s/%s: %v", event.Reason, event.InvolvedObject.Kind, orgId, event.InvolvedObject.Name, err)
+	}
+}
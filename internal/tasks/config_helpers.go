@@ -1,88 +1,414 @@
 package tasks
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"text/template"
+	"text/template/parse"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/samber/lo"
 )
 
 var (
 	paramsRegex *regexp.Regexp = regexp.MustCompile(`(?P<full>{{\w*(?P<param>.*?)\w*}})`)
-	labelRegex  *regexp.Regexp = regexp.MustCompile(`^(?P<full>{{\s*(?:(?P<label>device\.metadata\.labels\[(?P<key>.*)\]))\s*}})$`)
-	nameRegex   *regexp.Regexp = regexp.MustCompile(`^(?P<full>{{\s*(?:(?P<name>device\.metadata\.name))\s*}})$`)
+
+	// The original parameter syntax, which is still accepted within template actions
+	legacyNameRegex  *regexp.Regexp = regexp.MustCompile(`\bdevice\.metadata\.name\b`)
+	legacyLabelRegex *regexp.Regexp = regexp.MustCompile(`\bdevice\.metadata\.labels\[([^\]]*)\]`)
 )
 
-func ContainsParameter(b []byte) bool {
-	return paramsRegex.Match(b)
+// templateFields are the fields of a device that can be referenced in templates.
+var templateFields = []string{
+	"metadata.name",
+	"metadata.labels",
+	"metadata.annotations",
+	"status.systemInfo.architecture",
+	"status.systemInfo.operatingSystem",
+	"status.systemInfo.bootID",
+	"status.systemInfo.agentVersion",
+	"status.os.image",
 }
 
-func ValidateParameterFormat(b []byte) error {
-	matches := paramsRegex.FindAllStringSubmatch(string(b), -1)
-	for _, match := range matches {
-		param := match[0]
-		if !labelRegex.MatchString(param) && !nameRegex.MatchString(param) {
-			return fmt.Errorf("invalid parameter: %s", param)
+// templateMapFields are the fields of templateFields whose entries can be referenced by key.
+var templateMapFields = []string{
+	"metadata.labels",
+	"metadata.annotations",
+}
+
+// templateRenderFunc renders the template s found at the given path of a resource.
+type templateRenderFunc func(path string, s string) (string, error)
+
+// deviceTemplate renders the templates of a fleet's template for one of its devices.
+type deviceTemplate struct {
+	data  map[string]interface{}
+	funcs template.FuncMap
+}
+
+func newDeviceTemplate(objectMeta api.ObjectMeta, status *api.DeviceStatus) *deviceTemplate {
+	labels := lo.FromPtrOr(objectMeta.Labels, map[string]string{})
+	annotations := lo.FromPtrOr(objectMeta.Annotations, map[string]string{})
+
+	metadata := map[string]interface{}{
+		"labels":      labels,
+		"annotations": annotations,
+	}
+	// Referencing the name of a device without one must fail rather than render an empty string
+	if objectMeta.Name != nil {
+		metadata["name"] = *objectMeta.Name
+	}
+
+	systemInfo := map[string]interface{}{
+		"architecture":    "",
+		"operatingSystem": "",
+		"bootID":          "",
+		"agentVersion":    "",
+	}
+	osStatus := map[string]interface{}{
+		"image": "",
+	}
+	if status != nil {
+		systemInfo["architecture"] = status.SystemInfo.Architecture
+		systemInfo["operatingSystem"] = status.SystemInfo.OperatingSystem
+		systemInfo["bootID"] = status.SystemInfo.BootID
+		systemInfo["agentVersion"] = lo.FromPtr(status.SystemInfo.AgentVersion)
+		osStatus["image"] = status.Os.Image
+	}
+
+	return &deviceTemplate{
+		data: map[string]interface{}{
+			"metadata": metadata,
+			"status": map[string]interface{}{
+				"systemInfo": systemInfo,
+				"os":         osStatus,
+			},
+		},
+		funcs: templateFuncs(labels, annotations),
+	}
+}
+
+// render renders the template s, which is named after its path so that errors can be located.
+func (d *deviceTemplate) render(path string, s string) (string, error) {
+	if !ContainsParameter([]byte(s)) {
+		return s, nil
+	}
+	tmpl, err := parseTemplate(path, s, d.funcs)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, d.data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func templateFuncs(labels map[string]string, annotations map[string]string) template.FuncMap {
+	return template.FuncMap{
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"replace": func(old string, new string, s string) string {
+			return strings.ReplaceAll(s, old, new)
+		},
+		"base64": func(s string) string {
+			return base64.StdEncoding.EncodeToString([]byte(s))
+		},
+		"default": func(defaultValue string, value string) string {
+			if value == "" {
+				return defaultValue
+			}
+			return value
+		},
+		"label":      lookupFunc("label", labels),
+		"annotation": lookupFunc("annotation", annotations),
+	}
+}
+
+func lookupFunc(kind string, values map[string]string) func(string) (string, error) {
+	return func(key string) (string, error) {
+		value, ok := values[key]
+		if !ok {
+			return "", fmt.Errorf("no %s found with key %s", kind, key)
+		}
+		return value, nil
+	}
+}
+
+func parseTemplate(name string, s string, funcs template.FuncMap) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Funcs(funcs).Parse(rewriteLegacyParameters(s))
+	if err != nil {
+		return nil, err
+	}
+	if len(tmpl.Templates()) > 1 {
+		return nil, fmt.Errorf("template: %s: defining templates is not supported", name)
+	}
+	if tmpl.Tree != nil {
+		if err := checkTemplateNode(tmpl.Tree, tmpl.Tree.Root, true); err != nil {
+			return nil, err
+		}
+	}
+	return tmpl, nil
+}
+
+// rewriteLegacyParameters rewrites "device.metadata.name" and "device.metadata.labels[key]" within
+// template actions to their equivalents in the template language.
+func rewriteLegacyParameters(s string) string {
+	return paramsRegex.ReplaceAllStringFunc(s, func(action string) string {
+		action = legacyNameRegex.ReplaceAllString(action, ".metadata.name")
+		return legacyLabelRegex.ReplaceAllStringFunc(action, func(label string) string {
+			key := legacyLabelRegex.FindStringSubmatch(label)[1]
+			if unquoted, err := strconv.Unquote(key); err == nil {
+				key = unquoted
+			}
+			return fmt.Sprintf("(label %s)", strconv.Quote(key))
+		})
+	})
+}
+
+// checkTemplateNode makes sure that the fields referenced by the template exist, as far as they
+// are known statically. Fields are relative to the device unless the dot was changed by range or with.
+func checkTemplateNode(tree *parse.Tree, node parse.Node, dotIsDevice bool) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkTemplateNode(tree, child, dotIsDevice); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		return checkTemplateNode(tree, n.Pipe, dotIsDevice)
+	case *parse.IfNode:
+		return checkTemplateBranch(tree, &n.BranchNode, dotIsDevice, dotIsDevice)
+	case *parse.RangeNode:
+		return checkTemplateBranch(tree, &n.BranchNode, dotIsDevice, false)
+	case *parse.WithNode:
+		return checkTemplateBranch(tree, &n.BranchNode, dotIsDevice, false)
+	case *parse.PipeNode:
+		if n == nil {
+			return nil
+		}
+		for _, cmd := range n.Cmds {
+			if err := checkTemplateNode(tree, cmd, dotIsDevice); err != nil {
+				return err
+			}
 		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			if err := checkTemplateNode(tree, arg, dotIsDevice); err != nil {
+				return err
+			}
+		}
+	case *parse.ChainNode:
+		return checkTemplateNode(tree, n.Node, dotIsDevice)
+	case *parse.FieldNode:
+		if dotIsDevice && !isTemplateField(n.Ident) {
+			location, _ := tree.ErrorContext(n)
+			return fmt.Errorf("template: %s: unknown field .%s", location, strings.Join(n.Ident, "."))
+		}
+	case *parse.VariableNode:
+		// $ is always the device
+		if len(n.Ident) > 1 && n.Ident[0] == "$" && !isTemplateField(n.Ident[1:]) {
+			location, _ := tree.ErrorContext(n)
+			return fmt.Errorf("template: %s: unknown field .%s", location, strings.Join(n.Ident[1:], "."))
+		}
+	case *parse.TemplateNode:
+		location, _ := tree.ErrorContext(n)
+		return fmt.Errorf("template: %s: nested templates are not supported", location)
 	}
 	return nil
 }
 
-func ReplaceParameters(b []byte, objectMeta api.ObjectMeta) ([]byte, []string) {
-	replacements := map[string]string{}
-	paramsToMatches := map[string]string{}
+func checkTemplateBranch(tree *parse.Tree, n *parse.BranchNode, dotIsDevice bool, dotIsDeviceInList bool) error {
+	if err := checkTemplateNode(tree, n.Pipe, dotIsDevice); err != nil {
+		return err
+	}
+	if err := checkTemplateNode(tree, n.List, dotIsDeviceInList); err != nil {
+		return err
+	}
+	return checkTemplateNode(tree, n.ElseList, dotIsDevice)
+}
 
-	matches := paramsRegex.FindAllStringSubmatch(string(b), -1)
-	for _, match := range matches {
-		paramsToMatches[match[0]] = match[1]
+func isTemplateField(ident []string) bool {
+	path := strings.Join(ident, ".")
+	for _, field := range templateFields {
+		if path == field || strings.HasPrefix(field, path+".") {
+			return true
+		}
 	}
+	for _, field := range templateMapFields {
+		if len(ident) == strings.Count(field, ".")+2 && strings.HasPrefix(path, field+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func ContainsParameter(b []byte) bool {
+	return paramsRegex.Match(b)
+}
 
-	warnings := []string{}
+func ValidateParameterFormat(b []byte) error {
+	return validateTemplate("template", string(b))
+}
 
-	for param, match := range paramsToMatches {
-		switch {
-		case nameRegex.MatchString(param):
-			if objectMeta.Name == nil {
-				warnings = append(warnings, "parameter referenced name, but no name found")
-				continue
-			}
-			replacements[match] = *objectMeta.Name
-		case labelRegex.MatchString(param):
-			key, err := findKeyinLabelParam(param)
+// validateTemplate validates the template s found at the given path without rendering it.
+func validateTemplate(path string, s string) error {
+	if !ContainsParameter([]byte(s)) {
+		return nil
+	}
+	_, err := parseTemplate(path, s, templateFuncs(nil, nil))
+	return err
+}
+
+func ReplaceParameters(b []byte, objectMeta api.ObjectMeta) ([]byte, []string) {
+	replaced, err := newDeviceTemplate(objectMeta, nil).render("template", string(b))
+	if err != nil {
+		return b, []string{err.Error()}
+	}
+	return []byte(replaced), nil
+}
+
+// renderTemplateStrings calls render on each string of a value unmarshalled from JSON, replacing the
+// strings in place. The errors of all strings are joined.
+func renderTemplateStrings(value interface{}, path string, render templateRenderFunc) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return render(path, v)
+	case map[string]interface{}:
+		keys := lo.Keys(v)
+		sort.Strings(keys)
+		var errs []error
+		for _, key := range keys {
+			rendered, err := renderTemplateStrings(v[key], path+"."+key, render)
 			if err != nil {
-				warnings = append(warnings, err.Error())
+				errs = append(errs, err)
 				continue
 			}
-			if objectMeta.Labels == nil {
-				warnings = append(warnings, fmt.Sprintf("no label found with key %s", key))
-				continue
-			}
-			val, ok := (*objectMeta.Labels)[key]
-			if !ok {
-				warnings = append(warnings, fmt.Sprintf("no label found with key %s", key))
+			v[key] = rendered
+		}
+		return v, errors.Join(errs...)
+	case []interface{}:
+		var errs []error
+		for i := range v {
+			rendered, err := renderTemplateStrings(v[i], fmt.Sprintf("%s[%d]", path, i), render)
+			if err != nil {
+				errs = append(errs, err)
 				continue
 			}
-			replacements[match] = val
-		default:
-			warnings = append(warnings, fmt.Sprintf("found unknown parameter: %s", param))
+			v[i] = rendered
 		}
+		return v, errors.Join(errs...)
+	default:
+		return value, nil
 	}
+}
 
-	outputStr := string(b)
-	for old, new := range replacements {
-		outputStr = strings.ReplaceAll(outputStr, old, new)
+// renderItemTemplates renders the templates in the strings of an item and unmarshals the result into out.
+func renderItemTemplates(item interface{}, path string, render templateRenderFunc, out interface{}) error {
+	itemJson, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("failed converting %s to json: %w", path, err)
+	}
+	var value interface{}
+	if err := json.Unmarshal(itemJson, &value); err != nil {
+		return fmt.Errorf("failed converting %s from json: %w", path, err)
+	}
+	value, err = renderTemplateStrings(value, path, render)
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	renderedJson, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed converting %s to json: %w", path, err)
+	}
+	if err := json.Unmarshal(renderedJson, out); err != nil {
+		return fmt.Errorf("failed converting %s from json: %w", path, err)
+	}
+	return nil
+}
+
+// renderConfigItemTemplates renders the templates in the strings of a config provider, including its
+// condition. The base64-encoded contents of inline files are decoded before rendering them.
+func renderConfigItemTemplates(configItem api.ConfigProviderSpec, path string, render templateRenderFunc) (map[string]interface{}, error) {
+	cfgJson, err := configItem.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed converting configuration to json: %w", err)
+	}
+	var value map[string]interface{}
+	if err := json.Unmarshal(cfgJson, &value); err != nil {
+		return nil, fmt.Errorf("failed converting configuration from json: %w", err)
+	}
+
+	files, _ := value["inline"].([]interface{})
+	for i := range files {
+		file, ok := files[i].(map[string]interface{})
+		if !ok || file["contentEncoding"] != string(api.Base64) {
+			continue
+		}
+		content, _ := file["content"].(string)
+		decoded, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return nil, fmt.Errorf("error base64 decoding %s.inline[%d].content: %w", path, i, err)
+		}
+		file["content"] = string(decoded)
 	}
 
-	return []byte(outputStr), warnings
+	_, err = renderTemplateStrings(value, path, render)
+
+	for i := range files {
+		file, ok := files[i].(map[string]interface{})
+		if !ok || file["contentEncoding"] != string(api.Base64) {
+			continue
+		}
+		content, _ := file["content"].(string)
+		file["content"] = base64.StdEncoding.EncodeToString([]byte(content))
+	}
+
+	return value, err
 }
 
-func findKeyinLabelParam(param string) (string, error) {
-	matches := labelRegex.FindStringSubmatch(param)
-	for i, name := range labelRegex.SubexpNames() {
-		if name == "key" {
-			return matches[i], nil
+// validateDeviceSpecTemplates validates the templates of a fleet's device template, returning
+// errors that locate each invalid template relative to path.
+func validateDeviceSpecTemplates(spec api.DeviceSpec, path string) error {
+	validate := func(path string, s string) (string, error) {
+		return s, validateTemplate(path, s)
+	}
+
+	var errs []error
+	if spec.Os != nil {
+		if err := validateTemplate(path+".os.image", spec.Os.Image); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for i, configItem := range lo.FromPtr(spec.Config) {
+		if _, err := renderConfigItemTemplates(configItem, fmt.Sprintf("%s.config[%d]", path, i), validate); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for i, app := range lo.FromPtr(spec.Applications) {
+		if app.EnvVars == nil {
+			continue
+		}
+		if err := renderItemTemplates(*app.EnvVars, fmt.Sprintf("%s.applications[%d].envVars", path, i), validate, nil); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if spec.Hooks != nil {
+		if err := renderItemTemplates(spec.Hooks, path+".hooks", validate, nil); err != nil {
+			errs = append(errs, err)
 		}
 	}
-	return "", fmt.Errorf("could not find label key in param %s", param)
+	return errors.Join(errs...)
 }
@@ -1,6 +1,7 @@
 package tasks
 
 import (
+	"encoding/base64"
 	"fmt"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/flightctl/flightctl/internal/util"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
 )
 
 func TestUtil(t *testing.T) {
@@ -83,4 +85,82 @@ var _ = Describe("config helpers", func() {
 			}
 		})
 	})
+
+	When("the config uses the template language", func() {
+		It("will render fields, functions and conditionals", func() {
+			labels := map[string]string{"site": "Lab-1"}
+			annotations := map[string]string{"tier": "edge"}
+			meta := api.ObjectMeta{Labels: &labels, Annotations: &annotations, Name: util.StrToPtr("devname")}
+			status := &api.DeviceStatus{
+				SystemInfo: api.DeviceSystemInfo{Architecture: "arm64", OperatingSystem: "linux"},
+				Os:         api.DeviceOSStatus{Image: "quay.io/org/os:1"},
+			}
+			tmpl := newDeviceTemplate(meta, status)
+
+			tests := map[string]string{
+				`{{ .metadata.name | upper }}`:                                             "DEVNAME",
+				`{{ label "site" | lower | replace "-" "_" }}`:                             "lab_1",
+				`{{ annotation "tier" }}`:                                                  "edge",
+				`{{ .metadata.labels.site }}`:                                              "Lab-1",
+				`{{ index .metadata.labels "zone" | default "zone-a" }}`:                   "zone-a",
+				`{{ .metadata.name | base64 }}`:                                            "ZGV2bmFtZQ==",
+				`{{ .status.systemInfo.architecture }}/{{ .status.os.image }}`:             "arm64/quay.io/org/os:1",
+				`{{ if eq .status.systemInfo.architecture "arm64" }}a{{ else }}b{{ end }}`: "a",
+				`{{ device.metadata.labels["site"] }}`:                                     "Lab-1",
+			}
+			for template, expected := range tests {
+				rendered, err := tmpl.render("template", template)
+				Expect(err).ToNot(HaveOccurred(), template)
+				Expect(rendered).To(Equal(expected), template)
+			}
+		})
+
+		It("will fail on missing keys", func() {
+			tmpl := newDeviceTemplate(api.ObjectMeta{Name: util.StrToPtr("devname")}, nil)
+			for _, template := range []string{`{{ .metadata.labels.site }}`, `{{ label "site" }}`, `{{ annotation "tier" }}`} {
+				_, err := tmpl.render("template", template)
+				Expect(err).To(HaveOccurred(), template)
+			}
+		})
+
+		It("will reject unknown fields and functions", func() {
+			for _, template := range []string{
+				`{{ .metadata.owner }}`,
+				`{{ .status.systemInfo.hostname }}`,
+				`{{ .metadata.labels.a.b }}`,
+				`{{ if .spec }}x{{ end }}`,
+				`{{ $.metadata.uid }}`,
+				`{{ env "HOME" }}`,
+				`{{ define "x" }}x{{ end }}`,
+			} {
+				Expect(ValidateParameterFormat([]byte(template))).ToNot(Succeed(), template)
+			}
+			Expect(ValidateParameterFormat([]byte(`{{ range $k, $v := .metadata.labels }}{{ $k }}={{ $v }}{{ end }}`))).To(Succeed())
+		})
+	})
+
+	When("a fleet template has invalid parameters", func() {
+		It("will report the location of each of them", func() {
+			config := api.ConfigProviderSpec{}
+			Expect(config.FromInlineConfigProviderSpec(api.InlineConfigProviderSpec{
+				Name: "inline",
+				When: util.StrToPtr("{{ .metadata.owner }}"),
+				Inline: []api.FileSpec{
+					{Path: "/etc/ok", Content: "{{ .metadata.name }}"},
+					{Path: "/etc/bad", Content: base64.StdEncoding.EncodeToString([]byte("line\n{{ hello }}")), ContentEncoding: lo.ToPtr(api.Base64)},
+				},
+			})).To(Succeed())
+			spec := api.DeviceSpec{
+				Os:     &api.DeviceOSSpec{Image: "os:{{ .metadata.labels[stage] }}"},
+				Config: &[]api.ConfigProviderSpec{config},
+			}
+
+			err := validateDeviceSpecTemplates(spec, "spec.template.spec")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.template.spec.os.image:1:"))
+			Expect(err.Error()).To(ContainSubstring("spec.template.spec.config[0].inline[1].content:2:"))
+			Expect(err.Error()).To(ContainSubstring("spec.template.spec.config[0].when:1:"))
+			Expect(err.Error()).ToNot(ContainSubstring("inline[0]"))
+		})
+	})
 })
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
 	"github.com/flightctl/flightctl/pkg/queues"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const TaskQueue = "task-queue"
@@ -22,22 +24,32 @@ func dispatchTasks(store store.Store, callbackManager CallbackManager, k8sClient
 		}
 		log.Infof("dispatching task %s, op %s, kind %s, orgID %s, name %s",
 			reference.TaskName, reference.Op, reference.Kind, reference.OrgID, reference.Name)
-		switch reference.TaskName {
-		case FleetRolloutTask:
-			return fleetRollout(ctx, &reference, store, callbackManager, log)
-		case FleetSelectorMatchTask:
-			return fleetSelectorMatching(ctx, &reference, store, callbackManager, log)
-		case TemplateVersionPopulateTask:
-			return templateVersionPopulate(ctx, &reference, store, callbackManager, k8sClient, log)
-		case FleetValidateTask:
-			return fleetValidate(ctx, &reference, store, callbackManager, k8sClient, log)
-		case DeviceRenderTask:
-			return deviceRender(ctx, &reference, store, callbackManager, k8sClient, log)
-		case RepositoryUpdatesTask:
-			return repositoryUpdate(ctx, &reference, store, callbackManager, log)
-		default:
-			return fmt.Errorf("unexpected task name %s", reference.TaskName)
-		}
+		ctx, span := tracing.StartSpan(ctx, fmt.Sprintf("process %s", reference.TaskName),
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(reference.spanAttributes()...),
+		)
+		err := runTask(ctx, &reference, store, callbackManager, k8sClient, log)
+		tracing.EndSpan(span, err)
+		return err
+	}
+}
+
+func runTask(ctx context.Context, reference *ResourceReference, store store.Store, callbackManager CallbackManager, k8sClient k8sclient.K8SClient, log logrus.FieldLogger) error {
+	switch reference.TaskName {
+	case FleetRolloutTask:
+		return fleetRollout(ctx, reference, store, callbackManager, log)
+	case FleetSelectorMatchTask:
+		return fleetSelectorMatching(ctx, reference, store, callbackManager, log)
+	case TemplateVersionPopulateTask:
+		return templateVersionPopulate(ctx, reference, store, callbackManager, k8sClient, log)
+	case FleetValidateTask:
+		return fleetValidate(ctx, reference, store, callbackManager, k8sClient, log)
+	case DeviceRenderTask:
+		return deviceRender(ctx, reference, store, callbackManager, k8sClient, log)
+	case RepositoryUpdatesTask:
+		return repositoryUpdate(ctx, reference, store, callbackManager, log)
+	default:
+		return fmt.Errorf("unexpected task name %s", reference.TaskName)
 	}
 }
 
@@ -3,77 +3,145 @@ package tasks
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
-	"github.com/flightctl/flightctl/api/v1alpha1"
+	api "github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
 
 const (
-	// TODO: make configurable
-	// DeviceDisconnectedTimeout is the duration after which a device is considered to be not reporting and set to unknown status.
+	// DeviceDisconnectedTimeout is the default duration after which a device is considered to be not reporting and set to unknown status.
 	DeviceDisconnectedTimeout = 5 * time.Minute
 	// DeviceDisconnectedPollingInterval is the interval at which the device liveness task runs.
 	DeviceDisconnectedPollingInterval = 2 * time.Minute
+	// DeviceDisconnectedMaxConcurrentOrgs is the maximum number of orgs checked concurrently.
+	DeviceDisconnectedMaxConcurrentOrgs = 4
 )
 
 type DeviceDisconnected struct {
 	log         logrus.FieldLogger
 	deviceStore store.Device
+	fleetStore  store.Fleet
+	eventStore  store.Event
+	timeout     time.Duration
 }
 
-func NewDeviceDisconnected(log logrus.FieldLogger, store store.Store) *DeviceDisconnected {
+func NewDeviceDisconnected(log logrus.FieldLogger, store store.Store, timeout time.Duration) *DeviceDisconnected {
+	if timeout <= 0 {
+		timeout = DeviceDisconnectedTimeout
+	}
 	return &DeviceDisconnected{
 		log:         log,
 		deviceStore: store.Device(),
+		fleetStore:  store.Fleet(),
+		eventStore:  store.Event(),
+		timeout:     timeout,
 	}
 }
 
-// Poll checks the status of devices and updates the status to unknown if the device has not reported in the last DeviceDisconnectedTimeout.
+// Poll checks the status of devices and updates the status to unknown if the device has not reported in the last
+// disconnect timeout, which is either the service-wide timeout or the one configured in the device's fleet.
+// Each org is handled separately, with a single update statement per distinct timeout.
 func (t *DeviceDisconnected) Poll() {
 	t.log.Info("Running DeviceDisconnected Polling")
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	statusInfoMessage := fmt.Sprintf("Did not check in for more than %d minutes", int(DeviceDisconnectedTimeout.Minutes()))
-	// TODO: one thread per org?
-	orgID := uuid.UUID{}
-	// batch of 1000 devices
-	listParams := store.ListParams{Limit: ItemsPerPage}
-	for {
-		devices, err := t.deviceStore.List(ctx, orgID, listParams)
-		if err != nil {
-			t.log.WithError(err).Error("failed to list devices")
-			return
-		}
+	orgIds, err := t.deviceStore.ListOrgIds(ctx)
+	if err != nil {
+		t.log.WithError(err).Error("failed to list orgs")
+		return
+	}
 
-		var batch []string
-		for _, device := range devices.Items {
-			if device.Status != nil && device.Status.Summary.Status != v1alpha1.DeviceSummaryStatusUnknown {
-				if device.Status.LastSeen.Add(DeviceDisconnectedTimeout).Before(time.Now()) {
-					batch = append(batch, *device.Metadata.Name)
-				}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, DeviceDisconnectedMaxConcurrentOrgs)
+	for _, orgId := range orgIds {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(orgId uuid.UUID) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := t.pollOrg(ctx, orgId); err != nil {
+				t.log.WithError(err).Errorf("failed to check disconnected devices of org %s", orgId)
 			}
+		}(orgId)
+	}
+	wg.Wait()
+}
+
+func (t *DeviceDisconnected) pollOrg(ctx context.Context, orgId uuid.UUID) error {
+	now := time.Now()
+
+	fleetTimeouts, err := t.fleetTimeouts(ctx, orgId)
+	if err != nil {
+		return err
+	}
+
+	// Devices owned by fleets with their own timeout
+	customOwners := make([]string, 0, len(fleetTimeouts))
+	for owner, timeout := range fleetTimeouts {
+		customOwners = append(customOwners, owner)
+		if err := t.setUnknown(ctx, orgId, now, timeout, store.OwnerFilter{Owners: []string{owner}}); err != nil {
+			return err
 		}
+	}
+
+	// All other devices
+	return t.setUnknown(ctx, orgId, now, t.timeout, store.OwnerFilter{ExcludeOwners: customOwners})
+}
 
-		t.log.Infof("Updating %d devices to unknown status", len(batch))
-		// TODO: This is MVP and needs to be properly evaluated for performance and race conditions
-		if err := t.deviceStore.UpdateSummaryStatusBatch(ctx, orgID, batch, v1alpha1.DeviceSummaryStatusUnknown, statusInfoMessage); err != nil {
-			t.log.WithError(err).Error("failed to update device summary status")
-			return
+// fleetTimeouts returns the disconnect timeouts of the org's fleets that override the default, by owner reference.
+func (t *DeviceDisconnected) fleetTimeouts(ctx context.Context, orgId uuid.UUID) (map[string]time.Duration, error) {
+	fleets, err := t.fleetStore.List(ctx, orgId, store.ListParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fleets: %w", err)
+	}
+
+	timeouts := map[string]time.Duration{}
+	for _, fleet := range fleets.Items {
+		if fleet.Spec.DeviceDisconnectedTimeout == nil {
+			continue
+		}
+		timeout, err := util.ParseDuration(*fleet.Spec.DeviceDisconnectedTimeout)
+		if err != nil {
+			t.log.Warnf("ignoring invalid disconnect timeout of fleet %s/%s: %v", orgId, *fleet.Metadata.Name, err)
+			continue
+		}
+		if timeout != t.timeout {
+			timeouts[*util.SetResourceOwner(model.FleetKind, *fleet.Metadata.Name)] = timeout
 		}
+	}
+	return timeouts, nil
+}
 
-		if devices.Metadata.Continue == nil {
-			break
-		} else {
-			cont, err := store.ParseContinueString(devices.Metadata.Continue)
-			if err != nil {
-				t.log.WithError(err).Error("failed to parse continuation for paging")
-				return
-			}
-			listParams.Continue = cont
+func (t *DeviceDisconnected) setUnknown(ctx context.Context, orgId uuid.UUID, now time.Time, timeout time.Duration, owners store.OwnerFilter) error {
+	statusInfo := fmt.Sprintf("Did not check in for more than %s", timeout)
+	names, err := t.deviceStore.SetUnknownIfNotSeenSince(ctx, orgId, now.Add(-timeout), owners, statusInfo)
+	if err != nil {
+		return fmt.Errorf("failed to update device summary status: %w", err)
+	}
+	if len(names) > 0 {
+		t.log.Infof("Updated %d devices of org %s to unknown status", len(names), orgId)
+	}
+
+	condition := api.Condition{
+		Type:    api.DeviceDisconnected,
+		Status:  api.ConditionStatusTrue,
+		Reason:  api.DeviceDisconnectedReasonNotSeen,
+		Message: statusInfo,
+	}
+	for _, name := range names {
+		if err := t.deviceStore.SetServiceConditions(ctx, orgId, name, []api.Condition{condition}); err != nil {
+			t.log.WithError(err).Errorf("failed to set disconnected condition of device %s/%s", orgId, name)
 		}
+		emitEvent(ctx, t.eventStore, orgId, api.NewEvent(model.DeviceKind, name, api.EventTypeWarning, api.EventReasonDeviceDisconnected, statusInfo), t.log)
 	}
+	return nil
 }
@@ -46,7 +46,7 @@ func BenchmarkDeviceDisconnectedPoll(b *testing.B) {
 }
 
 func benchmarkUpdateSummaryStatusBatch(b *testing.B, log *logrus.Logger, db *gorm.DB, dbStore store.Store, deviceNames []string) error {
-	disconnected := NewDeviceDisconnected(log, dbStore)
+	disconnected := NewDeviceDisconnected(log, dbStore, DeviceDisconnectedTimeout)
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		b.StartTimer()
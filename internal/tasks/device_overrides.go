@@ -0,0 +1,173 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/samber/lo"
+)
+
+// mergeDeviceOverrides merges the overrides of a device over the spec rendered from its fleet's template.
+// Config providers and applications replace the ones with the same name, hooks replace the ones with the
+// same name in the same phase, and resource monitors replace the ones of the same type (and path, for
+// Disk monitors). Anything not matching an item of the template is appended. Application environment
+// variables are merged into the variables of the named application.
+// It returns the merged spec along with the items that were taken from the overrides, or nil if there were none.
+func mergeDeviceOverrides(spec api.DeviceSpec, overrides api.DeviceOverridesSpec) (api.DeviceSpec, *api.DeviceOverriddenItems, error) {
+	overridden := api.DeviceOverriddenItems{}
+
+	if overrides.Config != nil {
+		config, names, err := mergeItems(lo.FromPtr(spec.Config), *overrides.Config, configProviderName)
+		if err != nil {
+			return spec, nil, err
+		}
+		spec.Config = &config
+		overridden.Config = toOptionalSlice(names)
+	}
+
+	if overrides.Applications != nil || overrides.ApplicationEnvVars != nil {
+		apps, names, err := mergeItems(lo.FromPtr(spec.Applications), lo.FromPtr(overrides.Applications), applicationName)
+		if err != nil {
+			return spec, nil, err
+		}
+		appNames := lo.Keys(lo.FromPtr(overrides.ApplicationEnvVars))
+		sort.Strings(appNames)
+		for _, appName := range appNames {
+			index := -1
+			for i := range apps {
+				if name, _ := applicationName(apps[i]); name == appName {
+					index = i
+					break
+				}
+			}
+			if index < 0 {
+				return spec, nil, fmt.Errorf("environment variables overridden for unknown application %q", appName)
+			}
+			envVars := lo.Assign(lo.FromPtr(apps[index].EnvVars), (*overrides.ApplicationEnvVars)[appName])
+			apps[index].EnvVars = &envVars
+			if !lo.Contains(names, appName) {
+				names = append(names, appName)
+			}
+		}
+		spec.Applications = &apps
+		overridden.Applications = toOptionalSlice(names)
+	}
+
+	if overrides.Hooks != nil {
+		hooks := lo.FromPtr(spec.Hooks)
+		var names []string
+		hooks.BeforeUpdating, names = mergeHooks(hooks.BeforeUpdating, overrides.Hooks.BeforeUpdating, "beforeUpdating", func(h api.DeviceUpdateHookSpec) *string { return h.Name }, names)
+		hooks.AfterUpdating, names = mergeHooks(hooks.AfterUpdating, overrides.Hooks.AfterUpdating, "afterUpdating", func(h api.DeviceUpdateHookSpec) *string { return h.Name }, names)
+		hooks.BeforeRebooting, names = mergeHooks(hooks.BeforeRebooting, overrides.Hooks.BeforeRebooting, "beforeRebooting", func(h api.DeviceRebootHookSpec) *string { return h.Name }, names)
+		hooks.AfterRebooting, names = mergeHooks(hooks.AfterRebooting, overrides.Hooks.AfterRebooting, "afterRebooting", func(h api.DeviceRebootHookSpec) *string { return h.Name }, names)
+		spec.Hooks = &hooks
+		overridden.Hooks = toOptionalSlice(names)
+	}
+
+	if overrides.Resources != nil {
+		resources, names, err := mergeItems(lo.FromPtr(spec.Resources), *overrides.Resources, resourceMonitorKey)
+		if err != nil {
+			return spec, nil, err
+		}
+		spec.Resources = &resources
+		overridden.Resources = toOptionalSlice(names)
+	}
+
+	if overridden.Config == nil && overridden.Applications == nil && overridden.Hooks == nil && overridden.Resources == nil {
+		return spec, nil, nil
+	}
+	return spec, &overridden, nil
+}
+
+// mergeItems replaces the items of base with the items of overrides that have the same key and appends the others.
+// It returns the merged items and the keys of the items taken from the overrides.
+func mergeItems[T any](base []T, overrides []T, key func(T) (string, error)) ([]T, []string, error) {
+	merged := append([]T{}, base...)
+	keys := make([]string, 0, len(overrides))
+	for _, item := range overrides {
+		itemKey, err := key(item)
+		if err != nil {
+			return nil, nil, err
+		}
+		replaced := false
+		for i := range merged {
+			mergedKey, err := key(merged[i])
+			if err != nil {
+				return nil, nil, err
+			}
+			if mergedKey == itemKey {
+				merged[i] = item
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, item)
+		}
+		keys = append(keys, itemKey)
+	}
+	return merged, keys, nil
+}
+
+// mergeHooks merges the hooks of one phase, appending the overridden hooks as "<phase>/<name>" to names.
+func mergeHooks[T any](base *[]T, overrides *[]T, phase string, name func(T) *string, names []string) (*[]T, []string) {
+	if overrides == nil {
+		return base, names
+	}
+	// hook names are validated when the overrides are set, so the key never fails
+	merged, keys, _ := mergeItems(lo.FromPtr(base), *overrides, func(h T) (string, error) { return lo.FromPtr(name(h)), nil })
+	for _, key := range keys {
+		names = append(names, fmt.Sprintf("%s/%s", phase, key))
+	}
+	return &merged, names
+}
+
+func configProviderName(config api.ConfigProviderSpec) (string, error) {
+	configJson, err := config.MarshalJSON()
+	if err != nil {
+		return "", fmt.Errorf("failed converting configuration to json: %w", err)
+	}
+	var named struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(configJson, &named); err != nil {
+		return "", fmt.Errorf("failed getting configuration name: %w", err)
+	}
+	return named.Name, nil
+}
+
+// applicationName returns the name of the application, which defaults to its image.
+func applicationName(app api.ApplicationSpec) (string, error) {
+	if app.Name != nil {
+		return *app.Name, nil
+	}
+	provider, err := app.AsImageApplicationProvider()
+	if err != nil {
+		return "", fmt.Errorf("failed getting application image: %w", err)
+	}
+	return provider.Image, nil
+}
+
+func resourceMonitorKey(resource api.ResourceMonitor) (string, error) {
+	monitorType, err := resource.Discriminator()
+	if err != nil {
+		return "", fmt.Errorf("failed getting resource monitor type: %w", err)
+	}
+	if monitorType != "Disk" {
+		return monitorType, nil
+	}
+	disk, err := resource.AsDiskResourceMonitorSpec()
+	if err != nil {
+		return "", fmt.Errorf("failed converting disk resource monitor: %w", err)
+	}
+	return fmt.Sprintf("%s:%s", monitorType, disk.Path), nil
+}
+
+func toOptionalSlice(s []string) *[]string {
+	if len(s) == 0 {
+		return nil
+	}
+	return &s
+}
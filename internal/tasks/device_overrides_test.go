@@ -0,0 +1,121 @@
+package tasks
+
+import (
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+)
+
+func inlineConfig(name string, content string) api.ConfigProviderSpec {
+	config := api.ConfigProviderSpec{}
+	Expect(config.FromInlineConfigProviderSpec(api.InlineConfigProviderSpec{
+		Name:   name,
+		Inline: []api.FileSpec{{Path: "/etc/" + name, Content: content}},
+	})).To(Succeed())
+	return config
+}
+
+func imageApp(name string, image string, envVars map[string]string) api.ApplicationSpec {
+	app := api.ApplicationSpec{Name: lo.EmptyableToPtr(name), EnvVars: lo.EmptyableToPtr(envVars)}
+	Expect(app.FromImageApplicationProvider(api.ImageApplicationProvider{Image: image})).To(Succeed())
+	return app
+}
+
+func diskMonitor(path string, samplingInterval string) api.ResourceMonitor {
+	monitor := api.ResourceMonitor{}
+	Expect(monitor.FromDiskResourceMonitorSpec(api.DiskResourceMonitorSpec{
+		Path:             path,
+		SamplingInterval: samplingInterval,
+		AlertRules:       []api.ResourceAlertRule{},
+	})).To(Succeed())
+	return monitor
+}
+
+var _ = Describe("mergeDeviceOverrides", func() {
+	var spec api.DeviceSpec
+
+	BeforeEach(func() {
+		spec = api.DeviceSpec{
+			Os:           &api.DeviceOSSpec{Image: "os"},
+			Config:       &[]api.ConfigProviderSpec{inlineConfig("first", "template"), inlineConfig("second", "template")},
+			Applications: &[]api.ApplicationSpec{imageApp("app", "app-image:1", map[string]string{"A": "1", "B": "2"}), imageApp("", "other-image", nil)},
+			Hooks: &api.DeviceHooksSpec{
+				AfterUpdating: &[]api.DeviceUpdateHookSpec{{Name: lo.ToPtr("reload"), Path: lo.ToPtr("/etc/template")}},
+			},
+			Resources: &[]api.ResourceMonitor{diskMonitor("/", "5m"), diskMonitor("/var", "5m")},
+		}
+	})
+
+	When("there are no overrides", func() {
+		It("returns the spec unchanged", func() {
+			merged, overridden, err := mergeDeviceOverrides(spec, api.DeviceOverridesSpec{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(overridden).To(BeNil())
+			Expect(merged).To(Equal(spec))
+		})
+	})
+
+	When("the overrides have config providers", func() {
+		It("replaces the ones with the same name and appends the others", func() {
+			merged, overridden, err := mergeDeviceOverrides(spec, api.DeviceOverridesSpec{
+				Config: &[]api.ConfigProviderSpec{inlineConfig("second", "device"), inlineConfig("third", "device")},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*merged.Config).To(Equal([]api.ConfigProviderSpec{
+				inlineConfig("first", "template"), inlineConfig("second", "device"), inlineConfig("third", "device"),
+			}))
+			Expect(overridden).To(Equal(&api.DeviceOverriddenItems{Config: &[]string{"second", "third"}}))
+			Expect(*spec.Config).To(HaveLen(2))
+		})
+	})
+
+	When("the overrides have applications and environment variables", func() {
+		It("replaces the applications by name and merges the environment variables", func() {
+			merged, overridden, err := mergeDeviceOverrides(spec, api.DeviceOverridesSpec{
+				Applications:       &[]api.ApplicationSpec{imageApp("", "other-image", map[string]string{"C": "3"})},
+				ApplicationEnvVars: &map[string]map[string]string{"app": {"B": "device", "D": "4"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*merged.Applications).To(Equal([]api.ApplicationSpec{
+				imageApp("app", "app-image:1", map[string]string{"A": "1", "B": "device", "D": "4"}),
+				imageApp("", "other-image", map[string]string{"C": "3"}),
+			}))
+			Expect(overridden).To(Equal(&api.DeviceOverriddenItems{Applications: &[]string{"other-image", "app"}}))
+			Expect(*(*spec.Applications)[0].EnvVars).To(Equal(map[string]string{"A": "1", "B": "2"}))
+		})
+
+		It("fails when the environment variables are set on an unknown application", func() {
+			_, _, err := mergeDeviceOverrides(spec, api.DeviceOverridesSpec{
+				ApplicationEnvVars: &map[string]map[string]string{"missing": {"A": "1"}},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("the overrides have hooks", func() {
+		It("replaces the hooks of the same phase by name and appends the others", func() {
+			merged, overridden, err := mergeDeviceOverrides(spec, api.DeviceOverridesSpec{
+				Hooks: &api.DeviceHooksSpec{
+					AfterUpdating:  &[]api.DeviceUpdateHookSpec{{Name: lo.ToPtr("reload"), Path: lo.ToPtr("/etc/device")}},
+					BeforeUpdating: &[]api.DeviceUpdateHookSpec{{Name: lo.ToPtr("backup"), Path: lo.ToPtr("/etc/device")}},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*merged.Hooks.AfterUpdating).To(Equal([]api.DeviceUpdateHookSpec{{Name: lo.ToPtr("reload"), Path: lo.ToPtr("/etc/device")}}))
+			Expect(*merged.Hooks.BeforeUpdating).To(Equal([]api.DeviceUpdateHookSpec{{Name: lo.ToPtr("backup"), Path: lo.ToPtr("/etc/device")}}))
+			Expect(overridden).To(Equal(&api.DeviceOverriddenItems{Hooks: &[]string{"beforeUpdating/backup", "afterUpdating/reload"}}))
+		})
+	})
+
+	When("the overrides have resource monitors", func() {
+		It("replaces Disk monitors by path and appends the others", func() {
+			merged, overridden, err := mergeDeviceOverrides(spec, api.DeviceOverridesSpec{
+				Resources: &[]api.ResourceMonitor{diskMonitor("/var", "1m"), diskMonitor("/home", "1m")},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*merged.Resources).To(Equal([]api.ResourceMonitor{diskMonitor("/", "5m"), diskMonitor("/var", "1m"), diskMonitor("/home", "1m")}))
+			Expect(overridden).To(Equal(&api.DeviceOverriddenItems{Resources: &[]string{"Disk:/var", "Disk:/home"}}))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package tasks
 
 import (
 	"context"
+	"crypto"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,7 +12,10 @@ import (
 	config_latest "github.com/coreos/ignition/v2/config/v3_4"
 	config_latest_types "github.com/coreos/ignition/v2/config/v3_4/types"
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	fcrypto "github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/ignition"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
@@ -55,13 +59,28 @@ func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 		return fmt.Errorf("failed getting device %s/%s: %w", t.resourceRef.OrgID, t.resourceRef.Name, err)
 	}
 
+	// The device keeps its rendered version while fleet management is paused, and is rendered again once resumed
+	pausedReason, err := getPausedReason(ctx, t.store.Fleet(), t.resourceRef.OrgID, device)
+	if err != nil {
+		return err
+	}
+	if len(pausedReason) > 0 {
+		t.log.Infof("Not rendering device %s/%s because fleet management is paused (%s)", t.resourceRef.OrgID, t.resourceRef.Name, pausedReason)
+		return nil
+	}
+
 	// If device.Spec or device.Spec.Config are nil, we still want to render an empty ignition config
 	var config *[]api.ConfigProviderSpec
 	if device.Spec != nil {
 		config = device.Spec.Config
 	}
 
-	renderedConfig, repoNames, renderErr := renderConfig(ctx, t.resourceRef.OrgID, t.store, t.k8sClient, config, !util.IsEmptyString(device.Metadata.Owner), false)
+	devicePublicKey, err := t.getDevicePublicKey(ctx)
+	if err != nil {
+		return t.setStatus(ctx, err)
+	}
+
+	renderedConfig, repoNames, secretVersions, renderErr := renderConfig(ctx, t.resourceRef.OrgID, t.store, t.k8sClient, config, devicePublicKey, !util.IsEmptyString(device.Metadata.Owner), false)
 
 	// Set the many-to-many relationship with the repos (we do this even if the render failed so that we will
 	// render the device again if the repository is updated, and then it might be fixed).
@@ -83,10 +102,53 @@ func (t *DeviceRenderLogic) RenderDevice(ctx context.Context) error {
 		return t.setStatus(ctx, err)
 	}
 
-	err = t.store.Device().UpdateRendered(ctx, t.resourceRef.OrgID, t.resourceRef.Name, string(renderedConfig), string(renderedApplications))
+	renderedVersion, err := t.store.Device().UpdateRendered(ctx, t.resourceRef.OrgID, t.resourceRef.Name, string(renderedConfig), string(renderedApplications))
+	if err != nil {
+		return t.setStatus(ctx, err)
+	}
+	emitEvent(ctx, t.store.Event(), t.resourceRef.OrgID, api.NewEvent(model.DeviceKind, t.resourceRef.Name, api.EventTypeNormal,
+		api.EventReasonRenderedVersionChanged, fmt.Sprintf("Device rendered at version %s", renderedVersion)), t.log)
+
+	err = t.updateSecretVersions(ctx, device, secretVersions)
 	return t.setStatus(ctx, err)
 }
 
+// getDevicePublicKey returns the public key of the device from the CSR of its
+// enrollment request, or nil if the device was not enrolled.
+func (t *DeviceRenderLogic) getDevicePublicKey(ctx context.Context) (crypto.PublicKey, error) {
+	enrollmentRequest, err := t.store.EnrollmentRequest().Get(ctx, t.resourceRef.OrgID, t.resourceRef.Name)
+	if errors.Is(err, flterrors.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed getting enrollment request of device %s/%s: %w", t.resourceRef.OrgID, t.resourceRef.Name, err)
+	}
+	csr, err := fcrypto.ParseCSR([]byte(enrollmentRequest.Spec.Csr))
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing CSR of device %s/%s: %w", t.resourceRef.OrgID, t.resourceRef.Name, err)
+	}
+	return csr.PublicKey, nil
+}
+
+// updateSecretVersions records the versions of the Vault secrets that were
+// rendered, so that the device is rendered again when new versions are written.
+func (t *DeviceRenderLogic) updateSecretVersions(ctx context.Context, device *api.Device, secretVersions map[string]int) error {
+	_, exists := lo.FromPtr(device.Metadata.Annotations)[model.DeviceAnnotationRenderedSecretVersions]
+	if len(secretVersions) == 0 {
+		if !exists {
+			return nil
+		}
+		return t.store.Device().UpdateAnnotations(ctx, t.resourceRef.OrgID, t.resourceRef.Name, nil, []string{model.DeviceAnnotationRenderedSecretVersions})
+	}
+
+	value, err := json.Marshal(secretVersions)
+	if err != nil {
+		return fmt.Errorf("failed marshalling secret versions: %w", err)
+	}
+	annotations := map[string]string{model.DeviceAnnotationRenderedSecretVersions: string(value)}
+	return t.store.Device().UpdateAnnotations(ctx, t.resourceRef.OrgID, t.resourceRef.Name, annotations, nil)
+}
+
 func (t *DeviceRenderLogic) setStatus(ctx context.Context, renderErr error) error {
 	condition := api.Condition{Type: api.DeviceSpecValid}
 
@@ -103,6 +165,10 @@ func (t *DeviceRenderLogic) setStatus(ctx context.Context, renderErr error) erro
 	if err != nil {
 		t.log.Errorf("Failed setting condition for device %s/%s: %v", t.resourceRef.OrgID, t.resourceRef.Name, err)
 	}
+	if renderErr != nil {
+		emitEvent(ctx, t.store.Event(), t.resourceRef.OrgID, api.NewEvent(model.DeviceKind, t.resourceRef.Name, api.EventTypeWarning,
+			api.EventReasonRenderFailed, fmt.Sprintf("Failed rendering device: %v", renderErr)), t.log)
+	}
 	return renderErr
 }
 
@@ -110,8 +176,10 @@ type renderConfigArgs struct {
 	orgId                uuid.UUID
 	store                store.Store
 	k8sClient            k8sclient.K8SClient
+	devicePublicKey      crypto.PublicKey
 	ignitionConfig       *config_latest_types.Config
 	repoNames            []string
+	secretVersions       map[string]int
 	validateOnly         bool
 	deviceBelongsToFleet bool
 }
@@ -182,7 +250,7 @@ func renderApplications(ctx context.Context, store store.Store, orgId uuid.UUID,
 	return renderedApplications, nil
 }
 
-func renderConfig(ctx context.Context, orgId uuid.UUID, store store.Store, k8sClient k8sclient.K8SClient, config *[]api.ConfigProviderSpec, deviceBelongsToFleet bool, validateOnly bool) (renderedConfig []byte, repoNames []string, err error) {
+func renderConfig(ctx context.Context, orgId uuid.UUID, store store.Store, k8sClient k8sclient.K8SClient, config *[]api.ConfigProviderSpec, devicePublicKey crypto.PublicKey, deviceBelongsToFleet bool, validateOnly bool) (renderedConfig []byte, repoNames []string, secretVersions map[string]int, err error) {
 	args := renderConfigArgs{}
 	emptyIgnitionConfig := config_latest_types.Config{
 		Ignition: config_latest_types.Ignition{
@@ -194,23 +262,25 @@ func renderConfig(ctx context.Context, orgId uuid.UUID, store store.Store, k8sCl
 	args.orgId = orgId
 	args.store = store
 	args.k8sClient = k8sClient
+	args.devicePublicKey = devicePublicKey
 	args.deviceBelongsToFleet = deviceBelongsToFleet
+	args.secretVersions = map[string]int{}
 
 	err = renderConfigItems(ctx, config, &args)
 	if err != nil {
-		return nil, args.repoNames, err
+		return nil, args.repoNames, nil, err
 	}
 
 	if validateOnly {
-		return nil, args.repoNames, nil
+		return nil, args.repoNames, nil, nil
 	}
 
 	renderedConfig, err = json.Marshal(args.ignitionConfig)
 	if err != nil {
-		return nil, args.repoNames, fmt.Errorf("failed marshalling configuration: %w", err)
+		return nil, args.repoNames, nil, fmt.Errorf("failed marshalling configuration: %w", err)
 	}
 
-	return renderedConfig, args.repoNames, nil
+	return renderedConfig, args.repoNames, args.secretVersions, nil
 }
 
 func renderConfigItems(ctx context.Context, config *[]api.ConfigProviderSpec, args *renderConfigArgs) error {
@@ -267,7 +337,14 @@ func validateParameters(item RenderItem, validateOnly, deviceBelongsToFleet bool
 	}
 	if validateOnly {
 		// Make sure all parameters are in the proper format
-		return ValidateParameterFormat(cfgJson)
+		var value interface{}
+		if err := json.Unmarshal(cfgJson, &value); err != nil {
+			return fmt.Errorf("failed converting configuration from json: %w", err)
+		}
+		_, err := renderTemplateStrings(value, "", func(path string, s string) (string, error) {
+			return s, validateTemplate(strings.TrimPrefix(path, "."), s)
+		})
+		return err
 	}
 
 	// If we're rendering the device config and it still has parameters, something went wrong
@@ -279,6 +356,18 @@ func validateParameters(item RenderItem, validateOnly, deviceBelongsToFleet bool
 		}
 	}
 
+	// Conditions are removed from the configuration of a device when rolling out a fleet's template
+	var condition struct {
+		When *string `json:"when"`
+	}
+	if err := json.Unmarshal(cfgJson, &condition); err == nil && condition.When != nil {
+		if deviceBelongsToFleet {
+			return fmt.Errorf("configuration condition %q is neither true nor false", *condition.When)
+		} else {
+			return fmt.Errorf("configuration contains condition, but conditions can only be used in fleet templates")
+		}
+	}
+
 	return nil
 }
 
@@ -297,6 +386,10 @@ func renderConfigItem(ctx context.Context, configItem *api.ConfigProviderSpec, a
 		return renderInlineConfig(configItem, args)
 	case api.HttpConfigProviderType:
 		return renderHttpProviderConfig(ctx, configItem, args)
+	case api.OciConfigProviderType:
+		return renderOciConfig(ctx, configItem, args)
+	case api.VaultSecretProviderType:
+		return renderVaultConfig(ctx, configItem, args)
 	default:
 		return "", fmt.Errorf("%w: unsupported config type %q", ErrUnknownConfigName, configType)
 	}
@@ -387,20 +480,38 @@ func renderK8sConfig(configItem *api.ConfigProviderSpec, args *renderConfigArgs)
 	if err != nil {
 		return k8sSpec.Name, fmt.Errorf("failed getting secret %s/%s: %w", k8sSpec.SecretRef.Namespace, k8sSpec.SecretRef.Name, err)
 	}
+	if args.validateOnly {
+		return k8sSpec.Name, nil
+	}
 	ignitionWrapper, err := ignition.NewWrapper()
 	if err != nil {
 		return k8sSpec.Name, fmt.Errorf("failed to create ignition wrapper: %w", err)
 	}
 	splits := filepath.SplitList(k8sSpec.SecretRef.MountPath)
 	for name, contents := range secret.Data {
-		ignitionWrapper.SetFile(filepath.Join(append(splits, name)...), contents, 0o644, false, nil, nil)
-	}
-	if !args.validateOnly {
-		args.ignitionConfig = lo.ToPtr(ignitionWrapper.Merge(*args.ignitionConfig))
+		if err := setDeviceSecretFile(ignitionWrapper, args, filepath.Join(append(splits, name)...), contents, 0o644, nil, nil); err != nil {
+			return k8sSpec.Name, fmt.Errorf("failed rendering secret %s/%s: %w", k8sSpec.SecretRef.Namespace, k8sSpec.SecretRef.Name, err)
+		}
 	}
+	args.ignitionConfig = lo.ToPtr(ignitionWrapper.Merge(*args.ignitionConfig))
 	return k8sSpec.Name, nil
 }
 
+// setDeviceSecretFile adds a file to the ignition config whose contents are
+// encrypted to the public key of the device, so that they can neither be read
+// from the database nor from the rendered device spec.
+func setDeviceSecretFile(ignitionWrapper ignition.Wrapper, args *renderConfigArgs, filePath string, contents []byte, mode int, user *string, group *string) error {
+	if args.devicePublicKey == nil {
+		return errors.New("secrets can only be rendered for enrolled devices")
+	}
+	ciphertext, err := fcrypto.EncryptDeviceSecret(args.devicePublicKey, contents)
+	if err != nil {
+		return fmt.Errorf("failed encrypting file %s: %w", filePath, err)
+	}
+	ignitionWrapper.SetDeviceSecretFile(filePath, ciphertext, mode, user, group)
+	return nil
+}
+
 func renderInlineConfig(configItem *api.ConfigProviderSpec, args *renderConfigArgs) (string, error) {
 	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
 	if err != nil {
@@ -476,3 +587,96 @@ func renderHttpProviderConfig(ctx context.Context, configItem *api.ConfigProvide
 
 	return httpConfigProviderSpec.Name, nil
 }
+
+func renderOciConfig(ctx context.Context, configItem *api.ConfigProviderSpec, args *renderConfigArgs) (string, error) {
+	ociSpec, err := configItem.AsOciConfigProviderSpec()
+	if err != nil {
+		return "", fmt.Errorf("%w: failed getting config item as OciConfigProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+
+	args.repoNames = append(args.repoNames, ociSpec.OciRef.Repository)
+	repo, err := args.store.Repository().GetInternal(ctx, args.orgId, ociSpec.OciRef.Repository)
+	if err != nil {
+		return ociSpec.Name, fmt.Errorf("failed fetching specified Repository definition %s/%s: %w", args.orgId, ociSpec.OciRef.Repository, err)
+	}
+	if repo.Spec == nil {
+		return ociSpec.Name, fmt.Errorf("empty Repository definition %s/%s", args.orgId, ociSpec.OciRef.Repository)
+	}
+	client, err := newOciClientForRepository(repo)
+	if err != nil {
+		return ociSpec.Name, fmt.Errorf("invalid OCI Repository definition %s/%s: %w", args.orgId, ociSpec.OciRef.Repository, err)
+	}
+
+	if args.validateOnly {
+		return ociSpec.Name, nil
+	}
+
+	files, err := client.PullArtifactFiles(ctx, ociSpec.OciRef.Artifact, ociSpec.OciRef.Reference)
+	if err != nil {
+		return ociSpec.Name, fmt.Errorf("failed pulling OCI artifact %s:%s from repository %s/%s: %w",
+			ociSpec.OciRef.Artifact, ociSpec.OciRef.Reference, args.orgId, ociSpec.OciRef.Repository, err)
+	}
+
+	ignitionWrapper, err := ignition.NewWrapper()
+	if err != nil {
+		return ociSpec.Name, fmt.Errorf("failed to create ignition wrapper: %w", err)
+	}
+	mountPath := lo.FromPtrOr(ociSpec.OciRef.MountPath, "/")
+	for name, contents := range files {
+		ignitionWrapper.SetFile(filepath.Join(mountPath, name), contents, 0o644, false, nil, nil)
+	}
+	args.ignitionConfig = lo.ToPtr(ignitionWrapper.Merge(*args.ignitionConfig))
+
+	return ociSpec.Name, nil
+}
+
+func renderVaultConfig(ctx context.Context, configItem *api.ConfigProviderSpec, args *renderConfigArgs) (string, error) {
+	vaultSpec, err := configItem.AsVaultSecretProviderSpec()
+	if err != nil {
+		return "", fmt.Errorf("%w: failed getting config item as VaultSecretProviderSpec: %w", ErrUnknownConfigName, err)
+	}
+
+	args.repoNames = append(args.repoNames, vaultSpec.VaultRef.Repository)
+	repo, err := args.store.Repository().GetInternal(ctx, args.orgId, vaultSpec.VaultRef.Repository)
+	if err != nil {
+		return vaultSpec.Name, fmt.Errorf("failed fetching specified Repository definition %s/%s: %w", args.orgId, vaultSpec.VaultRef.Repository, err)
+	}
+	if repo.Spec == nil {
+		return vaultSpec.Name, fmt.Errorf("empty Repository definition %s/%s", args.orgId, vaultSpec.VaultRef.Repository)
+	}
+	client, err := newVaultClientForRepository(repo)
+	if err != nil {
+		return vaultSpec.Name, fmt.Errorf("invalid Vault Repository definition %s/%s: %w", args.orgId, vaultSpec.VaultRef.Repository, err)
+	}
+
+	if args.validateOnly {
+		return vaultSpec.Name, nil
+	}
+
+	secret, err := client.ReadSecret(ctx, vaultSpec.VaultRef.Path, vaultSpec.VaultRef.Version)
+	if err != nil {
+		return vaultSpec.Name, fmt.Errorf("failed reading secret from Vault repository %s/%s: %w", args.orgId, vaultSpec.VaultRef.Repository, err)
+	}
+
+	ignitionWrapper, err := ignition.NewWrapper()
+	if err != nil {
+		return vaultSpec.Name, fmt.Errorf("failed to create ignition wrapper: %w", err)
+	}
+	for _, file := range vaultSpec.VaultRef.Files {
+		contents, err := vaultSecretValue(secret, file.Key)
+		if err != nil {
+			return vaultSpec.Name, fmt.Errorf("failed reading secret %s from Vault repository %s/%s: %w", vaultSpec.VaultRef.Path, args.orgId, vaultSpec.VaultRef.Repository, err)
+		}
+		if err := setDeviceSecretFile(ignitionWrapper, args, file.Path, contents, lo.FromPtrOr(file.Mode, 0o600), file.User, file.Group); err != nil {
+			return vaultSpec.Name, fmt.Errorf("failed rendering secret %s from Vault repository %s/%s: %w", vaultSpec.VaultRef.Path, args.orgId, vaultSpec.VaultRef.Repository, err)
+		}
+	}
+	args.ignitionConfig = lo.ToPtr(ignitionWrapper.Merge(*args.ignitionConfig))
+
+	// Only the latest version of a secret can change
+	if vaultSpec.VaultRef.Version == nil {
+		args.secretVersions[vaultSecretVersionKey(vaultSpec.VaultRef.Repository, vaultSpec.VaultRef.Path)] = secret.Version
+	}
+
+	return vaultSpec.Name, nil
+}
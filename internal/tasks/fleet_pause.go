@@ -0,0 +1,79 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/google/uuid"
+)
+
+// getPausedReason returns why fleet management of the device is paused, which is either
+// the label of the device itself or the label of the fleet owning it, or an empty string
+// if it is not paused.
+func getPausedReason(ctx context.Context, fleetStore store.Fleet, orgId uuid.UUID, device *api.Device) (string, error) {
+	if api.IsFleetControllerPaused(device.Metadata.Labels) {
+		return api.DevicePausedReasonDevice, nil
+	}
+
+	ownerName, isFleetOwner, err := getOwnerFleet(device)
+	if err != nil {
+		return "", fmt.Errorf("failed getting device owner: %w", err)
+	}
+	if !isFleetOwner || len(ownerName) == 0 {
+		return "", nil
+	}
+
+	fleet, err := fleetStore.Get(ctx, orgId, ownerName)
+	if errors.Is(err, flterrors.ErrResourceNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed getting fleet %s/%s: %w", orgId, ownerName, err)
+	}
+	if api.IsFleetControllerPaused(fleet.Metadata.Labels) {
+		return api.DevicePausedReasonFleet, nil
+	}
+	return "", nil
+}
+
+// setPausedCondition sets the Paused condition of the device according to the reason it is
+// paused, and returns true if the device was resumed. Devices that were never paused are
+// left without the condition.
+func setPausedCondition(ctx context.Context, devStore store.Device, orgId uuid.UUID, device *api.Device, reason string) (bool, error) {
+	var current *api.Condition
+	if device.Status != nil {
+		current = api.FindStatusCondition(device.Status.Conditions, api.DevicePaused)
+	}
+	wasPaused := current != nil && current.Status == api.ConditionStatusTrue
+
+	condition := api.Condition{Type: api.DevicePaused}
+	switch reason {
+	case "":
+		if !wasPaused {
+			return false, nil
+		}
+		condition.Status = api.ConditionStatusFalse
+		condition.Reason = api.DevicePausedReasonResumed
+		condition.Message = "Fleet management of the device was resumed"
+	case api.DevicePausedReasonDevice:
+		condition.Status = api.ConditionStatusTrue
+		condition.Reason = reason
+		condition.Message = fmt.Sprintf("Fleet management is paused by the %s=%s label of the device", api.FleetControllerLabel, api.FleetControllerPaused)
+	case api.DevicePausedReasonFleet:
+		condition.Status = api.ConditionStatusTrue
+		condition.Reason = reason
+		condition.Message = fmt.Sprintf("Fleet management is paused by the %s=%s label of the fleet", api.FleetControllerLabel, api.FleetControllerPaused)
+	}
+	if wasPaused && current.Reason == condition.Reason {
+		return false, nil
+	}
+
+	if err := devStore.SetServiceConditions(ctx, orgId, *device.Metadata.Name, []api.Condition{condition}); err != nil {
+		return false, fmt.Errorf("failed setting paused condition: %w", err)
+	}
+	return wasPaused && condition.Status == api.ConditionStatusFalse, nil
+}
@@ -2,7 +2,7 @@ package tasks
 
 import (
 	"context"
-	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -45,6 +45,7 @@ type FleetRolloutsLogic struct {
 	fleetStore      store.Fleet
 	devStore        store.Device
 	tvStore         store.TemplateVersion
+	eventStore      store.Event
 	resourceRef     ResourceReference
 	itemsPerPage    int
 	owner           string
@@ -57,6 +58,7 @@ func NewFleetRolloutsLogic(callbackManager CallbackManager, log logrus.FieldLogg
 		fleetStore:      storeInst.Fleet(),
 		devStore:        storeInst.Device(),
 		tvStore:         storeInst.TemplateVersion(),
+		eventStore:      storeInst.Event(),
 		resourceRef:     resourceRef,
 		itemsPerPage:    ItemsPerPage,
 	}
@@ -69,10 +71,22 @@ func (f *FleetRolloutsLogic) SetItemsPerPage(items int) {
 func (f FleetRolloutsLogic) RolloutFleet(ctx context.Context) error {
 	f.log.Infof("Rolling out fleet %s/%s", f.resourceRef.OrgID, f.resourceRef.Name)
 
+	fleet, err := f.fleetStore.Get(ctx, f.resourceRef.OrgID, f.resourceRef.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get fleet: %w", err)
+	}
+	fleetPaused := api.IsFleetControllerPaused(fleet.Metadata.Labels)
+
 	templateVersion, err := f.tvStore.GetNewestValid(ctx, f.resourceRef.OrgID, f.resourceRef.Name)
 	if err != nil {
 		return fmt.Errorf("failed to get templateVersion: %w", err)
 	}
+	if fleetPaused {
+		f.log.Infof("Not rolling out fleet %s/%s because fleet management is paused", f.resourceRef.OrgID, f.resourceRef.Name)
+	} else {
+		emitEvent(ctx, f.eventStore, f.resourceRef.OrgID, api.NewEvent(model.FleetKind, f.resourceRef.Name, api.EventTypeNormal, api.EventReasonRolloutStarted,
+			fmt.Sprintf("Rolling out template version %s to the devices of the fleet", *templateVersion.Metadata.Name)), f.log)
+	}
 
 	failureCount := 0
 	owner := util.SetResourceOwner(model.FleetKind, f.resourceRef.Name)
@@ -86,10 +100,27 @@ func (f FleetRolloutsLogic) RolloutFleet(ctx context.Context) error {
 			// TODO: Retry when we have a mechanism that allows it
 			return fmt.Errorf("failed fetching devices: %w", err)
 		}
+		deviceNames := make([]string, len(devices.Items))
+		for devIndex := range devices.Items {
+			deviceNames[devIndex] = *devices.Items[devIndex].Metadata.Name
+		}
+		overrides, err := f.devStore.ListOverrides(ctx, f.resourceRef.OrgID, deviceNames)
+		if err != nil {
+			return fmt.Errorf("failed fetching device overrides: %w", err)
+		}
 
 		for devIndex := range devices.Items {
 			device := &devices.Items[devIndex]
-			err = f.updateDeviceToFleetTemplate(ctx, device, templateVersion)
+			pausedReason := ""
+			if api.IsFleetControllerPaused(device.Metadata.Labels) {
+				pausedReason = api.DevicePausedReasonDevice
+			} else if fleetPaused {
+				pausedReason = api.DevicePausedReasonFleet
+			}
+			if f.checkPaused(ctx, device, pausedReason) {
+				continue
+			}
+			err = f.updateDeviceToFleetTemplate(ctx, device, templateVersion, overrides[*device.Metadata.Name])
 			if err != nil {
 				f.log.Errorf("failed to update target generation for device %s (fleet %s): %v", *device.Metadata.Name, f.resourceRef.Name, err)
 				failureCount++
@@ -124,6 +155,14 @@ func (f FleetRolloutsLogic) RolloutDevice(ctx context.Context) error {
 		return fmt.Errorf("failed to get device: %w", err)
 	}
 
+	pausedReason, err := getPausedReason(ctx, f.fleetStore, f.resourceRef.OrgID, device)
+	if err != nil {
+		return err
+	}
+	if f.checkPaused(ctx, device, pausedReason) {
+		return nil
+	}
+
 	if device.Metadata.Owner == nil || len(*device.Metadata.Owner) == 0 {
 		return nil
 	}
@@ -146,37 +185,80 @@ func (f FleetRolloutsLogic) RolloutDevice(ctx context.Context) error {
 		return fmt.Errorf("failed to get templateVersion: %w", err)
 	}
 
-	return f.updateDeviceToFleetTemplate(ctx, device, templateVersion)
+	overrides, err := f.devStore.GetOverrides(ctx, f.resourceRef.OrgID, *device.Metadata.Name)
+	if err != nil {
+		return fmt.Errorf("failed getting device overrides: %w", err)
+	}
+
+	return f.updateDeviceToFleetTemplate(ctx, device, templateVersion, overrides.Spec)
 }
 
-func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, device *api.Device, templateVersion *api.TemplateVersion) error {
+// checkPaused records whether fleet management of the device is paused, and returns true if it is.
+// A device that is resumed is rendered again, as it was not rendered while it was paused.
+func (f FleetRolloutsLogic) checkPaused(ctx context.Context, device *api.Device, pausedReason string) bool {
+	resumed, err := setPausedCondition(ctx, f.devStore, f.resourceRef.OrgID, device, pausedReason)
+	if err != nil {
+		f.log.Errorf("failed updating paused condition of device %s/%s: %v", f.resourceRef.OrgID, *device.Metadata.Name, err)
+	}
+	if resumed {
+		f.callbackManager.DeviceSourceUpdated(ctx, f.resourceRef.OrgID, *device.Metadata.Name)
+	}
+	if len(pausedReason) > 0 {
+		f.log.Infof("Not rolling out device %s/%s because fleet management is paused (%s)", f.resourceRef.OrgID, *device.Metadata.Name, pausedReason)
+		return true
+	}
+	return false
+}
+
+func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, device *api.Device, templateVersion *api.TemplateVersion, overrides api.DeviceOverridesSpec) error {
 	currentVersion := ""
+	currentOverriddenAnnotation := ""
 	if device.Metadata.Annotations != nil {
 		v, ok := (*device.Metadata.Annotations)[model.DeviceAnnotationTemplateVersion]
 		if ok {
 			currentVersion = v
 		}
+		currentOverriddenAnnotation = (*device.Metadata.Annotations)[model.DeviceAnnotationOverridden]
 	}
 
-	deviceConfig, err := f.getDeviceConfig(device, templateVersion)
+	render := f.deviceTemplateRenderer(device)
+	deviceConfig, err := f.getDeviceConfig(render, device, templateVersion)
+	if err != nil {
+		return err
+	}
+	deviceApps, err := f.getDeviceApps(render, templateVersion)
 	if err != nil {
 		return err
 	}
-	deviceApps, err := f.getDeviceApps(device, templateVersion)
+	deviceOs := f.getDeviceOs(render, templateVersion)
+	deviceHooks, err := f.getDeviceHooks(render, templateVersion)
 	if err != nil {
 		return err
 	}
 
 	newDeviceSpec := api.DeviceSpec{
 		Config:       deviceConfig,
-		Os:           templateVersion.Status.Os,
+		Os:           deviceOs,
 		Systemd:      templateVersion.Status.Systemd,
 		Resources:    templateVersion.Status.Resources,
-		Hooks:        templateVersion.Status.Hooks,
+		Hooks:        deviceHooks,
 		Applications: deviceApps,
 	}
 
-	if currentVersion == *templateVersion.Metadata.Name && api.DeviceSpecsAreEqual(newDeviceSpec, *device.Spec) {
+	newDeviceSpec, overridden, err := mergeDeviceOverrides(newDeviceSpec, overrides)
+	if err != nil {
+		return fmt.Errorf("failed merging device overrides: %w", err)
+	}
+	overriddenAnnotation := ""
+	if overridden != nil {
+		overriddenJson, err := json.Marshal(overridden)
+		if err != nil {
+			return fmt.Errorf("failed marshalling overridden items: %w", err)
+		}
+		overriddenAnnotation = string(overriddenJson)
+	}
+
+	if currentVersion == *templateVersion.Metadata.Name && currentOverriddenAnnotation == overriddenAnnotation && api.DeviceSpecsAreEqual(newDeviceSpec, *device.Spec) {
 		f.log.Debugf("Not rolling out device %s/%s because it is already at templateVersion %s", f.resourceRef.OrgID, *device.Metadata.Name, *templateVersion.Metadata.Name)
 		return nil
 	}
@@ -190,7 +272,13 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 	annotations := map[string]string{
 		model.DeviceAnnotationTemplateVersion: *templateVersion.Metadata.Name,
 	}
-	err = f.devStore.UpdateAnnotations(ctx, f.resourceRef.OrgID, *device.Metadata.Name, annotations, nil)
+	deleteKeys := []string{}
+	if overridden != nil {
+		annotations[model.DeviceAnnotationOverridden] = overriddenAnnotation
+	} else {
+		deleteKeys = append(deleteKeys, model.DeviceAnnotationOverridden)
+	}
+	err = f.devStore.UpdateAnnotations(ctx, f.resourceRef.OrgID, *device.Metadata.Name, annotations, deleteKeys)
 	if err != nil {
 		return fmt.Errorf("failed updating templateVersion annotation: %w", err)
 	}
@@ -198,20 +286,35 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 	return err
 }
 
-func (f FleetRolloutsLogic) getDeviceApps(device *api.Device, templateVersion *api.TemplateVersion) (*[]api.ApplicationSpec, error) {
+// deviceTemplateRenderer returns a function rendering the templates of the fleet's template for the device.
+// Templates that fail to render are logged and left as they are, so that the device's specification is
+// marked as invalid when it is rendered.
+func (f FleetRolloutsLogic) deviceTemplateRenderer(device *api.Device) templateRenderFunc {
+	deviceTemplate := newDeviceTemplate(device.Metadata, device.Status)
+	return func(path string, s string) (string, error) {
+		rendered, err := deviceTemplate.render(path, s)
+		if err != nil {
+			f.log.Infof("failed replacing parameters for device %s/%s: %v", f.resourceRef.OrgID, *device.Metadata.Name, err)
+			return s, nil
+		}
+		return rendered, nil
+	}
+}
+
+func (f FleetRolloutsLogic) getDeviceApps(render templateRenderFunc, templateVersion *api.TemplateVersion) (*[]api.ApplicationSpec, error) {
 	if templateVersion.Status.Applications == nil {
 		return nil, nil
 	}
 
 	deviceApps := []api.ApplicationSpec{}
-	for _, app := range *templateVersion.Status.Applications {
+	for i, app := range *templateVersion.Status.Applications {
 		appType, err := app.Type()
 		if err != nil {
 			return nil, fmt.Errorf("failed getting app type: %w", err)
 		}
 		switch appType {
 		case api.ImageApplicationProviderType:
-			newApp, err := f.replaceEnvVarValueParameters(device, app)
+			newApp, err := f.replaceEnvVarValueParameters(render, fmt.Sprintf("applications[%d].envVars", i), app)
 			if err != nil {
 				return nil, err
 			}
@@ -224,120 +327,81 @@ func (f FleetRolloutsLogic) getDeviceApps(device *api.Device, templateVersion *a
 	return &deviceApps, nil
 }
 
-func (f FleetRolloutsLogic) replaceEnvVarValueParameters(device *api.Device, app api.ApplicationSpec) (*api.ApplicationSpec, error) {
+func (f FleetRolloutsLogic) replaceEnvVarValueParameters(render templateRenderFunc, path string, app api.ApplicationSpec) (*api.ApplicationSpec, error) {
 	if app.EnvVars == nil {
 		return &app, nil
 	}
 
-	origEnvVars := *app.EnvVars
-	newEnvVars := make(map[string]string, len(origEnvVars))
-	for k, v := range origEnvVars {
-		newValue, warnings := ReplaceParameters([]byte(v), device.Metadata)
-		if len(warnings) > 0 {
-			f.log.Infof("failed replacing application parameters for device %s/%s: %s", f.resourceRef.OrgID, *device.Metadata.Name, strings.Join(warnings, ", "))
-		}
-		newEnvVars[k] = string(newValue)
+	newEnvVars := make(map[string]string, len(*app.EnvVars))
+	for k, v := range *app.EnvVars {
+		// rendering never fails, see deviceTemplateRenderer
+		newEnvVars[k], _ = render(path+"."+k, v)
 	}
 	app.EnvVars = &newEnvVars
 	return &app, nil
 }
 
-func (f FleetRolloutsLogic) getDeviceConfig(device *api.Device, templateVersion *api.TemplateVersion) (*[]api.ConfigProviderSpec, error) {
+func (f FleetRolloutsLogic) getDeviceOs(render templateRenderFunc, templateVersion *api.TemplateVersion) *api.DeviceOSSpec {
+	if templateVersion.Status.Os == nil {
+		return nil
+	}
+	deviceOs := *templateVersion.Status.Os
+	deviceOs.Image, _ = render("os.image", deviceOs.Image)
+	return &deviceOs
+}
+
+func (f FleetRolloutsLogic) getDeviceHooks(render templateRenderFunc, templateVersion *api.TemplateVersion) (*api.DeviceHooksSpec, error) {
+	if templateVersion.Status.Hooks == nil {
+		return nil, nil
+	}
+	deviceHooks := &api.DeviceHooksSpec{}
+	if err := renderItemTemplates(templateVersion.Status.Hooks, "hooks", render, deviceHooks); err != nil {
+		return nil, fmt.Errorf("failed replacing hook parameters: %w", err)
+	}
+	return deviceHooks, nil
+}
+
+func (f FleetRolloutsLogic) getDeviceConfig(render templateRenderFunc, device *api.Device, templateVersion *api.TemplateVersion) (*[]api.ConfigProviderSpec, error) {
 	if templateVersion.Status.Config == nil {
 		return nil, nil
 	}
 
 	deviceConfig := []api.ConfigProviderSpec{}
-	for _, configItem := range *templateVersion.Status.Config {
-		var newConfigItem *api.ConfigProviderSpec
-		var err error
-
-		// We need to decode the inline spec contents before replacing parameters
-		configType, err := configItem.Type()
-		if err != nil {
+	for i, configItem := range *templateVersion.Status.Config {
+		if _, err := configItem.Type(); err != nil {
 			return nil, fmt.Errorf("%w: failed getting config type: %w", ErrUnknownConfigName, err)
 		}
 
-		switch configType {
-		case api.GitConfigProviderType:
-			newConfigItem, err = f.replaceGenericConfigParameters(device, configItem)
-		case api.KubernetesSecretProviderType:
-			newConfigItem, err = f.replaceGenericConfigParameters(device, configItem)
-		case api.InlineConfigProviderType:
-			newConfigItem, err = f.replaceInlineSpecParameters(device, configItem)
-		case api.HttpConfigProviderType:
-			newConfigItem, err = f.replaceGenericConfigParameters(device, configItem)
-		default:
-			err = fmt.Errorf("%w: unsupported config type %q", ErrUnknownConfigName, configType)
-		}
+		value, err := renderConfigItemTemplates(configItem, fmt.Sprintf("config[%d]", i), render)
 		if err != nil {
 			return nil, err
 		}
 
-		deviceConfig = append(deviceConfig, *newConfigItem)
-	}
-
-	return &deviceConfig, nil
-}
-
-func (f FleetRolloutsLogic) replaceInlineSpecParameters(device *api.Device, configItem api.ConfigProviderSpec) (*api.ConfigProviderSpec, error) {
-	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert config to inline config: %w", err)
-	}
-
-	for fileIndex, file := range inlineSpec.Inline {
-		var decodedBytes []byte
-		var err error
-
-		if file.ContentEncoding == nil {
-			decodedBytes = []byte(file.Content)
-		} else {
-			decodedBytes, err = base64.StdEncoding.DecodeString(file.Content)
-			if err != nil {
-				return nil, fmt.Errorf("error base64 decoding: %w", err)
+		if when, ok := value["when"].(string); ok {
+			switch strings.TrimSpace(when) {
+			case "true":
+				delete(value, "when")
+			case "false", "":
+				f.log.Debugf("Excluding config item %s from device %s/%s because its condition is false", value["name"], f.resourceRef.OrgID, *device.Metadata.Name)
+				continue
+			default:
+				// Keep the condition so that the device's specification is marked as invalid
+				f.log.Infof("condition of config item %s for device %s/%s is neither true nor false: %s", value["name"], f.resourceRef.OrgID, *device.Metadata.Name, when)
 			}
 		}
 
-		contentsReplaced, warnings := ReplaceParameters(decodedBytes, device.Metadata)
-		if len(warnings) > 0 {
-			f.log.Infof("failed replacing parameters for device %s/%s: %s", f.resourceRef.OrgID, *device.Metadata.Name, strings.Join(warnings, ", "))
+		cfgJson, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed converting configuration to json: %w", err)
 		}
-
-		if file.ContentEncoding != nil && (*file.ContentEncoding) == api.Base64 {
-			inlineSpec.Inline[fileIndex].Content = base64.StdEncoding.EncodeToString(contentsReplaced)
-		} else {
-			inlineSpec.Inline[fileIndex].Content = string(contentsReplaced)
+		var newConfigItem api.ConfigProviderSpec
+		if err := newConfigItem.UnmarshalJSON(cfgJson); err != nil {
+			return nil, fmt.Errorf("failed converting configuration from json: %w", err)
 		}
+		deviceConfig = append(deviceConfig, newConfigItem)
 	}
 
-	newConfigItem := api.ConfigProviderSpec{}
-	err = newConfigItem.FromInlineConfigProviderSpec(inlineSpec)
-	if err != nil {
-		return nil, fmt.Errorf("failed converting inline config: %w", err)
-	}
-
-	return &newConfigItem, nil
-}
-
-func (f FleetRolloutsLogic) replaceGenericConfigParameters(device *api.Device, configItem api.ConfigProviderSpec) (*api.ConfigProviderSpec, error) {
-	cfgJson, err := configItem.MarshalJSON()
-	if err != nil {
-		return nil, fmt.Errorf("failed converting configuration to json: %w", err)
-	}
-
-	cfgJsonReplaced, warnings := ReplaceParameters(cfgJson, device.Metadata)
-	if len(warnings) > 0 {
-		f.log.Infof("failed replacing parameters for device %s/%s: %s", f.resourceRef.OrgID, *device.Metadata.Name, strings.Join(warnings, ", "))
-	}
-
-	var newConfigItem api.ConfigProviderSpec
-	err = newConfigItem.UnmarshalJSON(cfgJsonReplaced)
-	if err != nil {
-		return nil, fmt.Errorf("failed converting configuration from json: %w", err)
-	}
-
-	return &newConfigItem, nil
+	return &deviceConfig, nil
 }
 
 func (f FleetRolloutsLogic) updateDeviceInStore(ctx context.Context, device *api.Device, newDeviceSpec *api.DeviceSpec) error {
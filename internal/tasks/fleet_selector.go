@@ -34,6 +34,9 @@ import (
 //    Task description: Iterate all fleets and devices in the org and set owners/conditions as necessary
 //
 // In addition, we have the cases where the user deleted all fleets or devices in an org
+//
+// When the selectors of several fleets match a device, the fleet with the highest priority
+// owns it. The selectors only overlap if several of those fleets share the highest priority.
 
 func fleetSelectorMatching(ctx context.Context, resourceRef *ResourceReference, store store.Store, callbackManager CallbackManager, log logrus.FieldLogger) error {
 	logic := FleetSelectorMatchingLogic{
@@ -41,6 +44,7 @@ func fleetSelectorMatching(ctx context.Context, resourceRef *ResourceReference,
 		log:             log,
 		fleetStore:      store.Fleet(),
 		devStore:        store.Device(),
+		eventStore:      store.Event(),
 		resourceRef:     *resourceRef,
 	}
 
@@ -74,6 +78,7 @@ type FleetSelectorMatchingLogic struct {
 	log             logrus.FieldLogger
 	fleetStore      store.Fleet
 	devStore        store.Device
+	eventStore      store.Event
 	resourceRef     ResourceReference
 	itemsPerPage    int
 }
@@ -84,6 +89,7 @@ func NewFleetSelectorMatchingLogic(callbackManager CallbackManager, log logrus.F
 		log:             log,
 		fleetStore:      storeInst.Fleet(),
 		devStore:        storeInst.Device(),
+		eventStore:      storeInst.Event(),
 		resourceRef:     resourceRef,
 		itemsPerPage:    ItemsPerPage,
 	}
@@ -106,7 +112,7 @@ func (f FleetSelectorMatchingLogic) FleetSelectorUpdatedNoOverlapping(ctx contex
 	}
 
 	// empty selector matches no devices
-	if len(getMatchLabelsSafe(fleet)) == 0 {
+	if fleet.Spec.Selector.IsEmpty() {
 		return f.removeOwnerFromDevicesOwnedByFleet(ctx)
 	}
 
@@ -118,8 +124,9 @@ func (f FleetSelectorMatchingLogic) FleetSelectorUpdatedNoOverlapping(ctx contex
 
 	// List the devices that now match the fleet's selector
 	listParams := store.ListParams{
-		Labels: getMatchLabelsSafe(fleet),
-		Limit:  ItemsPerPage,
+		Labels:                getMatchLabelsSafe(fleet),
+		LabelMatchExpressions: getMatchExpressionsSafe(fleet),
+		Limit:                 ItemsPerPage,
 	}
 	errors := 0
 
@@ -213,10 +220,22 @@ func (f FleetSelectorMatchingLogic) handleOwningFleetChanged(ctx context.Context
 	}
 
 	newOwnerFleet := *fleet.Metadata.Name
-	if currentOwningFleet != nil && !util.LabelsMatchLabelSelector(*device.Metadata.Labels, getMatchLabelsSafe(currentOwningFleet)) {
+	if currentOwningFleet != nil && !currentOwningFleet.Spec.Selector.Matches(lo.FromPtr(device.Metadata.Labels)) {
 		return false, f.updateDeviceOwner(ctx, device, newOwnerFleet)
 	}
 
+	// Both fleets match, the one with the higher priority owns the device
+	if currentOwningFleet != nil {
+		newPriority := lo.FromPtr(fleet.Spec.Priority)
+		currentPriority := lo.FromPtr(currentOwningFleet.Spec.Priority)
+		if newPriority > currentPriority {
+			return false, f.updateDeviceOwner(ctx, device, newOwnerFleet)
+		}
+		if newPriority < currentPriority {
+			return false, nil
+		}
+	}
+
 	// The device matches more than one fleet
 	condition := api.Condition{
 		Type:    api.DeviceMultipleOwners,
@@ -242,10 +261,11 @@ func (f FleetSelectorMatchingLogic) removeOwnerFromDevicesOwnedByFleet(ctx conte
 func (f FleetSelectorMatchingLogic) removeOwnerFromOrphanedDevices(ctx context.Context, fleet *api.Fleet) error {
 	// Remove the owner from devices that don't match the label selector but still have this owner
 	listParams := store.ListParams{
-		Labels:       getMatchLabelsSafe(fleet),
-		InvertLabels: util.BoolToPtr(true),
-		Owners:       []string{*util.SetResourceOwner(model.FleetKind, *fleet.Metadata.Name)},
-		Limit:        ItemsPerPage,
+		Labels:                getMatchLabelsSafe(fleet),
+		LabelMatchExpressions: getMatchExpressionsSafe(fleet),
+		InvertLabels:          util.BoolToPtr(true),
+		Owners:                []string{*util.SetResourceOwner(model.FleetKind, *fleet.Metadata.Name)},
+		Limit:                 ItemsPerPage,
 	}
 	return f.removeOwnerFromMatchingDevices(ctx, listParams)
 }
@@ -306,14 +326,6 @@ func (f FleetSelectorMatchingLogic) CompareFleetsAndSetDeviceOwner(ctx context.C
 		return nil
 	}
 
-	// If the device now has no labels, make sure it has no owner
-	if device.Metadata.Labels == nil || len(*device.Metadata.Labels) == 0 {
-		if len(currentOwnerFleet) != 0 {
-			return f.updateDeviceOwner(ctx, device, "")
-		}
-		return nil
-	}
-
 	listParams := store.ListParams{Limit: 0}
 	fleets, err := f.fleetStore.List(ctx, f.resourceRef.OrgID, listParams)
 	if err != nil {
@@ -423,55 +435,31 @@ func (f FleetSelectorMatchingLogic) handleDeviceWithPotentialOverlap(ctx context
 		return nil, nil
 	}
 
-	// If the device now has no labels, make sure it has no owner and no multiple-owner condition
-	if device.Metadata.Labels == nil || len(*device.Metadata.Labels) == 0 {
-		if len(currentOwnerFleet) != 0 {
-			err = f.updateDeviceOwner(ctx, device, "")
-			if err != nil {
-				return nil, err
-			}
-
-			condition := api.Condition{
-				Type:   api.DeviceMultipleOwners,
-				Status: api.ConditionStatusFalse,
-			}
-			err = f.devStore.SetServiceConditions(ctx, f.resourceRef.OrgID, *device.Metadata.Name, []api.Condition{condition})
-			if err != nil {
-				return nil, err
-			}
-		}
-		return nil, nil
-	}
-
 	// Iterate over all fleets and find the (hopefully) one that matches
 	return f.findDeviceOwnerAmongAllFleets(ctx, device, currentOwnerFleet, fleets)
 }
 
 func (f FleetSelectorMatchingLogic) findDeviceOwnerAmongAllFleets(ctx context.Context, device *api.Device, currentOwnerFleet string, fleets *api.FleetList) (*[]string, error) {
-	// Find all fleets with a selector that the device matches
-	var matchingFleets []string
+	// Find the fleets with a selector that the device matches and the highest
+	// priority. A device without labels may still match the expressions of a
+	// selector, e.g. DoesNotExist.
+	_, ownerCandidates := MatchDeviceToFleets(lo.FromPtr(device.Metadata.Labels), fleets.Items)
 
-	for fleetIndex := range fleets.Items {
-		fleet := &fleets.Items[fleetIndex]
-		if util.LabelsMatchLabelSelector(*device.Metadata.Labels, getMatchLabelsSafe(fleet)) {
-			matchingFleets = append(matchingFleets, *fleet.Metadata.Name)
-		}
-	}
-	newConditionMessage := createOverlappingConditionMessage(matchingFleets)
+	newConditionMessage := createOverlappingConditionMessage(ownerCandidates)
 	currentConditionMessage := ""
 	condition := api.FindStatusCondition(device.Status.Conditions, api.DeviceMultipleOwners)
 	if condition != nil {
 		currentConditionMessage = condition.Message
 	}
 
-	err := f.setDeviceOwnerAccordingToMatchingFleets(ctx, device, currentOwnerFleet, matchingFleets)
+	err := f.setDeviceOwnerAccordingToMatchingFleets(ctx, device, currentOwnerFleet, ownerCandidates)
 	if err != nil {
 		return nil, err
 	}
 
 	if currentConditionMessage != newConditionMessage {
 		condition := api.Condition{Type: api.DeviceMultipleOwners}
-		if len(matchingFleets) > 1 {
+		if len(ownerCandidates) > 1 {
 			condition.Status = api.ConditionStatusTrue
 			condition.Reason = "MultipleOwners"
 			condition.Message = newConditionMessage
@@ -485,12 +473,12 @@ func (f FleetSelectorMatchingLogic) findDeviceOwnerAmongAllFleets(ctx context.Co
 		}
 	}
 
-	return &matchingFleets, nil
+	return &ownerCandidates, nil
 }
 
-func (f FleetSelectorMatchingLogic) setDeviceOwnerAccordingToMatchingFleets(ctx context.Context, device *api.Device, currentOwnerFleet string, matchingFleets []string) error {
+func (f FleetSelectorMatchingLogic) setDeviceOwnerAccordingToMatchingFleets(ctx context.Context, device *api.Device, currentOwnerFleet string, ownerCandidates []string) error {
 	// Get the new owner fleet (empty if no fleet matched, the name if 1 matched, or error if more than one matched)
-	switch len(matchingFleets) {
+	switch len(ownerCandidates) {
 	case 0:
 		if len(currentOwnerFleet) != 0 {
 			return f.updateDeviceOwner(ctx, device, "")
@@ -498,14 +486,14 @@ func (f FleetSelectorMatchingLogic) setDeviceOwnerAccordingToMatchingFleets(ctx
 		return nil
 	case 1:
 		// Update the device in the DB only if the owner changed
-		newOwnerFleet := matchingFleets[0]
+		newOwnerFleet := ownerCandidates[0]
 		if currentOwnerFleet != newOwnerFleet {
 			return f.updateDeviceOwner(ctx, device, newOwnerFleet)
 		}
 		return nil
 	default:
-		// The device matches more than one fleet, set fleet conditions
-		return f.setOverlappingFleetConditions(ctx, matchingFleets)
+		// The device matches more than one fleet with the highest priority, set fleet conditions
+		return f.setOverlappingFleetConditions(ctx, ownerCandidates)
 	}
 }
 
@@ -612,10 +600,17 @@ func (f FleetSelectorMatchingLogic) updateDeviceOwner(ctx context.Context, devic
 		fieldsToNil = append(fieldsToNil, "owner")
 	}
 
-	f.log.Infof("Updating fleet of device %s from %s to %s", *device.Metadata.Name, util.DefaultIfNil(device.Metadata.Owner, "<none>"), util.DefaultIfNil(newOwnerRef, "<none>"))
+	oldOwnerRef := device.Metadata.Owner
+	f.log.Infof("Updating fleet of device %s from %s to %s", *device.Metadata.Name, util.DefaultIfNil(oldOwnerRef, "<none>"), util.DefaultIfNil(newOwnerRef, "<none>"))
 	device.Metadata.Owner = newOwnerRef
 	_, err := f.devStore.Update(ctx, f.resourceRef.OrgID, device, fieldsToNil, false, f.callbackManager.DeviceUpdatedCallback)
-	return err
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Device owner changed from %s to %s", util.DefaultIfNil(oldOwnerRef, "<none>"), util.DefaultIfNil(newOwnerRef, "<none>"))
+	emitEvent(ctx, f.eventStore, f.resourceRef.OrgID, api.NewEvent(model.DeviceKind, *device.Metadata.Name, api.EventTypeNormal, api.EventReasonFleetChanged, message), f.log)
+	return nil
 }
 
 func (f FleetSelectorMatchingLogic) setOverlappingFleetConditions(ctx context.Context, overlappingFleetNames []string) error {
@@ -664,9 +659,41 @@ func createOverlappingConditionMessage(matchingFleets []string) string {
 	}
 }
 
+// MatchDeviceToFleets returns the names of the fleets whose selectors match the
+// labels of a device, and of the ones among them with the highest priority. The
+// device belongs to the latter if there is exactly one of them; otherwise their
+// selectors overlap and the device keeps its owner.
+func MatchDeviceToFleets(labels map[string]string, fleets []api.Fleet) (matchingFleets []string, ownerCandidates []string) {
+	var highestPriority int32
+	for fleetIndex := range fleets {
+		fleet := &fleets[fleetIndex]
+		if !fleet.Spec.Selector.Matches(labels) {
+			continue
+		}
+		matchingFleets = append(matchingFleets, *fleet.Metadata.Name)
+
+		priority := lo.FromPtr(fleet.Spec.Priority)
+		switch {
+		case len(ownerCandidates) == 0 || priority > highestPriority:
+			highestPriority = priority
+			ownerCandidates = []string{*fleet.Metadata.Name}
+		case priority == highestPriority:
+			ownerCandidates = append(ownerCandidates, *fleet.Metadata.Name)
+		}
+	}
+	return matchingFleets, ownerCandidates
+}
+
 func getMatchLabelsSafe(fleet *api.Fleet) map[string]string {
 	if fleet.Spec.Selector != nil {
 		return lo.FromPtr(fleet.Spec.Selector.MatchLabels)
 	}
 	return map[string]string{}
 }
+
+func getMatchExpressionsSafe(fleet *api.Fleet) api.MatchExpressions {
+	if fleet.Spec.Selector != nil {
+		return lo.FromPtr(fleet.Spec.Selector.MatchExpressions)
+	}
+	return api.MatchExpressions{}
+}
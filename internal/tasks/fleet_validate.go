@@ -2,6 +2,7 @@ package tasks
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
@@ -44,7 +45,7 @@ func (t *FleetValidateLogic) CreateNewTemplateVersionIfFleetValid(ctx context.Co
 		return fmt.Errorf("failed getting fleet %s/%s: %w", t.resourceRef.OrgID, t.resourceRef.Name, err)
 	}
 
-	_, repoNames, validationErr := renderConfig(ctx, t.resourceRef.OrgID, t.store, t.k8sClient, fleet.Spec.Template.Spec.Config, true, true)
+	_, repoNames, _, validationErr := renderConfig(ctx, t.resourceRef.OrgID, t.store, t.k8sClient, fleet.Spec.Template.Spec.Config, nil, true, true)
 
 	// Set the many-to-many relationship with the repos (we do this even if the validation failed so that we will
 	// validate the fleet again if the repository is updated, and then it might be fixed).
@@ -53,6 +54,11 @@ func (t *FleetValidateLogic) CreateNewTemplateVersionIfFleetValid(ctx context.Co
 		return fmt.Errorf("setting repository references: %w", err)
 	}
 
+	// Errors in template parameters are reported with their location in the fleet, along with the errors
+	// rendering the config
+	if err := validateDeviceSpecTemplates(fleet.Spec.Template.Spec, "spec.template.spec"); err != nil {
+		validationErr = errors.Join(validationErr, fmt.Errorf("invalid template parameters: %w", err))
+	}
 	if validationErr != nil {
 		return t.setStatus(ctx, validationErr)
 	}
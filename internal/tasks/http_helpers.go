@@ -96,3 +96,30 @@ func buildHttpRepoRequestAuth(repoHttpSpec api.HttpRepoSpec, req *http.Request)
 
 	return req, tlsConfig, nil
 }
+
+// buildServerTLSConfig returns the TLS configuration to connect to a server
+// whose certificate may be signed by a custom CA.
+func buildServerTLSConfig(caCrt *string, skipServerVerification *bool) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if caCrt != nil {
+		ca, err := base64.StdEncoding.DecodeString(*caCrt)
+		if err != nil {
+			return nil, err
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, err
+		}
+		if rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		rootCAs.AppendCertsFromPEM(ca)
+		tlsConfig.RootCAs = rootCAs
+	}
+	if skipServerVerification != nil {
+		tlsConfig.InsecureSkipVerify = *skipServerVerification
+	}
+	return tlsConfig, nil
+}
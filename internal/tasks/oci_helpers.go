@@ -0,0 +1,367 @@
+package tasks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store/model"
+)
+
+const (
+	ociManifestMediaType        = "application/vnd.oci.image.manifest.v1+json"
+	ociIndexMediaType           = "application/vnd.oci.image.index.v1+json"
+	dockerManifestMediaType     = "application/vnd.docker.distribution.manifest.v2+json"
+	dockerManifestListMediaType = "application/vnd.docker.distribution.manifest.list.v2+json"
+
+	// ociTitleAnnotation holds the file name of a layer, as set by tools such as oras
+	ociTitleAnnotation = "org.opencontainers.image.title"
+
+	// ociMaxArtifactSize limits the total size of the layers pulled for an artifact
+	ociMaxArtifactSize = 10 * 1024 * 1024
+	ociMaxManifestSize = 4 * 1024 * 1024
+	ociRequestTimeout  = 30 * time.Second
+)
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type ociManifest struct {
+	SchemaVersion int             `json:"schemaVersion"`
+	MediaType     string          `json:"mediaType,omitempty"`
+	Config        ociDescriptor   `json:"config"`
+	Layers        []ociDescriptor `json:"layers"`
+}
+
+// ociClient is a minimal client of the OCI distribution API, which supports
+// anonymous, basic and bearer token authentication.
+type ociClient struct {
+	baseURL     *url.URL
+	config      api.OciConfig
+	httpClient  *http.Client
+	bearerToken string
+	basicAuth   bool
+}
+
+func newOciClient(repoSpec api.OciRepoSpec) (*ociClient, error) {
+	rawURL := repoSpec.Url
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+	baseURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing registry URL: %w", err)
+	}
+	if baseURL.Scheme != "https" && baseURL.Scheme != "http" {
+		return nil, fmt.Errorf("unsupported registry URL scheme %q", baseURL.Scheme)
+	}
+
+	tlsConfig, err := buildServerTLSConfig(repoSpec.OciConfig.CaCrt, repoSpec.OciConfig.SkipServerVerification)
+	if err != nil {
+		return nil, fmt.Errorf("error building TLS configuration: %w", err)
+	}
+	client := &ociClient{
+		baseURL: baseURL,
+		config:  repoSpec.OciConfig,
+		httpClient: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+			Timeout:   ociRequestTimeout,
+		},
+	}
+	if repoSpec.OciConfig.Token != nil {
+		client.bearerToken = *repoSpec.OciConfig.Token
+	}
+	return client, nil
+}
+
+func newOciClientForRepository(repository *model.Repository) (*ociClient, error) {
+	if repository.Spec == nil {
+		return nil, fmt.Errorf("repository has no spec")
+	}
+	repoOciSpec, err := repository.Spec.Data.GetOciRepoSpec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OCI repo spec: %w", err)
+	}
+	return newOciClient(repoOciSpec)
+}
+
+// Ping checks that the registry is reachable and accepts the credentials.
+func (c *ociClient) Ping(ctx context.Context) error {
+	resp, err := c.get(ctx, "/v2/", "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkOciResponse(resp)
+}
+
+// FetchManifest returns the image manifest of an artifact by tag or digest,
+// along with the digest of the manifest.
+func (c *ociClient) FetchManifest(ctx context.Context, name, reference string) (*ociManifest, string, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("/v2/%s/manifests/%s", name, reference), pullScope(name),
+		ociManifestMediaType, dockerManifestMediaType, ociIndexMediaType, dockerManifestListMediaType)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	if err := checkOciResponse(resp); err != nil {
+		return nil, "", fmt.Errorf("fetching manifest of %s:%s: %w", name, reference, err)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType == ociIndexMediaType || mediaType == dockerManifestListMediaType {
+		return nil, "", fmt.Errorf("%s:%s is an image index, a single manifest is required", name, reference)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, ociMaxManifestSize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("reading manifest of %s:%s: %w", name, reference, err)
+	}
+	if len(body) > ociMaxManifestSize {
+		return nil, "", fmt.Errorf("manifest of %s:%s exceeds %d bytes", name, reference, ociMaxManifestSize)
+	}
+	if strings.Contains(reference, ":") {
+		if err := verifyOciDigest(reference, body); err != nil {
+			return nil, "", fmt.Errorf("manifest of %s:%s: %w", name, reference, err)
+		}
+	}
+	sum := sha256.Sum256(body)
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+
+	manifest := &ociManifest{}
+	if err := json.Unmarshal(body, manifest); err != nil {
+		return nil, "", fmt.Errorf("decoding manifest of %s:%s: %w", name, reference, err)
+	}
+	if manifest.SchemaVersion != 2 {
+		return nil, "", fmt.Errorf("unsupported manifest schema version %d", manifest.SchemaVersion)
+	}
+	return manifest, digest, nil
+}
+
+// FetchBlob returns the verified contents of a blob.
+func (c *ociClient) FetchBlob(ctx context.Context, name string, descriptor ociDescriptor) ([]byte, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("/v2/%s/blobs/%s", name, descriptor.Digest), pullScope(name))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := checkOciResponse(resp); err != nil {
+		return nil, fmt.Errorf("fetching blob %s: %w", descriptor.Digest, err)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, descriptor.Size+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading blob %s: %w", descriptor.Digest, err)
+	}
+	if int64(len(body)) != descriptor.Size {
+		return nil, fmt.Errorf("blob %s: expected %d bytes, got %d", descriptor.Digest, descriptor.Size, len(body))
+	}
+	if err := verifyOciDigest(descriptor.Digest, body); err != nil {
+		return nil, fmt.Errorf("blob %s: %w", descriptor.Digest, err)
+	}
+	return body, nil
+}
+
+// PullArtifactFiles returns the contents of the layers of an artifact, keyed by
+// the file name from their title annotation.
+func (c *ociClient) PullArtifactFiles(ctx context.Context, name, reference string) (map[string][]byte, error) {
+	manifest, _, err := c.FetchManifest(ctx, name, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	var totalSize int64
+	files := make(map[string][]byte, len(manifest.Layers))
+	for _, layer := range manifest.Layers {
+		title, ok := layer.Annotations[ociTitleAnnotation]
+		if !ok {
+			return nil, fmt.Errorf("layer %s has no %s annotation", layer.Digest, ociTitleAnnotation)
+		}
+		if title == "" || path.IsAbs(title) || path.Clean(title) != title || strings.HasPrefix(title, "..") {
+			return nil, fmt.Errorf("layer %s has an invalid file name %q", layer.Digest, title)
+		}
+		if _, exists := files[title]; exists {
+			return nil, fmt.Errorf("duplicate file name %q in artifact", title)
+		}
+		totalSize += layer.Size
+		if layer.Size < 0 || totalSize > ociMaxArtifactSize {
+			return nil, fmt.Errorf("artifact %s:%s exceeds %d bytes", name, reference, ociMaxArtifactSize)
+		}
+
+		contents, err := c.FetchBlob(ctx, name, layer)
+		if err != nil {
+			return nil, err
+		}
+		files[title] = contents
+	}
+	return files, nil
+}
+
+func (c *ociClient) get(ctx context.Context, path string, scope string, accept ...string) (*http.Response, error) {
+	resp, err := c.send(ctx, path, accept)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusUnauthorized || c.basicAuth || c.config.Token != nil {
+		return resp, nil
+	}
+
+	challenge := resp.Header.Get("WWW-Authenticate")
+	resp.Body.Close()
+	if err := c.authenticate(ctx, challenge, scope); err != nil {
+		return nil, err
+	}
+	return c.send(ctx, path, accept)
+}
+
+func (c *ociClient) send(ctx context.Context, path string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL.JoinPath(path).String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	for _, mediaType := range accept {
+		req.Header.Add("Accept", mediaType)
+	}
+	if c.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.bearerToken)
+	} else if c.basicAuth {
+		req.SetBasicAuth(*c.config.Username, *c.config.Password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending request: %w", err)
+	}
+	return resp, nil
+}
+
+// authenticate answers a WWW-Authenticate challenge of the registry, either by
+// using basic authentication or by getting a token from the announced realm.
+func (c *ociClient) authenticate(ctx context.Context, challenge string, scope string) error {
+	scheme, params := parseAuthChallenge(challenge)
+	hasCredentials := c.config.Username != nil && c.config.Password != nil
+
+	switch scheme {
+	case "basic":
+		if !hasCredentials {
+			return errors.New("registry requires credentials")
+		}
+		c.basicAuth = true
+		return nil
+	case "bearer":
+		realm, err := url.Parse(params["realm"])
+		if err != nil || params["realm"] == "" {
+			return fmt.Errorf("invalid authentication realm %q", params["realm"])
+		}
+		query := realm.Query()
+		if service, ok := params["service"]; ok {
+			query.Set("service", service)
+		}
+		if scope != "" {
+			query.Set("scope", scope)
+		} else if challengeScope, ok := params["scope"]; ok {
+			query.Set("scope", challengeScope)
+		}
+		realm.RawQuery = query.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+		if err != nil {
+			return fmt.Errorf("creating token request: %w", err)
+		}
+		if hasCredentials {
+			req.SetBasicAuth(*c.config.Username, *c.config.Password)
+		}
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("requesting token: %w", err)
+		}
+		defer resp.Body.Close()
+		if err := checkOciResponse(resp); err != nil {
+			return fmt.Errorf("requesting token: %w", err)
+		}
+
+		var tokenResponse struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}
+		if err := json.NewDecoder(io.LimitReader(resp.Body, ociMaxManifestSize)).Decode(&tokenResponse); err != nil {
+			return fmt.Errorf("decoding token response: %w", err)
+		}
+		c.bearerToken = tokenResponse.Token
+		if c.bearerToken == "" {
+			c.bearerToken = tokenResponse.AccessToken
+		}
+		if c.bearerToken == "" {
+			return errors.New("token response does not contain a token")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+}
+
+// parseAuthChallenge parses a WWW-Authenticate header such as
+// Bearer realm="https://auth.example.com/token",service="registry",scope="repository:foo:pull"
+func parseAuthChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := map[string]string{}
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimLeft(rest, ", ") {
+		key, value, found := strings.Cut(rest, "=")
+		if !found {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				params[key] = value[1:]
+				break
+			}
+			params[key] = value[1 : end+1]
+			rest = value[end+2:]
+		} else {
+			params[key], rest, _ = strings.Cut(value, ",")
+		}
+	}
+	return strings.ToLower(scheme), params
+}
+
+func pullScope(name string) string {
+	return fmt.Sprintf("repository:%s:pull", name)
+}
+
+func checkOciResponse(resp *http.Response) error {
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+}
+
+func verifyOciDigest(digest string, contents []byte) error {
+	algorithm, expected, found := strings.Cut(digest, ":")
+	if !found {
+		return fmt.Errorf("invalid digest %q", digest)
+	}
+	if algorithm != "sha256" {
+		return fmt.Errorf("unsupported digest algorithm %q", algorithm)
+	}
+	sum := sha256.Sum256(contents)
+	if hex.EncodeToString(sum[:]) != expected {
+		return fmt.Errorf("digest mismatch, expected %s", digest)
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package tasks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func ociDigest(contents []byte) string {
+	sum := sha256.Sum256(contents)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// fakeRegistry serves a single artifact and hands out bearer tokens to
+// clients presenting the expected basic credentials.
+type fakeRegistry struct {
+	server         *httptest.Server
+	manifest       []byte
+	blobs          map[string][]byte
+	tamperedBlob   bool
+	tokenRequests  int
+	requireAuth    bool
+	requestedScope string
+}
+
+const (
+	fakeRegistryUser  = "user"
+	fakeRegistryPass  = "pass"
+	fakeRegistryToken = "registry-token"
+)
+
+func newFakeRegistry(files map[string]string) *fakeRegistry {
+	r := &fakeRegistry{blobs: map[string][]byte{}, requireAuth: true}
+	layers := []ociDescriptor{}
+	for name, contents := range files {
+		digest := ociDigest([]byte(contents))
+		r.blobs[digest] = []byte(contents)
+		layers = append(layers, ociDescriptor{
+			MediaType:   "application/vnd.oci.image.layer.v1.tar",
+			Digest:      digest,
+			Size:        int64(len(contents)),
+			Annotations: map[string]string{ociTitleAnnotation: name},
+		})
+	}
+	config := []byte("{}")
+	r.blobs[ociDigest(config)] = config
+	manifest, err := json.Marshal(ociManifest{
+		SchemaVersion: 2,
+		MediaType:     ociManifestMediaType,
+		Config:        ociDescriptor{MediaType: "application/vnd.oci.empty.v1+json", Digest: ociDigest(config), Size: int64(len(config))},
+		Layers:        layers,
+	})
+	Expect(err).ToNot(HaveOccurred())
+	r.manifest = manifest
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, req *http.Request) {
+		r.tokenRequests++
+		r.requestedScope = req.URL.Query().Get("scope")
+		user, pass, ok := req.BasicAuth()
+		if !ok || user != fakeRegistryUser || pass != fakeRegistryPass {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"token": %q}`, fakeRegistryToken)
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, req *http.Request) {
+		if r.requireAuth && req.Header.Get("Authorization") != "Bearer "+fakeRegistryToken {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="fake-registry"`, r.server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case req.URL.Path == "/v2/":
+			w.WriteHeader(http.StatusOK)
+		case strings.HasPrefix(req.URL.Path, "/v2/configs/app/manifests/"):
+			// serve the artifact for any reference, so that clients must verify digests
+			w.Header().Set("Content-Type", ociManifestMediaType)
+			_, _ = w.Write(r.manifest)
+		case strings.HasPrefix(req.URL.Path, "/v2/configs/app/blobs/"):
+			blob, ok := r.blobs[strings.TrimPrefix(req.URL.Path, "/v2/configs/app/blobs/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if r.tamperedBlob {
+				blob = []byte(strings.ToUpper(string(blob)))
+			}
+			_, _ = w.Write(blob)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	r.server = httptest.NewServer(mux)
+	return r
+}
+
+func (r *fakeRegistry) repoSpec(withCredentials bool) api.OciRepoSpec {
+	spec := api.OciRepoSpec{Url: r.server.URL, Type: api.Oci}
+	if withCredentials {
+		spec.OciConfig.Username = util.StrToPtr(fakeRegistryUser)
+		spec.OciConfig.Password = util.StrToPtr(fakeRegistryPass)
+	}
+	return spec
+}
+
+var _ = Describe("ociClient", func() {
+	var (
+		registry *fakeRegistry
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		registry = newFakeRegistry(map[string]string{
+			"app.conf":     "key=value\n",
+			"conf.d/extra": "extra\n",
+		})
+	})
+
+	AfterEach(func() {
+		registry.server.Close()
+	})
+
+	When("pulling an artifact by tag", func() {
+		It("returns the files of the artifact", func() {
+			client, err := newOciClient(registry.repoSpec(true))
+			Expect(err).ToNot(HaveOccurred())
+
+			files, err := client.PullArtifactFiles(ctx, "configs/app", "v1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(2))
+			Expect(string(files["app.conf"])).To(Equal("key=value\n"))
+			Expect(string(files["conf.d/extra"])).To(Equal("extra\n"))
+			Expect(registry.tokenRequests).To(Equal(1))
+			Expect(registry.requestedScope).To(Equal("repository:configs/app:pull"))
+		})
+	})
+
+	When("pulling an artifact by digest", func() {
+		It("returns the manifest digest and the files of the artifact", func() {
+			client, err := newOciClient(registry.repoSpec(true))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, digest, err := client.FetchManifest(ctx, "configs/app", "v1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(digest).To(Equal(ociDigest(registry.manifest)))
+
+			files, err := client.PullArtifactFiles(ctx, "configs/app", digest)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(2))
+		})
+	})
+
+	When("the manifest does not match the requested digest", func() {
+		It("returns an error", func() {
+			client, err := newOciClient(registry.repoSpec(true))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = client.FetchManifest(ctx, "configs/app", ociDigest([]byte("other")))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("digest mismatch"))
+		})
+	})
+
+	When("a blob does not match its digest", func() {
+		It("returns an error", func() {
+			registry.tamperedBlob = true
+			client, err := newOciClient(registry.repoSpec(true))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = client.PullArtifactFiles(ctx, "configs/app", "v1")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("digest mismatch"))
+		})
+	})
+
+	When("the credentials are missing", func() {
+		It("fails to pull the artifact", func() {
+			client, err := newOciClient(registry.repoSpec(false))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = client.PullArtifactFiles(ctx, "configs/app", "v1")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("the registry allows anonymous access", func() {
+		It("pulls the artifact without requesting a token", func() {
+			registry.requireAuth = false
+			client, err := newOciClient(registry.repoSpec(false))
+			Expect(err).ToNot(HaveOccurred())
+
+			files, err := client.PullArtifactFiles(ctx, "configs/app", "v1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(2))
+			Expect(registry.tokenRequests).To(Equal(0))
+		})
+	})
+
+	When("testing access to the repository", func() {
+		It("succeeds with valid credentials and fails without", func() {
+			tester := &OciRepoTester{}
+
+			spec := api.RepositorySpec{}
+			Expect(spec.FromOciRepoSpec(registry.repoSpec(true))).To(Succeed())
+			Expect(tester.TestAccess(&model.Repository{Spec: model.MakeJSONField(spec)})).To(Succeed())
+
+			spec = api.RepositorySpec{}
+			Expect(spec.FromOciRepoSpec(registry.repoSpec(false))).To(Succeed())
+			Expect(tester.TestAccess(&model.Repository{Spec: model.MakeJSONField(spec)})).ToNot(Succeed())
+		})
+	})
+})
+
+var _ = Describe("parseAuthChallenge", func() {
+	It("parses quoted parameters containing commas", func() {
+		scheme, params := parseAuthChallenge(`Bearer realm="https://auth.example.com/token",service="registry",scope="repository:a:pull,push"`)
+		Expect(scheme).To(Equal("bearer"))
+		Expect(params).To(Equal(map[string]string{
+			"realm":   "https://auth.example.com/token",
+			"service": "registry",
+			"scope":   "repository:a:pull,push",
+		}))
+	})
+
+	It("parses a basic challenge", func() {
+		scheme, params := parseAuthChallenge(`Basic realm="registry"`)
+		Expect(scheme).To(Equal("basic"))
+		Expect(params).To(HaveKeyWithValue("realm", "registry"))
+	})
+})
@@ -58,6 +58,12 @@ func (r *RepoTester) TestRepositories() {
 		case "git":
 			log.Info("Defaulting to Git repository type")
 			r.TypeSpecificRepoTester = &GitRepoTester{}
+		case "oci":
+			log.Info("Detected OCI repository type")
+			r.TypeSpecificRepoTester = &OciRepoTester{}
+		case "vault":
+			log.Info("Detected Vault repository type")
+			r.TypeSpecificRepoTester = &VaultRepoTester{}
 		default:
 			log.Errorf("unsupported repository type: %s", repoSpec.Type)
 		}
@@ -81,6 +87,12 @@ type GitRepoTester struct {
 type HttpRepoTester struct {
 }
 
+type OciRepoTester struct {
+}
+
+type VaultRepoTester struct {
+}
+
 func (r *GitRepoTester) TestAccess(repository *model.Repository) error {
 	if repository.Spec == nil {
 		return fmt.Errorf("repository has no spec")
@@ -127,6 +139,22 @@ func (r *HttpRepoTester) TestAccess(repository *model.Repository) error {
 	return err
 }
 
+func (r *OciRepoTester) TestAccess(repository *model.Repository) error {
+	client, err := newOciClientForRepository(repository)
+	if err != nil {
+		return err
+	}
+	return client.Ping(context.Background())
+}
+
+func (r *VaultRepoTester) TestAccess(repository *model.Repository) error {
+	client, err := newVaultClientForRepository(repository)
+	if err != nil {
+		return err
+	}
+	return client.LookupSelf(context.Background())
+}
+
 func (r *RepoTester) SetAccessCondition(repository model.Repository, err error) error {
 	if repository.Status == nil {
 		repository.Status = model.MakeJSONField(api.RepositoryStatus{Conditions: []api.Condition{}})
@@ -0,0 +1,65 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// RetentionCleanupPollingInterval is the interval at which the retention cleanup tasks run.
+	RetentionCleanupPollingInterval = time.Hour
+)
+
+// RetentionCleanup deletes the records of all orgs, such as events or audit records,
+// that are older than a retention period.
+type RetentionCleanup struct {
+	log             logrus.FieldLogger
+	name            string
+	deleteOlderThan func(ctx context.Context, cutoff time.Time) (int64, error)
+	retention       time.Duration
+}
+
+// NewRetentionCleanup returns a task deleting the records that deleteOlderThan deletes, with name
+// being the plural of the records in the logs.
+func NewRetentionCleanup(log logrus.FieldLogger, name string, deleteOlderThan func(ctx context.Context, cutoff time.Time) (int64, error), retention time.Duration) *RetentionCleanup {
+	return &RetentionCleanup{
+		log:             log,
+		name:            name,
+		deleteOlderThan: deleteOlderThan,
+		retention:       retention,
+	}
+}
+
+func NewEventCleanup(log logrus.FieldLogger, store store.Store, retention time.Duration) *RetentionCleanup {
+	if retention <= 0 {
+		retention = time.Duration(config.DefaultEventRetentionPeriod)
+	}
+	return NewRetentionCleanup(log, "events", store.Event().DeleteOlderThan, retention)
+}
+
+func NewAuditCleanup(log logrus.FieldLogger, store store.Store, retention time.Duration) *RetentionCleanup {
+	if retention <= 0 {
+		retention = time.Duration(config.DefaultAuditRetentionPeriod)
+	}
+	return NewRetentionCleanup(log, "audit records", store.AuditRecord().DeleteOlderThan, retention)
+}
+
+// Poll deletes the records that are older than the retention period.
+func (t *RetentionCleanup) Poll() {
+	t.log.Infof("Running cleanup of %s", t.name)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deleted, err := t.deleteOlderThan(ctx, time.Now().Add(-t.retention))
+	if err != nil {
+		t.log.WithError(err).Errorf("failed to delete expired %s", t.name)
+		return
+	}
+	if deleted > 0 {
+		t.log.Infof("Deleted %d %s older than %s", deleted, t.name, t.retention)
+	}
+}
@@ -0,0 +1,119 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+type retentionCleanupStore struct {
+	store.Store
+	events       *dummyEvent
+	auditRecords *dummyAuditRecord
+}
+
+func (s *retentionCleanupStore) Event() store.Event {
+	return s.events
+}
+
+func (s *retentionCleanupStore) AuditRecord() store.AuditRecord {
+	return s.auditRecords
+}
+
+// deletions records the cutoffs of the calls to DeleteOlderThan.
+type deletions struct {
+	cutoffs []time.Time
+	err     error
+}
+
+func (d *deletions) deleteOlderThan(cutoff time.Time) (int64, error) {
+	d.cutoffs = append(d.cutoffs, cutoff)
+	return 1, d.err
+}
+
+type dummyEvent struct {
+	store.Event
+	deletions
+}
+
+func (s *dummyEvent) DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error) {
+	return s.deleteOlderThan(cutoff)
+}
+
+type dummyAuditRecord struct {
+	store.AuditRecord
+	deletions
+}
+
+func (s *dummyAuditRecord) DeleteOlderThan(ctx context.Context, cutoff time.Time) (int64, error) {
+	return s.deleteOlderThan(cutoff)
+}
+
+func TestRetentionCleanup(t *testing.T) {
+	defaults := config.NewDefault()
+	tests := []struct {
+		name              string
+		newCleanup        func(log logrus.FieldLogger, store store.Store, retention time.Duration) *RetentionCleanup
+		auditRecords      bool
+		retention         time.Duration
+		err               error
+		expectedRetention time.Duration
+	}{
+		{
+			name:              "events older than the retention period are deleted",
+			newCleanup:        NewEventCleanup,
+			retention:         24 * time.Hour,
+			expectedRetention: 24 * time.Hour,
+		},
+		{
+			name:              "the event retention period defaults to the one of the default config",
+			newCleanup:        NewEventCleanup,
+			expectedRetention: time.Duration(defaults.Service.EventRetentionPeriod),
+		},
+		{
+			name:              "audit records older than the retention period are deleted",
+			newCleanup:        NewAuditCleanup,
+			auditRecords:      true,
+			retention:         24 * time.Hour,
+			expectedRetention: 24 * time.Hour,
+		},
+		{
+			name:              "the audit record retention period defaults to the one of the default config",
+			newCleanup:        NewAuditCleanup,
+			auditRecords:      true,
+			expectedRetention: time.Duration(defaults.Audit.RetentionPeriod),
+		},
+		{
+			name:              "failures to delete the records are logged",
+			newCleanup:        NewAuditCleanup,
+			auditRecords:      true,
+			retention:         time.Hour,
+			err:               errors.New("database unavailable"),
+			expectedRetention: time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			st := &retentionCleanupStore{events: &dummyEvent{}, auditRecords: &dummyAuditRecord{}}
+			deleted := &st.events.deletions
+			if tt.auditRecords {
+				deleted = &st.auditRecords.deletions
+			}
+			deleted.err = tt.err
+
+			before := time.Now()
+			tt.newCleanup(log.InitLogs(), st, tt.retention).Poll()
+			require.Len(deleted.cutoffs, 1)
+			require.WithinRange(deleted.cutoffs[0], before.Add(-tt.expectedRetention), time.Now().Add(-tt.expectedRetention))
+		})
+	}
+}
@@ -57,6 +57,10 @@ func (t *TemplateVersionPopulateLogic) SyncFleetTemplateToTemplateVersion(ctx co
 		return t.setStatus(ctx, err)
 	}
 
+	if err := validateDeviceSpecTemplates(t.fleet.Spec.Template.Spec, "spec.template.spec"); err != nil {
+		return t.setStatus(ctx, fmt.Errorf("invalid template parameters: %w", err))
+	}
+
 	// freeze the config source
 	if t.fleet.Spec.Template.Spec.Config != nil {
 		t.frozenConfig = []api.ConfigProviderSpec{}
@@ -125,6 +129,10 @@ func (t *TemplateVersionPopulateLogic) handleConfigItem(ctx context.Context, con
 		return t.handleInlineConfig(configItem)
 	case api.HttpConfigProviderType:
 		return t.handleHttpConfig(configItem)
+	case api.OciConfigProviderType:
+		return t.handleOciConfig(ctx, configItem)
+	case api.VaultSecretProviderType:
+		return t.handleVaultConfig(configItem)
 	default:
 		return fmt.Errorf("unsupported config type %q", configType)
 	}
@@ -229,6 +237,7 @@ func (t *TemplateVersionPopulateLogic) handleK8sConfig(configItem *api.ConfigPro
 	inlineSpec := api.InlineConfigProviderSpec{
 		Inline: files,
 		Name:   k8sSpec.Name,
+		When:   k8sSpec.When,
 	}
 	if err = newConfig.FromInlineConfigProviderSpec(inlineSpec); err != nil {
 		return err
@@ -282,6 +291,59 @@ func (t *TemplateVersionPopulateLogic) handleHttpConfig(configItem *api.ConfigPr
 	return nil
 }
 
+// Translate tag into manifest digest
+func (t *TemplateVersionPopulateLogic) handleOciConfig(ctx context.Context, configItem *api.ConfigProviderSpec) error {
+	ociSpec, err := configItem.AsOciConfigProviderSpec()
+	if err != nil {
+		return fmt.Errorf("failed getting config item as OciConfigProviderSpec: %w", err)
+	}
+
+	repo, err := t.store.Repository().GetInternal(ctx, t.resourceRef.OrgID, ociSpec.OciRef.Repository)
+	if err != nil {
+		return fmt.Errorf("failed fetching specified Repository definition %s/%s: %w", t.resourceRef.OrgID, ociSpec.OciRef.Repository, err)
+	}
+
+	client, err := newOciClientForRepository(repo)
+	if err != nil {
+		return fmt.Errorf("invalid OCI Repository definition %s/%s: %w", t.resourceRef.OrgID, ociSpec.OciRef.Repository, err)
+	}
+
+	_, digest, err := client.FetchManifest(ctx, ociSpec.OciRef.Artifact, ociSpec.OciRef.Reference)
+	if err != nil {
+		return fmt.Errorf("failed resolving OCI artifact %s:%s from repository %s/%s: %w",
+			ociSpec.OciRef.Artifact, ociSpec.OciRef.Reference, t.resourceRef.OrgID, ociSpec.OciRef.Repository, err)
+	}
+
+	// Add the manifest digest into the frozen config
+	ociSpec.OciRef.Reference = digest
+	newConfig := &api.ConfigProviderSpec{}
+	err = newConfig.FromOciConfigProviderSpec(ociSpec)
+	if err != nil {
+		return fmt.Errorf("failed creating OCI config from item %s: %w", ociSpec.Name, err)
+	}
+	t.frozenConfig = append(t.frozenConfig, *newConfig)
+
+	return nil
+}
+
+func (t *TemplateVersionPopulateLogic) handleVaultConfig(configItem *api.ConfigProviderSpec) error {
+	vaultSpec, err := configItem.AsVaultSecretProviderSpec()
+	if err != nil {
+		return fmt.Errorf("failed getting config item as VaultSecretProviderSpec: %w", err)
+	}
+
+	// Just add the Vault config as-is, secrets are read when rendering each device
+	// so that they are never stored in the template version
+	newConfig := &api.ConfigProviderSpec{}
+	err = newConfig.FromVaultSecretProviderSpec(vaultSpec)
+	if err != nil {
+		return fmt.Errorf("failed creating Vault config from item %s: %w", vaultSpec.Name, err)
+	}
+
+	t.frozenConfig = append(t.frozenConfig, *newConfig)
+	return nil
+}
+
 func (t *TemplateVersionPopulateLogic) setStatus(ctx context.Context, validationErr error) error {
 	t.templateVersion.Status = &api.TemplateVersionStatus{}
 	if validationErr != nil {
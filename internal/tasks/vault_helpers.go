@@ -0,0 +1,239 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/samber/lo"
+)
+
+const (
+	vaultDefaultKvMountPath      = "secret"
+	vaultDefaultAppRoleMountPath = "approle"
+	vaultRequestTimeout          = 30 * time.Second
+	vaultMaxResponseSize         = 4 * 1024 * 1024
+)
+
+// vaultSecret is a version of a secret of a KV version 2 secrets engine.
+type vaultSecret struct {
+	Data    map[string]interface{}
+	Version int
+}
+
+// vaultClient is a minimal client of the HTTP API of Vault, which reads secrets
+// of a KV version 2 secrets engine using either a token or an AppRole.
+type vaultClient struct {
+	baseURL    *url.URL
+	config     api.VaultConfig
+	httpClient *http.Client
+	token      string
+}
+
+func newVaultClient(repoSpec api.VaultRepoSpec) (*vaultClient, error) {
+	baseURL, err := url.Parse(repoSpec.Url)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Vault URL: %w", err)
+	}
+	if baseURL.Scheme != "https" && baseURL.Scheme != "http" {
+		return nil, fmt.Errorf("unsupported Vault URL scheme %q", baseURL.Scheme)
+	}
+
+	tlsConfig, err := buildServerTLSConfig(repoSpec.VaultConfig.CaCrt, repoSpec.VaultConfig.SkipServerVerification)
+	if err != nil {
+		return nil, fmt.Errorf("error building TLS configuration: %w", err)
+	}
+	return &vaultClient{
+		baseURL: baseURL,
+		config:  repoSpec.VaultConfig,
+		httpClient: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+			Timeout:   vaultRequestTimeout,
+		},
+		token: lo.FromPtr(repoSpec.VaultConfig.Token),
+	}, nil
+}
+
+func newVaultClientForRepository(repository *model.Repository) (*vaultClient, error) {
+	if repository.Spec == nil {
+		return nil, fmt.Errorf("repository has no spec")
+	}
+	repoVaultSpec, err := repository.Spec.Data.GetVaultRepoSpec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Vault repo spec: %w", err)
+	}
+	return newVaultClient(repoVaultSpec)
+}
+
+// LookupSelf checks that the Vault server is reachable and accepts the credentials.
+func (c *vaultClient) LookupSelf(ctx context.Context) error {
+	return c.do(ctx, http.MethodGet, "auth/token/lookup-self", nil, nil, nil)
+}
+
+// ReadSecret returns the given version of a secret, or its latest version if
+// version is nil.
+func (c *vaultClient) ReadSecret(ctx context.Context, path string, version *int) (*vaultSecret, error) {
+	query := url.Values{}
+	if version != nil {
+		query.Set("version", strconv.Itoa(*version))
+	}
+
+	var response struct {
+		Data struct {
+			Data     map[string]interface{} `json:"data"`
+			Metadata struct {
+				Version int `json:"version"`
+			} `json:"metadata"`
+		} `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.kvPath("data", path), query, nil, &response); err != nil {
+		return nil, fmt.Errorf("reading secret %s: %w", path, err)
+	}
+	// A deleted or destroyed version has no data
+	if response.Data.Data == nil {
+		return nil, fmt.Errorf("reading secret %s: version %d has been deleted", path, response.Data.Metadata.Version)
+	}
+	return &vaultSecret{Data: response.Data.Data, Version: response.Data.Metadata.Version}, nil
+}
+
+// CurrentVersion returns the latest version of a secret without reading its data.
+func (c *vaultClient) CurrentVersion(ctx context.Context, path string) (int, error) {
+	var response struct {
+		Data struct {
+			CurrentVersion int `json:"current_version"`
+		} `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, c.kvPath("metadata", path), nil, nil, &response); err != nil {
+		return 0, fmt.Errorf("reading metadata of secret %s: %w", path, err)
+	}
+	return response.Data.CurrentVersion, nil
+}
+
+func (c *vaultClient) kvPath(kind, path string) string {
+	mountPath := lo.FromPtrOr(c.config.KvMountPath, vaultDefaultKvMountPath)
+	return strings.Join([]string{mountPath, kind, path}, "/")
+}
+
+// login gets a token from the AppRole auth method.
+func (c *vaultClient) login(ctx context.Context) error {
+	appRole := c.config.AppRole
+	if appRole == nil {
+		return errors.New("no Vault credentials configured")
+	}
+	body := map[string]string{
+		"role_id":   appRole.RoleId,
+		"secret_id": appRole.SecretId,
+	}
+	var response struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	mountPath := lo.FromPtrOr(appRole.MountPath, vaultDefaultAppRoleMountPath)
+	if err := c.send(ctx, http.MethodPost, fmt.Sprintf("auth/%s/login", mountPath), nil, body, &response); err != nil {
+		return fmt.Errorf("logging in with AppRole: %w", err)
+	}
+	if response.Auth.ClientToken == "" {
+		return errors.New("logging in with AppRole: no token in response")
+	}
+	c.token = response.Auth.ClientToken
+	return nil
+}
+
+// do sends an authenticated request, logging in first if the client has no
+// token yet.
+func (c *vaultClient) do(ctx context.Context, method, path string, query url.Values, body, result interface{}) error {
+	if c.token == "" {
+		if err := c.login(ctx); err != nil {
+			return err
+		}
+	}
+	return c.send(ctx, method, path, query, body, result)
+}
+
+func (c *vaultClient) send(ctx context.Context, method, path string, query url.Values, body, result interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	reqURL := c.baseURL.JoinPath("v1", path)
+	reqURL.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), reqBody)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("X-Vault-Token", c.token)
+	}
+	if c.config.Namespace != nil {
+		req.Header.Set("X-Vault-Namespace", *c.config.Namespace)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, vaultMaxResponseSize))
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return vaultResponseError(resp.StatusCode, respBody)
+	}
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
+
+func vaultResponseError(statusCode int, body []byte) error {
+	var response struct {
+		Errors []string `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &response); err == nil && len(response.Errors) > 0 {
+		return fmt.Errorf("unexpected status code %d: %s", statusCode, strings.Join(response.Errors, "; "))
+	}
+	return fmt.Errorf("unexpected status code %d", statusCode)
+}
+
+// vaultSecretValue returns the value of a key of a secret as file contents.
+// String values are used as-is, any other value is encoded as JSON.
+func vaultSecretValue(secret *vaultSecret, key string) ([]byte, error) {
+	value, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found in version %d of the secret", key, secret.Version)
+	}
+	if str, ok := value.(string); ok {
+		return []byte(str), nil
+	}
+	return json.Marshal(value)
+}
+
+// vaultSecretVersionKey identifies a secret of a Vault repository in the
+// secret versions recorded on devices.
+func vaultSecretVersionKey(repository, path string) string {
+	return repository + "/" + path
+}
@@ -0,0 +1,129 @@
+package tasks
+
+import (
+	"context"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/test/util/vault"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("vaultClient", func() {
+	var (
+		server *vault.FakeServer
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = vault.NewFakeServer()
+		server.WriteSecret("edge/wifi", map[string]interface{}{"psk": "first"})
+		server.WriteSecret("edge/wifi", map[string]interface{}{"psk": "second", "channels": []interface{}{1, 6}})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	tokenSpec := func(token string) api.VaultRepoSpec {
+		return api.VaultRepoSpec{Url: server.URL, Type: api.Vault, VaultConfig: api.VaultConfig{Token: &token}}
+	}
+
+	appRoleSpec := func(secretId string) api.VaultRepoSpec {
+		return api.VaultRepoSpec{Url: server.URL, Type: api.Vault, VaultConfig: api.VaultConfig{
+			AppRole: &api.VaultAppRoleAuth{RoleId: vault.RoleID, SecretId: secretId},
+		}}
+	}
+
+	When("authenticating with a token", func() {
+		It("reads the latest version of a secret", func() {
+			client, err := newVaultClient(tokenSpec(server.Token))
+			Expect(err).ToNot(HaveOccurred())
+
+			secret, err := client.ReadSecret(ctx, "edge/wifi", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret.Version).To(Equal(2))
+
+			value, err := vaultSecretValue(secret, "psk")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(value)).To(Equal("second"))
+
+			// values that are not strings are stored as JSON
+			value, err = vaultSecretValue(secret, "channels")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(value)).To(Equal("[1,6]"))
+
+			_, err = vaultSecretValue(secret, "missing")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("reads a given version of a secret", func() {
+			client, err := newVaultClient(tokenSpec(server.Token))
+			Expect(err).ToNot(HaveOccurred())
+
+			secret, err := client.ReadSecret(ctx, "edge/wifi", util.IntToPtr(1))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret.Version).To(Equal(1))
+			Expect(secret.Data).To(HaveKeyWithValue("psk", "first"))
+		})
+
+		It("returns the current version of a secret", func() {
+			client, err := newVaultClient(tokenSpec(server.Token))
+			Expect(err).ToNot(HaveOccurred())
+
+			version, err := client.CurrentVersion(ctx, "edge/wifi")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(Equal(2))
+
+			_, err = client.CurrentVersion(ctx, "edge/missing")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails with an invalid token", func() {
+			client, err := newVaultClient(tokenSpec("invalid"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = client.ReadSecret(ctx, "edge/wifi", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("permission denied"))
+		})
+	})
+
+	When("authenticating with an AppRole", func() {
+		It("logs in once and reads secrets", func() {
+			client, err := newVaultClient(appRoleSpec(vault.SecretID))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = client.ReadSecret(ctx, "edge/wifi", nil)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = client.CurrentVersion(ctx, "edge/wifi")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.Logins()).To(Equal(1))
+		})
+
+		It("fails with an invalid secret ID", func() {
+			client, err := newVaultClient(appRoleSpec("invalid"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = client.ReadSecret(ctx, "edge/wifi", nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("testing access to the repository", func() {
+		It("succeeds with valid credentials and fails without", func() {
+			tester := &VaultRepoTester{}
+
+			spec := api.RepositorySpec{}
+			Expect(spec.FromVaultRepoSpec(appRoleSpec(vault.SecretID))).To(Succeed())
+			Expect(tester.TestAccess(&model.Repository{Spec: model.MakeJSONField(spec)})).To(Succeed())
+
+			spec = api.RepositorySpec{}
+			Expect(spec.FromVaultRepoSpec(tokenSpec("invalid"))).To(Succeed())
+			Expect(tester.TestAccess(&model.Repository{Spec: model.MakeJSONField(spec)})).ToNot(Succeed())
+		})
+	})
+})
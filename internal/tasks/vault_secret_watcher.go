@@ -0,0 +1,139 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// VaultSecretWatcher renders devices again when a new version is written to one
+// of the Vault secrets in their rendered config. The versions that were
+// rendered are recorded in an annotation of each device.
+type VaultSecretWatcher struct {
+	log             logrus.FieldLogger
+	callbackManager CallbackManager
+	repoStore       store.Repository
+	deviceStore     store.Device
+}
+
+func NewVaultSecretWatcher(callbackManager CallbackManager, store store.Store, log logrus.FieldLogger) *VaultSecretWatcher {
+	return &VaultSecretWatcher{
+		log:             log,
+		callbackManager: callbackManager,
+		repoStore:       store.Repository(),
+		deviceStore:     store.Device(),
+	}
+}
+
+func (w *VaultSecretWatcher) Poll() {
+	w.log.Info("Running VaultSecretWatcher")
+	ctx := context.Background()
+
+	repositories, err := w.repoStore.ListIgnoreOrg()
+	if err != nil {
+		w.log.Errorf("error fetching repositories: %s", err)
+		return
+	}
+
+	clients := map[uuid.UUID]map[string]*vaultClient{}
+	for i := range repositories {
+		repository := &repositories[i]
+		if repository.Spec == nil {
+			continue
+		}
+		if _, err := repository.Spec.Data.GetVaultRepoSpec(); err != nil {
+			continue
+		}
+		client, err := newVaultClientForRepository(repository)
+		if err != nil {
+			w.log.Errorf("invalid Vault repository %s/%s: %v", repository.OrgID, repository.Name, err)
+			continue
+		}
+		if clients[repository.OrgID] == nil {
+			clients[repository.OrgID] = map[string]*vaultClient{}
+		}
+		clients[repository.OrgID][repository.Name] = client
+	}
+
+	for orgId, orgClients := range clients {
+		if err := w.pollOrg(ctx, orgId, orgClients); err != nil {
+			w.log.Errorf("failed checking Vault secrets of org %s: %v", orgId, err)
+		}
+	}
+}
+
+func (w *VaultSecretWatcher) pollOrg(ctx context.Context, orgId uuid.UUID, clients map[string]*vaultClient) error {
+	// The current version of each secret, fetched at most once per poll.
+	// KV versions start at 1, so 0 marks a secret whose version is unavailable.
+	currentVersions := map[string]int{}
+
+	listParams := store.ListParams{
+		Limit: ItemsPerPage,
+		AnnotationsMatchExpressions: api.MatchExpressions{
+			{Key: model.DeviceAnnotationRenderedSecretVersions, Operator: api.Exists},
+		},
+	}
+	for {
+		devices, err := w.deviceStore.List(ctx, orgId, listParams)
+		if err != nil {
+			return fmt.Errorf("failed fetching devices: %w", err)
+		}
+
+		for i := range devices.Items {
+			device := &devices.Items[i]
+			if w.secretsChanged(ctx, device, clients, currentVersions) {
+				w.log.Infof("Vault secrets of device %s/%s changed, rendering it again", orgId, *device.Metadata.Name)
+				w.callbackManager.DeviceSourceUpdated(ctx, orgId, *device.Metadata.Name)
+			}
+		}
+
+		if devices.Metadata.Continue == nil {
+			return nil
+		}
+		cont, err := store.ParseContinueString(devices.Metadata.Continue)
+		if err != nil {
+			return fmt.Errorf("failed to parse continuation for paging: %w", err)
+		}
+		listParams.Continue = cont
+	}
+}
+
+func (w *VaultSecretWatcher) secretsChanged(ctx context.Context, device *api.Device, clients map[string]*vaultClient, currentVersions map[string]int) bool {
+	if device.Metadata.Annotations == nil {
+		return false
+	}
+	renderedVersions := map[string]int{}
+	if err := json.Unmarshal([]byte((*device.Metadata.Annotations)[model.DeviceAnnotationRenderedSecretVersions]), &renderedVersions); err != nil {
+		w.log.Errorf("invalid secret versions of device %s: %v", *device.Metadata.Name, err)
+		return false
+	}
+
+	for key, renderedVersion := range renderedVersions {
+		currentVersion, ok := currentVersions[key]
+		if !ok {
+			repoName, path, _ := strings.Cut(key, "/")
+			client, ok := clients[repoName]
+			if !ok {
+				// The repository was deleted or changed, which renders the device anyway
+				continue
+			}
+			version, err := client.CurrentVersion(ctx, path)
+			if err != nil {
+				w.log.Errorf("failed checking version of secret %s in Vault repository %s: %v", path, repoName, err)
+			}
+			currentVersion = version
+			currentVersions[key] = currentVersion
+		}
+		if currentVersion != 0 && currentVersion != renderedVersion {
+			return true
+		}
+	}
+	return false
+}
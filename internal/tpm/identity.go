@@ -0,0 +1,246 @@
+package tpm
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	fccrypto "github.com/flightctl/flightctl/internal/crypto"
+	"github.com/google/go-tpm-tools/client"
+	"github.com/google/go-tpm/legacy/tpm2"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+// Identity is the identity key of the device generated in the TPM, along with
+// the endorsement key (EK) and attestation key (AK) used to attest it.
+//
+// The keys are primary keys of the TPM's hierarchies, so they are derived again
+// from the same seeds every time the TPM is opened and never leave the TPM.
+type Identity struct {
+	tpm    *TPM
+	key    *client.Key
+	ak     *client.Key
+	ek     *client.Key
+	ekCert []byte
+	// mu serializes the commands that use the identity key
+	mu sync.Mutex
+}
+
+// identityKeyTemplate is the template of the device identity key: a P-256 key
+// that can sign arbitrary digests, such as TLS handshakes, and compute ECDH
+// shared secrets to decrypt the secrets encrypted to the device. A key that
+// can do both has no scheme, so the scheme is given with every signature.
+func identityKeyTemplate() tpm2.Public {
+	return tpm2.Public{
+		Type:       tpm2.AlgECC,
+		NameAlg:    tpm2.AlgSHA256,
+		Attributes: tpm2.FlagFixedTPM | tpm2.FlagFixedParent | tpm2.FlagSensitiveDataOrigin | tpm2.FlagUserWithAuth | tpm2.FlagSign | tpm2.FlagDecrypt,
+		ECCParameters: &tpm2.ECCParams{
+			CurveID: tpm2.CurveNISTP256,
+		},
+	}
+}
+
+// identitySignatureScheme is the scheme of the signatures of the identity key.
+var identitySignatureScheme = tpm2.SigScheme{Alg: tpm2.AlgECDSA, Hash: tpm2.AlgSHA256}
+
+// NewIdentity loads the identity key of the device. The EK certificate is read
+// from the TPM's NV storage unless the PEM-encoded ekCertificate is given, for
+// TPMs that were not provisioned with one.
+func (t *TPM) NewIdentity(ekCertificate []byte) (*Identity, error) {
+	if t == nil || t.channel == nil {
+		return nil, errors.New("no TPM available")
+	}
+	i := &Identity{tpm: t}
+
+	var err error
+	if i.ek, i.ekCert, err = loadEndorsementKey(t.channel, ekCertificate); err != nil {
+		return nil, err
+	}
+	if i.ak, err = client.AttestationKeyECC(t.channel); err != nil {
+		i.Close()
+		return nil, fmt.Errorf("loading attestation key: %w", err)
+	}
+	if i.key, err = client.NewKey(t.channel, tpm2.HandleOwner, identityKeyTemplate()); err != nil {
+		i.Close()
+		return nil, fmt.Errorf("loading identity key: %w", err)
+	}
+	return i, nil
+}
+
+// loadEndorsementKey loads the EK matching the given certificate, or else the
+// first EK with a certificate in NV storage, preferring RSA as most TPMs are
+// provisioned with an RSA EK certificate.
+func loadEndorsementKey(rw io.ReadWriter, ekCertificate []byte) (*client.Key, []byte, error) {
+	if len(ekCertificate) > 0 {
+		certs, err := certutil.ParseCertsPEM(ekCertificate)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing EK certificate: %w", err)
+		}
+		load := client.EndorsementKeyRSA
+		if certs[0].PublicKeyAlgorithm == x509.ECDSA {
+			load = client.EndorsementKeyECC
+		}
+		ek, err := load(rw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("loading endorsement key: %w", err)
+		}
+		if err := ek.SetCert(certs[0]); err != nil {
+			ek.Close()
+			return nil, nil, fmt.Errorf("EK certificate: %w", err)
+		}
+		return ek, ekCertificate, nil
+	}
+
+	for _, load := range []func(io.ReadWriter) (*client.Key, error){client.EndorsementKeyRSA, client.EndorsementKeyECC} {
+		ek, err := load(rw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("loading endorsement key: %w", err)
+		}
+		if ek.Cert() != nil {
+			return ek, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ek.CertDERBytes()}), nil
+		}
+		ek.Close()
+	}
+	return nil, nil, errors.New("no EK certificate found in the TPM")
+}
+
+// Signer returns the signer of the identity key.
+func (i *Identity) Signer() crypto.Signer {
+	return &identitySigner{identity: i}
+}
+
+// ECDHKey returns the identity key for computing ECDH shared secrets, which
+// decrypt the secrets encrypted to the device.
+func (i *Identity) ECDHKey() fccrypto.ECDHKey {
+	return &identityECDHKey{identity: i}
+}
+
+// PublicKey returns the public key of the identity key.
+func (i *Identity) PublicKey() crypto.PublicKey {
+	return i.key.PublicKey()
+}
+
+// Attest certifies the identity key with the attestation key for the given CSR.
+func (i *Identity) Attest(csr []byte) (*v1alpha1.TPMAttestation, error) {
+	akPublic, err := i.ak.PublicArea().Encode()
+	if err != nil {
+		return nil, fmt.Errorf("encoding attestation key: %w", err)
+	}
+	identityPublic, err := i.key.PublicArea().Encode()
+	if err != nil {
+		return nil, fmt.Errorf("encoding identity key: %w", err)
+	}
+	certifyInfo, signature, err := tpm2.CertifyEx(i.tpm.channel, "", "", i.key.Handle(), i.ak.Handle(),
+		fccrypto.TPMAttestationQualifyingData(csr), *i.ak.PublicArea().ECCParameters.Sign)
+	if err != nil {
+		return nil, fmt.Errorf("certifying identity key: %w", err)
+	}
+	return &v1alpha1.TPMAttestation{
+		EkCertificate:        string(i.ekCert),
+		AttestationKeyPublic: akPublic,
+		IdentityKeyPublic:    identityPublic,
+		CertifyInfo:          certifyInfo,
+		CertifySignature:     signature,
+	}, nil
+}
+
+// ActivateCredential recovers the secret of a credential challenge, which only
+// succeeds if it was made for the EK and AK of this TPM.
+func (i *Identity) ActivateCredential(challenge *v1alpha1.TPMCredentialChallenge) ([]byte, error) {
+	// strip the TPM2B size prefixes, which the command adds again
+	if len(challenge.CredentialBlob) < 2 || len(challenge.EncryptedSecret) < 2 {
+		return nil, errors.New("malformed credential challenge")
+	}
+	session, err := client.NewEKSession(i.tpm.channel)
+	if err != nil {
+		return nil, fmt.Errorf("starting endorsement key session: %w", err)
+	}
+	defer session.Close()
+	ekAuth, err := session.Auth()
+	if err != nil {
+		return nil, fmt.Errorf("authorizing endorsement key: %w", err)
+	}
+	akAuth := tpm2.AuthCommand{Session: tpm2.HandlePasswordSession, Attributes: tpm2.AttrContinueSession}
+	secret, err := tpm2.ActivateCredentialUsingAuth(i.tpm.channel, []tpm2.AuthCommand{akAuth, ekAuth},
+		i.ak.Handle(), i.ek.Handle(), challenge.CredentialBlob[2:], challenge.EncryptedSecret[2:])
+	if err != nil {
+		return nil, fmt.Errorf("activating credential: %w", err)
+	}
+	return secret, nil
+}
+
+type identitySigner struct {
+	identity *Identity
+}
+
+func (s *identitySigner) Public() crypto.PublicKey {
+	return s.identity.PublicKey()
+}
+
+// Sign signs a SHA-256 digest, returning an ASN.1 encoded ECDSA signature.
+func (s *identitySigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts != nil && opts.HashFunc() != crypto.SHA256 {
+		return nil, fmt.Errorf("unsupported hash algorithm %v", opts.HashFunc())
+	}
+	if len(digest) != crypto.SHA256.Size() {
+		return nil, fmt.Errorf("digest length: got %d, want %d", len(digest), crypto.SHA256.Size())
+	}
+
+	s.identity.mu.Lock()
+	defer s.identity.mu.Unlock()
+	signature, err := tpm2.Sign(s.identity.tpm.channel, s.identity.key.Handle(), "", digest, nil, &identitySignatureScheme)
+	if err != nil {
+		return nil, fmt.Errorf("signing with identity key: %w", err)
+	}
+	if signature.ECC == nil {
+		return nil, errors.New("identity key returned a signature that is not ECDSA")
+	}
+	return asn1.Marshal(struct{ R, S *big.Int }{signature.ECC.R, signature.ECC.S})
+}
+
+type identityECDHKey struct {
+	identity *Identity
+}
+
+func (k *identityECDHKey) Public() crypto.PublicKey {
+	return k.identity.PublicKey()
+}
+
+// ECDH returns the X coordinate of the shared point, like ecdh.PrivateKey.ECDH.
+func (k *identityECDHKey) ECDH(remote *ecdh.PublicKey) ([]byte, error) {
+	// the public key is an uncompressed point
+	point := remote.Bytes()
+	if len(point) == 0 || point[0] != 4 {
+		return nil, errors.New("unsupported public key encoding")
+	}
+	coordinateSize := (len(point) - 1) / 2
+
+	k.identity.mu.Lock()
+	defer k.identity.mu.Unlock()
+	shared, err := tpm2.ECDHZGen(k.identity.tpm.channel, k.identity.key.Handle(), "", tpm2.ECPoint{
+		XRaw: point[1 : 1+coordinateSize],
+		YRaw: point[1+coordinateSize:],
+	})
+	if err != nil {
+		return nil, fmt.Errorf("computing shared secret with identity key: %w", err)
+	}
+	return shared.X().FillBytes(make([]byte, coordinateSize)), nil
+}
+
+// Close unloads the keys from the TPM.
+func (i *Identity) Close() {
+	for _, key := range []*client.Key{i.key, i.ak, i.ek} {
+		if key != nil {
+			key.Close()
+		}
+	}
+}
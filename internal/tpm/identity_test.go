@@ -0,0 +1,220 @@
+package tpm
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	fccrypto "github.com/flightctl/flightctl/internal/crypto"
+	"github.com/google/go-tpm-tools/client"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestEKCA returns a CA standing in for a TPM manufacturer, and the
+// certificate it issued for the ECC EK of the TPM.
+func newTestEKCA(t *testing.T, tpm *TPM) (*x509.CertPool, []byte) {
+	require := require.New(t)
+
+	ek, err := client.EndorsementKeyECC(tpm.channel)
+	require.NoError(err)
+	ekPublicKey := ek.PublicKey()
+	ek.Close()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test TPM manufacturer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(err)
+
+	ekTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageKeyEncipherment,
+	}
+	ekDER, err := x509.CreateCertificate(rand.Reader, ekTemplate, caCert, ekPublicKey, caKey)
+	require.NoError(err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	return roots, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ekDER})
+}
+
+func newTestIdentity(t *testing.T) (*Identity, *x509.CertPool) {
+	tpm, err := OpenTPMSimulator()
+	require.NoError(t, err)
+	t.Cleanup(tpm.Close)
+
+	roots, ekCert := newTestEKCA(t, tpm)
+	identity, err := tpm.NewIdentity(ekCert)
+	require.NoError(t, err)
+	t.Cleanup(identity.Close)
+	return identity, roots
+}
+
+func toCryptoAttestation(attestation *v1alpha1.TPMAttestation) *fccrypto.TPMAttestation {
+	return &fccrypto.TPMAttestation{
+		EKCertificate:        []byte(attestation.EkCertificate),
+		AttestationKeyPublic: attestation.AttestationKeyPublic,
+		IdentityKeyPublic:    attestation.IdentityKeyPublic,
+		CertifyInfo:          attestation.CertifyInfo,
+		CertifySignature:     attestation.CertifySignature,
+	}
+}
+
+func TestIdentitySigner(t *testing.T) {
+	require := require.New(t)
+	identity, _ := newTestIdentity(t)
+
+	csrPEM, err := fccrypto.MakeCSR(identity.Signer(), "device")
+	require.NoError(err)
+	csr, err := fccrypto.ParseCSR(csrPEM)
+	require.NoError(err)
+	require.NoError(csr.CheckSignature())
+	require.True(identity.PublicKey().(*ecdsa.PublicKey).Equal(csr.PublicKey))
+
+	digest := sha256.Sum256([]byte("handshake"))
+	signature, err := identity.Signer().Sign(rand.Reader, digest[:], nil)
+	require.NoError(err)
+	require.True(ecdsa.VerifyASN1(identity.PublicKey().(*ecdsa.PublicKey), digest[:], signature))
+}
+
+func TestIdentityDecryptsDeviceSecrets(t *testing.T) {
+	require := require.New(t)
+	identity, _ := newTestIdentity(t)
+
+	ciphertext, err := fccrypto.EncryptDeviceSecret(identity.PublicKey(), []byte("s3cr3t"))
+	require.NoError(err)
+	plaintext, err := fccrypto.DecryptDeviceSecret(identity.ECDHKey(), ciphertext)
+	require.NoError(err)
+	require.Equal([]byte("s3cr3t"), plaintext)
+
+	// a secret encrypted to another device can't be decrypted
+	otherPublicKey, _, err := fccrypto.NewKeyPair()
+	require.NoError(err)
+	ciphertext, err = fccrypto.EncryptDeviceSecret(otherPublicKey, []byte("s3cr3t"))
+	require.NoError(err)
+	_, err = fccrypto.DecryptDeviceSecret(identity.ECDHKey(), ciphertext)
+	require.Error(err)
+}
+
+func TestIdentityAttestation(t *testing.T) {
+	require := require.New(t)
+	identity, roots := newTestIdentity(t)
+
+	csrPEM, err := fccrypto.MakeCSR(identity.Signer(), "device")
+	require.NoError(err)
+	attestation, err := identity.Attest(csrPEM)
+	require.NoError(err)
+
+	verified, err := fccrypto.VerifyTPMAttestation(toCryptoAttestation(attestation), roots, identity.PublicKey(), fccrypto.TPMAttestationQualifyingData(csrPEM))
+	require.NoError(err)
+
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	credentialBlob, encryptedSecret, err := fccrypto.MakeTPMCredentialChallenge(verified, secret)
+	require.NoError(err)
+	activated, err := identity.ActivateCredential(&v1alpha1.TPMCredentialChallenge{
+		CredentialBlob:  credentialBlob,
+		EncryptedSecret: encryptedSecret,
+	})
+	require.NoError(err)
+	require.Equal(secret, activated)
+}
+
+func TestIdentityAttestationRejected(t *testing.T) {
+	identity, roots := newTestIdentity(t)
+	csrPEM, err := fccrypto.MakeCSR(identity.Signer(), "device")
+	require.NoError(t, err)
+	otherCSRPEM, err := fccrypto.MakeCSR(identity.Signer(), "other-device")
+	require.NoError(t, err)
+	_, otherKey, err := fccrypto.NewKeyPair()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		modify         func(attestation *fccrypto.TPMAttestation)
+		roots          func() *x509.CertPool
+		publicKey      any
+		qualifyingData []byte
+		wantErr        string
+	}{
+		{
+			name:    "no EK roots",
+			roots:   func() *x509.CertPool { return nil },
+			wantErr: "no TPM endorsement key CAs are configured",
+		},
+		{
+			name:    "EK certificate from an unknown CA",
+			roots:   func() *x509.CertPool { return x509.NewCertPool() },
+			wantErr: "verifying EK certificate",
+		},
+		{
+			name:      "CSR with another key",
+			publicKey: otherKey.(*ecdsa.PrivateKey).Public(),
+			wantErr:   "identity key does not match the public key of the CSR",
+		},
+		{
+			name:           "certification for another request",
+			qualifyingData: fccrypto.TPMAttestationQualifyingData(otherCSRPEM),
+			wantErr:        "certification of identity key does not match the request",
+		},
+		{
+			name: "tampered certification",
+			modify: func(attestation *fccrypto.TPMAttestation) {
+				attestation.CertifyInfo[len(attestation.CertifyInfo)-1] ^= 0xff
+			},
+			wantErr: "verifying certification of identity key",
+		},
+		{
+			name: "attestation key is the identity key",
+			modify: func(attestation *fccrypto.TPMAttestation) {
+				attestation.AttestationKeyPublic = attestation.IdentityKeyPublic
+			},
+			wantErr: "attestation key is not a restricted signing key generated in the TPM",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			attestation, err := identity.Attest(csrPEM)
+			require.NoError(err)
+			cryptoAttestation := toCryptoAttestation(attestation)
+			if tt.modify != nil {
+				tt.modify(cryptoAttestation)
+			}
+			testRoots := roots
+			if tt.roots != nil {
+				testRoots = tt.roots()
+			}
+			publicKey := identity.PublicKey()
+			if tt.publicKey != nil {
+				publicKey = tt.publicKey
+			}
+			qualifyingData := fccrypto.TPMAttestationQualifyingData(csrPEM)
+			if tt.qualifyingData != nil {
+				qualifyingData = tt.qualifyingData
+			}
+
+			_, err = fccrypto.VerifyTPMAttestation(cryptoAttestation, testRoots, publicKey, qualifyingData)
+			require.ErrorContains(err, tt.wantErr)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package util
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -210,6 +211,19 @@ func (d Duration) String() string {
 	return time.Duration(d).String()
 }
 
+// ParseDuration parses a duration in the API's format, a positive integer followed by
+// 's', 'm', 'h' or 'd'. Unlike time.ParseDuration, days are supported.
+func ParseDuration(s string) (time.Duration, error) {
+	if days, found := strings.CutSuffix(s, "d"); found {
+		n, err := strconv.ParseInt(days, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
+
 func MergeLabels(labels ...map[string]string) map[string]string {
 	result := make(map[string]string)
 	for _, l := range labels {
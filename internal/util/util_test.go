@@ -2,6 +2,7 @@ package util
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -23,5 +24,18 @@ var _ = Describe("Test util", func() {
 			Expect(LabelsMatchLabelSelector(map[string]string{"key1": "val1", "key2": "val2"}, map[string]string{"key1": "val1"})).To(BeTrue())
 			Expect(LabelsMatchLabelSelector(map[string]string{"key1": "val1"}, map[string]string{"key1": "val1", "key2": "val2"})).To(BeFalse())
 		})
+
+		It("ParseDuration", func() {
+			d, err := ParseDuration("90s")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(d).To(Equal(90 * time.Second))
+			d, err = ParseDuration("2d")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(d).To(Equal(48 * time.Hour))
+			_, err = ParseDuration("xd")
+			Expect(err).To(HaveOccurred())
+			_, err = ParseDuration("5")
+			Expect(err).To(HaveOccurred())
+		})
 	})
 })
@@ -25,6 +25,8 @@ const (
 	OciImageDigestFmt          string = `[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*[:][[:xdigit:]]{32,}`
 	OciImageReferenceFmt       string = `(` + OciImageNameFmt + `)(?:\:(` + OciImageTagFmt + `))?(?:\@(` + OciImageDigestFmt + `))?`
 	OciImageReferenceMaxLength int    = 2048
+	OciArtifactNameFmt         string = ociNameCompFmt + `(?:\/` + ociNameCompFmt + `)*`
+	OciArtifactReferenceFmt    string = `(?:` + OciImageTagFmt + `|` + OciImageDigestFmt + `)`
 )
 
 // capture(namePat)
@@ -32,7 +34,9 @@ const (
 // optional(literal("@"), capture(digestPat))
 
 var (
-	OciImageReferenceRegexp = regexp.MustCompile("^" + OciImageReferenceFmt + "$")
+	OciImageReferenceRegexp    = regexp.MustCompile("^" + OciImageReferenceFmt + "$")
+	OciArtifactNameRegexp      = regexp.MustCompile("^" + OciArtifactNameFmt + "$")
+	OciArtifactReferenceRegexp = regexp.MustCompile("^" + OciArtifactReferenceFmt + "$")
 )
 
 // Validates an OCI image reference.
@@ -40,6 +44,16 @@ func ValidateOciImageReference(s *string, path string) []error {
 	return ValidateString(s, path, 1, OciImageReferenceMaxLength, OciImageReferenceRegexp, OciImageReferenceFmt, "quay.io/flightctl/flightctl:latest")
 }
 
+// Validates the name of an OCI artifact within a registry.
+func ValidateOciArtifactName(s *string, path string) []error {
+	return ValidateString(s, path, 1, OciImageReferenceMaxLength, OciArtifactNameRegexp, OciArtifactNameFmt, "flightctl/configs")
+}
+
+// Validates the tag or digest of an OCI artifact.
+func ValidateOciArtifactReference(s *string, path string) []error {
+	return ValidateString(s, path, 1, OciImageReferenceMaxLength, OciArtifactReferenceRegexp, OciArtifactReferenceFmt, "v1", "sha256:0123456789abcdef0123456789abcdef")
+}
+
 const (
 	// as per https://docs.github.com/en/get-started/using-git/dealing-with-special-characters-in-branch-and-tag-names#naming-branches-and-tags
 	GitRevisionFmt string = `[a-zA-Z0-9]([a-zA-Z0-9\.\-\_\/])*`
@@ -52,3 +66,15 @@ var GitRevisionRegexp = regexp.MustCompile("^" + GitRevisionFmt + "$")
 func ValidateGitRevision(name *string, path string) []error {
 	return ValidateString(name, path, 1, GitRevisionMaxLength, GitRevisionRegexp, GitRevisionFmt)
 }
+
+const (
+	DurationFmt       string = `[1-9]\d*[smhd]`
+	DurationMaxLength int    = 32
+)
+
+var DurationRegexp = regexp.MustCompile("^" + DurationFmt + "$")
+
+// Validates a duration, a positive integer followed by 's', 'm', 'h' or 'd'.
+func ValidateDuration(d *string, path string) []error {
+	return ValidateString(d, path, 2, DurationMaxLength, DurationRegexp, DurationFmt, "30s", "5m", "1h", "7d")
+}
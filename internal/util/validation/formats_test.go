@@ -94,3 +94,29 @@ func TestValidateGitRevision(t *testing.T) {
 		assert.NotEmpty(ValidateGitRevision(&val, "bad.image.ref"), fmt.Sprintf("value: %q", val))
 	}
 }
+
+func TestValidateOciArtifactReference(t *testing.T) {
+	assert := assert.New(t)
+
+	goodValues := []string{
+		"latest",
+		"v1.0.0",
+		"sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	}
+	for _, val := range goodValues {
+		val := val
+		assert.Empty(ValidateOciArtifactReference(&val, "good.reference"))
+	}
+
+	badValues := []string{
+		"",
+		".starts-with-dot",
+		"sha256:not-hex",
+		"flightctl:latest",
+		strings.Repeat("a", 129),
+	}
+	for _, val := range badValues {
+		val := val
+		assert.NotEmpty(ValidateOciArtifactReference(&val, "bad.reference"), fmt.Sprintf("value: %q", val))
+	}
+}
@@ -113,6 +113,31 @@ func ValidateFilePath(s *string, path string) []error {
 	return asErrors(errs)
 }
 
+func ValidateRelativePath(s *string, path string, maxLen int) []error {
+	if s == nil {
+		return []error{}
+	}
+
+	errs := field.ErrorList{}
+	if len(*s) == 0 {
+		errs = append(errs, field.Required(fieldPathFor(path), ""))
+	}
+	if len(*s) > maxLen {
+		errs = append(errs, field.TooLong(fieldPathFor(path), *s, maxLen))
+	}
+	if filepath.IsAbs(*s) {
+		errs = append(errs, field.Invalid(fieldPathFor(path), *s, "must be a relative path"))
+	}
+	if len(*s) > 0 && filepath.Clean(*s) != *s {
+		errs = append(errs, field.Invalid(fieldPathFor(path), *s, "must be clean (without consecutive separators, . or .. elements)"))
+	}
+	if *s == ".." || strings.HasPrefix(*s, "../") {
+		errs = append(errs, field.Invalid(fieldPathFor(path), *s, "must not reference a parent directory"))
+	}
+
+	return asErrors(errs)
+}
+
 func ValidateLinuxUserGroup(s *string, path string) []error {
 	if s == nil {
 		return []error{}
@@ -266,6 +291,16 @@ func ValidateCSR(csr []byte) []error {
 	return nil
 }
 
+func ValidatePublicKey(key *string, path string) []error {
+	if key == nil {
+		return nil
+	}
+	if _, err := crypto.ParsePublicKeyPEM([]byte(*key)); err != nil {
+		return asErrors(field.ErrorList{field.Invalid(fieldPathFor(path), *key, err.Error())})
+	}
+	return nil
+}
+
 func fieldPathFor(path string) *field.Path {
 	fields := strings.Split(path, ".")
 	return field.NewPath(fields[0], fields[1:]...)
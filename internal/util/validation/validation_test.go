@@ -0,0 +1,38 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRelativePath(t *testing.T) {
+	assert := assert.New(t)
+
+	goodValues := []string{
+		"secret",
+		"edge/site-a/wifi",
+		"edge/{{ device.metadata.name }}",
+	}
+	for _, val := range goodValues {
+		val := val
+		assert.Empty(ValidateRelativePath(&val, "good.path", 64))
+	}
+
+	badValues := []string{
+		"",
+		"/absolute",
+		"trailing/",
+		"double//separator",
+		"..",
+		"../parent",
+		"edge/../../parent",
+		strings.Repeat("a", 65),
+	}
+	for _, val := range badValues {
+		val := val
+		assert.NotEmpty(ValidateRelativePath(&val, "bad.path", 64), fmt.Sprintf("value: %q", val))
+	}
+}
@@ -12,8 +12,13 @@ import (
 
 const initialIgnition = `{"ignition": {"version": "3.4.0"}}`
 
+// DeviceSecretMediaType is the media type of the data URL of files whose
+// contents are encrypted to the public key of the device.
+const DeviceSecretMediaType = "application/vnd.flightctl.device-secret"
+
 type Wrapper interface {
 	SetFile(filePath string, contents []byte, mode int, base64 bool, user *string, group *string)
+	SetDeviceSecretFile(filePath string, ciphertext []byte, mode int, user *string, group *string)
 	AsJson() ([]byte, error)
 	AsMap() (map[string]interface{}, error)
 	Merge(parent config_latest_types.Config) config_latest_types.Config
@@ -34,6 +39,22 @@ func NewWrapper() (Wrapper, error) {
 }
 
 func (w *wrapper) SetFile(filePath string, contents []byte, mode int, base64 bool, user *string, group *string) {
+	if base64 {
+		url := dataurl.New(contents, "text/plain;base64")
+		url.Encoding = dataurl.EncodingASCII // Otherwise the library will double base64 encode
+		w.setFile(filePath, url.String(), mode, user, group)
+	} else {
+		w.setFile(filePath, dataurl.New(contents, "text/plain").String(), mode, user, group)
+	}
+}
+
+// SetDeviceSecretFile adds a file whose contents were encrypted to the public
+// key of the device, which the agent decrypts before writing the file.
+func (w *wrapper) SetDeviceSecretFile(filePath string, ciphertext []byte, mode int, user *string, group *string) {
+	w.setFile(filePath, dataurl.New(ciphertext, DeviceSecretMediaType).String(), mode, user, group)
+}
+
+func (w *wrapper) setFile(filePath string, source string, mode int, user *string, group *string) {
 	file := config_latest_types.File{
 		Node: config_latest_types.Node{
 			Path:      filePath,
@@ -42,18 +63,10 @@ func (w *wrapper) SetFile(filePath string, contents []byte, mode int, base64 boo
 			User:      config_latest_types.NodeUser{Name: lo.ToPtr("root")},
 		},
 		FileEmbedded1: config_latest_types.FileEmbedded1{
-			Contents: config_latest_types.Resource{},
+			Contents: config_latest_types.Resource{Source: &source},
 			Mode:     &mode,
 		},
 	}
-
-	if base64 {
-		url := dataurl.New(contents, "text/plain;base64")
-		url.Encoding = dataurl.EncodingASCII // Otherwise the library will double base64 encode
-		file.FileEmbedded1.Contents.Source = lo.ToPtr(url.String())
-	} else {
-		file.FileEmbedded1.Contents.Source = lo.ToPtr(dataurl.New(contents, "text/plain").String())
-	}
 	if user != nil {
 		file.Node.User = userStringToNodeUser(*user)
 	}
@@ -12,6 +12,8 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 type amqpProvider struct {
@@ -116,15 +118,20 @@ type amqpQueue struct {
 	closed     atomic.Bool
 }
 
-func (r *amqpQueue) Publish(payload []byte) error {
+// Publish publishes the payload along with the trace context of ctx, so that
+// consuming the message continues the trace.
+func (r *amqpQueue) Publish(ctx context.Context, payload []byte) error {
 	if r.closed.Load() {
 		return errors.New("queue is closed")
 	}
-	return r.channel.Publish("",
+	headers := amqp.Table{}
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier(headers))
+	return r.channel.PublishWithContext(ctx, "",
 		r.name, // queue name
 		false,  // mandatory
 		false,  // immediate
 		amqp.Publishing{
+			Headers:      headers,
 			DeliveryMode: amqp.Persistent,
 			ContentType:  "text/plain",
 			Body:         payload,
@@ -154,6 +161,7 @@ func (r *amqpQueue) Consume(ctx context.Context, handler ConsumeHandler) error {
 		for d := range msgs {
 			requestID := reqid.NextRequestID()
 			reqCtx := context.WithValue(ctx, middleware.RequestIDKey, requestID)
+			reqCtx = otel.GetTextMapPropagator().Extract(reqCtx, headerCarrier(d.Headers))
 			log := log.WithReqIDFromCtx(reqCtx, r.log)
 			if err = handler(reqCtx, d.Body, log); err != nil {
 				log.WithError(err).Errorf("failed to consume message: %s", string(d.Body))
@@ -180,3 +188,26 @@ func (a *amqpQueue) Close() {
 		a.connection.Close()
 	}
 }
+
+// headerCarrier carries the trace context in the headers of a message.
+type headerCarrier amqp.Table
+
+// Make sure we conform to the TextMapCarrier interface
+var _ propagation.TextMapCarrier = headerCarrier(nil)
+
+func (c headerCarrier) Get(key string) string {
+	value, _ := c[key].(string)
+	return value
+}
+
+func (c headerCarrier) Set(key string, value string) {
+	c[key] = value
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
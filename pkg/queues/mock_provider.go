@@ -178,15 +178,15 @@ func (mr *MockPublisherMockRecorder) Close() *gomock.Call {
 }
 
 // Publish mocks base method.
-func (m *MockPublisher) Publish(payload []byte) error {
+func (m *MockPublisher) Publish(ctx context.Context, payload []byte) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Publish", payload)
+	ret := m.ctrl.Call(m, "Publish", ctx, payload)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Publish indicates an expected call of Publish.
-func (mr *MockPublisherMockRecorder) Publish(payload any) *gomock.Call {
+func (mr *MockPublisherMockRecorder) Publish(ctx, payload any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockPublisher)(nil).Publish), payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockPublisher)(nil).Publish), ctx, payload)
 }
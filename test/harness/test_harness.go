@@ -95,7 +95,7 @@ func NewTestHarness(testDirPath string, goRoutineErrorHandler func(error)) (*Tes
 	mockK8sClient := k8sclient.NewMockK8SClient(ctrl)
 	workerServer := workerserver.New(&serverCfg, serverLog, store, provider, mockK8sClient)
 
-	agentServer, agentListener, err := testutil.NewTestAgentServer(serverLog, &serverCfg, store, ca, serverCerts)
+	agentServer, agentListener, err := testutil.NewTestAgentServer(serverLog, &serverCfg, store, ca, serverCerts, provider)
 	if err != nil {
 		return nil, fmt.Errorf("NewTestHarness: %w", err)
 	}
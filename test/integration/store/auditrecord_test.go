@@ -0,0 +1,116 @@
+package store_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+var _ = Describe("auditRecordStore", func() {
+	var (
+		log       *logrus.Logger
+		ctx       context.Context
+		orgId     uuid.UUID
+		storeInst store.Store
+		cfg       *config.Config
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		orgId, _ = uuid.NewUUID()
+		log = flightlog.InitLogs()
+		storeInst, cfg, dbName, _ = store.PrepareDBForUnitTests(log)
+
+		timestamp := time.Now().UTC().Truncate(time.Second)
+		for i, user := range []string{"alice", "bob", "alice"} {
+			diff := map[string]interface{}{"spec": map[string]interface{}{"replicas": float64(i)}}
+			err := storeInst.AuditRecord().Create(ctx, orgId, &api.AuditRecord{
+				Metadata:   api.ObjectMeta{Name: util.StrToPtr(fmt.Sprintf("record-%d", i))},
+				Timestamp:  timestamp.Add(time.Duration(i) * time.Second),
+				RequestId:  fmt.Sprintf("req-%d", i),
+				User:       user,
+				Verb:       api.AuditVerbUpdate,
+				Resource:   api.ObjectReference{Kind: "Fleet", Name: fmt.Sprintf("fleet-%d", i)},
+				Outcome:    api.AuditOutcomeSuccess,
+				StatusCode: 200,
+				Diff:       &diff,
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	AfterEach(func() {
+		store.DeleteTestDB(log, cfg, storeInst, dbName)
+	})
+
+	Context("AuditRecord store", func() {
+		It("List audit records in the order of the calls", func() {
+			records, err := storeInst.AuditRecord().List(ctx, orgId, store.ListParams{Limit: 1000})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records.Items).To(HaveLen(3))
+			Expect(records.Items[0].Kind).To(Equal(model.AuditRecordKind))
+			Expect(*records.Items[0].Metadata.Name).To(Equal("record-0"))
+			Expect(records.Items[2].RequestId).To(Equal("req-2"))
+			Expect(records.Items[2].Resource).To(Equal(api.ObjectReference{Kind: "Fleet", Name: "fleet-2"}))
+			Expect(*records.Items[2].Diff).To(Equal(map[string]interface{}{"spec": map[string]interface{}{"replicas": float64(2)}}))
+		})
+
+		It("List audit records by user", func() {
+			records, err := storeInst.AuditRecord().List(ctx, orgId, store.ListParams{
+				Limit:         1000,
+				FieldSelector: fields.SelectorFromSet(fields.Set{"user": "alice"}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records.Items).To(HaveLen(2))
+			for _, record := range records.Items {
+				Expect(record.User).To(Equal("alice"))
+			}
+		})
+
+		It("List audit records with pagination", func() {
+			records, err := storeInst.AuditRecord().List(ctx, orgId, store.ListParams{Limit: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records.Items).To(HaveLen(2))
+			Expect(records.Metadata.Continue).ToNot(BeNil())
+			Expect(*records.Metadata.RemainingItemCount).To(Equal(int64(1)))
+		})
+
+		It("Delete audit records older than the cutoff", func() {
+			err := storeInst.AuditRecord().Create(ctx, orgId, &api.AuditRecord{
+				Metadata:   api.ObjectMeta{Name: util.StrToPtr("record-old")},
+				Timestamp:  time.Now().UTC().Add(-48 * time.Hour),
+				RequestId:  "req-old",
+				User:       "alice",
+				Verb:       api.AuditVerbDelete,
+				Resource:   api.ObjectReference{Kind: "Fleet", Name: "fleet-old"},
+				Outcome:    api.AuditOutcomeSuccess,
+				StatusCode: 200,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			deleted, err := storeInst.AuditRecord().DeleteOlderThan(ctx, time.Now().Add(-24*time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(Equal(int64(1)))
+
+			records, err := storeInst.AuditRecord().List(ctx, orgId, store.ListParams{Limit: 1000})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records.Items).To(HaveLen(3))
+			for _, record := range records.Items {
+				Expect(*record.Metadata.Name).ToNot(Equal("record-old"))
+			}
+		})
+	})
+})
@@ -20,6 +20,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/fields"
 )
 
 func TestStore(t *testing.T) {
@@ -51,8 +52,8 @@ var _ = Describe("DeviceStore create", func() {
 		storeInst, cfg, dbName, db = store.PrepareDBForUnitTests(log)
 		devStore = storeInst.Device()
 		called = false
-		callback = store.DeviceStoreCallback(func(before *model.Device, after *model.Device) { called = true })
-		allDeletedCallback = store.DeviceStoreAllDeletedCallback(func(orgId uuid.UUID) { called = true })
+		callback = store.DeviceStoreCallback(func(ctx context.Context, before *model.Device, after *model.Device) { called = true })
+		allDeletedCallback = store.DeviceStoreAllDeletedCallback(func(ctx context.Context, orgId uuid.UUID) { called = true })
 
 		testutil.CreateTestDevices(ctx, 3, devStore, orgId, nil, false)
 	})
@@ -326,6 +327,39 @@ var _ = Describe("DeviceStore create", func() {
 			Expect(len(devices.Items)).To(Equal(2))
 		})
 
+		It("List with system info field selector", func() {
+			allDevices, err := devStore.List(ctx, orgId, store.ListParams{})
+			Expect(err).ToNot(HaveOccurred())
+			for i := range allDevices.Items {
+				d := &allDevices.Items[i]
+				d.Status.SystemInfo = api.DeviceSystemInfo{
+					Architecture:    "amd64",
+					OperatingSystem: "linux",
+					BootID:          fmt.Sprintf("boot-%d", i),
+					Hostname:        lo.ToPtr(fmt.Sprintf("edge-%d", i)),
+					Cpu:             &api.DeviceCPUInfo{Model: "test", Count: i + 1},
+					Product:         &api.DeviceProductInfo{SerialNumber: lo.ToPtr(fmt.Sprintf("serial-%d", i))},
+				}
+				_, err = devStore.UpdateStatus(ctx, orgId, d)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			for selector, expected := range map[string]int{
+				"status.systemInfo.hostname=edge-1":                               1,
+				"status.systemInfo.hostname!=edge-1":                              2,
+				"status.systemInfo.architecture=amd64":                            3,
+				"status.systemInfo.cpu.count=3":                                   1,
+				"status.systemInfo.product.serialNumber=serial-0":                 1,
+				"status.systemInfo.hostname=edge-1,status.systemInfo.cpu.count=1": 0,
+			} {
+				fieldSelector, err := fields.ParseSelector(selector)
+				Expect(err).ToNot(HaveOccurred())
+				devices, err := devStore.List(ctx, orgId, store.ListParams{FieldSelector: fieldSelector})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(devices.Items).To(HaveLen(expected), selector)
+			}
+		})
+
 		It("CreateOrUpdateDevice create mode", func() {
 			imageName := "tv"
 			device := api.Device{
@@ -517,8 +551,9 @@ var _ = Describe("DeviceStore create", func() {
 			Expect(err).Should(MatchError(flterrors.ErrNoRenderedVersion))
 
 			// Set first rendered config
-			err = devStore.UpdateRendered(ctx, orgId, "dev", "this is the first config", "")
+			renderedVersion, err := devStore.UpdateRendered(ctx, orgId, "dev", "this is the first config", "")
 			Expect(err).ToNot(HaveOccurred())
+			Expect(renderedVersion).To(Equal("1"))
 
 			// Getting first rendered config
 			renderedConfig, err := devStore.GetRendered(ctx, orgId, "dev", nil, "")
@@ -533,8 +568,9 @@ var _ = Describe("DeviceStore create", func() {
 			Expect(renderedConfig).To(BeNil())
 
 			// Set second rendered config
-			err = devStore.UpdateRendered(ctx, orgId, "dev", "this is the second config", "")
+			renderedVersion, err = devStore.UpdateRendered(ctx, orgId, "dev", "this is the second config", "")
 			Expect(err).ToNot(HaveOccurred())
+			Expect(renderedVersion).To(Equal("2"))
 
 			// Passing previous renderedVersion
 			renderedConfig, err = devStore.GetRendered(ctx, orgId, "dev", util.StrToPtr("1"), "")
@@ -544,6 +580,51 @@ var _ = Describe("DeviceStore create", func() {
 			Expect(renderedConfig.RenderedVersion).To(Equal("2"))
 		})
 
+		It("UpdateOverrides", func() {
+			testutil.CreateTestDevice(ctx, devStore, orgId, "dev", util.SetResourceOwner(model.FleetKind, "fleet"), nil, nil)
+			overrides, err := devStore.GetOverrides(ctx, orgId, "dev")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*overrides.Metadata.Name).To(Equal("dev"))
+			Expect(overrides.Spec).To(Equal(api.DeviceOverridesSpec{}))
+
+			spec := api.DeviceOverridesSpec{
+				ApplicationEnvVars: &map[string]map[string]string{"app": {"KEY": "value"}},
+			}
+			overrides, err = devStore.UpdateOverrides(ctx, orgId, "dev", spec, callback)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(called).To(BeTrue())
+			Expect(overrides.Spec).To(Equal(spec))
+
+			overrides, err = devStore.GetOverrides(ctx, orgId, "dev")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(overrides.Spec).To(Equal(spec))
+
+			overridesByName, err := devStore.ListOverrides(ctx, orgId, []string{"dev", "mydevice-1", "nonexistent"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(overridesByName).To(Equal(map[string]api.DeviceOverridesSpec{"dev": spec, "mydevice-1": {}}))
+
+			// devices without a fleet can only have their overrides cleared
+			_, err = devStore.UpdateOverrides(ctx, orgId, "mydevice-1", spec, callback)
+			Expect(err).To(MatchError(flterrors.ErrOverridesWithoutFleet))
+			_, err = devStore.UpdateOverrides(ctx, orgId, "mydevice-1", api.DeviceOverridesSpec{}, callback)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = devStore.UpdateOverrides(ctx, orgId, "nonexistent", spec, callback)
+			Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+		})
+
+		It("GetRendered with overridden items", func() {
+			testutil.CreateTestDevice(ctx, storeInst.Device(), orgId, "dev", nil, nil, nil)
+			_, err := devStore.UpdateRendered(ctx, orgId, "dev", "config", "")
+			Expect(err).ToNot(HaveOccurred())
+			err = devStore.UpdateAnnotations(ctx, orgId, "dev", map[string]string{model.DeviceAnnotationOverridden: `{"config":["first"]}`}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			renderedConfig, err := devStore.GetRendered(ctx, orgId, "dev", nil, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(renderedConfig.Overridden).To(Equal(&api.DeviceOverriddenItems{Config: &[]string{"first"}}))
+		})
+
 		It("OverwriteRepositoryRefs", func() {
 			err := testutil.CreateRepositories(ctx, 2, storeInst, orgId)
 			Expect(err).ToNot(HaveOccurred())
@@ -0,0 +1,96 @@
+package store_test
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	testutil "github.com/flightctl/flightctl/test/util"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("DeviceBundleStore", func() {
+	var (
+		log       *logrus.Logger
+		ctx       context.Context
+		orgId     uuid.UUID
+		storeInst store.Store
+		cfg       *config.Config
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		orgId, _ = uuid.NewUUID()
+		log = flightlog.InitLogs()
+		storeInst, cfg, dbName, _ = store.PrepareDBForUnitTests(log)
+
+		testutil.CreateTestDevices(ctx, 1, storeInst.Device(), orgId, nil, false)
+	})
+
+	AfterEach(func() {
+		store.DeleteTestDB(log, cfg, storeInst, dbName)
+	})
+
+	Context("DeviceBundle store", func() {
+		It("Get bundle - not found error", func() {
+			_, err := storeInst.DeviceBundle().Get(ctx, orgId, "mydevice-1")
+			Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+		})
+
+		It("Request and upload bundle success", func() {
+			requested, err := storeInst.DeviceBundle().Request(ctx, orgId, "mydevice-1", "request-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			bundle, err := storeInst.DeviceBundle().Get(ctx, orgId, "mydevice-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bundle.RequestID).To(Equal("request-1"))
+			Expect(bundle.RequestedAt).To(BeTemporally("~", requested.RequestedAt))
+			Expect(bundle.UploadedAt).To(BeNil())
+
+			err = storeInst.DeviceBundle().Upload(ctx, orgId, "mydevice-1", "request-1", []byte("bundle"))
+			Expect(err).ToNot(HaveOccurred())
+
+			bundle, err = storeInst.DeviceBundle().Get(ctx, orgId, "mydevice-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bundle.UploadedAt).ToNot(BeNil())
+			Expect(bundle.Data).To(Equal([]byte("bundle")))
+			Expect(*bundle.ToApiStatus().Size).To(Equal(int64(6)))
+		})
+
+		It("Request bundle replaces the previous one", func() {
+			_, err := storeInst.DeviceBundle().Request(ctx, orgId, "mydevice-1", "request-1")
+			Expect(err).ToNot(HaveOccurred())
+			err = storeInst.DeviceBundle().Upload(ctx, orgId, "mydevice-1", "request-1", []byte("bundle"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = storeInst.DeviceBundle().Request(ctx, orgId, "mydevice-1", "request-2")
+			Expect(err).ToNot(HaveOccurred())
+			bundle, err := storeInst.DeviceBundle().Get(ctx, orgId, "mydevice-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bundle.RequestID).To(Equal("request-2"))
+			Expect(bundle.UploadedAt).To(BeNil())
+			Expect(bundle.Data).To(BeEmpty())
+
+			// the upload of an earlier request is rejected
+			err = storeInst.DeviceBundle().Upload(ctx, orgId, "mydevice-1", "request-1", []byte("bundle"))
+			Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+		})
+
+		It("Delete device deletes its bundle", func() {
+			_, err := storeInst.DeviceBundle().Request(ctx, orgId, "mydevice-1", "request-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = storeInst.Device().Delete(ctx, orgId, "mydevice-1", func(ctx context.Context, before, after *model.Device) {})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = storeInst.DeviceBundle().Get(ctx, orgId, "mydevice-1")
+			Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+		})
+	})
+})
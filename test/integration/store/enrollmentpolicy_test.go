@@ -0,0 +1,154 @@
+package store_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+func createEnrollmentPolicies(numEnrollmentPolicies int, ctx context.Context, store store.Store, orgId uuid.UUID) {
+	for i := 1; i <= numEnrollmentPolicies; i++ {
+		resource := api.EnrollmentPolicy{
+			Metadata: api.ObjectMeta{
+				Name:   util.StrToPtr(fmt.Sprintf("myenrollmentpolicy-%d", i)),
+				Labels: &map[string]string{"key": fmt.Sprintf("value-%d", i)},
+			},
+			Spec: api.EnrollmentPolicySpec{
+				MaxApprovals: lo.ToPtr(int64(2)),
+			},
+		}
+
+		_, err := store.EnrollmentPolicy().Create(ctx, orgId, &resource)
+		if err != nil {
+			log.Fatalf("creating enrollmentpolicy: %v", err)
+		}
+	}
+}
+
+var _ = Describe("enrollmentPolicyStore create", func() {
+	var (
+		log                   *logrus.Logger
+		ctx                   context.Context
+		orgId                 uuid.UUID
+		storeInst             store.Store
+		cfg                   *config.Config
+		dbName                string
+		numEnrollmentPolicies int
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		orgId, _ = uuid.NewUUID()
+		log = flightlog.InitLogs()
+		numEnrollmentPolicies = 3
+		storeInst, cfg, dbName, _ = store.PrepareDBForUnitTests(log)
+
+		createEnrollmentPolicies(numEnrollmentPolicies, ctx, storeInst, orgId)
+	})
+
+	AfterEach(func() {
+		store.DeleteTestDB(log, cfg, storeInst, dbName)
+	})
+
+	Context("EnrollmentPolicy store", func() {
+		It("Get enrollmentpolicy success", func() {
+			policy, err := storeInst.EnrollmentPolicy().Get(ctx, orgId, "myenrollmentpolicy-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.ApiVersion).To(Equal(model.EnrollmentPolicyAPI))
+			Expect(policy.Kind).To(Equal(model.EnrollmentPolicyKind))
+			Expect(*policy.Metadata.Name).To(Equal("myenrollmentpolicy-1"))
+			Expect(policy.Status).ToNot(BeNil())
+			Expect(policy.Status.ApprovedCount).To(Equal(int64(0)))
+		})
+
+		It("Get enrollmentpolicy - not found error", func() {
+			_, err := storeInst.EnrollmentPolicy().Get(ctx, orgId, "nonexistent")
+			Expect(err).To(HaveOccurred())
+			Expect(err).Should(MatchError(flterrors.ErrResourceNotFound))
+		})
+
+		It("Delete all enrollmentpolicies in org", func() {
+			listParams := store.ListParams{Limit: 1000}
+			policies, err := storeInst.EnrollmentPolicy().List(ctx, orgId, listParams)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(policies.Items)).To(Equal(numEnrollmentPolicies))
+
+			err = storeInst.EnrollmentPolicy().DeleteAll(ctx, orgId)
+			Expect(err).ToNot(HaveOccurred())
+
+			policies, err = storeInst.EnrollmentPolicy().List(ctx, orgId, listParams)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(policies.Items)).To(Equal(0))
+		})
+
+		It("RecordApproval counts approvals up to the maximum", func() {
+			approvedAt := time.Now().Truncate(time.Second)
+			err := storeInst.EnrollmentPolicy().RecordApproval(ctx, orgId, "myenrollmentpolicy-1", approvedAt)
+			Expect(err).ToNot(HaveOccurred())
+			err = storeInst.EnrollmentPolicy().RecordApproval(ctx, orgId, "myenrollmentpolicy-1", approvedAt)
+			Expect(err).ToNot(HaveOccurred())
+			err = storeInst.EnrollmentPolicy().RecordApproval(ctx, orgId, "myenrollmentpolicy-1", approvedAt)
+			Expect(err).Should(MatchError(flterrors.ErrMaxApprovalsReached))
+
+			policy, err := storeInst.EnrollmentPolicy().Get(ctx, orgId, "myenrollmentpolicy-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.Status.ApprovedCount).To(Equal(int64(2)))
+			Expect(policy.Status.LastApprovedAt.Equal(approvedAt)).To(BeTrue())
+		})
+
+		It("RevertApproval frees an approval for another request", func() {
+			err := storeInst.EnrollmentPolicy().RecordApproval(ctx, orgId, "myenrollmentpolicy-1", time.Now())
+			Expect(err).ToNot(HaveOccurred())
+			err = storeInst.EnrollmentPolicy().RecordApproval(ctx, orgId, "myenrollmentpolicy-1", time.Now())
+			Expect(err).ToNot(HaveOccurred())
+
+			err = storeInst.EnrollmentPolicy().RevertApproval(ctx, orgId, "myenrollmentpolicy-1")
+			Expect(err).ToNot(HaveOccurred())
+			err = storeInst.EnrollmentPolicy().RecordApproval(ctx, orgId, "myenrollmentpolicy-1", time.Now())
+			Expect(err).ToNot(HaveOccurred())
+
+			policy, err := storeInst.EnrollmentPolicy().Get(ctx, orgId, "myenrollmentpolicy-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.Status.ApprovedCount).To(Equal(int64(2)))
+		})
+
+		It("RecordApproval - not found error", func() {
+			err := storeInst.EnrollmentPolicy().RecordApproval(ctx, orgId, "nonexistent", time.Now())
+			Expect(err).Should(MatchError(flterrors.ErrResourceNotFound))
+		})
+
+		It("CreateOrUpdateEnrollmentPolicy update mode preserves status", func() {
+			err := storeInst.EnrollmentPolicy().RecordApproval(ctx, orgId, "myenrollmentpolicy-1", time.Now())
+			Expect(err).ToNot(HaveOccurred())
+
+			policy := api.EnrollmentPolicy{
+				Metadata: api.ObjectMeta{
+					Name: util.StrToPtr("myenrollmentpolicy-1"),
+				},
+				Spec: api.EnrollmentPolicySpec{
+					MaxApprovals: lo.ToPtr(int64(10)),
+				},
+				Status: &api.EnrollmentPolicyStatus{},
+			}
+			updated, created, err := storeInst.EnrollmentPolicy().CreateOrUpdate(ctx, orgId, &policy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(Equal(false))
+			Expect(*updated.Spec.MaxApprovals).To(Equal(int64(10)))
+			Expect(updated.Status.ApprovedCount).To(Equal(int64(1)))
+		})
+	})
+})
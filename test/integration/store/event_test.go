@@ -0,0 +1,127 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+var _ = Describe("EventStore", func() {
+	var (
+		log       *logrus.Logger
+		ctx       context.Context
+		orgId     uuid.UUID
+		storeInst store.Store
+		cfg       *config.Config
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		orgId, _ = uuid.NewUUID()
+		log = flightlog.InitLogs()
+		storeInst, cfg, dbName, _ = store.PrepareDBForUnitTests(log)
+	})
+
+	AfterEach(func() {
+		store.DeleteTestDB(log, cfg, storeInst, dbName)
+	})
+
+	listEvents := func(selector string) []api.Event {
+		listParams := store.ListParams{}
+		if selector != "" {
+			listParams.FieldSelector = fields.ParseSelectorOrDie(selector)
+		}
+		events, err := storeInst.Event().List(ctx, orgId, listParams)
+		Expect(err).ToNot(HaveOccurred())
+		return events.Items
+	}
+
+	Context("Event store", func() {
+		It("Emit event success", func() {
+			err := storeInst.Event().Emit(ctx, orgId, api.NewEvent(model.DeviceKind, "mydevice", api.EventTypeNormal, api.EventReasonRenderedVersionChanged, "Device rendered at version 1"))
+			Expect(err).ToNot(HaveOccurred())
+
+			events := listEvents("")
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Kind).To(Equal(model.EventKind))
+			Expect(events[0].InvolvedObject).To(Equal(api.ObjectReference{Kind: model.DeviceKind, Name: "mydevice"}))
+			Expect(events[0].Reason).To(Equal(api.EventReasonRenderedVersionChanged))
+			Expect(events[0].Message).To(Equal("Device rendered at version 1"))
+			Expect(events[0].Count).To(Equal(int64(1)))
+			Expect(events[0].Timestamp).To(BeTemporally("~", time.Now(), time.Minute))
+			Expect(*events[0].Metadata.CreationTimestamp).To(BeTemporally("~", events[0].Timestamp, time.Second))
+		})
+
+		It("Emit repeated event increments its count", func() {
+			for i := 0; i < 3; i++ {
+				err := storeInst.Event().Emit(ctx, orgId, api.NewEvent(model.DeviceKind, "mydevice", api.EventTypeWarning, api.EventReasonDeviceDisconnected, "Did not check in for more than 5m0s"))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			events := listEvents("")
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Count).To(Equal(int64(3)))
+			Expect(events[0].Timestamp).To(BeTemporally(">", *events[0].Metadata.CreationTimestamp))
+
+			// another event in between starts a new series
+			err := storeInst.Event().Emit(ctx, orgId, api.NewEvent(model.DeviceKind, "mydevice", api.EventTypeNormal, api.EventReasonDeviceReconnected, "The device reported its status"))
+			Expect(err).ToNot(HaveOccurred())
+			err = storeInst.Event().Emit(ctx, orgId, api.NewEvent(model.DeviceKind, "mydevice", api.EventTypeWarning, api.EventReasonDeviceDisconnected, "Did not check in for more than 5m0s"))
+			Expect(err).ToNot(HaveOccurred())
+			events = listEvents("")
+			Expect(events).To(HaveLen(3))
+			Expect(events[2].Reason).To(Equal(api.EventReasonDeviceDisconnected))
+			Expect(events[2].Count).To(Equal(int64(1)))
+		})
+
+		It("List events with field selector", func() {
+			err := storeInst.Event().Emit(ctx, orgId, api.NewEvent(model.DeviceKind, "mydevice", api.EventTypeNormal, api.EventReasonFleetChanged, "Device owner changed from <none> to Fleet/myfleet"))
+			Expect(err).ToNot(HaveOccurred())
+			err = storeInst.Event().Emit(ctx, orgId, api.NewEvent(model.DeviceKind, "otherdevice", api.EventTypeNormal, api.EventReasonFleetChanged, "Device owner changed from <none> to Fleet/myfleet"))
+			Expect(err).ToNot(HaveOccurred())
+			err = storeInst.Event().Emit(ctx, orgId, api.NewEvent(model.FleetKind, "mydevice", api.EventTypeNormal, api.EventReasonRolloutStarted, "Rolling out template version 1"))
+			Expect(err).ToNot(HaveOccurred())
+
+			events := listEvents("involvedObject.kind=Device,involvedObject.name=mydevice")
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Reason).To(Equal(api.EventReasonFleetChanged))
+
+			events = listEvents("reason=RolloutStarted")
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].InvolvedObject.Kind).To(Equal(model.FleetKind))
+
+			// events of other orgs are not listed
+			otherOrgId, _ := uuid.NewUUID()
+			otherEvents, err := storeInst.Event().List(ctx, otherOrgId, store.ListParams{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(otherEvents.Items).To(BeEmpty())
+		})
+
+		It("Delete events older than cutoff", func() {
+			old := api.NewEvent(model.DeviceKind, "mydevice", api.EventTypeNormal, api.EventReasonEnrollmentRequestApproved, "Enrollment request approved by admin")
+			old.Timestamp = time.Now().Add(-48 * time.Hour)
+			err := storeInst.Event().Emit(ctx, orgId, old)
+			Expect(err).ToNot(HaveOccurred())
+			err = storeInst.Event().Emit(ctx, orgId, api.NewEvent(model.DeviceKind, "mydevice", api.EventTypeNormal, api.EventReasonRenderedVersionChanged, "Device rendered at version 1"))
+			Expect(err).ToNot(HaveOccurred())
+
+			deleted, err := storeInst.Event().DeleteOlderThan(ctx, time.Now().Add(-24*time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(Equal(int64(1)))
+
+			events := listEvents("")
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Reason).To(Equal(api.EventReasonRenderedVersionChanged))
+		})
+	})
+})
@@ -144,7 +144,7 @@ var _ = Describe("FleetStore create", func() {
 
 		It("Delete fleet success", func() {
 			called := false
-			callback := store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {
+			callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {
 				called = true
 			})
 			err := storeInst.Fleet().Delete(ctx, orgId, callback, "myfleet-1")
@@ -154,7 +154,7 @@ var _ = Describe("FleetStore create", func() {
 
 		It("Delete fleet success when not found", func() {
 			called := false
-			callback := store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {
+			callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {
 				called = true
 			})
 			err := storeInst.Fleet().Delete(ctx, orgId, callback, "nonexistent")
@@ -164,7 +164,7 @@ var _ = Describe("FleetStore create", func() {
 
 		It("Delete all fleets in org", func() {
 			called := false
-			callback := store.FleetStoreAllDeletedCallback(func(orgId uuid.UUID) {
+			callback := store.FleetStoreAllDeletedCallback(func(ctx context.Context, orgId uuid.UUID) {
 				called = true
 			})
 
@@ -373,7 +373,7 @@ var _ = Describe("FleetStore create", func() {
 				Status: nil,
 			}
 			called := false
-			callback := store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {
+			callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {
 				called = true
 			})
 			_, created, err := storeInst.Fleet().CreateOrUpdate(ctx, orgId, &fleet, callback)
@@ -416,7 +416,7 @@ var _ = Describe("FleetStore create", func() {
 			updatedFleet.Spec.Selector = &api.LabelSelector{MatchLabels: &map[string]string{"key": "value"}}
 
 			called := false
-			callback := store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {
+			callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {
 				called = true
 			})
 			_, created, err := storeInst.Fleet().CreateOrUpdate(ctx, orgId, updatedFleet, callback)
@@ -442,7 +442,7 @@ var _ = Describe("FleetStore create", func() {
 			fleet.Status = nil
 
 			called := false
-			callback := store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {
+			callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {
 				called = true
 			})
 			_, created, err := storeInst.Fleet().CreateOrUpdate(ctx, orgId, fleet, callback)
@@ -468,7 +468,7 @@ var _ = Describe("FleetStore create", func() {
 			fleet.Status = nil
 
 			called := false
-			callback := store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {
+			callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {
 				called = true
 			})
 			fleet.Metadata.Owner = util.StrToPtr("test")
@@ -518,7 +518,7 @@ var _ = Describe("FleetStore create", func() {
 			fleet.Metadata.Owner = util.StrToPtr("owner")
 			fleet2.Metadata.Owner = util.StrToPtr("owner2")
 			called := false
-			callback := store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {
+			callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {
 				called = true
 			})
 			_, created, err := storeInst.Fleet().CreateOrUpdate(ctx, orgId, fleet, callback)
@@ -568,7 +568,7 @@ var _ = Describe("FleetStore create", func() {
 				Status: nil,
 			}
 			called := 0
-			callback := store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {
+			callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {
 				called++
 			})
 			err := storeInst.Fleet().CreateOrUpdateMultiple(ctx, orgId, callback, &fleet, &fleet2)
@@ -617,7 +617,7 @@ var _ = Describe("FleetStore create", func() {
 				Status: nil,
 			}
 			called := 0
-			callback := store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {
+			callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {
 				called++
 			})
 			err := storeInst.Fleet().CreateOrUpdateMultiple(ctx, orgId, callback, &fleet, &fleet2)
@@ -658,7 +658,7 @@ var _ = Describe("FleetStore create", func() {
 				Owners: []string{owner},
 			}
 
-			callback := store.FleetStoreAllDeletedCallback(func(orgId uuid.UUID) {})
+			callback := store.FleetStoreAllDeletedCallback(func(ctx context.Context, orgId uuid.UUID) {})
 			err := storeInst.Fleet().DeleteAll(ctx, orgId, callback)
 			Expect(err).ToNot(HaveOccurred())
 			testutil.CreateTestFleets(ctx, numFleets, storeInst.Fleet(), orgId, "myfleet", true, util.StrToPtr(owner))
@@ -753,7 +753,7 @@ var _ = Describe("FleetStore create", func() {
 			Expect(*(repos.Items[0]).Metadata.Name).To(Equal("myrepository-1"))
 
 			called := false
-			callback := store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {
+			callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {
 				called = true
 			})
 			err = storeInst.Fleet().Delete(ctx, orgId, callback, "myfleet-1")
@@ -773,7 +773,7 @@ var _ = Describe("FleetStore create", func() {
 			Expect(*(repos.Items[0]).Metadata.Name).To(Equal("myrepository-1"))
 
 			called := false
-			callback := store.FleetStoreAllDeletedCallback(func(orgId uuid.UUID) {
+			callback := store.FleetStoreAllDeletedCallback(func(ctx context.Context, orgId uuid.UUID) {
 				called = true
 			})
 			err = storeInst.Fleet().DeleteAll(ctx, orgId, callback)
@@ -5,6 +5,7 @@ import (
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/store/model"
@@ -39,7 +40,7 @@ var _ = Describe("RepositoryStore create", func() {
 		numRepositories = 3
 		storeInst, cfg, dbName, db = store.PrepareDBForUnitTests(log)
 		callbackCalled = false
-		callback = store.RepositoryStoreCallback(func(*model.Repository) { callbackCalled = true })
+		callback = store.RepositoryStoreCallback(func(context.Context, *model.Repository) { callbackCalled = true })
 
 		err := testutil.CreateRepositories(ctx, 3, storeInst, orgId)
 		Expect(err).ToNot(HaveOccurred())
@@ -99,7 +100,7 @@ var _ = Describe("RepositoryStore create", func() {
 
 		It("Delete all repositories in org", func() {
 			otherOrgId, _ := uuid.NewUUID()
-			deleteAllCallback := store.RepositoryStoreAllDeletedCallback(func(uuid.UUID) { callbackCalled = true })
+			deleteAllCallback := store.RepositoryStoreAllDeletedCallback(func(context.Context, uuid.UUID) { callbackCalled = true })
 			err := storeInst.Repository().DeleteAll(ctx, otherOrgId, deleteAllCallback)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(callbackCalled).To(BeTrue())
@@ -305,10 +306,187 @@ var _ = Describe("RepositoryStore create", func() {
 			Expect(repos.Items).To(HaveLen(1))
 			Expect(*(repos.Items[0]).Metadata.Name).To(Equal("myrepository-1"))
 
-			deleteAllCallback := store.RepositoryStoreAllDeletedCallback(func(uuid.UUID) { callbackCalled = true })
+			deleteAllCallback := store.RepositoryStoreAllDeletedCallback(func(context.Context, uuid.UUID) { callbackCalled = true })
 			err = storeInst.Repository().DeleteAll(ctx, orgId, deleteAllCallback)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(callbackCalled).To(BeTrue())
 		})
 	})
 })
+
+var _ = Describe("RepositoryStore secrets", func() {
+	var (
+		log       *logrus.Logger
+		ctx       context.Context
+		orgId     uuid.UUID
+		storeInst store.Store
+		cfg       *config.Config
+		dbName    string
+		db        *gorm.DB
+		oldKey    crypto.EncryptionKey
+		newKey    crypto.EncryptionKey
+		repoStore store.Repository
+		callback  store.RepositoryStoreCallback
+	)
+
+	newEncryptionKey := func(id string) crypto.EncryptionKey {
+		key, err := crypto.NewEncryptionKey()
+		Expect(err).ToNot(HaveOccurred())
+		return crypto.EncryptionKey{ID: id, Key: key}
+	}
+
+	newRepoStore := func(keys ...crypto.EncryptionKey) store.Repository {
+		encrypter, err := crypto.NewSecretEncrypter(keys...)
+		Expect(err).ToNot(HaveOccurred())
+		return store.NewRepository(db, encrypter, log)
+	}
+
+	rawSpec := func(name string) string {
+		var spec string
+		err := db.Raw("SELECT spec::text FROM repositories WHERE org_id = ? AND name = ?", orgId, name).Scan(&spec).Error
+		Expect(err).ToNot(HaveOccurred())
+		return spec
+	}
+
+	createHttpRepository := func(name, password string) {
+		spec := api.RepositorySpec{}
+		err := spec.FromHttpRepoSpec(api.HttpRepoSpec{
+			Url:  "https://example.com/repo.git",
+			Type: "http",
+			HttpConfig: api.HttpConfig{
+				Username: util.StrToPtr("user"),
+				Password: util.StrToPtr(password),
+				Token:    util.StrToPtr("token-" + password),
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		repository := api.Repository{
+			Metadata: api.ObjectMeta{Name: util.StrToPtr(name)},
+			Spec:     spec,
+		}
+		_, err = repoStore.Create(ctx, orgId, &repository, callback)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		orgId, _ = uuid.NewUUID()
+		log = flightlog.InitLogs()
+		storeInst, cfg, dbName, db = store.PrepareDBForUnitTests(log)
+		callback = store.RepositoryStoreCallback(func(context.Context, *model.Repository) {})
+		oldKey = newEncryptionKey("old")
+		newKey = newEncryptionKey("new")
+		repoStore = newRepoStore(oldKey)
+
+		createHttpRepository("secret-repo", "hunter2")
+	})
+
+	AfterEach(func() {
+		store.DeleteTestDB(log, cfg, storeInst, dbName)
+	})
+
+	It("stores credentials encrypted", func() {
+		spec := rawSpec("secret-repo")
+		Expect(spec).ToNot(ContainSubstring("hunter2"))
+		Expect(spec).To(ContainSubstring("enc:v1:old:"))
+		Expect(spec).To(ContainSubstring("user"))
+
+		repo, err := repoStore.GetInternal(ctx, orgId, "secret-repo")
+		Expect(err).ToNot(HaveOccurred())
+		httpSpec, err := repo.Spec.Data.GetHttpRepoSpec()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*httpSpec.HttpConfig.Password).To(Equal("hunter2"))
+		Expect(*httpSpec.HttpConfig.Token).To(Equal("token-hunter2"))
+		Expect(repo.String()).ToNot(ContainSubstring("hunter2"))
+
+		repos, err := repoStore.ListIgnoreOrg()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(repos).To(HaveLen(1))
+		Expect(repos[0].Spec.Data).To(Equal(repo.Spec.Data))
+	})
+
+	It("never returns credentials from the API", func() {
+		repo, err := repoStore.Get(ctx, orgId, "secret-repo")
+		Expect(err).ToNot(HaveOccurred())
+		httpSpec, err := repo.Spec.GetHttpRepoSpec()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*httpSpec.HttpConfig.Password).To(Equal(model.RedactedValue))
+		Expect(*httpSpec.HttpConfig.Token).To(Equal(model.RedactedValue))
+
+		repos, err := repoStore.List(ctx, orgId, store.ListParams{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(repos.Items).To(HaveLen(1))
+		httpSpec, err = repos.Items[0].Spec.GetHttpRepoSpec()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*httpSpec.HttpConfig.Password).To(Equal(model.RedactedValue))
+		Expect(*httpSpec.HttpConfig.Token).To(Equal(model.RedactedValue))
+	})
+
+	It("keeps credentials that are sent back redacted", func() {
+		repo, err := repoStore.Get(ctx, orgId, "secret-repo")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = repoStore.Update(ctx, orgId, repo, callback)
+		Expect(err).ToNot(HaveOccurred())
+
+		internal, err := repoStore.GetInternal(ctx, orgId, "secret-repo")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*internal.Generation).To(Equal(int64(1)))
+		httpSpec, err := internal.Spec.Data.GetHttpRepoSpec()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*httpSpec.HttpConfig.Password).To(Equal("hunter2"))
+		Expect(*httpSpec.HttpConfig.Token).To(Equal("token-hunter2"))
+	})
+
+	It("reads credentials stored before encryption was enabled", func() {
+		repoStore = store.NewRepository(db, nil, log)
+		createHttpRepository("cleartext-repo", "swordfish")
+		Expect(rawSpec("cleartext-repo")).To(ContainSubstring("swordfish"))
+
+		repoStore = newRepoStore(oldKey)
+		repo, err := repoStore.GetInternal(ctx, orgId, "cleartext-repo")
+		Expect(err).ToNot(HaveOccurred())
+		httpSpec, err := repo.Spec.Data.GetHttpRepoSpec()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*httpSpec.HttpConfig.Password).To(Equal("swordfish"))
+
+		// without keys, encrypted credentials cannot be read
+		_, err = store.NewRepository(db, nil, log).GetInternal(ctx, orgId, "secret-repo")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("skips repositories whose credentials cannot be decrypted when listing across orgs", func() {
+		repoStore = newRepoStore(newKey)
+		createHttpRepository("new-key-repo", "swordfish")
+
+		// the credentials of secret-repo are encrypted with a key the store does not have
+		repos, err := repoStore.ListIgnoreOrg()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(repos).To(HaveLen(1))
+		Expect(repos[0].Name).To(Equal("new-key-repo"))
+	})
+
+	It("rotates encryption keys", func() {
+		repoStore = store.NewRepository(db, nil, log)
+		createHttpRepository("cleartext-repo", "swordfish")
+
+		repoStore = newRepoStore(newKey, oldKey)
+		rotated, err := repoStore.RotateSecrets(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rotated).To(Equal(2))
+		Expect(rawSpec("secret-repo")).To(ContainSubstring("enc:v1:new:"))
+		Expect(rawSpec("cleartext-repo")).ToNot(ContainSubstring("swordfish"))
+
+		rotated, err = repoStore.RotateSecrets(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rotated).To(Equal(0))
+
+		repoStore = newRepoStore(newKey)
+		for name, password := range map[string]string{"secret-repo": "hunter2", "cleartext-repo": "swordfish"} {
+			repo, err := repoStore.GetInternal(ctx, orgId, name)
+			Expect(err).ToNot(HaveOccurred())
+			httpSpec, err := repo.Spec.Data.GetHttpRepoSpec()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*httpSpec.HttpConfig.Password).To(Equal(password))
+		}
+	})
+})
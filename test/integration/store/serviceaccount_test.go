@@ -0,0 +1,116 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("serviceAccountStore", func() {
+	var (
+		log       *logrus.Logger
+		ctx       context.Context
+		orgId     uuid.UUID
+		storeInst store.Store
+		cfg       *config.Config
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		orgId, _ = uuid.NewUUID()
+		log = flightlog.InitLogs()
+		storeInst, cfg, dbName, _ = store.PrepareDBForUnitTests(log)
+
+		_, err := storeInst.ServiceAccount().Create(ctx, orgId, &api.ServiceAccount{
+			Metadata: api.ObjectMeta{Name: util.StrToPtr("ci")},
+			Spec:     api.ServiceAccountSpec{Scopes: []string{"read", "fleets:write"}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		store.DeleteTestDB(log, cfg, storeInst, dbName)
+	})
+
+	Context("ServiceAccount store", func() {
+		It("Get serviceaccount lists its tokens in the status", func() {
+			err := storeInst.ServiceAccount().CreateToken(ctx, orgId, &model.ServiceAccountToken{
+				ServiceAccountName: "ci",
+				Name:               "pipeline",
+				TokenHash:          "hash-1",
+				Scopes:             []string{"read"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			serviceAccount, err := storeInst.ServiceAccount().Get(ctx, orgId, "ci")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(serviceAccount.Kind).To(Equal(model.ServiceAccountKind))
+			Expect(serviceAccount.Spec.Scopes).To(Equal([]string{"read", "fleets:write"}))
+			Expect(serviceAccount.Status.Tokens).To(HaveLen(1))
+			Expect(serviceAccount.Status.Tokens[0].Name).To(Equal("pipeline"))
+			Expect(serviceAccount.Status.Tokens[0].Scopes).To(Equal([]string{"read"}))
+			Expect(serviceAccount.Status.Tokens[0].LastUsedAt).To(BeNil())
+		})
+
+		It("CreateToken - duplicate name error", func() {
+			token := model.ServiceAccountToken{ServiceAccountName: "ci", Name: "pipeline", TokenHash: "hash-1"}
+			Expect(storeInst.ServiceAccount().CreateToken(ctx, orgId, &token)).To(Succeed())
+			token = model.ServiceAccountToken{ServiceAccountName: "ci", Name: "pipeline", TokenHash: "hash-2"}
+			Expect(storeInst.ServiceAccount().CreateToken(ctx, orgId, &token)).To(MatchError(flterrors.ErrDuplicateName))
+		})
+
+		It("CreateToken - not found error", func() {
+			token := model.ServiceAccountToken{ServiceAccountName: "nonexistent", Name: "pipeline", TokenHash: "hash-1"}
+			Expect(storeInst.ServiceAccount().CreateToken(ctx, orgId, &token)).To(MatchError(flterrors.ErrResourceNotFound))
+		})
+
+		It("UseToken records when the token was last used", func() {
+			token := model.ServiceAccountToken{ServiceAccountName: "ci", Name: "pipeline", TokenHash: "hash-1", Scopes: []string{"read"}}
+			Expect(storeInst.ServiceAccount().CreateToken(ctx, orgId, &token)).To(Succeed())
+
+			usedAt := time.Now().UTC().Truncate(time.Second)
+			used, err := storeInst.ServiceAccount().UseToken(ctx, "hash-1", usedAt)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(used.Name).To(Equal("pipeline"))
+			Expect(used.ServiceAccount.Spec.Data.Scopes).To(Equal([]string{"read", "fleets:write"}))
+
+			serviceAccount, err := storeInst.ServiceAccount().Get(ctx, orgId, "ci")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(serviceAccount.Status.Tokens[0].LastUsedAt.Equal(usedAt)).To(BeTrue())
+
+			_, err = storeInst.ServiceAccount().UseToken(ctx, "unknown", usedAt)
+			Expect(err).Should(MatchError(flterrors.ErrResourceNotFound))
+		})
+
+		It("DeleteToken revokes the token", func() {
+			token := model.ServiceAccountToken{ServiceAccountName: "ci", Name: "pipeline", TokenHash: "hash-1"}
+			Expect(storeInst.ServiceAccount().CreateToken(ctx, orgId, &token)).To(Succeed())
+
+			Expect(storeInst.ServiceAccount().DeleteToken(ctx, orgId, "ci", "pipeline")).To(Succeed())
+			_, err := storeInst.ServiceAccount().UseToken(ctx, "hash-1", time.Now())
+			Expect(err).Should(MatchError(flterrors.ErrResourceNotFound))
+			Expect(storeInst.ServiceAccount().DeleteToken(ctx, orgId, "ci", "pipeline")).To(MatchError(flterrors.ErrResourceNotFound))
+		})
+
+		It("Delete serviceaccount revokes its tokens", func() {
+			token := model.ServiceAccountToken{ServiceAccountName: "ci", Name: "pipeline", TokenHash: "hash-1"}
+			Expect(storeInst.ServiceAccount().CreateToken(ctx, orgId, &token)).To(Succeed())
+
+			Expect(storeInst.ServiceAccount().Delete(ctx, orgId, "ci")).To(Succeed())
+			_, err := storeInst.ServiceAccount().UseToken(ctx, "hash-1", time.Now())
+			Expect(err).Should(MatchError(flterrors.ErrResourceNotFound))
+		})
+	})
+})
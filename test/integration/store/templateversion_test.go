@@ -138,7 +138,7 @@ var _ = Describe("TemplateVersion", func() {
 			err = testutil.CreateTestTemplateVersions(ctx, numResources, tvStore, otherOrgId, "myfleet")
 			Expect(err).ToNot(HaveOccurred())
 
-			callback := store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {})
+			callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {})
 			err = storeInst.Fleet().Delete(ctx, otherOrgId, callback, "myfleet")
 			Expect(err).ToNot(HaveOccurred())
 
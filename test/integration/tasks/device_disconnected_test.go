@@ -0,0 +1,115 @@
+package tasks_test
+
+import (
+	"context"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/tasks"
+	"github.com/flightctl/flightctl/internal/util"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	testutil "github.com/flightctl/flightctl/test/util"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("DeviceDisconnected", func() {
+	var (
+		log          *logrus.Logger
+		ctx          context.Context
+		orgId        uuid.UUID
+		otherOrgId   uuid.UUID
+		deviceStore  store.Device
+		fleetStore   store.Fleet
+		storeInst    store.Store
+		cfg          *config.Config
+		dbName       string
+		disconnected *tasks.DeviceDisconnected
+	)
+
+	setLastSeen := func(orgId uuid.UUID, name string, lastSeen time.Time) {
+		device, err := deviceStore.Get(ctx, orgId, name)
+		Expect(err).ToNot(HaveOccurred())
+		device.Status.LastSeen = lastSeen
+		device.Status.Summary.Status = api.DeviceSummaryStatusOnline
+		_, err = deviceStore.UpdateStatus(ctx, orgId, device)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getDevice := func(orgId uuid.UUID, name string) *api.Device {
+		device, err := deviceStore.Get(ctx, orgId, name)
+		Expect(err).ToNot(HaveOccurred())
+		return device
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		orgId, _ = uuid.NewUUID()
+		otherOrgId, _ = uuid.NewUUID()
+		log = flightlog.InitLogs()
+		storeInst, cfg, dbName, _ = store.PrepareDBForUnitTests(log)
+		deviceStore = storeInst.Device()
+		fleetStore = storeInst.Fleet()
+		disconnected = tasks.NewDeviceDisconnected(log, storeInst, 5*time.Minute)
+
+		fleet := api.Fleet{
+			Metadata: api.ObjectMeta{Name: util.StrToPtr("patient-fleet")},
+			Spec: api.FleetSpec{
+				Selector:                  &api.LabelSelector{MatchLabels: &map[string]string{"key": "patient"}},
+				DeviceDisconnectedTimeout: util.StrToPtr("1h"),
+			},
+		}
+		_, err := fleetStore.Create(ctx, orgId, &fleet, func(ctx context.Context, before *model.Fleet, after *model.Fleet) {})
+		Expect(err).ToNot(HaveOccurred())
+
+		testutil.CreateTestDevice(ctx, deviceStore, orgId, "recent", nil, nil, nil)
+		testutil.CreateTestDevice(ctx, deviceStore, orgId, "stale", nil, nil, nil)
+		testutil.CreateTestDevice(ctx, deviceStore, orgId, "patient", util.StrToPtr("Fleet/patient-fleet"), nil, nil)
+		testutil.CreateTestDevice(ctx, deviceStore, otherOrgId, "stale", nil, nil, nil)
+
+		setLastSeen(orgId, "recent", time.Now())
+		setLastSeen(orgId, "stale", time.Now().Add(-10*time.Minute))
+		setLastSeen(orgId, "patient", time.Now().Add(-10*time.Minute))
+		setLastSeen(otherOrgId, "stale", time.Now().Add(-10*time.Minute))
+	})
+
+	AfterEach(func() {
+		store.DeleteTestDB(log, cfg, storeInst, dbName)
+	})
+
+	It("sets devices that did not report to unknown according to their timeout", func() {
+		disconnected.Poll()
+
+		Expect(getDevice(orgId, "recent").Status.Summary.Status).To(Equal(api.DeviceSummaryStatusOnline))
+		Expect(getDevice(orgId, "patient").Status.Summary.Status).To(Equal(api.DeviceSummaryStatusOnline))
+
+		for _, org := range []uuid.UUID{orgId, otherOrgId} {
+			device := getDevice(org, "stale")
+			Expect(device.Status.Summary.Status).To(Equal(api.DeviceSummaryStatusUnknown))
+			Expect(api.IsStatusConditionTrue(device.Status.Conditions, api.DeviceDisconnected)).To(BeTrue())
+		}
+	})
+
+	It("clears the disconnected condition when the device reports again", func() {
+		disconnected.Poll()
+		Expect(api.IsStatusConditionTrue(getDevice(orgId, "stale").Status.Conditions, api.DeviceDisconnected)).To(BeTrue())
+
+		setLastSeen(orgId, "stale", time.Now())
+
+		device := getDevice(orgId, "stale")
+		Expect(device.Status.Summary.Status).To(Equal(api.DeviceSummaryStatusOnline))
+		condition := api.FindStatusCondition(device.Status.Conditions, api.DeviceDisconnected)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(api.ConditionStatusFalse))
+		Expect(condition.Reason).To(Equal(api.DeviceDisconnectedReasonReconnected))
+
+		// Polling again does not mark the device as disconnected
+		disconnected.Poll()
+		Expect(getDevice(orgId, "stale").Status.Summary.Status).To(Equal(api.DeviceSummaryStatusOnline))
+	})
+})
@@ -18,6 +18,7 @@ import (
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
@@ -58,11 +59,11 @@ var _ = Describe("FleetRollout", func() {
 		fleetStore = storeInst.Fleet()
 		tvStore = storeInst.TemplateVersion()
 		fleetName = "myfleet"
-		callback = func(before *model.Fleet, after *model.Fleet) {}
+		callback = func(ctx context.Context, before *model.Fleet, after *model.Fleet) {}
 		ctrl = gomock.NewController(GinkgoT())
 		mockPublisher = queues.NewMockPublisher(ctrl)
 		callbackManager = tasks.NewCallbackManager(mockPublisher, log)
-		mockPublisher.EXPECT().Publish(gomock.Any()).AnyTimes()
+		mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -139,6 +140,76 @@ var _ = Describe("FleetRollout", func() {
 			Expect((*dev.Metadata.Annotations)[model.DeviceAnnotationTemplateVersion]).To(Equal("1.0.0"))
 		})
 
+		It("a paused device is not rolled out until it is resumed", func() {
+			testutil.CreateTestFleet(ctx, fleetStore, orgId, fleetName, nil, nil)
+			err := testutil.CreateTestTemplateVersion(ctx, tvStore, orgId, fleetName, "1.0.0", "my first OS", true)
+			Expect(err).ToNot(HaveOccurred())
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "mydevice-1", util.StrToPtr("Fleet/myfleet"), nil,
+				&map[string]string{api.FleetControllerLabel: api.FleetControllerPaused})
+
+			logic := tasks.NewFleetRolloutsLogic(callbackManager, log, storeInst, tasks.ResourceReference{OrgID: orgId, Name: "mydevice-1"})
+			err = logic.RolloutDevice(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			dev, err := deviceStore.Get(ctx, orgId, "mydevice-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lo.FromPtr(dev.Metadata.Annotations)).ToNot(HaveKey(model.DeviceAnnotationTemplateVersion))
+			condition := api.FindStatusCondition(dev.Status.Conditions, api.DevicePaused)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(api.ConditionStatusTrue))
+			Expect(condition.Reason).To(Equal(api.DevicePausedReasonDevice))
+
+			// Resume the device
+			dev.Metadata.Labels = &map[string]string{}
+			_, err = deviceStore.Update(ctx, orgId, dev, nil, false, func(ctx context.Context, before *model.Device, after *model.Device) {})
+			Expect(err).ToNot(HaveOccurred())
+			err = logic.RolloutDevice(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			dev, err = deviceStore.Get(ctx, orgId, "mydevice-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect((*dev.Metadata.Annotations)[model.DeviceAnnotationTemplateVersion]).To(Equal("1.0.0"))
+			condition = api.FindStatusCondition(dev.Status.Conditions, api.DevicePaused)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(api.ConditionStatusFalse))
+			Expect(condition.Reason).To(Equal(api.DevicePausedReasonResumed))
+		})
+
+		It("the devices of a paused fleet are not rolled out until it is resumed", func() {
+			testutil.CreateTestFleet(ctx, fleetStore, orgId, fleetName, nil, nil)
+			err := testutil.CreateTestTemplateVersion(ctx, tvStore, orgId, fleetName, "1.0.0", "my first OS", true)
+			Expect(err).ToNot(HaveOccurred())
+			testutil.CreateTestDevices(ctx, numDevices, deviceStore, orgId, util.StrToPtr("Fleet/myfleet"), true)
+			fleet, err := fleetStore.Get(ctx, orgId, fleetName)
+			Expect(err).ToNot(HaveOccurred())
+			fleet.Metadata.Labels = &map[string]string{api.FleetControllerLabel: api.FleetControllerPaused}
+			_, _, err = fleetStore.CreateOrUpdate(ctx, orgId, fleet, callback)
+			Expect(err).ToNot(HaveOccurred())
+
+			logic := tasks.NewFleetRolloutsLogic(callbackManager, log, storeInst, tasks.ResourceReference{OrgID: orgId, Name: fleetName})
+			err = logic.RolloutFleet(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			for i := 1; i <= numDevices; i++ {
+				dev, err := deviceStore.Get(ctx, orgId, fmt.Sprintf("mydevice-%d", i))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lo.FromPtr(dev.Metadata.Annotations)).ToNot(HaveKey(model.DeviceAnnotationTemplateVersion))
+				condition := api.FindStatusCondition(dev.Status.Conditions, api.DevicePaused)
+				Expect(condition).ToNot(BeNil())
+				Expect(condition.Reason).To(Equal(api.DevicePausedReasonFleet))
+			}
+
+			// Resume the fleet
+			fleet.Metadata.Labels = &map[string]string{}
+			_, _, err = fleetStore.CreateOrUpdate(ctx, orgId, fleet, callback)
+			Expect(err).ToNot(HaveOccurred())
+			err = logic.RolloutFleet(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			for i := 1; i <= numDevices; i++ {
+				dev, err := deviceStore.Get(ctx, orgId, fmt.Sprintf("mydevice-%d", i))
+				Expect(err).ToNot(HaveOccurred())
+				Expect((*dev.Metadata.Annotations)[model.DeviceAnnotationTemplateVersion]).To(Equal("1.0.0"))
+				Expect(api.IsStatusConditionFalse(dev.Status.Conditions, api.DevicePaused)).To(BeTrue())
+			}
+		})
+
 		When("the fleet is valid and contains parameters", func() {
 			var (
 				gitConfig    *api.GitConfigProviderSpec
@@ -190,7 +261,7 @@ var _ = Describe("FleetRollout", func() {
 				err = httpItem.FromHttpConfigProviderSpec(*httpConfig)
 				Expect(err).ToNot(HaveOccurred())
 				tv.Status.Config = &[]api.ConfigProviderSpec{gitItem, inlineItem, httpItem}
-				tvCallback := store.TemplateVersionStoreCallback(func(tv *model.TemplateVersion) {})
+				tvCallback := store.TemplateVersionStoreCallback(func(ctx context.Context, tv *model.TemplateVersion) {})
 				err = storeInst.TemplateVersion().UpdateStatus(ctx, orgId, tv, util.BoolToPtr(true), tvCallback)
 				Expect(err).ToNot(HaveOccurred())
 
@@ -261,7 +332,7 @@ var _ = Describe("FleetRollout", func() {
 				err = httpItem.FromHttpConfigProviderSpec(*httpConfig)
 				Expect(err).ToNot(HaveOccurred())
 				tv.Status.Config = &[]api.ConfigProviderSpec{gitItem, inlineItem}
-				tvCallback := store.TemplateVersionStoreCallback(func(tv *model.TemplateVersion) {})
+				tvCallback := store.TemplateVersionStoreCallback(func(ctx context.Context, tv *model.TemplateVersion) {})
 				err = storeInst.TemplateVersion().UpdateStatus(ctx, orgId, tv, util.BoolToPtr(true), tvCallback)
 				Expect(err).ToNot(HaveOccurred())
 
@@ -302,6 +373,41 @@ var _ = Describe("FleetRollout", func() {
 					}
 				}
 			})
+
+			It("only includes the config items whose condition is true", func() {
+				testutil.CreateTestFleet(ctx, fleetStore, orgId, fleetName, nil, nil)
+				err := testutil.CreateTestTemplateVersion(ctx, tvStore, orgId, fleetName, "1.0", "myOS", true)
+				Expect(err).ToNot(HaveOccurred())
+
+				tv, err := storeInst.TemplateVersion().Get(ctx, orgId, fleetName, "1.0")
+				Expect(err).ToNot(HaveOccurred())
+				gitConfig.When = util.StrToPtr(`{{ eq (label "key") "some-value" }}`)
+				gitItem := api.ConfigProviderSpec{}
+				err = gitItem.FromGitConfigProviderSpec(*gitConfig)
+				Expect(err).ToNot(HaveOccurred())
+				httpConfig.When = util.StrToPtr(`{{ eq (label "key") "other-value" }}`)
+				httpItem := api.ConfigProviderSpec{}
+				err = httpItem.FromHttpConfigProviderSpec(*httpConfig)
+				Expect(err).ToNot(HaveOccurred())
+				tv.Status.Config = &[]api.ConfigProviderSpec{gitItem, httpItem}
+				tvCallback := store.TemplateVersionStoreCallback(func(ctx context.Context, tv *model.TemplateVersion) {})
+				err = storeInst.TemplateVersion().UpdateStatus(ctx, orgId, tv, util.BoolToPtr(true), tvCallback)
+				Expect(err).ToNot(HaveOccurred())
+
+				labels := map[string]string{"key": "some-value"}
+				testutil.CreateTestDevice(ctx, deviceStore, orgId, "mydevice-1", util.StrToPtr("Fleet/myfleet"), nil, &labels)
+
+				logic := tasks.NewFleetRolloutsLogic(callbackManager, log, storeInst, tasks.ResourceReference{OrgID: orgId, Name: "mydevice-1"})
+				err = logic.RolloutDevice(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				dev, err := deviceStore.Get(ctx, orgId, "mydevice-1")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(*dev.Spec.Config).To(HaveLen(1))
+				gitSpec, err := (*dev.Spec.Config)[0].AsGitConfigProviderSpec()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(gitSpec.GitRef.Path).To(Equal("path-some-value"))
+				Expect(gitSpec.When).To(BeNil())
+			})
 		})
 	})
 
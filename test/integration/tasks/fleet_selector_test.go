@@ -42,7 +42,7 @@ var _ = Describe("FleetSelector", func() {
 		fleetStore = storeInst.Fleet()
 		ctrl := gomock.NewController(GinkgoT())
 		publisher := queues.NewMockPublisher(ctrl)
-		publisher.EXPECT().Publish(gomock.Any()).Return(nil).AnyTimes()
+		publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		callbackManager = tasks.NewCallbackManager(publisher, log)
 		logic = tasks.NewFleetSelectorMatchingLogic(callbackManager, log, storeInst, tasks.ResourceReference{OrgID: orgId, Name: "fleet", Kind: model.FleetKind})
 		logic.SetItemsPerPage(2)
@@ -342,5 +342,231 @@ var _ = Describe("FleetSelector", func() {
 				Expect(api.IsStatusConditionTrue(device.Status.Conditions, api.DeviceMultipleOwners)).To(BeFalse())
 			}
 		})
+
+		It("Fleet selector with match expressions updated no overlap", func() {
+			testutil.CreateTestFleetWithSelector(ctx, fleetStore, orgId, "fleet", &api.LabelSelector{
+				MatchLabels: &map[string]string{"site": "berlin"},
+				MatchExpressions: &api.MatchExpressions{
+					{Key: "env", Operator: api.In, Values: &[]string{"prod", "staging"}},
+					{Key: "canary", Operator: api.DoesNotExist},
+				},
+			}, nil)
+
+			// Matches the labels and expressions
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "match", nil, nil, &map[string]string{"site": "berlin", "env": "prod"})
+			// Matches the labels but not the In expression
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "wrong-env", nil, nil, &map[string]string{"site": "berlin", "env": "dev"})
+			// Matches the labels but not the DoesNotExist expression
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "canary", nil, nil, &map[string]string{"site": "berlin", "env": "prod", "canary": "true"})
+			// Owned by the fleet, but only matches the expressions
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "fleet-to-none", util.StrToPtr("Fleet/fleet"), nil, &map[string]string{"site": "paris", "env": "staging"})
+			// Owned by the fleet, and still matches
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "stay-in-fleet", util.StrToPtr("Fleet/fleet"), nil, &map[string]string{"site": "berlin", "env": "staging"})
+
+			err := logic.FleetSelectorUpdatedNoOverlapping(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			devices, err := deviceStore.List(ctx, orgId, store.ListParams{Limit: 0})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(devices.Items)).To(Equal(5))
+			for _, device := range devices.Items {
+				switch *device.Metadata.Name {
+				case "match", "stay-in-fleet":
+					Expect(*device.Metadata.Owner).To(Equal("Fleet/fleet"))
+				default:
+					Expect(device.Metadata.Owner).To(BeNil())
+				}
+			}
+		})
+
+		It("Fleet selector with only match expressions", func() {
+			testutil.CreateTestFleetWithSelector(ctx, fleetStore, orgId, "fleet", &api.LabelSelector{
+				MatchExpressions: &api.MatchExpressions{
+					{Key: "env", Operator: api.Exists},
+					{Key: "env", Operator: api.NotIn, Values: &[]string{"dev"}},
+				},
+			}, nil)
+
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "prod", nil, nil, &map[string]string{"env": "prod"})
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "dev", util.StrToPtr("Fleet/fleet"), nil, &map[string]string{"env": "dev"})
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "no-env", util.StrToPtr("Fleet/fleet"), nil, &map[string]string{"site": "berlin"})
+
+			err := logic.FleetSelectorUpdatedNoOverlapping(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			device, err := deviceStore.Get(ctx, orgId, "prod")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*device.Metadata.Owner).To(Equal("Fleet/fleet"))
+			device, err = deviceStore.Get(ctx, orgId, "dev")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(device.Metadata.Owner).To(BeNil())
+			device, err = deviceStore.Get(ctx, orgId, "no-env")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(device.Metadata.Owner).To(BeNil())
+		})
+
+		It("Fleet selectors mixing labels and expressions updated with overlap", func() {
+			testutil.CreateTestFleetWithSelector(ctx, fleetStore, orgId, "labels", &api.LabelSelector{
+				MatchLabels: &map[string]string{"site": "berlin"},
+			}, nil)
+			testutil.CreateTestFleetWithSelector(ctx, fleetStore, orgId, "expressions", &api.LabelSelector{
+				MatchExpressions: &api.MatchExpressions{
+					{Key: "site", Operator: api.In, Values: &[]string{"berlin", "paris"}},
+					{Key: "env", Operator: api.In, Values: &[]string{"prod"}},
+				},
+			}, nil)
+			testutil.CreateTestFleetWithSelector(ctx, fleetStore, orgId, "mixed", &api.LabelSelector{
+				MatchLabels:      &map[string]string{"site": "paris"},
+				MatchExpressions: &api.MatchExpressions{{Key: "env", Operator: api.NotIn, Values: &[]string{"prod"}}},
+			}, nil)
+
+			// Only matches "labels"
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "berlin-dev", util.StrToPtr("Fleet/expressions"), nil, &map[string]string{"site": "berlin", "env": "dev"})
+			// Matches "labels" and "expressions"
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "berlin-prod", nil, nil, &map[string]string{"site": "berlin", "env": "prod"})
+			// Only matches "expressions"
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "paris-prod", util.StrToPtr("Fleet/mixed"), nil, &map[string]string{"site": "paris", "env": "prod"})
+			// Only matches "mixed", as NotIn matches devices without the label
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "paris", nil, nil, &map[string]string{"site": "paris"})
+			// Matches no fleet
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "rome-prod", util.StrToPtr("Fleet/labels"), nil, &map[string]string{"site": "rome", "env": "prod"})
+
+			err := logic.HandleOrgwideUpdate(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			devices, err := deviceStore.List(ctx, orgId, store.ListParams{Limit: 0})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(devices.Items)).To(Equal(5))
+			for _, device := range devices.Items {
+				multipleOwners := api.IsStatusConditionTrue(device.Status.Conditions, api.DeviceMultipleOwners)
+				switch *device.Metadata.Name {
+				case "berlin-dev":
+					Expect(*device.Metadata.Owner).To(Equal("Fleet/labels"))
+					Expect(multipleOwners).To(BeFalse())
+				case "berlin-prod":
+					Expect(device.Metadata.Owner).To(BeNil())
+					Expect(multipleOwners).To(BeTrue())
+				case "paris-prod":
+					Expect(*device.Metadata.Owner).To(Equal("Fleet/expressions"))
+					Expect(multipleOwners).To(BeFalse())
+				case "paris":
+					Expect(*device.Metadata.Owner).To(Equal("Fleet/mixed"))
+					Expect(multipleOwners).To(BeFalse())
+				case "rome-prod":
+					Expect(device.Metadata.Owner).To(BeNil())
+					Expect(multipleOwners).To(BeFalse())
+				}
+			}
+
+			fleets, err := fleetStore.List(ctx, orgId, store.ListParams{Limit: 0})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(fleets.Items)).To(Equal(3))
+			for _, fleet := range fleets.Items {
+				overlapping := api.IsStatusConditionTrue(fleet.Status.Conditions, api.FleetOverlappingSelectors)
+				Expect(overlapping).To(Equal(*fleet.Metadata.Name != "mixed"))
+			}
+		})
+
+		It("Device labels updated with match expressions", func() {
+			testutil.CreateTestFleetWithSelector(ctx, fleetStore, orgId, "prod", &api.LabelSelector{
+				MatchExpressions: &api.MatchExpressions{{Key: "env", Operator: api.In, Values: &[]string{"prod"}}},
+			}, nil)
+			testutil.CreateTestFleetWithSelector(ctx, fleetStore, orgId, "unlabeled", &api.LabelSelector{
+				MatchExpressions: &api.MatchExpressions{{Key: "env", Operator: api.DoesNotExist}},
+			}, nil)
+
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "to-prod", util.StrToPtr("Fleet/unlabeled"), nil, &map[string]string{"env": "prod"})
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "no-labels", util.StrToPtr("Fleet/prod"), nil, &map[string]string{})
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "dev", util.StrToPtr("Fleet/prod"), nil, &map[string]string{"env": "dev"})
+
+			expectedOwners := map[string]*string{
+				"to-prod":   util.StrToPtr("Fleet/prod"),
+				"no-labels": util.StrToPtr("Fleet/unlabeled"),
+				"dev":       nil,
+			}
+			for name, owner := range expectedOwners {
+				resourceRef := tasks.ResourceReference{OrgID: orgId, Name: name, Kind: model.DeviceKind}
+				logic = tasks.NewFleetSelectorMatchingLogic(callbackManager, log, storeInst, resourceRef)
+				err := logic.CompareFleetsAndSetDeviceOwner(ctx)
+				Expect(err).ToNot(HaveOccurred())
+
+				device, err := deviceStore.Get(ctx, orgId, name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(device.Metadata.Owner).To(Equal(owner), name)
+			}
+		})
+
+		It("Fleet priority resolves overlapping selectors", func() {
+			testutil.CreateTestFleetWithPriority(ctx, fleetStore, orgId, "site", &map[string]string{"site": "berlin"}, 0)
+			testutil.CreateTestFleetWithPriority(ctx, fleetStore, orgId, "prod", &map[string]string{"env": "prod"}, 10)
+			testutil.CreateTestFleetWithPriority(ctx, fleetStore, orgId, "canary", &map[string]string{"ring": "canary"}, 10)
+
+			// Only matches "site"
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "berlin", nil, nil, &map[string]string{"site": "berlin"})
+			// Matches "site" and "prod", which has the higher priority
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "berlin-prod", util.StrToPtr("Fleet/site"), nil, &map[string]string{"site": "berlin", "env": "prod"})
+			// Matches all fleets, "prod" and "canary" share the highest priority
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "berlin-prod-canary", nil, nil, &map[string]string{"site": "berlin", "env": "prod", "ring": "canary"})
+
+			err := logic.HandleOrgwideUpdate(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			devices, err := deviceStore.List(ctx, orgId, store.ListParams{Limit: 0})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(devices.Items)).To(Equal(3))
+			for _, device := range devices.Items {
+				multipleOwners := api.IsStatusConditionTrue(device.Status.Conditions, api.DeviceMultipleOwners)
+				switch *device.Metadata.Name {
+				case "berlin":
+					Expect(*device.Metadata.Owner).To(Equal("Fleet/site"))
+					Expect(multipleOwners).To(BeFalse())
+				case "berlin-prod":
+					Expect(*device.Metadata.Owner).To(Equal("Fleet/prod"))
+					Expect(multipleOwners).To(BeFalse())
+				case "berlin-prod-canary":
+					Expect(device.Metadata.Owner).To(BeNil())
+					Expect(multipleOwners).To(BeTrue())
+					condition := api.FindStatusCondition(device.Status.Conditions, api.DeviceMultipleOwners)
+					Expect(condition.Message).To(Equal("canary,prod"))
+				}
+			}
+
+			fleets, err := fleetStore.List(ctx, orgId, store.ListParams{Limit: 0})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(fleets.Items)).To(Equal(3))
+			for _, fleet := range fleets.Items {
+				overlapping := api.IsStatusConditionTrue(fleet.Status.Conditions, api.FleetOverlappingSelectors)
+				Expect(overlapping).To(Equal(*fleet.Metadata.Name != "site"), *fleet.Metadata.Name)
+			}
+		})
+
+		It("Fleet selector updated with higher priority than the owner", func() {
+			testutil.CreateTestFleetWithPriority(ctx, fleetStore, orgId, "fleet", &map[string]string{"key": "value"}, 10)
+			testutil.CreateTestFleetWithPriority(ctx, fleetStore, orgId, "lower", &map[string]string{"key": "value"}, 0)
+			testutil.CreateTestFleetWithPriority(ctx, fleetStore, orgId, "higher", &map[string]string{"other": "value"}, 20)
+
+			// Owned by a fleet with a lower priority, should now belong to "fleet"
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "from-lower", util.StrToPtr("Fleet/lower"), nil, &map[string]string{"key": "value"})
+			// Owned by a fleet with a higher priority, should stay there
+			testutil.CreateTestDevice(ctx, deviceStore, orgId, "from-higher", util.StrToPtr("Fleet/higher"), nil, &map[string]string{"key": "value", "other": "value"})
+
+			err := logic.FleetSelectorUpdatedNoOverlapping(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			device, err := deviceStore.Get(ctx, orgId, "from-lower")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*device.Metadata.Owner).To(Equal("Fleet/fleet"))
+			Expect(api.IsStatusConditionTrue(device.Status.Conditions, api.DeviceMultipleOwners)).To(BeFalse())
+			device, err = deviceStore.Get(ctx, orgId, "from-higher")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*device.Metadata.Owner).To(Equal("Fleet/higher"))
+			Expect(api.IsStatusConditionTrue(device.Status.Conditions, api.DeviceMultipleOwners)).To(BeFalse())
+
+			fleets, err := fleetStore.List(ctx, orgId, store.ListParams{Limit: 0})
+			Expect(err).ToNot(HaveOccurred())
+			for _, fleet := range fleets.Items {
+				Expect(api.IsStatusConditionTrue(fleet.Status.Conditions, api.FleetOverlappingSelectors)).To(BeFalse())
+			}
+		})
 	})
 })
@@ -48,7 +48,7 @@ var _ = Describe("FleetValidate", func() {
 		storeInst, cfg, dbName, _ = store.PrepareDBForUnitTests(log)
 		ctrl := gomock.NewController(GinkgoT())
 		publisher := queues.NewMockPublisher(ctrl)
-		publisher.EXPECT().Publish(gomock.Any()).Return(nil).AnyTimes()
+		publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		callbackManager = tasks.NewCallbackManager(publisher, log)
 
 		spec := api.RepositorySpec{}
@@ -76,7 +76,7 @@ var _ = Describe("FleetValidate", func() {
 			Spec: specHttp,
 		}
 
-		repoCallback := store.RepositoryStoreCallback(func(*model.Repository) {})
+		repoCallback := store.RepositoryStoreCallback(func(context.Context, *model.Repository) {})
 		_, err = storeInst.Repository().Create(ctx, orgId, repository, repoCallback)
 		Expect(err).ToNot(HaveOccurred())
 		_, err = storeInst.Repository().Create(ctx, orgId, repositoryHttp, repoCallback)
@@ -134,7 +134,7 @@ var _ = Describe("FleetValidate", func() {
 		badHttpConfig.HttpRef.FilePath = "http-path"
 		badHttpConfig.HttpRef.Suffix = util.StrToPtr("/suffix")
 
-		callback = store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {})
+		callback = store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {})
 	})
 
 	AfterEach(func() {
@@ -338,6 +338,7 @@ var _ = Describe("FleetValidate", func() {
 			Expect(fleet.Status.Conditions).To(HaveLen(1))
 			Expect(fleet.Status.Conditions[0].Type).To(Equal(api.FleetValid))
 			Expect(fleet.Status.Conditions[0].Status).To(Equal(api.ConditionStatusFalse))
+			Expect(fleet.Status.Conditions[0].Message).To(ContainSubstring("spec.template.spec.config[0].gitRef.path:1"))
 
 			repos, err := storeInst.Fleet().GetRepositoryRefs(ctx, orgId, "myfleet")
 			Expect(err).ToNot(HaveOccurred())
@@ -349,6 +350,39 @@ var _ = Describe("FleetValidate", func() {
 		})
 	})
 
+	When("a Fleet has an invalid configuration and an invalid parameter", func() {
+		It("reports both errors in the Condition", func() {
+			resourceRef := tasks.ResourceReference{OrgID: orgId, Name: "myfleet", Kind: model.FleetKind}
+			logic := tasks.NewFleetValidateLogic(callbackManager, log, storeInst, nil, resourceRef)
+
+			gitItem := api.ConfigProviderSpec{}
+			err := gitItem.FromGitConfigProviderSpec(*badGitConfig)
+			Expect(err).ToNot(HaveOccurred())
+
+			httpItem := api.ConfigProviderSpec{}
+			// Set a parameter that we don't support
+			goodHttpConfig.HttpRef.FilePath = "http-path-{{ device.metadata.owner }}"
+			err = httpItem.FromHttpConfigProviderSpec(*goodHttpConfig)
+			Expect(err).ToNot(HaveOccurred())
+
+			fleet.Spec.Template.Spec.Config = &[]api.ConfigProviderSpec{gitItem, httpItem}
+
+			_, err = storeInst.Fleet().Create(ctx, orgId, fleet, callback)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = logic.CreateNewTemplateVersionIfFleetValid(ctx)
+			Expect(err).To(HaveOccurred())
+
+			fleet, err = storeInst.Fleet().Get(ctx, orgId, "myfleet")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fleet.Status.Conditions).To(HaveLen(1))
+			Expect(fleet.Status.Conditions[0].Status).To(Equal(api.ConditionStatusFalse))
+			Expect(fleet.Status.Conditions[0].Message).To(ContainSubstring("failed fetching specified Repository definition"))
+			Expect(fleet.Status.Conditions[0].Message).To(ContainSubstring("spec.template.spec.config[1].httpRef.filePath:1"))
+		})
+	})
+
 	When("a Fleet has an invalid configuration type", func() {
 		It("sets an error Condition", func() {
 			resourceRef := tasks.ResourceReference{OrgID: orgId, Name: "myfleet", Kind: model.FleetKind}
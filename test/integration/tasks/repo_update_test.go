@@ -128,7 +128,7 @@ var _ = Describe("RepoUpdate", func() {
 		}
 		fleet2.Spec.Template.Spec = api.DeviceSpec{Config: &config2}
 
-		fleetCallback := store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {})
+		fleetCallback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {})
 		_, err = storeInst.Fleet().Create(ctx, orgId, &fleet1, fleetCallback)
 		Expect(err).ToNot(HaveOccurred())
 		err = storeInst.Fleet().OverwriteRepositoryRefs(ctx, orgId, "fleet1", "myrepository-1")
@@ -153,7 +153,7 @@ var _ = Describe("RepoUpdate", func() {
 			},
 		}
 
-		devCallback := store.DeviceStoreCallback(func(before *model.Device, after *model.Device) {})
+		devCallback := store.DeviceStoreCallback(func(ctx context.Context, before *model.Device, after *model.Device) {})
 		_, err = storeInst.Device().Create(ctx, orgId, &device1, devCallback)
 		Expect(err).ToNot(HaveOccurred())
 		err = storeInst.Device().OverwriteRepositoryRefs(ctx, orgId, "device1", "myrepository-1")
@@ -173,8 +173,8 @@ var _ = Describe("RepoUpdate", func() {
 		It("refreshes relevant fleets and devices", func() {
 			resourceRef := tasks.ResourceReference{OrgID: orgId, Name: "myrepository-1", Kind: model.RepositoryKind}
 			logic := tasks.NewRepositoryUpdateLogic(callbackManager, log, storeInst, resourceRef)
-			mockPublisher.EXPECT().Publish(newResourceReferenceMatcher(tasks.FleetValidateTask, "fleet1")).Times(1)
-			mockPublisher.EXPECT().Publish(newResourceReferenceMatcher(tasks.DeviceRenderTask, "device1")).Times(1)
+			mockPublisher.EXPECT().Publish(gomock.Any(), newResourceReferenceMatcher(tasks.FleetValidateTask, "fleet1")).Times(1)
+			mockPublisher.EXPECT().Publish(gomock.Any(), newResourceReferenceMatcher(tasks.DeviceRenderTask, "device1")).Times(1)
 			err := logic.HandleRepositoryUpdate(ctx)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -185,8 +185,8 @@ var _ = Describe("RepoUpdate", func() {
 		It("refreshes relevant fleets and devices", func() {
 			resourceRef := tasks.ResourceReference{OrgID: orgId, Kind: model.RepositoryKind}
 			logic := tasks.NewRepositoryUpdateLogic(callbackManager, log, storeInst, resourceRef)
-			mockPublisher.EXPECT().Publish(newResourceReferenceMatcher(tasks.FleetValidateTask, "")).Times(2)
-			mockPublisher.EXPECT().Publish(newResourceReferenceMatcher(tasks.DeviceRenderTask, "")).Times(2)
+			mockPublisher.EXPECT().Publish(gomock.Any(), newResourceReferenceMatcher(tasks.FleetValidateTask, "")).Times(2)
+			mockPublisher.EXPECT().Publish(gomock.Any(), newResourceReferenceMatcher(tasks.DeviceRenderTask, "")).Times(2)
 			err := logic.HandleAllRepositoriesDeleted(ctx, log)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -42,9 +42,9 @@ var _ = Describe("TVPopulate", func() {
 		storeInst, cfg, dbName, _ = store.PrepareDBForUnitTests(log)
 		ctrl = gomock.NewController(GinkgoT())
 		publisher = queues.NewMockPublisher(ctrl)
-		publisher.EXPECT().Publish(gomock.Any()).AnyTimes()
+		publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).AnyTimes()
 		callbackManager = tasks.NewCallbackManager(publisher, log)
-		fleetCallback = func(before *model.Fleet, after *model.Fleet) {}
+		fleetCallback = func(ctx context.Context, before *model.Fleet, after *model.Fleet) {}
 
 		fleet = &api.Fleet{
 			Metadata: api.ObjectMeta{Name: util.StrToPtr("fleet")},
@@ -61,7 +61,7 @@ var _ = Describe("TVPopulate", func() {
 			},
 			Spec: api.TemplateVersionSpec{Fleet: *fleet.Metadata.Name},
 		}
-		tvCallback := store.TemplateVersionStoreCallback(func(tv *model.TemplateVersion) {})
+		tvCallback := store.TemplateVersionStoreCallback(func(ctx context.Context, tv *model.TemplateVersion) {})
 		_, err = storeInst.TemplateVersion().Create(ctx, orgId, tv, tvCallback)
 		Expect(err).ToNot(HaveOccurred())
 	})
@@ -0,0 +1,203 @@
+package tasks_test
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	agentconfig "github.com/flightctl/flightctl/internal/agent/device/config"
+	"github.com/flightctl/flightctl/internal/config"
+	fcrypto "github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/tasks"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/ignition"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/flightctl/flightctl/test/util/vault"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"github.com/vincent-petithory/dataurl"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Vault secret provider", func() {
+	var (
+		log             *logrus.Logger
+		ctx             context.Context
+		orgId           uuid.UUID
+		storeInst       store.Store
+		cfg             *config.Config
+		dbName          string
+		callbackManager tasks.CallbackManager
+		ctrl            *gomock.Controller
+		mockPublisher   *queues.MockPublisher
+		server          *vault.FakeServer
+		privateKeys     map[string]crypto.PrivateKey
+	)
+
+	enrollDevice := func(name string) {
+		_, privateKey, err := fcrypto.NewKeyPair()
+		Expect(err).ToNot(HaveOccurred())
+		csr, err := fcrypto.MakeCSR(privateKey.(crypto.Signer), name)
+		Expect(err).ToNot(HaveOccurred())
+		enrollmentRequest := api.EnrollmentRequest{
+			Metadata: api.ObjectMeta{Name: util.StrToPtr(name)},
+			Spec:     api.EnrollmentRequestSpec{Csr: string(csr)},
+		}
+		_, err = storeInst.EnrollmentRequest().Create(ctx, orgId, &enrollmentRequest)
+		Expect(err).ToNot(HaveOccurred())
+		privateKeys[name] = privateKey
+	}
+
+	createDevice := func(name string, version *int) {
+		enrollDevice(name)
+
+		vaultConfig := api.VaultSecretProviderSpec{Name: "wifi"}
+		vaultConfig.VaultRef.Repository = "vault"
+		vaultConfig.VaultRef.Path = "edge/wifi"
+		vaultConfig.VaultRef.Version = version
+		vaultConfig.VaultRef.Files = []api.VaultSecretFile{
+			{Key: "psk", Path: "/etc/wifi/psk", User: util.StrToPtr("nm")},
+		}
+		vaultItem := api.ConfigProviderSpec{}
+		Expect(vaultItem.FromVaultSecretProviderSpec(vaultConfig)).To(Succeed())
+
+		device := api.Device{
+			Metadata: api.ObjectMeta{Name: util.StrToPtr(name)},
+			Spec:     &api.DeviceSpec{Config: &[]api.ConfigProviderSpec{vaultItem}},
+		}
+		_, err := storeInst.Device().Create(ctx, orgId, &device, func(ctx context.Context, before *model.Device, after *model.Device) {})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	renderDevice := func(name string) {
+		resourceRef := tasks.ResourceReference{OrgID: orgId, Name: name, Kind: model.DeviceKind}
+		logic := tasks.NewDeviceRenderLogic(callbackManager, log, storeInst, nil, resourceRef)
+		Expect(logic.RenderDevice(ctx)).To(Succeed())
+	}
+
+	// renderedSecret returns the decrypted contents of a file of the rendered config
+	renderedSecret := func(name string, path string) string {
+		rendered, err := storeInst.Device().GetRendered(ctx, orgId, name, nil, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rendered.Config).ToNot(BeNil())
+		ignitionConfig, err := agentconfig.ParseAndConvertConfigFromStr(*rendered.Config)
+		Expect(err).ToNot(HaveOccurred())
+		for _, file := range ignitionConfig.Storage.Files {
+			if file.Path != path {
+				continue
+			}
+			source, err := dataurl.DecodeString(*file.Contents.Source)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(source.MediaType.ContentType()).To(Equal(ignition.DeviceSecretMediaType))
+			plaintext, err := fcrypto.DecryptDeviceSecret(privateKeys[name], source.Data)
+			Expect(err).ToNot(HaveOccurred())
+			return string(plaintext)
+		}
+		Fail("file " + path + " not found in rendered config")
+		return ""
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		orgId, _ = uuid.NewUUID()
+		log = flightlog.InitLogs()
+		storeInst, cfg, dbName, _ = store.PrepareDBForUnitTests(log)
+		ctrl = gomock.NewController(GinkgoT())
+		mockPublisher = queues.NewMockPublisher(ctrl)
+		callbackManager = tasks.NewCallbackManager(mockPublisher, log)
+		privateKeys = map[string]crypto.PrivateKey{}
+
+		server = vault.NewFakeServer()
+		server.WriteSecret("edge/wifi", map[string]interface{}{"psk": "first-psk"})
+
+		spec := api.RepositorySpec{}
+		err := spec.FromVaultRepoSpec(api.VaultRepoSpec{
+			Url:  server.URL,
+			Type: api.Vault,
+			VaultConfig: api.VaultConfig{
+				AppRole: &api.VaultAppRoleAuth{RoleId: vault.RoleID, SecretId: vault.SecretID},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		repository := api.Repository{Metadata: api.ObjectMeta{Name: util.StrToPtr("vault")}, Spec: spec}
+		_, err = storeInst.Repository().Create(ctx, orgId, &repository, func(context.Context, *model.Repository) {})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		ctrl.Finish()
+		store.DeleteTestDB(log, cfg, storeInst, dbName)
+	})
+
+	When("a device references a Vault secret", func() {
+		It("renders the keys of the secret as files and records the secret version", func() {
+			createDevice("device", nil)
+			renderDevice("device")
+
+			rendered, err := storeInst.Device().GetRendered(ctx, orgId, "device", nil, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rendered.Config).ToNot(BeNil())
+			Expect(*rendered.Config).To(ContainSubstring(`"nm"`))
+			Expect(renderedSecret("device", "/etc/wifi/psk")).To(Equal("first-psk"))
+
+			device, err := storeInst.Device().Get(ctx, orgId, "device")
+			Expect(err).ToNot(HaveOccurred())
+			versions := map[string]int{}
+			Expect(json.Unmarshal([]byte((*device.Metadata.Annotations)[model.DeviceAnnotationRenderedSecretVersions]), &versions)).To(Succeed())
+			Expect(versions).To(Equal(map[string]int{"vault/edge/wifi": 1}))
+		})
+
+		It("fails to render a key missing from the secret", func() {
+			server.WriteSecret("edge/wifi", map[string]interface{}{"password": "second-psk"})
+			createDevice("device", nil)
+
+			resourceRef := tasks.ResourceReference{OrgID: orgId, Name: "device", Kind: model.DeviceKind}
+			logic := tasks.NewDeviceRenderLogic(callbackManager, log, storeInst, nil, resourceRef)
+			err := logic.RenderDevice(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`key "psk" not found`))
+		})
+
+		It("fails to render the secret for a device that was not enrolled", func() {
+			createDevice("device", nil)
+			Expect(storeInst.EnrollmentRequest().Delete(ctx, orgId, "device")).To(Succeed())
+
+			resourceRef := tasks.ResourceReference{OrgID: orgId, Name: "device", Kind: model.DeviceKind}
+			logic := tasks.NewDeviceRenderLogic(callbackManager, log, storeInst, nil, resourceRef)
+			err := logic.RenderDevice(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("secrets can only be rendered for enrolled devices"))
+		})
+	})
+
+	When("a new version of a Vault secret is written", func() {
+		It("renders the devices using its latest version again", func() {
+			createDevice("latest", nil)
+			createDevice("pinned", util.IntToPtr(1))
+			renderDevice("latest")
+			renderDevice("pinned")
+
+			watcher := tasks.NewVaultSecretWatcher(callbackManager, storeInst, log)
+
+			// nothing changed since the devices were rendered
+			watcher.Poll()
+
+			server.WriteSecret("edge/wifi", map[string]interface{}{"psk": "second-psk"})
+			mockPublisher.EXPECT().Publish(gomock.Any(), newResourceReferenceMatcher(tasks.DeviceRenderTask, "latest")).Times(1)
+			watcher.Poll()
+
+			renderDevice("latest")
+			Expect(renderedSecret("latest", "/etc/wifi/psk")).To(Equal("second-psk"))
+
+			// the device is up to date again
+			watcher.Poll()
+		})
+	})
+})
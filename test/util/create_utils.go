@@ -74,7 +74,7 @@ func ReturnTestDevice(orgId uuid.UUID, name string, owner *string, tv *string, l
 
 func CreateTestDevice(ctx context.Context, deviceStore store.Device, orgId uuid.UUID, name string, owner *string, tv *string, labels *map[string]string) {
 	resource := ReturnTestDevice(orgId, name, owner, tv, labels)
-	callback := store.DeviceStoreCallback(func(before *model.Device, after *model.Device) {})
+	callback := store.DeviceStoreCallback(func(ctx context.Context, before *model.Device, after *model.Device) {})
 	_, _, err := deviceStore.CreateOrUpdate(ctx, orgId, &resource, nil, false, callback)
 	if err != nil {
 		log.Fatalf("creating device: %v", err)
@@ -110,7 +110,43 @@ func CreateTestFleet(ctx context.Context, fleetStore store.Fleet, orgId uuid.UUI
 	if selector != nil {
 		resource.Spec.Selector = &api.LabelSelector{MatchLabels: selector}
 	}
-	callback := store.FleetStoreCallback(func(before *model.Fleet, after *model.Fleet) {})
+	callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {})
+	_, err := fleetStore.Create(ctx, orgId, &resource, callback)
+	if err != nil {
+		log.Fatalf("creating fleet: %v", err)
+	}
+}
+
+func CreateTestFleetWithSelector(ctx context.Context, fleetStore store.Fleet, orgId uuid.UUID, name string, selector *api.LabelSelector, owner *string) {
+	resource := api.Fleet{
+		Metadata: api.ObjectMeta{
+			Name:  &name,
+			Owner: owner,
+		},
+		Spec: api.FleetSpec{
+			Selector: selector,
+		},
+	}
+
+	callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {})
+	_, err := fleetStore.Create(ctx, orgId, &resource, callback)
+	if err != nil {
+		log.Fatalf("creating fleet: %v", err)
+	}
+}
+
+func CreateTestFleetWithPriority(ctx context.Context, fleetStore store.Fleet, orgId uuid.UUID, name string, selector *map[string]string, priority int32) {
+	resource := api.Fleet{
+		Metadata: api.ObjectMeta{
+			Name: &name,
+		},
+		Spec: api.FleetSpec{
+			Selector: &api.LabelSelector{MatchLabels: selector},
+			Priority: &priority,
+		},
+	}
+
+	callback := store.FleetStoreCallback(func(ctx context.Context, before *model.Fleet, after *model.Fleet) {})
 	_, err := fleetStore.Create(ctx, orgId, &resource, callback)
 	if err != nil {
 		log.Fatalf("creating fleet: %v", err)
@@ -139,7 +175,7 @@ func CreateTestTemplateVersion(ctx context.Context, tvStore store.TemplateVersio
 		},
 	}
 
-	callback := store.TemplateVersionStoreCallback(func(tv *model.TemplateVersion) {})
+	callback := store.TemplateVersionStoreCallback(func(ctx context.Context, tv *model.TemplateVersion) {})
 	tv, err := tvStore.Create(ctx, orgId, &resource, callback)
 	if err != nil {
 		return err
@@ -178,7 +214,7 @@ func CreateRepositories(ctx context.Context, numRepositories int, storeInst stor
 			Spec: spec,
 		}
 
-		callback := store.RepositoryStoreCallback(func(*model.Repository) {})
+		callback := store.RepositoryStoreCallback(func(context.Context, *model.Repository) {})
 		_, err = storeInst.Repository().Create(ctx, orgId, &resource, callback)
 		if err != nil {
 			return err
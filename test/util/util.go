@@ -73,7 +73,7 @@ func (t *testProvider) Wait() {
 	t.wg.Wait()
 }
 
-func (t *testProvider) Publish(b []byte) error {
+func (t *testProvider) Publish(ctx context.Context, b []byte) error {
 	t.queue <- b
 	return nil
 }
@@ -119,11 +119,11 @@ func NewTestApiServer(log logrus.FieldLogger, cfg *config.Config, store store.St
 
 	metrics := instrumentation.NewApiMetrics(cfg)
 
-	return apiserver.New(log, cfg, store, ca, listener, provider, metrics), listener, nil
+	return apiserver.New(log, cfg, store, ca, listener, provider, metrics, nil), listener, nil
 }
 
 // NewTestServer creates a new test server and returns the server and the listener listening on localhost's next available port.
-func NewTestAgentServer(log logrus.FieldLogger, cfg *config.Config, store store.Store, ca *crypto.CA, serverCerts *crypto.TLSCertificateConfig) (*agentserver.AgentServer, net.Listener, error) {
+func NewTestAgentServer(log logrus.FieldLogger, cfg *config.Config, store store.Store, ca *crypto.CA, serverCerts *crypto.TLSCertificateConfig, provider queues.Provider) (*agentserver.AgentServer, net.Listener, error) {
 	// create a listener using the next available port
 	_, tlsConfig, _, err := crypto.TLSConfigForServer(ca.Config, serverCerts)
 	if err != nil {
@@ -138,7 +138,7 @@ func NewTestAgentServer(log logrus.FieldLogger, cfg *config.Config, store store.
 
 	metrics := instrumentation.NewApiMetrics(cfg)
 
-	return agentserver.New(log, cfg, store, ca, listener, metrics), listener, nil
+	return agentserver.New(log, cfg, store, ca, listener, provider, metrics), listener, nil
 }
 
 // NewTestStore creates a new test store and returns the store and the database name.
@@ -163,7 +163,7 @@ func NewTestStore(cfg config.Config, log *logrus.Logger) (store.Store, string, e
 		return nil, "", fmt.Errorf("NewTestStore: initializing test db %s: %w", randomDBName, err)
 	}
 
-	dbStore := store.NewStore(db, log.WithField("pkg", "store"))
+	dbStore := store.NewStore(db, nil, log.WithField("pkg", "store"))
 	err = dbStore.InitialMigration()
 	if err != nil {
 		return nil, "", fmt.Errorf("NewTestStore: performing initial migration: %w", err)
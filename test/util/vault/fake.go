@@ -0,0 +1,124 @@
+// Package vault provides an in-process fake of the parts of the Vault HTTP API
+// used by the Vault secret provider: token lookup, AppRole login and the KV
+// version 2 secrets engine mounted at "secret".
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	RoleID   = "fake-role-id"
+	SecretID = "fake-secret-id"
+)
+
+type FakeServer struct {
+	*httptest.Server
+
+	// Token is accepted by the server, and returned by AppRole logins
+	Token string
+
+	mu      sync.Mutex
+	secrets map[string][]map[string]interface{}
+	logins  int
+}
+
+func NewFakeServer() *FakeServer {
+	f := &FakeServer{
+		Token:   "hvs.fake-token",
+		secrets: map[string][]map[string]interface{}{},
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.handle))
+	return f
+}
+
+// WriteSecret writes a new version of a secret and returns its version.
+func (f *FakeServer) WriteSecret(path string, data map[string]interface{}) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.secrets[path] = append(f.secrets[path], data)
+	return len(f.secrets[path])
+}
+
+// Logins returns the number of successful AppRole logins.
+func (f *FakeServer) Logins() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.logins
+}
+
+func (f *FakeServer) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Method == http.MethodPost && r.URL.Path == "/v1/auth/approle/login" {
+		var body struct {
+			RoleID   string `json:"role_id"`
+			SecretID string `json:"secret_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.RoleID != RoleID || body.SecretID != SecretID {
+			writeErrors(w, http.StatusBadRequest, "invalid role or secret ID")
+			return
+		}
+		f.logins++
+		writeJSON(w, map[string]interface{}{"auth": map[string]interface{}{"client_token": f.Token}})
+		return
+	}
+
+	if r.Header.Get("X-Vault-Token") != f.Token {
+		writeErrors(w, http.StatusForbidden, "permission denied")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/auth/token/lookup-self":
+		writeJSON(w, map[string]interface{}{"data": map[string]interface{}{"id": f.Token}})
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v1/secret/data/"):
+		versions, ok := f.secrets[strings.TrimPrefix(r.URL.Path, "/v1/secret/data/")]
+		if !ok {
+			writeErrors(w, http.StatusNotFound)
+			return
+		}
+		version := len(versions)
+		if v := r.URL.Query().Get("version"); v != "" {
+			var err error
+			if version, err = strconv.Atoi(v); err != nil || version < 1 || version > len(versions) {
+				writeErrors(w, http.StatusNotFound)
+				return
+			}
+		}
+		writeJSON(w, map[string]interface{}{"data": map[string]interface{}{
+			"data":     versions[version-1],
+			"metadata": map[string]interface{}{"version": version},
+		}})
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/"):
+		versions, ok := f.secrets[strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/")]
+		if !ok {
+			writeErrors(w, http.StatusNotFound)
+			return
+		}
+		writeJSON(w, map[string]interface{}{"data": map[string]interface{}{"current_version": len(versions)}})
+	default:
+		writeErrors(w, http.StatusNotFound, fmt.Sprintf("no handler for route %q", r.URL.Path))
+	}
+}
+
+func writeJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeErrors(w http.ResponseWriter, statusCode int, errors ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if errors == nil {
+		errors = []string{}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": errors})
+}